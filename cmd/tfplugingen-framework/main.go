@@ -60,16 +60,22 @@ func runCLI(name, versionOutput string, args []string, stdin io.Reader, stdout,
 func initCommands(ui cli.Ui) map[string]cli.CommandFactory {
 	return map[string]cli.CommandFactory{
 		// Code generation commands
-		"generate":              commandFactory(&cmd.GenerateCommand{UI: ui}),
-		"generate all":          commandFactory(&cmd.GenerateAllCommand{UI: ui}),
-		"generate resources":    commandFactory(&cmd.GenerateResourcesCommand{UI: ui}),
-		"generate data-sources": commandFactory(&cmd.GenerateDataSourcesCommand{UI: ui}),
-		"generate provider":     commandFactory(&cmd.GenerateProviderCommand{UI: ui}),
+		"generate":                     commandFactory(&cmd.GenerateCommand{UI: ui}),
+		"generate all":                 commandFactory(&cmd.GenerateAllCommand{UI: ui}),
+		"generate resources":           commandFactory(&cmd.GenerateResourcesCommand{UI: ui}),
+		"generate data-sources":        commandFactory(&cmd.GenerateDataSourcesCommand{UI: ui}),
+		"generate ephemeral-resources": commandFactory(&cmd.GenerateEphemeralResourcesCommand{UI: ui}),
+		"generate provider":            commandFactory(&cmd.GenerateProviderCommand{UI: ui}),
+		"generate functions":           commandFactory(&cmd.GenerateFunctionsCommand{UI: ui}),
+		"generate docs":                commandFactory(&cmd.GenerateDocsCommand{UI: ui}),
+		"generate examples":            commandFactory(&cmd.GenerateExamplesCommand{UI: ui}),
 		// Code scaffolding commands
 		"scaffold":             commandFactory(&cmd.ScaffoldCommand{UI: ui}),
 		"scaffold resource":    commandFactory(&cmd.ScaffoldResourceCommand{UI: ui}),
 		"scaffold data-source": commandFactory(&cmd.ScaffoldDataSourceCommand{UI: ui}),
 		"scaffold provider":    commandFactory(&cmd.ScaffoldProviderCommand{UI: ui}),
+		// Validation commands
+		"validate": commandFactory(&cmd.ValidateCommand{UI: ui}),
 	}
 }
 
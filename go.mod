@@ -7,6 +7,7 @@ require (
 	github.com/greatman/terraform-plugin-codegen-spec v0.0.0-20250323035625-ee71a33e31a7
 	github.com/hashicorp/cli v1.1.7
 	github.com/mattn/go-colorable v0.1.14
+	github.com/xeipuuv/gojsonschema v1.2.0
 )
 
 require (
@@ -30,7 +31,6 @@ require (
 	github.com/stretchr/testify v1.7.2 // indirect
 	github.com/xeipuuv/gojsonpointer v0.0.0-20190905194746-02993c407bfb // indirect
 	github.com/xeipuuv/gojsonreference v0.0.0-20180127040603-bd5ef7bd5415 // indirect
-	github.com/xeipuuv/gojsonschema v1.2.0 // indirect
 	golang.org/x/crypto v0.32.0 // indirect
 	golang.org/x/sys v0.29.0 // indirect
 )
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/output"
+)
+
+// newWriter returns the destination for generated code. If check is true,
+// the generated code is held in memory so that it can be compared with the
+// output directory by checkOutput.
+func newWriter(check bool) output.Writer {
+	if check {
+		return output.NewMemoryWriter()
+	}
+
+	return output.NewFileWriter()
+}
+
+// checkOutput compares the generated code held in memory with the files in
+// the output directory. A diff is output for each file which differs, and
+// files which are missing, or are no longer generated from the specification
+// are listed. An error is returned if there are any differences.
+func checkOutput(ui cli.Ui, w output.Writer, outputPath string, kinds ...output.Kind) error {
+	mw, ok := w.(*output.MemoryWriter)
+	if !ok {
+		return fmt.Errorf("unexpected writer type %T for check", w)
+	}
+
+	differences, err := output.Check(mw, outputPath, kinds...)
+	if err != nil {
+		return fmt.Errorf("error checking generated code: %w", err)
+	}
+
+	if len(differences) == 0 {
+		return nil
+	}
+
+	for _, d := range differences {
+		switch d.Type {
+		case output.DifferenceModified:
+			ui.Output(strings.TrimSuffix(string(d.Diff), "\n"))
+		case output.DifferenceMissing:
+			ui.Output(fmt.Sprintf("%s: file is missing", d.Path))
+		case output.DifferenceExtra:
+			ui.Output(fmt.Sprintf("%s: file is not generated from the specification", d.Path))
+		}
+	}
+
+	return fmt.Errorf("generated code in %s is out of date, %d file(s) differ", outputPath, len(differences))
+}
@@ -5,24 +5,36 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"go/format"
 	"log/slog"
-	"os"
 	"strings"
 
 	"github.com/greatman/terraform-plugin-codegen-spec/spec"
 	"github.com/hashicorp/cli"
 
-	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/input"
-	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/validate"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/output"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/report"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/scaffold"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
 
 type GenerateAllCommand struct {
-	UI              cli.Ui
-	flagIRInputPath string
-	flagOutputPath  string
-	flagPackageName string
+	UI               cli.Ui
+	flagIRInputPath  string
+	flagOutputPath   string
+	flagPackageName  string
+	flagCheck        bool
+	flagTemplatesDir string
+	flagTypeMapping  string
+	flagStrict       bool
+	flagReport       string
+	flagRegistry     bool
+	flagLogLevel     string
+	flagLogFormat    string
 }
 
 func (cmd *GenerateAllCommand) Flags() *flag.FlagSet {
@@ -30,6 +42,13 @@ func (cmd *GenerateAllCommand) Flags() *flag.FlagSet {
 	fs.StringVar(&cmd.flagIRInputPath, "input", "", "path to intermediate representation (JSON)")
 	fs.StringVar(&cmd.flagOutputPath, "output", "./output", "directory path to output generated code files")
 	fs.StringVar(&cmd.flagPackageName, "package", "", "name of Go package for generated code files")
+	fs.BoolVar(&cmd.flagCheck, "check", false, "compare generated code with the output directory, without writing files, and fail if they differ")
+	fs.StringVar(&cmd.flagTemplatesDir, "templates-dir", "", "directory path to templates which override the embedded code generation templates of the same name")
+	fs.StringVar(&cmd.flagTypeMapping, "type-mapping", "", "path to JSON file which maps Terraform types to the Go types and conversions used for associated external types")
+	fs.BoolVar(&cmd.flagStrict, "strict", false, "fail if any to/from methods for associated external types cannot be generated, rather than skipping them")
+	fs.StringVar(&cmd.flagReport, "report", "", "path to JSON file in which to record the schemas processed, files written, Go identifiers generated, skipped paths and timings")
+	fs.BoolVar(&cmd.flagRegistry, "registry", false, "generate "+output.ProviderRegistryFile+", listing the constructors of the data sources and resources, which are expected in the package set by --package (requires --package)")
+	addLogFlags(fs, &cmd.flagLogLevel, &cmd.flagLogFormat)
 
 	return fs
 }
@@ -79,20 +98,22 @@ func (cmd *GenerateAllCommand) Synopsis() string {
 func (cmd *GenerateAllCommand) Run(args []string) int {
 	ctx := context.Background()
 
-	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
-		Level: slog.LevelWarn,
-	}))
-
 	fs := cmd.Flags()
 	err := fs.Parse(args)
 	if err != nil {
-		logger.Error("error parsing command flags", "err", err)
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
+	logger, err := newLogger(cmd.flagLogLevel, cmd.flagLogFormat)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
 		return 1
 	}
 
 	err = cmd.runInternal(ctx, logger)
 	if err != nil {
-		logger.Error("error executing command", "err", err)
+		outputError(cmd.UI, logger, cmd.flagLogFormat, err)
 		return 1
 	}
 
@@ -100,38 +121,132 @@ func (cmd *GenerateAllCommand) Run(args []string) int {
 }
 
 func (cmd *GenerateAllCommand) runInternal(ctx context.Context, logger *slog.Logger) error {
-	// read input file
-	src, err := input.Read(cmd.flagIRInputPath)
-	if err != nil {
-		return fmt.Errorf("error reading IR JSON: %w", err)
+	// the registry lists constructors without package qualifiers, which only
+	// compiles if all generated and scaffolded code shares a single package
+	if cmd.flagRegistry && cmd.flagPackageName == "" {
+		return errors.New("--registry flag requires the --package flag")
 	}
 
-	// validate JSON
-	err = validate.JSON(src)
+	// read, validate and parse input file
+	src, spec, ext, err := readSpecification(ctx, cmd.flagIRInputPath)
 	if err != nil {
-		return fmt.Errorf("error validating IR JSON: %w", err)
+		return err
 	}
 
-	// parse and validate IR against specification
-	spec, err := spec.Parse(ctx, src)
-	if err != nil {
-		return fmt.Errorf("error parsing IR JSON: %w", err)
+	// locate errors found after parsing within the input file
+	ctx = diagnostic.SetDocumentInContext(ctx, src)
+
+	var options schema.GeneratorOptions
+
+	// override embedded templates with any supplied templates
+	if cmd.flagTemplatesDir != "" {
+		options.Templates, err = schema.LoadTemplates(cmd.flagTemplatesDir)
+		if err != nil {
+			return fmt.Errorf("error loading templates: %w", err)
+		}
+	}
+
+	// configure the Go types of associated external types
+	if cmd.flagTypeMapping != "" {
+		options.TypeMappings, err = schema.LoadTypeMappings(cmd.flagTypeMapping)
+		if err != nil {
+			return fmt.Errorf("error loading type mappings: %w", err)
+		}
 	}
 
-	err = generateDataSourceCode(ctx, spec, cmd.flagOutputPath, cmd.flagPackageName, "DataSource", logger)
+	// fail on, or summarise, unimplemented to/from methods
+	unimplemented := schema.NewUnimplementedTracker(cmd.flagStrict)
+	ctx = schema.SetUnimplementedTrackerInContext(ctx, unimplemented)
+
+	// record the generated code, if a report is requested
+	var rep *report.Report
+
+	if cmd.flagReport != "" {
+		rep = report.New()
+		ctx = report.SetReportInContext(ctx, rep)
+	}
+
+	w := newWriter(cmd.flagCheck)
+
+	err = generateDataSourceCode(ctx, spec, ext, options, rep.Writer(w), cmd.flagOutputPath, cmd.flagPackageName, "DataSource", logger)
 	if err != nil {
 		return fmt.Errorf("error generating data source code: %w", err)
 	}
 
-	err = generateResourceCode(ctx, spec, cmd.flagOutputPath, cmd.flagPackageName, "Resource", logger)
+	err = generateEphemeralResourceCode(ctx, ext, options, rep.Writer(w), cmd.flagOutputPath, cmd.flagPackageName, "EphemeralResource", logger)
+	if err != nil {
+		return fmt.Errorf("error generating ephemeral resource code: %w", err)
+	}
+
+	err = generateResourceCode(ctx, spec, ext, options, rep.Writer(w), cmd.flagOutputPath, cmd.flagPackageName, "Resource", logger)
 	if err != nil {
 		return fmt.Errorf("error generating resource code: %w", err)
 	}
 
-	err = generateProviderCode(ctx, spec, cmd.flagOutputPath, cmd.flagPackageName, "Provider", logger)
+	err = generateProviderCode(ctx, spec, ext, options, rep.Writer(w), cmd.flagOutputPath, cmd.flagPackageName, "Provider", logger)
 	if err != nil {
 		return fmt.Errorf("error generating provider code: %w", err)
 	}
 
+	err = generateFunctionCode(ctx, ext, rep.Writer(w), cmd.flagOutputPath, cmd.flagPackageName, logger)
+	if err != nil {
+		return fmt.Errorf("error generating function code: %w", err)
+	}
+
+	if cmd.flagRegistry {
+		err = generateProviderRegistryCode(spec, rep.Writer(w), cmd.flagOutputPath, cmd.flagPackageName)
+		if err != nil {
+			return fmt.Errorf("error generating provider registry code: %w", err)
+		}
+	}
+
+	outputSkipped(cmd.UI, unimplemented, cmd.flagLogFormat)
+
+	if cmd.flagReport != "" {
+		err = writeReport(rep, unimplemented, cmd.flagReport)
+		if err != nil {
+			return fmt.Errorf("error writing report: %w", err)
+		}
+	}
+
+	if cmd.flagCheck {
+		return checkOutput(cmd.UI, w, cmd.flagOutputPath, output.DataSourceKind, output.EphemeralResourceKind, output.FunctionKind, output.ProviderKind, output.ResourceKind)
+	}
+
+	return nil
+}
+
+// generateProviderRegistryCode lists the constructors of the data sources and
+// resources, which are named as in the scaffolding code. The registry is
+// written to the packageName package, into which all generated code is
+// placed, and in which the scaffolded constructors are expected to be defined.
+func generateProviderRegistryCode(spec spec.Specification, w output.Writer, outputPath, packageName string) error {
+	dataSources := make([]schema.FrameworkIdentifier, 0, len(spec.DataSources))
+
+	for _, d := range spec.DataSources {
+		dataSources = append(dataSources, schema.FrameworkIdentifier(d.Name))
+	}
+
+	resources := make([]schema.FrameworkIdentifier, 0, len(spec.Resources))
+
+	for _, r := range spec.Resources {
+		resources = append(resources, schema.FrameworkIdentifier(r.Name))
+	}
+
+	registry, err := scaffold.RegistryBytes(packageName, dataSources, resources)
+	if err != nil {
+		return fmt.Errorf("error creating provider registry Go code: %w", err)
+	}
+
+	formattedRegistry, err := format.Source(registry)
+	if err != nil {
+		return fmt.Errorf("error formatting Go code: %w", err)
+	}
+
+	err = output.WriteProviderRegistry(w, formattedRegistry, outputPath)
+	if err != nil {
+		return fmt.Errorf("error writing Go code to output: %w", err)
+	}
+
 	return nil
 }
@@ -4,8 +4,13 @@
 package cmd_test
 
 import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/cli"
 
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/cmd"
@@ -55,3 +60,224 @@ func TestGenerateAllCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateAllCommand_Check(t *testing.T) {
+	t.Parallel()
+
+	goldenFileDir := "testdata/custom_and_external/all_output/specified_pkg_name"
+
+	testCases := map[string]struct {
+		modify       func(t *testing.T, outputDir string)
+		expectedCode int
+		expected     []string
+	}{
+		"up-to-date": {
+			modify:       func(t *testing.T, outputDir string) {},
+			expectedCode: 0,
+		},
+		"modified": {
+			modify: func(t *testing.T, outputDir string) {
+				path := filepath.Join(outputDir, "example_resource_gen.go")
+
+				b, err := os.ReadFile(path)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				err = os.WriteFile(path, bytes.Replace(b, []byte("ExampleResourceSchema"), []byte("ModifiedResourceSchema"), 1), 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			expectedCode: 1,
+			expected: []string{
+				"--- a/example_resource_gen.go\n+++ b/example_resource_gen.go\n",
+				"-func ModifiedResourceSchema(ctx context.Context) schema.Schema {\n+func ExampleResourceSchema(ctx context.Context) schema.Schema {\n",
+			},
+		},
+		"missing": {
+			modify: func(t *testing.T, outputDir string) {
+				err := os.Remove(filepath.Join(outputDir, "example_provider_gen.go"))
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			expectedCode: 1,
+			expected: []string{
+				"example_provider_gen.go: file is missing\n",
+			},
+		},
+		"extra": {
+			modify: func(t *testing.T, outputDir string) {
+				err := os.WriteFile(filepath.Join(outputDir, "removed_data_source_gen.go"), nil, 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			expectedCode: 1,
+			expected: []string{
+				"removed_data_source_gen.go: file is not generated from the specification\n",
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testOutputDir := t.TempDir()
+
+			entries, err := os.ReadDir(goldenFileDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, entry := range entries {
+				b, err := os.ReadFile(filepath.Join(goldenFileDir, entry.Name()))
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				err = os.WriteFile(filepath.Join(testOutputDir, entry.Name()), b, 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			testCase.modify(t, testOutputDir)
+
+			mockUi := cli.NewMockUi()
+			c := cmd.GenerateAllCommand{
+				UI: mockUi,
+			}
+
+			args := []string{
+				"--input", "testdata/custom_and_external/ir.json",
+				"--package", "specified",
+				"--output", testOutputDir,
+				"--check",
+			}
+
+			exitCode := c.Run(args)
+			if exitCode != testCase.expectedCode {
+				t.Fatalf("expected exit code %d, got %d: %s", testCase.expectedCode, exitCode, mockUi.OutputWriter.String())
+			}
+
+			for _, expected := range testCase.expected {
+				if !strings.Contains(mockUi.OutputWriter.String(), expected) {
+					t.Errorf("expected output to contain %q, got: %s", expected, mockUi.OutputWriter.String())
+				}
+			}
+
+			// the output directory is left unchanged
+			if testCase.expectedCode == 0 {
+				compareDirectories(t, goldenFileDir, testOutputDir)
+			}
+		})
+	}
+}
+
+func TestGenerateAllCommand_Registry(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pkgName  string
+		expected string
+	}{
+		"specified_pkg_name": {
+			pkgName: "specified",
+			expected: `// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package specified
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// DataSources returns the constructors of the data sources defined in the
+// specification, for use in the DataSources method of the provider.
+func DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewExampleDataSource,
+	}
+}
+
+// Resources returns the constructors of the resources defined in the
+// specification, for use in the Resources method of the provider.
+func Resources() []func() resource.Resource {
+	return []func() resource.Resource{
+		NewExampleResource,
+	}
+}
+`,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testOutputDir := t.TempDir()
+			mockUi := cli.NewMockUi()
+			c := cmd.GenerateAllCommand{
+				UI: mockUi,
+			}
+
+			args := []string{
+				"--input", "testdata/custom_and_external/ir.json",
+				"--package", testCase.pkgName,
+				"--output", testOutputDir,
+				"--registry",
+			}
+
+			exitCode := c.Run(args)
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running `generate all` cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			got, err := os.ReadFile(filepath.Join(testOutputDir, "provider_registry_gen.go"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateAllCommand_RegistryWithoutPackage(t *testing.T) {
+	t.Parallel()
+
+	testOutputDir := t.TempDir()
+	mockUi := cli.NewMockUi()
+	c := cmd.GenerateAllCommand{
+		UI: mockUi,
+	}
+
+	args := []string{
+		"--input", "testdata/custom_and_external/ir.json",
+		"--output", testOutputDir,
+		"--registry",
+	}
+
+	exitCode := c.Run(args)
+	if exitCode != 1 {
+		t.Fatalf("expected exit code 1, got %d", exitCode)
+	}
+
+	if !strings.Contains(mockUi.ErrorWriter.String(), "--registry flag requires the --package flag") {
+		t.Errorf("unexpected error output: %s", mockUi.ErrorWriter.String())
+	}
+
+	entries, err := os.ReadDir(testOutputDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 0 {
+		t.Errorf("expected no files to be written, got %d", len(entries))
+	}
+}
@@ -5,30 +5,38 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
-	"log"
 	"log/slog"
-	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/greatman/terraform-plugin-codegen-spec/spec"
 	"github.com/hashicorp/cli"
 
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/datasource"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/format"
-	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/input"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/output"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/report"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
-	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/validate"
 )
 
 type GenerateDataSourcesCommand struct {
-	UI              cli.Ui
-	flagIRInputPath string
-	flagOutputPath  string
-	flagPackageName string
+	UI               cli.Ui
+	flagIRInputPath  string
+	flagOutputPath   string
+	flagPackageName  string
+	flagCheck        bool
+	flagTemplatesDir string
+	flagTypeMapping  string
+	flagStrict       bool
+	flagReport       string
+	flagLogLevel     string
+	flagLogFormat    string
 }
 
 func (cmd *GenerateDataSourcesCommand) Flags() *flag.FlagSet {
@@ -36,6 +44,12 @@ func (cmd *GenerateDataSourcesCommand) Flags() *flag.FlagSet {
 	fs.StringVar(&cmd.flagIRInputPath, "input", "./ir.json", "path to intermediate representation (JSON)")
 	fs.StringVar(&cmd.flagOutputPath, "output", "./output", "directory path to output generated code files")
 	fs.StringVar(&cmd.flagPackageName, "package", "", "name of Go package for generated code files")
+	fs.BoolVar(&cmd.flagCheck, "check", false, "compare generated code with the output directory, without writing files, and fail if they differ")
+	fs.StringVar(&cmd.flagTemplatesDir, "templates-dir", "", "directory path to templates which override the embedded code generation templates of the same name")
+	fs.StringVar(&cmd.flagTypeMapping, "type-mapping", "", "path to JSON file which maps Terraform types to the Go types and conversions used for associated external types")
+	fs.BoolVar(&cmd.flagStrict, "strict", false, "fail if any to/from methods for associated external types cannot be generated, rather than skipping them")
+	fs.StringVar(&cmd.flagReport, "report", "", "path to JSON file in which to record the schemas processed, files written, Go identifiers generated, skipped paths and timings")
+	addLogFlags(fs, &cmd.flagLogLevel, &cmd.flagLogFormat)
 
 	return fs
 }
@@ -85,10 +99,6 @@ func (a *GenerateDataSourcesCommand) Synopsis() string {
 func (cmd *GenerateDataSourcesCommand) Run(args []string) int {
 	ctx := context.Background()
 
-	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
-		Level: slog.LevelWarn,
-	}))
-
 	fs := cmd.Flags()
 	err := fs.Parse(args)
 	if err != nil {
@@ -96,9 +106,15 @@ func (cmd *GenerateDataSourcesCommand) Run(args []string) int {
 		return 1
 	}
 
+	logger, err := newLogger(cmd.flagLogLevel, cmd.flagLogFormat)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
 	err = cmd.runInternal(ctx, logger)
 	if err != nil {
-		cmd.UI.Error(fmt.Sprintf("Error executing command: %s\n", err))
+		outputError(cmd.UI, logger, cmd.flagLogFormat, err)
 		return 1
 	}
 
@@ -106,95 +122,167 @@ func (cmd *GenerateDataSourcesCommand) Run(args []string) int {
 }
 
 func (cmd *GenerateDataSourcesCommand) runInternal(ctx context.Context, logger *slog.Logger) error {
-	// read input file
-	src, err := input.Read(cmd.flagIRInputPath)
+	// read, validate and parse input file
+	src, spec, ext, err := readSpecification(ctx, cmd.flagIRInputPath)
 	if err != nil {
-		return fmt.Errorf("error reading IR JSON: %w", err)
+		return err
 	}
 
-	// validate JSON
-	err = validate.JSON(src)
-	if err != nil {
-		return fmt.Errorf("error validating IR JSON: %w", err)
+	// locate errors found after parsing within the input file
+	ctx = diagnostic.SetDocumentInContext(ctx, src)
+
+	var options schema.GeneratorOptions
+
+	// override embedded templates with any supplied templates
+	if cmd.flagTemplatesDir != "" {
+		options.Templates, err = schema.LoadTemplates(cmd.flagTemplatesDir)
+		if err != nil {
+			return fmt.Errorf("error loading templates: %w", err)
+		}
 	}
 
-	// parse and validate IR against specification
-	spec, err := spec.Parse(ctx, src)
-	if err != nil {
-		return fmt.Errorf("error parsing IR JSON: %w", err)
+	// configure the Go types of associated external types
+	if cmd.flagTypeMapping != "" {
+		options.TypeMappings, err = schema.LoadTypeMappings(cmd.flagTypeMapping)
+		if err != nil {
+			return fmt.Errorf("error loading type mappings: %w", err)
+		}
 	}
 
-	err = generateDataSourceCode(ctx, spec, cmd.flagOutputPath, cmd.flagPackageName, "DataSource", logger)
+	// fail on, or summarise, unimplemented to/from methods
+	unimplemented := schema.NewUnimplementedTracker(cmd.flagStrict)
+	ctx = schema.SetUnimplementedTrackerInContext(ctx, unimplemented)
+
+	// record the generated code, if a report is requested
+	var rep *report.Report
+
+	if cmd.flagReport != "" {
+		rep = report.New()
+		ctx = report.SetReportInContext(ctx, rep)
+	}
+
+	w := newWriter(cmd.flagCheck)
+
+	err = generateDataSourceCode(ctx, spec, ext, options, rep.Writer(w), cmd.flagOutputPath, cmd.flagPackageName, "DataSource", logger)
 	if err != nil {
 		return fmt.Errorf("error generating data source code: %w", err)
 	}
 
+	outputSkipped(cmd.UI, unimplemented, cmd.flagLogFormat)
+
+	if cmd.flagReport != "" {
+		err = writeReport(rep, unimplemented, cmd.flagReport)
+		if err != nil {
+			return fmt.Errorf("error writing report: %w", err)
+		}
+	}
+
+	if cmd.flagCheck {
+		return checkOutput(cmd.UI, w, cmd.flagOutputPath, output.DataSourceKind)
+	}
+
 	return nil
 }
 
-func generateDataSourceCode(ctx context.Context, spec spec.Specification, outputPath, packageName, generatorType string, logger *slog.Logger) error {
+func generateDataSourceCode(ctx context.Context, spec spec.Specification, ext extension.Specification, options schema.GeneratorOptions, w output.Writer, outputPath, packageName, generatorType string, logger *slog.Logger) error {
 	ctxWithPath := logging.SetPathInContext(ctx, "data_source")
 
+	timer := report.GetReportFromContext(ctx).Timer("data_source")
+
 	// convert IR to framework schema
-	s, err := datasource.NewSchemas(spec)
+	s, err := datasource.NewSchemas(spec, ext)
 	if err != nil {
-		return fmt.Errorf("error converting IR to Plugin Framework schema: %w", err)
+		return fmt.Errorf("error converting IR to Plugin Framework schema: %w", diagnostic.WithPositionsFromContext(ctx, err))
 	}
 
-	// convert framework schema to []byte
-	g := schema.NewGeneratorSchemas(s)
-	schemas, err := g.Schemas(packageName, generatorType)
-	if err != nil {
-		return fmt.Errorf("error converting Plugin Framework schema to Go code: %w", err)
-	}
+	g := schema.NewGeneratorSchemas(s, options)
+
+	// errors are collected across all generation steps, so that every
+	// schema which cannot be generated is reported
+	var errs []error
 
 	// generate model code
 	models, err := g.Models()
 	if err != nil {
-		log.Fatal(err)
+		errs = append(errs, fmt.Errorf("error generating model Go code: %w", withPath(ctxWithPath, err)))
 	}
 
+	timer.Phase("models")
+
 	// generate custom type and value types code
 	customTypeValue, err := g.CustomTypeValue()
 	if err != nil {
-		log.Fatal(err)
+		errs = append(errs, fmt.Errorf("error generating custom type and value type Go code: %w", withPath(ctxWithPath, err)))
 	}
 
+	timer.Phase("custom_types")
+
 	// generate "expand" and "flatten" code
 	toFromFunctions, err := g.ToFromFunctions(ctxWithPath, logger)
 	if err != nil {
-		log.Fatal(err)
+		errs = append(errs, fmt.Errorf("error generating to/from Go code: %w", withPath(ctxWithPath, err)))
+	}
+
+	timer.Phase("to_from")
+
+	// convert framework schema to []byte, importing the packages of the
+	// type mappings used by the "expand" and "flatten" code
+	schemas, err := g.Schemas(packageName, generatorType)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error converting Plugin Framework schema to Go code: %w", withPath(ctxWithPath, err)))
+	}
+
+	timer.Phase("schema")
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
 	}
 
 	// format schema code
 	formattedSchemas, err := format.Format(schemas)
 	if err != nil {
-		return fmt.Errorf("error formatting Go code: %w", err)
+		errs = append(errs, fmt.Errorf("error formatting schema Go code: %w", err))
 	}
 
 	// format model code
 	formattedModels, err := format.Format(models)
 	if err != nil {
-		log.Fatal(err)
+		errs = append(errs, fmt.Errorf("error formatting model Go code: %w", err))
 	}
 
 	// format custom type and value types code
 	formattedCustomTypeValue, err := format.Format(customTypeValue)
 	if err != nil {
-		log.Fatal(err)
+		errs = append(errs, fmt.Errorf("error formatting custom type and value type Go code: %w", err))
 	}
 
 	// format "expand" and "flatten" code
 	formattedToFromFunctions, err := format.Format(toFromFunctions)
 	if err != nil {
-		log.Fatal(err)
+		errs = append(errs, fmt.Errorf("error formatting to/from Go code: %w", err))
+	}
+
+	timer.Phase("format")
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
 	}
 
 	// write code
-	err = output.WriteDataSources(formattedSchemas, formattedModels, formattedCustomTypeValue, formattedToFromFunctions, outputPath, packageName)
+	err = output.WriteDataSources(w, formattedSchemas, formattedModels, formattedCustomTypeValue, formattedToFromFunctions, outputPath, packageName)
 	if err != nil {
 		return fmt.Errorf("error writing Go code to output: %w", err)
 	}
 
+	timer.Phase("write")
+
+	// record the generated code, if a report was requested
+	for name := range formattedSchemas {
+		err = report.GetReportFromContext(ctx).AddSchema("data_source", name, filepath.Join(outputPath, output.DataSourceKind.Path(name, packageName)), formattedCustomTypeValue[name], formattedToFromFunctions[name])
+		if err != nil {
+			return fmt.Errorf("error recording generated code in report: %w", err)
+		}
+	}
+
 	return nil
 }
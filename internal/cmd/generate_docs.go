@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/spec"
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/datasource"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/output"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/provider"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+type GenerateDocsCommand struct {
+	UI                cli.Ui
+	flagIRInputPath   string
+	flagOutputPath    string
+	flagTemplatesPath string
+	flagCheck         bool
+	flagLogLevel      string
+	flagLogFormat     string
+}
+
+func (cmd *GenerateDocsCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("generate docs", flag.ExitOnError)
+	fs.StringVar(&cmd.flagIRInputPath, "input", "./ir.json", "path to intermediate representation (JSON)")
+	fs.StringVar(&cmd.flagOutputPath, "output", "./docs", "directory path to output generated documentation files")
+	fs.StringVar(&cmd.flagTemplatesPath, "templates", "", "directory path to documentation templates which override the default layout")
+	fs.BoolVar(&cmd.flagCheck, "check", false, "compare generated documentation with the output directory, without writing files, and fail if they differ")
+	addLogFlags(fs, &cmd.flagLogLevel, &cmd.flagLogFormat)
+
+	return fs
+}
+
+func (cmd *GenerateDocsCommand) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugingen-framework generate docs [<args>]\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\nTemplates in the templates directory override the default layout:\n\n")
+	strBuilder.WriteString("    index.md.tmpl                  provider\n")
+	strBuilder.WriteString("    resources.md.tmpl              all resources\n")
+	strBuilder.WriteString("    resources/<name>.md.tmpl       named resource\n")
+	strBuilder.WriteString("    data-sources.md.tmpl           all data sources\n")
+	strBuilder.WriteString("    data-sources/<name>.md.tmpl    named data source\n")
+	strBuilder.WriteString("\n")
+
+	return strBuilder.String()
+}
+
+func (a *GenerateDocsCommand) Synopsis() string {
+	return "Generate Terraform Registry documentation for the provider, resources and data sources from an Intermediate Representation (IR) JSON file."
+}
+
+func (cmd *GenerateDocsCommand) Run(args []string) int {
+	ctx := context.Background()
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
+	logger, err := newLogger(cmd.flagLogLevel, cmd.flagLogFormat)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
+	err = cmd.runInternal(ctx)
+	if err != nil {
+		outputError(cmd.UI, logger, cmd.flagLogFormat, err)
+		return 1
+	}
+
+	return 0
+}
+
+func (cmd *GenerateDocsCommand) runInternal(ctx context.Context) error {
+	// read, validate and parse input file
+	src, spec, ext, err := readSpecification(ctx, cmd.flagIRInputPath)
+	if err != nil {
+		return err
+	}
+
+	// locate errors found after parsing within the input file
+	ctx = diagnostic.SetDocumentInContext(ctx, src)
+
+	w := newWriter(cmd.flagCheck)
+
+	err = generateDocs(ctx, spec, ext, w, cmd.flagOutputPath, cmd.flagTemplatesPath)
+	if err != nil {
+		return fmt.Errorf("error generating documentation: %w", err)
+	}
+
+	if cmd.flagCheck {
+		return checkOutput(cmd.UI, w, cmd.flagOutputPath)
+	}
+
+	return nil
+}
+
+func generateDocs(ctx context.Context, spec spec.Specification, ext extension.Specification, w output.Writer, outputPath, templatesPath string) error {
+	providerName := spec.Provider.Name
+
+	// provider documentation is written to the index page, which is
+	// expected by the registry even if the provider has no schema
+	s, err := provider.NewSchemas(spec, ext)
+	if err != nil {
+		return fmt.Errorf("error converting IR to Plugin Framework schema: %w", diagnostic.WithPositionsFromContext(ctx, err))
+	}
+
+	templates, err := docsTemplates(templatesPath, "index.md.tmpl", "", nil)
+	if err != nil {
+		return err
+	}
+
+	docs, err := schema.NewGeneratorSchemas(s, schema.GeneratorOptions{}).Docs(providerName, "Provider", templates)
+	if err != nil {
+		return err
+	}
+
+	err = output.WriteDocs(w, map[string][]byte{"index": docs[providerName]}, outputPath)
+	if err != nil {
+		return fmt.Errorf("error writing documentation to output: %w", err)
+	}
+
+	resourceSchemas, err := resource.NewSchemas(spec, ext)
+	if err != nil {
+		return fmt.Errorf("error converting IR to Plugin Framework schema: %w", diagnostic.WithPositionsFromContext(ctx, err))
+	}
+
+	dataSourceSchemas, err := datasource.NewSchemas(spec, ext)
+	if err != nil {
+		return fmt.Errorf("error converting IR to Plugin Framework schema: %w", diagnostic.WithPositionsFromContext(ctx, err))
+	}
+
+	for _, d := range []struct {
+		dir      string
+		docsType string
+		schemas  map[string]schema.GeneratorSchema
+	}{
+		{
+			dir:      "resources",
+			docsType: "Resource",
+			schemas:  resourceSchemas,
+		},
+		{
+			dir:      "data-sources",
+			docsType: "Data Source",
+			schemas:  dataSourceSchemas,
+		},
+	} {
+		names := make([]string, 0, len(d.schemas))
+
+		for k := range d.schemas {
+			names = append(names, k)
+		}
+
+		templates, err := docsTemplates(templatesPath, d.dir+".md.tmpl", d.dir, names)
+		if err != nil {
+			return err
+		}
+
+		docs, err := schema.NewGeneratorSchemas(d.schemas, schema.GeneratorOptions{}).Docs(providerName, d.docsType, templates)
+		if err != nil {
+			return err
+		}
+
+		err = output.WriteDocs(w, docs, filepath.Join(outputPath, d.dir))
+		if err != nil {
+			return fmt.Errorf("error writing documentation to output: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// docsTemplates reads the templates which override the default documentation
+// layout. The defaultFile is used in place of the embedded template for all
+// entities, and a file named for an entity within the overrideDir is used for
+// only that entity.
+func docsTemplates(templatesPath, defaultFile, overrideDir string, names []string) (schema.DocsTemplates, error) {
+	templates := schema.DocsTemplates{
+		Default:   schema.DocsMarkdownTemplate,
+		Overrides: make(map[string]string),
+	}
+
+	if templatesPath == "" {
+		return templates, nil
+	}
+
+	b, err := readTemplate(filepath.Join(templatesPath, defaultFile))
+	if err != nil {
+		return schema.DocsTemplates{}, err
+	}
+
+	if b != nil {
+		templates.Default = string(b)
+	}
+
+	if overrideDir == "" {
+		return templates, nil
+	}
+
+	for _, name := range names {
+		b, err := readTemplate(filepath.Join(templatesPath, overrideDir, name+".md.tmpl"))
+		if err != nil {
+			return schema.DocsTemplates{}, err
+		}
+
+		if b != nil {
+			templates.Overrides[name] = string(b)
+		}
+	}
+
+	return templates, nil
+}
+
+// readTemplate returns nil if the template file does not exist.
+func readTemplate(path string) ([]byte, error) {
+	b, err := os.ReadFile(path)
+
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("error reading template %s: %w", path, err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/cmd"
+)
+
+func TestGenerateDocsCommand(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		irInputPath   string
+		templatesDir  string
+		goldenFileDir string
+	}{
+		"custom_and_external": {
+			irInputPath:   "testdata/custom_and_external/ir.json",
+			goldenFileDir: "testdata/custom_and_external/docs_output",
+		},
+		"custom_and_external_templates": {
+			irInputPath:   "testdata/custom_and_external/ir.json",
+			templatesDir:  "testdata/docs_templates",
+			goldenFileDir: "testdata/custom_and_external/docs_templates_output",
+		},
+		"provider_no_attributes": {
+			irInputPath:   "testdata/provider_no_attributes/ir.json",
+			goldenFileDir: "testdata/provider_no_attributes/docs_output",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testOutputDir := t.TempDir()
+			mockUi := cli.NewMockUi()
+			c := cmd.GenerateDocsCommand{
+				UI: mockUi,
+			}
+
+			args := []string{
+				"--input", testCase.irInputPath,
+				"--templates", testCase.templatesDir,
+				"--output", testOutputDir,
+			}
+
+			exitCode := c.Run(args)
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running `generate docs` cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			compareDirectories(t, testCase.goldenFileDir, testOutputDir)
+		})
+	}
+}
+
+func TestGenerateDocsCommand_InvalidTemplate(t *testing.T) {
+	t.Parallel()
+
+	templatesDir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(templatesDir, "data-sources.md.tmpl"), []byte("# {{.Name}"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mockUi := cli.NewMockUi()
+	c := cmd.GenerateDocsCommand{
+		UI: mockUi,
+	}
+
+	args := []string{
+		"--input", "testdata/custom_and_external/ir.json",
+		"--templates", templatesDir,
+		"--output", t.TempDir(),
+	}
+
+	exitCode := c.Run(args)
+	if exitCode != 1 {
+		t.Fatalf("expected exit code 1, got %d", exitCode)
+	}
+
+	expected := "error parsing documentation template for example"
+
+	if !strings.Contains(mockUi.ErrorWriter.String(), expected) {
+		t.Errorf("expected error to contain %q, got: %s", expected, mockUi.ErrorWriter.String())
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/spec"
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/datasource"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/output"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+type GenerateExamplesCommand struct {
+	UI              cli.Ui
+	flagIRInputPath string
+	flagOutputPath  string
+	flagOptional    bool
+	flagCheck       bool
+	flagLogLevel    string
+	flagLogFormat   string
+}
+
+func (cmd *GenerateExamplesCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("generate examples", flag.ExitOnError)
+	fs.StringVar(&cmd.flagIRInputPath, "input", "./ir.json", "path to intermediate representation (JSON)")
+	fs.StringVar(&cmd.flagOutputPath, "output", "./examples", "directory path to output generated example configuration files")
+	fs.BoolVar(&cmd.flagOptional, "optional", false, "include optional attributes and blocks in the example configurations")
+	fs.BoolVar(&cmd.flagCheck, "check", false, "compare generated examples with the output directory, without writing files, and fail if they differ")
+	addLogFlags(fs, &cmd.flagLogLevel, &cmd.flagLogFormat)
+
+	return fs
+}
+
+func (cmd *GenerateExamplesCommand) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugingen-framework generate examples [<args>]\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+
+	return strBuilder.String()
+}
+
+func (a *GenerateExamplesCommand) Synopsis() string {
+	return "Generate example Terraform configurations for resources and data sources from an Intermediate Representation (IR) JSON file."
+}
+
+func (cmd *GenerateExamplesCommand) Run(args []string) int {
+	ctx := context.Background()
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
+	logger, err := newLogger(cmd.flagLogLevel, cmd.flagLogFormat)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
+	err = cmd.runInternal(ctx)
+	if err != nil {
+		outputError(cmd.UI, logger, cmd.flagLogFormat, err)
+		return 1
+	}
+
+	return 0
+}
+
+func (cmd *GenerateExamplesCommand) runInternal(ctx context.Context) error {
+	// read, validate and parse input file
+	src, spec, ext, err := readSpecification(ctx, cmd.flagIRInputPath)
+	if err != nil {
+		return err
+	}
+
+	// locate errors found after parsing within the input file
+	ctx = diagnostic.SetDocumentInContext(ctx, src)
+
+	w := newWriter(cmd.flagCheck)
+
+	err = generateExamples(ctx, spec, ext, w, cmd.flagOutputPath, cmd.flagOptional)
+	if err != nil {
+		return fmt.Errorf("error generating examples: %w", err)
+	}
+
+	if cmd.flagCheck {
+		return checkOutput(cmd.UI, w, cmd.flagOutputPath)
+	}
+
+	return nil
+}
+
+func generateExamples(ctx context.Context, spec spec.Specification, ext extension.Specification, w output.Writer, outputPath string, includeOptional bool) error {
+	resourceSchemas, err := resource.NewSchemas(spec, ext)
+	if err != nil {
+		return fmt.Errorf("error converting IR to Plugin Framework schema: %w", diagnostic.WithPositionsFromContext(ctx, err))
+	}
+
+	dataSourceSchemas, err := datasource.NewSchemas(spec, ext)
+	if err != nil {
+		return fmt.Errorf("error converting IR to Plugin Framework schema: %w", diagnostic.WithPositionsFromContext(ctx, err))
+	}
+
+	for _, e := range []struct {
+		dir       string
+		filename  string
+		blockType string
+		schemas   map[string]schema.GeneratorSchema
+	}{
+		{
+			dir:       "resources",
+			filename:  "resource.tf",
+			blockType: "resource",
+			schemas:   resourceSchemas,
+		},
+		{
+			dir:       "data-sources",
+			filename:  "data-source.tf",
+			blockType: "data",
+			schemas:   dataSourceSchemas,
+		},
+	} {
+		examples, err := schema.NewGeneratorSchemas(e.schemas, schema.GeneratorOptions{}).Examples(spec.Provider.Name, e.blockType, includeOptional)
+		if err != nil {
+			return err
+		}
+
+		err = output.WriteExamples(w, examples, filepath.Join(outputPath, e.dir), e.filename)
+		if err != nil {
+			return fmt.Errorf("error writing examples to output: %w", err)
+		}
+	}
+
+	return nil
+}
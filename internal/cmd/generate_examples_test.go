@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/cmd"
+)
+
+func TestGenerateExamplesCommand(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		irInputPath   string
+		optional      bool
+		goldenFileDir string
+	}{
+		"custom_and_external": {
+			irInputPath:   "testdata/custom_and_external/ir.json",
+			goldenFileDir: "testdata/custom_and_external/examples_output",
+		},
+		"custom_and_external_optional": {
+			irInputPath:   "testdata/custom_and_external/ir.json",
+			optional:      true,
+			goldenFileDir: "testdata/custom_and_external/examples_optional_output",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testOutputDir := t.TempDir()
+			mockUi := cli.NewMockUi()
+			c := cmd.GenerateExamplesCommand{
+				UI: mockUi,
+			}
+
+			args := []string{
+				"--input", testCase.irInputPath,
+				"--output", testOutputDir,
+			}
+
+			if testCase.optional {
+				args = append(args, "--optional")
+			}
+
+			exitCode := c.Run(args)
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running `generate examples` cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			compareDirectories(t, testCase.goldenFileDir, testOutputDir)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/format"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/function"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/output"
+)
+
+type GenerateFunctionsCommand struct {
+	UI              cli.Ui
+	flagIRInputPath string
+	flagOutputPath  string
+	flagPackageName string
+	flagCheck       bool
+	flagLogLevel    string
+	flagLogFormat   string
+}
+
+func (cmd *GenerateFunctionsCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("generate functions", flag.ExitOnError)
+	fs.StringVar(&cmd.flagIRInputPath, "input", "./ir.json", "path to intermediate representation (JSON)")
+	fs.StringVar(&cmd.flagOutputPath, "output", "./output", "directory path to output generated code files")
+	fs.StringVar(&cmd.flagPackageName, "package", "", "name of Go package for generated code files")
+	fs.BoolVar(&cmd.flagCheck, "check", false, "compare generated code with the output directory, without writing files, and fail if they differ")
+	addLogFlags(fs, &cmd.flagLogLevel, &cmd.flagLogFormat)
+
+	return fs
+}
+
+func (cmd *GenerateFunctionsCommand) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugingen-framework generate functions [<args>]\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+
+	return strBuilder.String()
+}
+
+func (a *GenerateFunctionsCommand) Synopsis() string {
+	return "Generate code for provider-defined functions from an Intermediate Representation (IR) JSON file."
+}
+
+func (cmd *GenerateFunctionsCommand) Run(args []string) int {
+	ctx := context.Background()
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
+	logger, err := newLogger(cmd.flagLogLevel, cmd.flagLogFormat)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
+	err = cmd.runInternal(ctx, logger)
+	if err != nil {
+		outputError(cmd.UI, logger, cmd.flagLogFormat, err)
+		return 1
+	}
+
+	return 0
+}
+
+func (cmd *GenerateFunctionsCommand) runInternal(ctx context.Context, logger *slog.Logger) error {
+	// read, validate and parse input file
+	src, _, ext, err := readSpecification(ctx, cmd.flagIRInputPath)
+	if err != nil {
+		return err
+	}
+
+	// locate errors found after parsing within the input file
+	ctx = diagnostic.SetDocumentInContext(ctx, src)
+
+	w := newWriter(cmd.flagCheck)
+
+	err = generateFunctionCode(ctx, ext, w, cmd.flagOutputPath, cmd.flagPackageName, logger)
+	if err != nil {
+		return fmt.Errorf("error generating function code: %w", err)
+	}
+
+	if cmd.flagCheck {
+		return checkOutput(cmd.UI, w, cmd.flagOutputPath, output.FunctionKind)
+	}
+
+	return nil
+}
+
+func generateFunctionCode(ctx context.Context, ext extension.Specification, w output.Writer, outputPath, packageName string, logger *slog.Logger) error {
+	// convert IR to framework function definitions
+	f, err := function.NewFunctions(ext)
+	if err != nil {
+		return fmt.Errorf("error converting IR to Plugin Framework function definitions: %w", diagnostic.WithPositionsFromContext(ctx, err))
+	}
+
+	// convert framework function definitions to []byte
+	g := function.NewGeneratorFunctions(f)
+	definitions, err := g.Definitions(packageName)
+	if err != nil {
+		return fmt.Errorf("error converting Plugin Framework function definitions to Go code: %w", err)
+	}
+
+	// format function code
+	formattedDefinitions, err := format.Format(definitions)
+	if err != nil {
+		return fmt.Errorf("error formatting Go code: %w", err)
+	}
+
+	// write code
+	err = output.WriteFunctions(w, formattedDefinitions, outputPath, packageName)
+	if err != nil {
+		return fmt.Errorf("error writing Go code to output: %w", err)
+	}
+
+	return nil
+}
@@ -4,10 +4,16 @@
 package cmd_test
 
 import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/cli"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/cmd"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/report"
 )
 
 func TestGenerateResourcesCommand(t *testing.T) {
@@ -48,3 +54,383 @@ func TestGenerateResourcesCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateResourcesCommand_TemplatesDir(t *testing.T) {
+	t.Parallel()
+
+	embedded, err := os.ReadFile("../schema/templates/nested_object_to.gotmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		template      string
+		expectedCode  int
+		expected      string
+		expectedError string
+	}{
+		"override": {
+			template:     strings.ReplaceAll(string(embedded), "Value Is Unknown", "Value Must Be Known"),
+			expectedCode: 0,
+			expected:     `"ListNestedAttributeAssocExtTypeValue Value Must Be Known"`,
+		},
+		"invalid": {
+			template:      "{{ .Name ",
+			expectedCode:  1,
+			expectedError: "nested_object_to.gotmpl: template: nested_object_to.gotmpl:1: unclosed action",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			templatesDir := t.TempDir()
+			testOutputDir := t.TempDir()
+
+			err := os.WriteFile(filepath.Join(templatesDir, "nested_object_to.gotmpl"), []byte(testCase.template), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			mockUi := cli.NewMockUi()
+			c := cmd.GenerateResourcesCommand{
+				UI: mockUi,
+			}
+
+			args := []string{
+				"--input", "testdata/custom_and_external/ir.json",
+				"--package", "generated",
+				"--output", testOutputDir,
+				"--templates-dir", templatesDir,
+			}
+
+			exitCode := c.Run(args)
+			if exitCode != testCase.expectedCode {
+				t.Fatalf("expected exit code %d, got %d: %s", testCase.expectedCode, exitCode, mockUi.ErrorWriter.String())
+			}
+
+			if testCase.expectedError != "" && !strings.Contains(mockUi.ErrorWriter.String(), testCase.expectedError) {
+				t.Errorf("expected error to contain %q, got: %s", testCase.expectedError, mockUi.ErrorWriter.String())
+			}
+
+			if testCase.expected == "" {
+				return
+			}
+
+			b, err := os.ReadFile(filepath.Join(testOutputDir, "example_resource_gen.go"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(string(b), testCase.expected) {
+				t.Errorf("expected generated code to contain %s", testCase.expected)
+			}
+		})
+	}
+}
+
+func TestGenerateResourcesCommand_TypeMapping(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typeMapping   string
+		expectedCode  int
+		expected      []string
+		expectedError string
+	}{
+		"type-mapping": {
+			typeMapping: `{
+  "int64": {
+    "go_type": "int",
+    "to": "int({{.}}.ValueInt64())",
+    "from": "types.Int64Value(int64({{.}}))"
+  },
+  "number": {
+    "go_type": "json.Number",
+    "import": {
+      "path": "encoding/json"
+    },
+    "to": "json.Number({{.}}.ValueBigFloat().String())",
+    "from": "types.NumberValue(func() *big.Float { f, _ := new(big.Float).SetString({{.}}.String()); return f }())"
+  }
+}`,
+			expectedCode: 0,
+			expected: []string{
+				`"encoding/json"`,
+				"int(v.Int64Attribute.ValueInt64())",
+				"types.Int64Value(int64(apiObject.Int64Attribute))",
+				"json.Number(v.NumberAttribute.ValueBigFloat().String())",
+				"Values []int",
+			},
+		},
+		"invalid": {
+			typeMapping:   `{"bytes": {"go_type": "[]byte", "to": "{{.}}", "from": "{{.}}"}}`,
+			expectedCode:  1,
+			expectedError: `type mapping "bytes" must be one of bool, float32, float64, int32, int64, number, string`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			typeMappingPath := filepath.Join(t.TempDir(), "type_mapping.json")
+			testOutputDir := t.TempDir()
+
+			err := os.WriteFile(typeMappingPath, []byte(testCase.typeMapping), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			mockUi := cli.NewMockUi()
+			c := cmd.GenerateResourcesCommand{
+				UI: mockUi,
+			}
+
+			args := []string{
+				"--input", "testdata/custom_and_external/ir.json",
+				"--package", "generated",
+				"--output", testOutputDir,
+				"--type-mapping", typeMappingPath,
+			}
+
+			exitCode := c.Run(args)
+			if exitCode != testCase.expectedCode {
+				t.Fatalf("expected exit code %d, got %d: %s", testCase.expectedCode, exitCode, mockUi.ErrorWriter.String())
+			}
+
+			if testCase.expectedError != "" && !strings.Contains(mockUi.ErrorWriter.String(), testCase.expectedError) {
+				t.Errorf("expected error to contain %q, got: %s", testCase.expectedError, mockUi.ErrorWriter.String())
+			}
+
+			if len(testCase.expected) == 0 {
+				return
+			}
+
+			b, err := os.ReadFile(filepath.Join(testOutputDir, "example_resource_gen.go"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, expected := range testCase.expected {
+				if !strings.Contains(string(b), expected) {
+					t.Errorf("expected generated code to contain %s", expected)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateResourcesCommand_Strict(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		args          []string
+		expectedCode  int
+		expectedError string
+	}{
+		"skipped": {
+			expectedCode:  0,
+			expectedError: "to/from methods were not generated for 1 path(s), use --strict to fail instead:\n  resource.example.single_nested_attribute.dynamic_attribute",
+		},
+		"strict": {
+			args:          []string{"--strict"},
+			expectedCode:  1,
+			expectedError: "example.single_nested_attribute.dynamic_attribute: dynamic attribute without an associated external type is not yet implemented",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testOutputDir := t.TempDir()
+			mockUi := cli.NewMockUi()
+			c := cmd.GenerateResourcesCommand{
+				UI: mockUi,
+			}
+
+			args := append([]string{
+				"--input", "testdata/unimplemented/ir.json",
+				"--package", "generated",
+				"--output", testOutputDir,
+			}, testCase.args...)
+
+			exitCode := c.Run(args)
+			if exitCode != testCase.expectedCode {
+				t.Fatalf("expected exit code %d, got %d: %s", testCase.expectedCode, exitCode, mockUi.ErrorWriter.String())
+			}
+
+			if !strings.Contains(mockUi.ErrorWriter.String(), testCase.expectedError) {
+				t.Errorf("expected error to contain %q, got: %s", testCase.expectedError, mockUi.ErrorWriter.String())
+			}
+		})
+	}
+}
+
+func TestGenerateResourcesCommand_WriteOnly(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		irInputPath   string
+		expectedError string
+	}{
+		"set": {
+			irInputPath:   "testdata/write_only_set/ir.json",
+			expectedError: `/resources/0/schema/attributes/0/set/write_only, line 17, column 29: resource "example" attribute "secrets" is write-only, which is not supported for set attributes`,
+		},
+		"set-nested-block": {
+			irInputPath:   "testdata/write_only_set_nested_block/ir.json",
+			expectedError: `/resources/0/schema/blocks/0/set_nested/nested_object/attributes/0/string, line 17, column 31: resource "example" block "credentials" attribute "password" is write-only, which is not supported within set nested blocks`,
+		},
+		"computed-nested": {
+			irInputPath:   "testdata/write_only_computed_nested/ir.json",
+			expectedError: `/resources/0/schema/attributes/0/map_nested/nested_object/attributes/0/string, line 18, column 31: resource "example" attribute "credentials" attribute "password" is write-only, which is not supported within computed nested attributes`,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testOutputDir := t.TempDir()
+			mockUi := cli.NewMockUi()
+			c := cmd.GenerateResourcesCommand{
+				UI: mockUi,
+			}
+
+			args := []string{
+				"--input", testCase.irInputPath,
+				"--package", "generated",
+				"--output", testOutputDir,
+			}
+
+			exitCode := c.Run(args)
+			if exitCode != 1 {
+				t.Fatalf("expected exit code 1, got %d", exitCode)
+			}
+
+			if !strings.Contains(mockUi.ErrorWriter.String(), testCase.expectedError) {
+				t.Errorf("expected error to contain %q, got: %s", testCase.expectedError, mockUi.ErrorWriter.String())
+			}
+
+			entries, err := os.ReadDir(testOutputDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(entries) > 0 {
+				t.Errorf("expected no generated files, got %d", len(entries))
+			}
+		})
+	}
+}
+
+func TestGenerateResourcesCommand_InvalidAdditions(t *testing.T) {
+	t.Parallel()
+
+	mockUi := cli.NewMockUi()
+	c := cmd.GenerateResourcesCommand{
+		UI: mockUi,
+	}
+
+	args := []string{
+		"--input", "testdata/invalid_additions/ir.json",
+		"--package", "generated",
+		"--output", t.TempDir(),
+	}
+
+	exitCode := c.Run(args)
+	if exitCode != 1 {
+		t.Fatalf("expected exit code 1, got %d", exitCode)
+	}
+
+	for _, expected := range []string{
+		`/resources/0/schema/attributes/0/string/constraints/conflicts_with/0, line 15, column 36: resource "example" attribute "id" constraint conflicts_with references "missing", which is not a sibling attribute or block`,
+		`/resources/0/schema/attributes/1/list/default/static/1, line 27, column 32: resource "example" attribute "ports" has an invalid static default: value[1] must be a number`,
+		`/resources/0/config_validators/0/at_least_one_of/1, line 35, column 37: resource "example" config validator 0: path "nope.x" is not defined in the schema`,
+		`/resources/1/config_validators/0/exactly_one_of/1, line 53, column 36: resource "other" config validator 0: path "missing" is not defined in the schema`,
+	} {
+		if !strings.Contains(mockUi.ErrorWriter.String(), expected) {
+			t.Errorf("expected error to contain %q, got: %s", expected, mockUi.ErrorWriter.String())
+		}
+	}
+}
+
+func TestGenerateResourcesCommand_Report(t *testing.T) {
+	t.Parallel()
+
+	testOutputDir := t.TempDir()
+	reportPath := filepath.Join(t.TempDir(), "report.json")
+	mockUi := cli.NewMockUi()
+	c := cmd.GenerateResourcesCommand{
+		UI: mockUi,
+	}
+
+	args := []string{
+		"--input", "testdata/unimplemented/ir.json",
+		"--package", "generated",
+		"--output", testOutputDir,
+		"--report", reportPath,
+	}
+
+	exitCode := c.Run(args)
+	if exitCode != 0 {
+		t.Fatalf("unexpected error running `generate resources` cmd: %s", mockUi.ErrorWriter.String())
+	}
+
+	b, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got report.Report
+
+	err = json.Unmarshal(b, &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file := filepath.Join(testOutputDir, "example_resource_gen.go")
+
+	expectedSchemas := []report.Schema{
+		{
+			Type:            "resource",
+			Name:            "example",
+			File:            file,
+			CustomTypes:     []string{"SingleNestedAttributeType", "SingleNestedAttributeValue"},
+			ToFromFunctions: []string{},
+		},
+	}
+
+	if diff := cmp.Diff(got.Schemas, expectedSchemas); diff != "" {
+		t.Errorf("unexpected schemas difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(got.Files, []string{file}); diff != "" {
+		t.Errorf("unexpected files difference: %s", diff)
+	}
+
+	expectedSkipped := []report.Skipped{
+		{
+			Path:              "resource.example.single_nested_attribute.dynamic_attribute",
+			UnimplementedPath: "dynamic_attribute",
+			Error:             "dynamic attribute without an associated external type is not yet implemented",
+		},
+	}
+
+	if diff := cmp.Diff(got.Skipped, expectedSkipped); diff != "" {
+		t.Errorf("unexpected skipped difference: %s", diff)
+	}
+
+	var phases []string
+
+	for _, p := range got.Phases {
+		phases = append(phases, p.Type+"."+p.Phase)
+	}
+
+	expectedPhases := []string{"resource.models", "resource.custom_types", "resource.to_from", "resource.schema", "resource.format", "resource.write"}
+
+	if diff := cmp.Diff(phases, expectedPhases); diff != "" {
+		t.Errorf("unexpected phases difference: %s", diff)
+	}
+}
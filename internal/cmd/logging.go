@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// addLogFlags adds the flags which configure the logger, and which are common
+// to every command.
+func addLogFlags(fs *flag.FlagSet, level, format *string) {
+	fs.StringVar(level, "log-level", "warn", "minimum level of logs written to stderr, one of debug, info, warn or error")
+	fs.StringVar(format, "log-format", logging.FormatText, "format of logs written to stderr, one of text or json, in which case errors are also logged")
+}
+
+// newLogger returns a logger which writes to stderr.
+func newLogger(level, format string) (*slog.Logger, error) {
+	return logging.NewLogger(os.Stderr, level, format)
+}
+
+// outputError reports the error returned by a command. If logs are formatted
+// as JSON, each of the errors collected during code generation is logged as
+// a separate record, along with the path at which it occurred, or the JSON
+// pointer, line and column within the specification, rather than output in
+// the UI.
+func outputError(ui cli.Ui, logger *slog.Logger, format string, err error) {
+	if format != logging.FormatJSON {
+		ui.Error(fmt.Sprintf("Error executing command: %s\n", err))
+
+		return
+	}
+
+	for _, e := range diagnostics(err) {
+		var diagnosticErr *diagnostic.Error
+
+		if errors.As(e, &diagnosticErr) {
+			line, column := diagnosticErr.Position()
+
+			logger.Error("error executing command", "pointer", diagnosticErr.Pointer(), "line", line, "column", column, "err", diagnosticErr.Unwrap())
+
+			continue
+		}
+
+		var generatorErr *schema.GeneratorError
+
+		if errors.As(e, &generatorErr) {
+			logger.Error("error executing command", "path", generatorErr.Path(), "err", generatorErr.Unwrap())
+
+			continue
+		}
+
+		logger.Error("error executing command", "err", e)
+	}
+}
+
+// diagnostics returns each of the errors which are joined within err, or err
+// itself if it does not join any errors.
+func diagnostics(err error) []error {
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		if joined, ok := e.(interface{ Unwrap() []error }); ok {
+			var errs []error
+
+			for _, v := range joined.Unwrap() {
+				errs = append(errs, diagnostics(v)...)
+			}
+
+			return errs
+		}
+	}
+
+	return []error{err}
+}
+
+// withPath prefixes the path of each of the errors collected during code
+// generation with the path from the context, such as resource, so that the
+// path identifies the schema within the specification.
+func withPath(ctx context.Context, err error) error {
+	return errors.Join(schema.NewGeneratorErrors(err, logging.GetPathFromContext(ctx))...)
+}
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/report"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// writeReport records the paths for which to/from methods were not generated
+// in the report, which is then written as JSON to path.
+func writeReport(rep *report.Report, unimplemented *schema.UnimplementedTracker, path string) error {
+	for _, s := range unimplemented.Skipped() {
+		rep.AddSkipped(s.Path, s.UnimplementedPath, s.Err)
+	}
+
+	return rep.Write(path)
+}
@@ -4,9 +4,13 @@
 package cmd
 
 import (
+	"context"
 	"strings"
 
+	"github.com/greatman/terraform-plugin-codegen-spec/spec"
 	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
 )
 
 type ScaffoldCommand struct {
@@ -30,3 +34,11 @@ func (a *ScaffoldCommand) Synopsis() string {
 func (cmd *ScaffoldCommand) Run(args []string) int {
 	return cli.RunResultHelp
 }
+
+// scaffoldSpecification reads, validates and parses the specification at the
+// input path, for scaffolding code which uses the code generated from it.
+func scaffoldSpecification(ctx context.Context, inputPath string) (spec.Specification, extension.Specification, error) {
+	_, s, ext, err := readSpecification(ctx, inputPath)
+
+	return s, ext, err
+}
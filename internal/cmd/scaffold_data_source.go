@@ -14,18 +14,24 @@ import (
 
 	"github.com/hashicorp/cli"
 
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/datasource"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/output"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/scaffold"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
 
 type ScaffoldDataSourceCommand struct {
-	UI                      cli.Ui
-	flagDataSourceNameSnake string
-	flagOutputDir           string
-	flagOutputFile          string
-	flagPackageName         string
-	flagForceOverwrite      bool
+	UI                       cli.Ui
+	flagDataSourceNameSnake  string
+	flagOutputDir            string
+	flagOutputFile           string
+	flagPackageName          string
+	flagForceOverwrite       bool
+	flagIRInputPath          string
+	flagGeneratedPackage     string
+	flagGeneratedPackageName string
+	flagLogLevel             string
+	flagLogFormat            string
 }
 
 func (cmd *ScaffoldDataSourceCommand) Flags() *flag.FlagSet {
@@ -33,9 +39,13 @@ func (cmd *ScaffoldDataSourceCommand) Flags() *flag.FlagSet {
 
 	fs.StringVar(&cmd.flagDataSourceNameSnake, "name", "", "name of data source in snake case without the provider type prefix, required")
 	fs.BoolVar(&cmd.flagForceOverwrite, "force", false, "force creation, overwriting existing files")
+	fs.StringVar(&cmd.flagIRInputPath, "input", "", "path to intermediate representation (JSON), to use the schema and model generated from it")
+	fs.StringVar(&cmd.flagGeneratedPackage, "generated-package", "", "Go import path of the package containing code generated from --input, default is the package of the scaffolded code")
+	fs.StringVar(&cmd.flagGeneratedPackageName, "generated-package-name", "", "name of Go package containing code generated from --input, as set by --package when generating, default is the last element of --generated-package")
 	fs.StringVar(&cmd.flagOutputDir, "output-dir", ".", "directory path to output scaffolded code file")
 	fs.StringVar(&cmd.flagOutputFile, "output-file", "", "file name and extension to write scaffolded code to, default will use the --name flag with '_data_source.go' suffix")
 	fs.StringVar(&cmd.flagPackageName, "package", "provider", "name of Go package for scaffolded code file")
+	addLogFlags(fs, &cmd.flagLogLevel, &cmd.flagLogFormat)
 	return fs
 }
 
@@ -91,16 +101,22 @@ func (cmd *ScaffoldDataSourceCommand) Run(args []string) int {
 		return 1
 	}
 
+	logger, err := newLogger(cmd.flagLogLevel, cmd.flagLogFormat)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
 	err = cmd.runInternal(ctx)
 	if err != nil {
-		cmd.UI.Error(fmt.Sprintf("Error executing command: %s\n", err))
+		outputError(cmd.UI, logger, cmd.flagLogFormat, err)
 		return 1
 	}
 
 	return 0
 }
 
-func (cmd *ScaffoldDataSourceCommand) runInternal(_ context.Context) error {
+func (cmd *ScaffoldDataSourceCommand) runInternal(ctx context.Context) error {
 	if cmd.flagDataSourceNameSnake == "" {
 		return errors.New("--name flag is required")
 	}
@@ -110,7 +126,17 @@ func (cmd *ScaffoldDataSourceCommand) runInternal(_ context.Context) error {
 		return fmt.Errorf("'%s' is not a valid Terraform data source identifier", cmd.flagDataSourceNameSnake)
 	}
 
-	goBytes, err := scaffold.DataSourceBytes(dataSourceIdentifier, cmd.flagPackageName)
+	var generated *scaffold.Generated
+	var err error
+
+	if cmd.flagIRInputPath != "" {
+		generated, err = cmd.generated(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	goBytes, err := scaffold.DataSourceBytes(dataSourceIdentifier, cmd.flagPackageName, generated)
 	if err != nil {
 		return fmt.Errorf("error creating scaffolding data source Go code: %w", err)
 	}
@@ -128,6 +154,27 @@ func (cmd *ScaffoldDataSourceCommand) runInternal(_ context.Context) error {
 	return nil
 }
 
+// generated returns the details of the code generated for the data source
+// from the specification.
+func (cmd *ScaffoldDataSourceCommand) generated(ctx context.Context) (*scaffold.Generated, error) {
+	spec, ext, err := scaffoldSpecification(ctx, cmd.flagIRInputPath)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas, err := datasource.NewSchemas(spec, ext)
+	if err != nil {
+		return nil, fmt.Errorf("error converting IR to Plugin Framework schema: %w", err)
+	}
+
+	s, ok := schemas[cmd.flagDataSourceNameSnake]
+	if !ok {
+		return nil, fmt.Errorf("data source %q is not defined in %s", cmd.flagDataSourceNameSnake, cmd.flagIRInputPath)
+	}
+
+	return scaffold.NewGenerated(s, cmd.flagGeneratedPackage, cmd.flagGeneratedPackageName), nil
+}
+
 func (cmd *ScaffoldDataSourceCommand) getOutputFilePath() string {
 	filename := fmt.Sprintf("%s_data_source.go", cmd.flagDataSourceNameSnake)
 	if cmd.flagOutputFile != "" {
@@ -14,11 +14,20 @@ func TestScaffoldDataSourceCommand(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
+		args          []string
 		goldenFileDir string
 	}{
 		"data source scaffold": {
 			goldenFileDir: "testdata/scaffold/data_source",
 		},
+		"data source scaffold with input": {
+			args: []string{
+				"--name", "example",
+				"--input", "testdata/custom_and_external/ir.json",
+				"--generated-package", "example.com/examplecloud/internal/datasource_example",
+			},
+			goldenFileDir: "testdata/scaffold/data_source_input",
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -37,6 +46,8 @@ func TestScaffoldDataSourceCommand(t *testing.T) {
 				"--output-dir", testOutputDir,
 			}
 
+			args = append(args, testCase.args...)
+
 			exitCode := c.Run(args)
 			if exitCode != 0 {
 				t.Fatalf("unexpected error running `scaffold data-source` cmd: %s", mockUi.ErrorWriter.String())
@@ -15,17 +15,23 @@ import (
 	"github.com/hashicorp/cli"
 
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/output"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/scaffold"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
 
 type ScaffoldProviderCommand struct {
-	UI                    cli.Ui
-	flagProviderNameSnake string
-	flagOutputDir         string
-	flagOutputFile        string
-	flagPackageName       string
-	flagForceOverwrite    bool
+	UI                       cli.Ui
+	flagProviderNameSnake    string
+	flagOutputDir            string
+	flagOutputFile           string
+	flagPackageName          string
+	flagForceOverwrite       bool
+	flagIRInputPath          string
+	flagGeneratedPackage     string
+	flagGeneratedPackageName string
+	flagLogLevel             string
+	flagLogFormat            string
 }
 
 func (cmd *ScaffoldProviderCommand) Flags() *flag.FlagSet {
@@ -33,9 +39,13 @@ func (cmd *ScaffoldProviderCommand) Flags() *flag.FlagSet {
 
 	fs.StringVar(&cmd.flagProviderNameSnake, "name", "", "name of provider in snake case, required")
 	fs.BoolVar(&cmd.flagForceOverwrite, "force", false, "force creation, overwriting existing files")
+	fs.StringVar(&cmd.flagIRInputPath, "input", "", "path to intermediate representation (JSON), to use the schema and model generated from it")
+	fs.StringVar(&cmd.flagGeneratedPackage, "generated-package", "", "Go import path of the package containing code generated from --input, default is the package of the scaffolded code")
+	fs.StringVar(&cmd.flagGeneratedPackageName, "generated-package-name", "", "name of Go package containing code generated from --input, as set by --package when generating, default is the last element of --generated-package")
 	fs.StringVar(&cmd.flagOutputDir, "output-dir", ".", "directory path to output scaffolded code file")
 	fs.StringVar(&cmd.flagOutputFile, "output-file", "", "file name and extension to write scaffolded code to, default is 'provider.go'")
 	fs.StringVar(&cmd.flagPackageName, "package", "provider", "name of Go package for scaffolded code file")
+	addLogFlags(fs, &cmd.flagLogLevel, &cmd.flagLogFormat)
 	return fs
 }
 
@@ -91,16 +101,22 @@ func (cmd *ScaffoldProviderCommand) Run(args []string) int {
 		return 1
 	}
 
+	logger, err := newLogger(cmd.flagLogLevel, cmd.flagLogFormat)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
 	err = cmd.runInternal(ctx)
 	if err != nil {
-		cmd.UI.Error(fmt.Sprintf("Error executing command: %s\n", err))
+		outputError(cmd.UI, logger, cmd.flagLogFormat, err)
 		return 1
 	}
 
 	return 0
 }
 
-func (cmd *ScaffoldProviderCommand) runInternal(_ context.Context) error {
+func (cmd *ScaffoldProviderCommand) runInternal(ctx context.Context) error {
 	if cmd.flagProviderNameSnake == "" {
 		return errors.New("--name flag is required")
 	}
@@ -110,7 +126,17 @@ func (cmd *ScaffoldProviderCommand) runInternal(_ context.Context) error {
 		return fmt.Errorf("'%s' is not a valid Terraform provider identifier", cmd.flagProviderNameSnake)
 	}
 
-	goBytes, err := scaffold.ProviderBytes(providerIdentifier, cmd.flagPackageName)
+	var generated *scaffold.Generated
+	var err error
+
+	if cmd.flagIRInputPath != "" {
+		generated, err = cmd.generated(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	goBytes, err := scaffold.ProviderBytes(providerIdentifier, cmd.flagPackageName, generated)
 	if err != nil {
 		return fmt.Errorf("error creating scaffolding provider Go code: %w", err)
 	}
@@ -128,6 +154,30 @@ func (cmd *ScaffoldProviderCommand) runInternal(_ context.Context) error {
 	return nil
 }
 
+// generated returns the details of the code generated for the provider from
+// the specification.
+func (cmd *ScaffoldProviderCommand) generated(ctx context.Context) (*scaffold.Generated, error) {
+	spec, ext, err := scaffoldSpecification(ctx, cmd.flagIRInputPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.Provider == nil || spec.Provider.Schema == nil {
+		return nil, fmt.Errorf("provider schema is not defined in %s", cmd.flagIRInputPath)
+	}
+
+	if spec.Provider.Name != cmd.flagProviderNameSnake {
+		return nil, fmt.Errorf("provider %q is not defined in %s", cmd.flagProviderNameSnake, cmd.flagIRInputPath)
+	}
+
+	schemas, err := provider.NewSchemas(spec, ext)
+	if err != nil {
+		return nil, fmt.Errorf("error converting IR to Plugin Framework schema: %w", err)
+	}
+
+	return scaffold.NewGenerated(schemas[spec.Provider.Name], cmd.flagGeneratedPackage, cmd.flagGeneratedPackageName), nil
+}
+
 func (cmd *ScaffoldProviderCommand) getOutputFilePath() string {
 	filename := "provider.go"
 	if cmd.flagOutputFile != "" {
@@ -14,11 +14,20 @@ func TestScaffoldProviderCommand(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
+		args          []string
 		goldenFileDir string
 	}{
 		"provider scaffold": {
 			goldenFileDir: "testdata/scaffold/provider",
 		},
+		"provider scaffold with input": {
+			args: []string{
+				"--name", "example",
+				"--input", "testdata/custom_and_external/ir.json",
+				"--generated-package", "example.com/examplecloud/internal/provider_example",
+			},
+			goldenFileDir: "testdata/scaffold/provider_input",
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -37,6 +46,8 @@ func TestScaffoldProviderCommand(t *testing.T) {
 				"--output-dir", testOutputDir,
 			}
 
+			args = append(args, testCase.args...)
+
 			exitCode := c.Run(args)
 			if exitCode != 0 {
 				t.Fatalf("unexpected error running `scaffold provider` cmd: %s", mockUi.ErrorWriter.String())
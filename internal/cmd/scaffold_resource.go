@@ -15,17 +15,24 @@ import (
 	"github.com/hashicorp/cli"
 
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/output"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/resource"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/scaffold"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
 
 type ScaffoldResourceCommand struct {
-	UI                    cli.Ui
-	flagResourceNameSnake string
-	flagOutputDir         string
-	flagOutputFile        string
-	flagPackageName       string
-	flagForceOverwrite    bool
+	UI                       cli.Ui
+	flagResourceNameSnake    string
+	flagOutputDir            string
+	flagOutputFile           string
+	flagPackageName          string
+	flagForceOverwrite       bool
+	flagIdentity             bool
+	flagIRInputPath          string
+	flagGeneratedPackage     string
+	flagGeneratedPackageName string
+	flagLogLevel             string
+	flagLogFormat            string
 }
 
 func (cmd *ScaffoldResourceCommand) Flags() *flag.FlagSet {
@@ -33,9 +40,14 @@ func (cmd *ScaffoldResourceCommand) Flags() *flag.FlagSet {
 
 	fs.StringVar(&cmd.flagResourceNameSnake, "name", "", "name of resource in snake case without the provider type prefix, required")
 	fs.BoolVar(&cmd.flagForceOverwrite, "force", false, "force creation, overwriting existing files")
+	fs.BoolVar(&cmd.flagIdentity, "identity", false, "implement resource identity, with an identity schema containing an id attribute")
+	fs.StringVar(&cmd.flagIRInputPath, "input", "", "path to intermediate representation (JSON), to use the schema and model generated from it")
+	fs.StringVar(&cmd.flagGeneratedPackage, "generated-package", "", "Go import path of the package containing code generated from --input, default is the package of the scaffolded code")
+	fs.StringVar(&cmd.flagGeneratedPackageName, "generated-package-name", "", "name of Go package containing code generated from --input, as set by --package when generating, default is the last element of --generated-package")
 	fs.StringVar(&cmd.flagOutputDir, "output-dir", ".", "directory path to output scaffolded code file")
 	fs.StringVar(&cmd.flagOutputFile, "output-file", "", "file name and extension to write scaffolded code to, default will use the --name flag with '_resource.go' suffix")
 	fs.StringVar(&cmd.flagPackageName, "package", "provider", "name of Go package for scaffolded code file")
+	addLogFlags(fs, &cmd.flagLogLevel, &cmd.flagLogFormat)
 	return fs
 }
 
@@ -91,16 +103,22 @@ func (cmd *ScaffoldResourceCommand) Run(args []string) int {
 		return 1
 	}
 
+	logger, err := newLogger(cmd.flagLogLevel, cmd.flagLogFormat)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
 	err = cmd.runInternal(ctx)
 	if err != nil {
-		cmd.UI.Error(fmt.Sprintf("Error executing command: %s\n", err))
+		outputError(cmd.UI, logger, cmd.flagLogFormat, err)
 		return 1
 	}
 
 	return 0
 }
 
-func (cmd *ScaffoldResourceCommand) runInternal(_ context.Context) error {
+func (cmd *ScaffoldResourceCommand) runInternal(ctx context.Context) error {
 	if cmd.flagResourceNameSnake == "" {
 		return errors.New("--name flag is required")
 	}
@@ -110,7 +128,17 @@ func (cmd *ScaffoldResourceCommand) runInternal(_ context.Context) error {
 		return fmt.Errorf("'%s' is not a valid Terraform resource identifier", cmd.flagResourceNameSnake)
 	}
 
-	goBytes, err := scaffold.ResourceBytes(resourceIdentifier, cmd.flagPackageName)
+	var generated *scaffold.Generated
+	var err error
+
+	if cmd.flagIRInputPath != "" {
+		generated, err = cmd.generated(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	goBytes, err := scaffold.ResourceBytes(resourceIdentifier, cmd.flagPackageName, cmd.flagIdentity, generated)
 	if err != nil {
 		return fmt.Errorf("error creating scaffolding resource Go code: %w", err)
 	}
@@ -128,6 +156,31 @@ func (cmd *ScaffoldResourceCommand) runInternal(_ context.Context) error {
 	return nil
 }
 
+// generated returns the details of the code generated for the resource from
+// the specification.
+func (cmd *ScaffoldResourceCommand) generated(ctx context.Context) (*scaffold.Generated, error) {
+	spec, ext, err := scaffoldSpecification(ctx, cmd.flagIRInputPath)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas, err := resource.NewSchemas(spec, ext)
+	if err != nil {
+		return nil, fmt.Errorf("error converting IR to Plugin Framework schema: %w", err)
+	}
+
+	s, ok := schemas[cmd.flagResourceNameSnake]
+	if !ok {
+		return nil, fmt.Errorf("resource %q is not defined in %s", cmd.flagResourceNameSnake, cmd.flagIRInputPath)
+	}
+
+	if cmd.flagIdentity && len(s.Identity) == 0 {
+		return nil, fmt.Errorf("resource %q does not define an identity in %s", cmd.flagResourceNameSnake, cmd.flagIRInputPath)
+	}
+
+	return scaffold.NewGenerated(s, cmd.flagGeneratedPackage, cmd.flagGeneratedPackageName), nil
+}
+
 func (cmd *ScaffoldResourceCommand) getOutputFilePath() string {
 	filename := fmt.Sprintf("%s_resource.go", cmd.flagResourceNameSnake)
 	if cmd.flagOutputFile != "" {
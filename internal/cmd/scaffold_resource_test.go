@@ -14,11 +14,33 @@ func TestScaffoldResourceCommand(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
+		args          []string
 		goldenFileDir string
 	}{
 		"resource scaffold": {
 			goldenFileDir: "testdata/scaffold/resource",
 		},
+		"resource scaffold with identity": {
+			args:          []string{"--identity"},
+			goldenFileDir: "testdata/scaffold/resource_identity",
+		},
+		"resource scaffold with input": {
+			args: []string{
+				"--name", "example",
+				"--input", "testdata/custom_and_external/ir.json",
+				"--generated-package", "example.com/examplecloud/internal/resource_example",
+			},
+			goldenFileDir: "testdata/scaffold/resource_input",
+		},
+		"resource scaffold with input and generated package name": {
+			args: []string{
+				"--name", "example",
+				"--input", "testdata/custom_and_external/ir.json",
+				"--generated-package", "example.com/examplecloud/internal/gen",
+				"--generated-package-name", "t3",
+			},
+			goldenFileDir: "testdata/scaffold/resource_input_package_name",
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -37,6 +59,8 @@ func TestScaffoldResourceCommand(t *testing.T) {
 				"--output-dir", testOutputDir,
 			}
 
+			args = append(args, testCase.args...)
+
 			exitCode := c.Run(args)
 			if exitCode != 0 {
 				t.Fatalf("unexpected error running `scaffold resource` cmd: %s", mockUi.ErrorWriter.String())
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/input"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/validate"
+)
+
+// readSpecification reads, validates and parses the specification at the
+// input path, returning the document so that the line and column of errors
+// found after parsing can also be populated.
+func readSpecification(ctx context.Context, inputPath string) ([]byte, spec.Specification, extension.Specification, error) {
+	// read input file
+	src, err := input.Read(inputPath)
+	if err != nil {
+		return nil, spec.Specification{}, extension.Specification{}, fmt.Errorf("error reading IR JSON: %w", err)
+	}
+
+	s, ext, err := parseSpecification(ctx, src)
+	if err != nil {
+		return src, spec.Specification{}, extension.Specification{}, err
+	}
+
+	return src, s, ext, nil
+}
+
+// parseSpecification validates and parses the document. Every error found
+// when validating the document against the specification JSON schema, and
+// when validating the additions to the specification, is returned at once,
+// along with its JSON pointer, line and column. Only invalid JSON syntax
+// prevents further validation.
+func parseSpecification(ctx context.Context, src []byte) (spec.Specification, extension.Specification, error) {
+	// validate JSON
+	err := validate.JSON(src)
+	if err != nil {
+		return spec.Specification{}, extension.Specification{}, fmt.Errorf("error validating IR JSON: %w", diagnostic.WithPositions(src, err))
+	}
+
+	// remove additions to specification, which are parsed separately
+	specSrc, err := extension.SpecificationDocument(src)
+	if err != nil {
+		return spec.Specification{}, extension.Specification{}, fmt.Errorf("error parsing IR JSON: %w", err)
+	}
+
+	// validate IR against specification
+	schemaErr := validate.Specification(specSrc, extension.Float32Tokens(src))
+
+	// parse and validate additions to specification, including ephemeral
+	// resources, regardless of whether the IR is valid
+	ext, extErr := extension.Parse(ctx, src)
+
+	if schemaErr != nil {
+		// values of the wrong type are also reported when decoding the
+		// additions, so only the errors of the JSON schema are kept
+		err = errors.Join(schemaErr, withoutTypeErrors(extErr))
+
+		return spec.Specification{}, extension.Specification{}, fmt.Errorf("error validating IR JSON: %w", diagnostic.WithPositions(src, err))
+	}
+
+	// parse IR
+	s, err := extension.ParseSpecification(ctx, src)
+	if err != nil {
+		return spec.Specification{}, extension.Specification{}, fmt.Errorf("error parsing IR JSON: %w", err)
+	}
+
+	if extErr != nil {
+		return spec.Specification{}, extension.Specification{}, fmt.Errorf("error validating IR JSON: %w", diagnostic.WithPositions(src, extErr))
+	}
+
+	return s, ext, nil
+}
+
+// withoutTypeErrors returns the errors joined by err, other than those which
+// result from decoding a value of the wrong type.
+func withoutTypeErrors(err error) error {
+	errs := []error{err}
+
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs = joined.Unwrap()
+	}
+
+	var kept []error
+
+	for _, e := range errs {
+		var typeErr *json.UnmarshalTypeError
+
+		if e != nil && !errors.As(e, &typeErr) {
+			kept = append(kept, e)
+		}
+	}
+
+	return errors.Join(kept...)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// outputSkipped summarises the paths for which to/from methods were not
+// generated as they are not yet implemented. Nothing is output if no paths
+// were skipped, which is always the case when running with --strict, or if
+// logs are formatted as JSON, as each skipped path has already been logged.
+func outputSkipped(ui cli.Ui, unimplemented *schema.UnimplementedTracker, logFormat string) {
+	skipped := unimplemented.Skipped()
+
+	if len(skipped) == 0 || logFormat == logging.FormatJSON {
+		return
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("to/from methods were not generated for %d path(s), use --strict to fail instead:", len(skipped)))
+
+	for _, s := range skipped {
+		sb.WriteString("\n  " + s.Path)
+	}
+
+	ui.Warn(sb.String())
+}
@@ -4,13 +4,24 @@ package datasource_example
 
 import (
 	"context"
+	"encoding/json"
 	"example.com/apisdk"
+	"example.com/ratiotypes"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"math/big"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -22,6 +33,30 @@ func ExampleDataSourceSchema(ctx context.Context) schema.Schema {
 			"bool_attribute": schema.BoolAttribute{
 				Computed: true,
 			},
+			"dynamic_attribute": schema.DynamicAttribute{
+				Computed: true,
+			},
+			"dynamic_attribute_assoc_ext_type": schema.DynamicAttribute{
+				CustomType: DynamicAttributeAssocExtTypeType{},
+				Computed:   true,
+			},
+			"float32_attribute": schema.Float32Attribute{
+				Computed: true,
+			},
+			"int64_attribute_constraints": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10),
+				},
+			},
+			"list_float32_attribute": schema.ListAttribute{
+				ElementType: types.Float32Type,
+				Computed:    true,
+			},
+			"list_float32_custom_type_attribute": schema.ListAttribute{
+				ElementType: ratiotypes.RatioType{},
+				Computed:    true,
+			},
 			"list_list_attribute": schema.ListAttribute{
 				ElementType: types.ListType{
 					ElemType: types.StringType,
@@ -336,6 +371,16 @@ func ExampleDataSourceSchema(ctx context.Context) schema.Schema {
 				},
 				Computed: true,
 			},
+			"string_attribute_constraints": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.RegexMatches(regexp.MustCompile("^[a-z]+$"), "must contain only lowercase letters"),
+					stringvalidator.ConflictsWith(
+						path.MatchRelative().AtParent().AtName("bool_attribute"),
+					),
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"list_nested_block_assoc_ext_type": schema.ListNestedBlock{
@@ -381,6 +426,9 @@ func ExampleDataSourceSchema(ctx context.Context) schema.Schema {
 						},
 					},
 				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
 			},
 			"list_nested_block_three": schema.ListNestedBlock{
 				NestedObject: schema.NestedBlockObject{
@@ -571,8 +619,27 @@ func ExampleDataSourceSchema(ctx context.Context) schema.Schema {
 	}
 }
 
+func ExampleDataSourceConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("int64_attribute_constraints"),
+			path.MatchRoot("string_attribute_constraints"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("list_nested_block_one").AtAnyListIndex().AtName("bool_attribute"),
+			path.MatchRoot("single_nested_block_one").AtName("bool_attribute"),
+		),
+	}
+}
+
 type ExampleModel struct {
 	BoolAttribute                     types.Bool                             `tfsdk:"bool_attribute"`
+	DynamicAttribute                  types.Dynamic                          `tfsdk:"dynamic_attribute"`
+	DynamicAttributeAssocExtType      DynamicAttributeAssocExtTypeValue      `tfsdk:"dynamic_attribute_assoc_ext_type"`
+	Float32Attribute                  types.Float32                          `tfsdk:"float32_attribute"`
+	Int64AttributeConstraints         types.Int64                            `tfsdk:"int64_attribute_constraints"`
+	ListFloat32Attribute              types.List                             `tfsdk:"list_float32_attribute"`
+	ListFloat32CustomTypeAttribute    types.List                             `tfsdk:"list_float32_custom_type_attribute"`
 	ListListAttribute                 types.List                             `tfsdk:"list_list_attribute"`
 	ListMapAttribute                  types.List                             `tfsdk:"list_map_attribute"`
 	ListNestedAttributeAssocExtType   types.List                             `tfsdk:"list_nested_attribute_assoc_ext_type"`
@@ -590,6 +657,7 @@ type ExampleModel struct {
 	SingleNestedAttributeOne          SingleNestedAttributeOneValue          `tfsdk:"single_nested_attribute_one"`
 	SingleNestedAttributeThree        SingleNestedAttributeThreeValue        `tfsdk:"single_nested_attribute_three"`
 	SingleNestedAttributeTwo          SingleNestedAttributeTwoValue          `tfsdk:"single_nested_attribute_two"`
+	StringAttributeConstraints        types.String                           `tfsdk:"string_attribute_constraints"`
 	ListNestedBlockAssocExtType       types.List                             `tfsdk:"list_nested_block_assoc_ext_type"`
 	ListNestedBlockOne                types.List                             `tfsdk:"list_nested_block_one"`
 	ListNestedBlockThree              types.List                             `tfsdk:"list_nested_block_three"`
@@ -601,6 +669,78 @@ type ExampleModel struct {
 	SingleNestedBlockTwo              SingleNestedBlockTwoValue              `tfsdk:"single_nested_block_two"`
 }
 
+var _ basetypes.DynamicTypable = DynamicAttributeAssocExtTypeType{}
+
+type DynamicAttributeAssocExtTypeType struct {
+	basetypes.DynamicType
+}
+
+func (t DynamicAttributeAssocExtTypeType) Equal(o attr.Type) bool {
+	other, ok := o.(DynamicAttributeAssocExtTypeType)
+
+	if !ok {
+		return false
+	}
+
+	return t.DynamicType.Equal(other.DynamicType)
+}
+
+func (t DynamicAttributeAssocExtTypeType) String() string {
+	return "DynamicAttributeAssocExtTypeType"
+}
+
+func (t DynamicAttributeAssocExtTypeType) ValueFromDynamic(ctx context.Context, in basetypes.DynamicValue) (basetypes.DynamicValuable, diag.Diagnostics) {
+	return DynamicAttributeAssocExtTypeValue{
+		DynamicValue: in,
+	}, nil
+}
+
+func (t DynamicAttributeAssocExtTypeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.DynamicType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicValue, ok := attrValue.(basetypes.DynamicValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	dynamicValuable, diags := t.ValueFromDynamic(ctx, dynamicValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting DynamicValue to DynamicValuable: %v", diags)
+	}
+
+	return dynamicValuable, nil
+}
+
+func (t DynamicAttributeAssocExtTypeType) ValueType(ctx context.Context) attr.Value {
+	return DynamicAttributeAssocExtTypeValue{}
+}
+
+var _ basetypes.DynamicValuable = DynamicAttributeAssocExtTypeValue{}
+
+type DynamicAttributeAssocExtTypeValue struct {
+	basetypes.DynamicValue
+}
+
+func (v DynamicAttributeAssocExtTypeValue) Equal(o attr.Value) bool {
+	other, ok := o.(DynamicAttributeAssocExtTypeValue)
+
+	if !ok {
+		return false
+	}
+
+	return v.DynamicValue.Equal(other.DynamicValue)
+}
+
+func (v DynamicAttributeAssocExtTypeValue) Type(ctx context.Context) attr.Type {
+	return DynamicAttributeAssocExtTypeType{}
+}
+
 var _ basetypes.ObjectTypable = ListNestedAttributeAssocExtTypeType{}
 
 type ListNestedAttributeAssocExtTypeType struct {
@@ -11496,6 +11636,219 @@ func (v SingleNestedBlockTwoSingleNestedBlockOneValue) AttributeTypes(ctx contex
 	}
 }
 
+func (v DynamicAttributeAssocExtTypeValue) ToAny(ctx context.Context) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnderlyingValueNull() {
+		return nil, diags
+	}
+
+	if v.IsUnknown() || v.IsUnderlyingValueUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(
+			"DynamicAttributeAssocExtTypeValue Value Is Unknown",
+			`"DynamicAttributeAssocExtTypeValue" is unknown.`,
+		))
+
+		return nil, diags
+	}
+
+	var toAny func(value attr.Value) (any, diag.Diagnostics)
+
+	toAny = func(value attr.Value) (any, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		if value.IsNull() {
+			return nil, diags
+		}
+
+		if value.IsUnknown() {
+			diags.Append(diag.NewErrorDiagnostic(
+				"DynamicAttributeAssocExtTypeValue Value Is Unknown",
+				`"DynamicAttributeAssocExtTypeValue" contains an unknown value.`,
+			))
+
+			return nil, diags
+		}
+
+		switch value := value.(type) {
+		case basetypes.DynamicValue:
+			return toAny(value.UnderlyingValue())
+		case basetypes.BoolValue:
+			return value.ValueBool(), diags
+		case basetypes.NumberValue:
+			return json.Number(value.ValueBigFloat().Text('g', -1)), diags
+		case basetypes.StringValue:
+			return value.ValueString(), diags
+		case basetypes.MapValue:
+			m := make(map[string]any, len(value.Elements()))
+
+			for k, e := range value.Elements() {
+				a, d := toAny(e)
+
+				diags.Append(d...)
+
+				m[k] = a
+			}
+
+			return m, diags
+		case basetypes.ObjectValue:
+			m := make(map[string]any, len(value.Attributes()))
+
+			for k, e := range value.Attributes() {
+				a, d := toAny(e)
+
+				diags.Append(d...)
+
+				m[k] = a
+			}
+
+			return m, diags
+		case interface{ Elements() []attr.Value }:
+			s := make([]any, 0, len(value.Elements()))
+
+			for _, e := range value.Elements() {
+				a, d := toAny(e)
+
+				diags.Append(d...)
+
+				s = append(s, a)
+			}
+
+			return s, diags
+		}
+
+		diags.Append(diag.NewErrorDiagnostic(
+			"DynamicAttributeAssocExtTypeValue Value Is Unsupported",
+			fmt.Sprintf(`"DynamicAttributeAssocExtTypeValue" contains a value of unsupported type %T.`, value),
+		))
+
+		return nil, diags
+	}
+
+	a, d := toAny(v.UnderlyingValue())
+
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return a, diags
+}
+
+func (v DynamicAttributeAssocExtTypeValue) FromAny(ctx context.Context, apiObject any) (DynamicAttributeAssocExtTypeValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return DynamicAttributeAssocExtTypeValue{
+			types.DynamicNull(),
+		}, diags
+	}
+
+	var a any = apiObject
+
+	var fromAny func(a any) (attr.Value, diag.Diagnostics)
+
+	fromAny = func(a any) (attr.Value, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		switch a := a.(type) {
+		case nil:
+			return types.DynamicNull(), diags
+		case bool:
+			return types.BoolValue(a), diags
+		case float64:
+			return types.NumberValue(big.NewFloat(a)), diags
+		case int:
+			return types.NumberValue(new(big.Float).SetInt64(int64(a))), diags
+		case int64:
+			return types.NumberValue(new(big.Float).SetInt64(a)), diags
+		case json.Number:
+			// The precision matches that of numbers in Terraform, so that large
+			// integers keep their value.
+			f, _, err := big.ParseFloat(a.String(), 10, 512, big.ToNearestEven)
+
+			if err != nil {
+				diags.Append(diag.NewErrorDiagnostic(
+					"DynamicAttributeAssocExtTypeValue Value Is Invalid",
+					fmt.Sprintf(`"DynamicAttributeAssocExtTypeValue" contains an invalid number %q.`, a),
+				))
+
+				return nil, diags
+			}
+
+			return types.NumberValue(f), diags
+		case string:
+			return types.StringValue(a), diags
+		case []any:
+			elementTypes := make([]attr.Type, 0, len(a))
+			elements := make([]attr.Value, 0, len(a))
+
+			for _, e := range a {
+				element, d := fromAny(e)
+
+				diags.Append(d...)
+
+				if diags.HasError() {
+					return nil, diags
+				}
+
+				elementTypes = append(elementTypes, element.Type(ctx))
+				elements = append(elements, element)
+			}
+
+			tupleValue, d := basetypes.NewTupleValue(elementTypes, elements)
+
+			diags.Append(d...)
+
+			return tupleValue, diags
+		case map[string]any:
+			attributeTypes := make(map[string]attr.Type, len(a))
+			attributes := make(map[string]attr.Value, len(a))
+
+			for k, e := range a {
+				attribute, d := fromAny(e)
+
+				diags.Append(d...)
+
+				if diags.HasError() {
+					return nil, diags
+				}
+
+				attributeTypes[k] = attribute.Type(ctx)
+				attributes[k] = attribute
+			}
+
+			objectValue, d := basetypes.NewObjectValue(attributeTypes, attributes)
+
+			diags.Append(d...)
+
+			return objectValue, diags
+		}
+
+		diags.Append(diag.NewErrorDiagnostic(
+			"DynamicAttributeAssocExtTypeValue Value Is Unsupported",
+			fmt.Sprintf(`"DynamicAttributeAssocExtTypeValue" cannot be created from a value of unsupported type %T.`, a),
+		))
+
+		return nil, diags
+	}
+
+	value, d := fromAny(a)
+
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return DynamicAttributeAssocExtTypeValue{
+			types.DynamicUnknown(),
+		}, diags
+	}
+
+	return DynamicAttributeAssocExtTypeValue{
+		types.DynamicValue(value),
+	}, diags
+}
+
 func (v ListNestedAttributeAssocExtTypeValue) ToApisdkType(ctx context.Context) (*apisdk.Type, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
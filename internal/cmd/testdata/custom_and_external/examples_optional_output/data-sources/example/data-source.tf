@@ -0,0 +1,70 @@
+data "example_example" "example" {
+  int64_attribute_constraints          = 1
+  list_nested_attribute_assoc_ext_type = [{
+    float64_attribute = 1.5
+    int64_attribute   = 1
+    number_attribute  = 1
+    string_attribute  = "example"
+  }]
+  map_nested_attribute_assoc_ext_type = {
+    key = {
+      float64_attribute = 1.5
+      int64_attribute   = 1
+      number_attribute  = 1
+      string_attribute  = "example"
+    }
+  }
+  set_nested_attribute_assoc_ext_type = [{
+    float64_attribute = 1.5
+    int64_attribute   = 1
+    number_attribute  = 1
+    string_attribute  = "example"
+  }]
+  single_nested_attribute_assoc_ext_type = {
+    float64_attribute = 1.5
+    int64_attribute   = 1
+    number_attribute  = 1
+    string_attribute  = "example"
+  }
+
+  list_nested_block_assoc_ext_type {
+    float64_attribute = 1.5
+    int64_attribute   = 1
+    number_attribute  = 1
+    string_attribute  = "example"
+  }
+
+  list_nested_block_one {}
+
+  list_nested_block_three {
+    list_nested_block_three_list_nested_block_one {}
+  }
+
+  list_nested_block_two {
+    list_nested_block_two_list_nested_block_one {}
+  }
+
+  set_nested_block_assoc_ext_type {
+    float64_attribute = 1.5
+    int64_attribute   = 1
+    number_attribute  = 1
+    string_attribute  = "example"
+  }
+
+  single_nested_block_assoc_ext_type {
+    float64_attribute = 1.5
+    int64_attribute   = 1
+    number_attribute  = 1
+    string_attribute  = "example"
+  }
+
+  single_nested_block_one {}
+
+  single_nested_block_three {
+    single_nested_block_three_list_nested_block_one {}
+  }
+
+  single_nested_block_two {
+    single_nested_block_two_single_nested_block_one {}
+  }
+}
@@ -0,0 +1,93 @@
+resource "example_example" "example" {
+  dynamic_attribute                    = "example"
+  dynamic_attribute_assoc_ext_type     = "example"
+  float32_attribute                    = 1.5
+  list_attribute_constraints           = ["example"]
+  list_attribute_default               = ["a", "b"]
+  list_list_attribute_assoc_ext_type   = [["example"]]
+  list_nested_attribute_assoc_ext_type = [{
+    bool_attribute       = true
+    float32_attribute    = 1.5
+    float64_attribute    = 1.5
+    int32_attribute      = 1
+    int64_attribute      = 1
+    list_list_attribute  = [["example"]]
+    map_object_attribute = {
+      key = {
+        name   = "example"
+        values = [1]
+      }
+    }
+    number_attribute = 1
+    object_attribute = {
+      int32_attribute = 1
+      list_attribute  = ["example"]
+    }
+    string_attribute = "example"
+  }]
+  map_attribute_default               = { "one" = 1, "two" = 2 }
+  map_nested_attribute_assoc_ext_type = {
+    key = {
+      bool_attribute    = true
+      float64_attribute = 1.5
+      int64_attribute   = 1
+      number_attribute  = 1
+      string_attribute  = "example"
+    }
+  }
+  object_attribute_default = { name = "example", tags = ["a"] }
+  object_float32_attribute = {
+    float32_attribute = 1.5
+  }
+  set_attribute_default               = [1.5]
+  set_nested_attribute_assoc_ext_type = [
+    {
+      bool_attribute    = true
+      float64_attribute = 1.5
+      int64_attribute   = 1
+      number_attribute  = 1
+      string_attribute  = "example"
+    },
+    {
+      bool_attribute    = false
+      float64_attribute = 2.5
+      int64_attribute   = 2
+      number_attribute  = 2
+      string_attribute  = "example-2"
+    },
+  ]
+  single_nested_attribute_assoc_ext_type = {
+    bool_attribute       = true
+    float64_attribute    = 1.5
+    int64_attribute      = 1
+    number_attribute     = 1
+    string_attribute     = "example"
+    write_only_attribute = "example"
+  }
+  string_attribute_constraints = "one"
+  write_only_attribute         = "example"
+
+  list_nested_block_assoc_ext_type {
+    bool_attribute    = true
+    float64_attribute = 1.5
+    int64_attribute   = 1
+    number_attribute  = 1
+    string_attribute  = "example"
+  }
+
+  set_nested_block_assoc_ext_type {
+    bool_attribute    = true
+    float64_attribute = 1.5
+    int64_attribute   = 1
+    number_attribute  = 1
+    string_attribute  = "example"
+  }
+
+  single_nested_block_assoc_ext_type {
+    bool_attribute    = true
+    float64_attribute = 1.5
+    int64_attribute   = 1
+    number_attribute  = 1
+    string_attribute  = "example"
+  }
+}
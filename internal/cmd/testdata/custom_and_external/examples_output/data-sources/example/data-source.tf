@@ -0,0 +1,3 @@
+data "example_example" "example" {
+  int64_attribute_constraints = 1
+}
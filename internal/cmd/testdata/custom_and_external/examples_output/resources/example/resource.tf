@@ -0,0 +1,7 @@
+resource "example_example" "example" {
+  write_only_attribute = "example"
+
+  list_nested_block_assoc_ext_type {
+    string_attribute = "example"
+  }
+}
@@ -6,8 +6,11 @@ import (
 	"context"
 	"example.com/apisdk"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -19,6 +22,12 @@ import (
 func ExampleProviderSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"float32_attribute_constraints": schema.Float32Attribute{
+				Optional: true,
+				Validators: []validator.Float32{
+					float32validator.AtLeast(0.5),
+				},
+			},
 			"list_nested_attribute_assoc_ext_type": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -28,6 +37,9 @@ func ExampleProviderSchema(ctx context.Context) schema.Schema {
 						"float64_attribute": schema.Float64Attribute{
 							Optional: true,
 						},
+						"int32_attribute": schema.Int32Attribute{
+							Optional: true,
+						},
 						"int64_attribute": schema.Int64Attribute{
 							Optional: true,
 						},
@@ -152,6 +164,9 @@ func ExampleProviderSchema(ctx context.Context) schema.Schema {
 						},
 					},
 				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
 			},
 			"set_nested_block_assoc_ext_type": schema.SetNestedBlock{
 				NestedObject: schema.NestedBlockObject{
@@ -211,6 +226,7 @@ func ExampleProviderSchema(ctx context.Context) schema.Schema {
 }
 
 type ExampleModel struct {
+	Float32AttributeConstraints       types.Float32                          `tfsdk:"float32_attribute_constraints"`
 	ListNestedAttributeAssocExtType   types.List                             `tfsdk:"list_nested_attribute_assoc_ext_type"`
 	MapNestedAttributeAssocExtType    types.Map                              `tfsdk:"map_nested_attribute_assoc_ext_type"`
 	SetNestedAttributeAssocExtType    types.Set                              `tfsdk:"set_nested_attribute_assoc_ext_type"`
@@ -281,6 +297,24 @@ func (t ListNestedAttributeAssocExtTypeType) ValueFromObject(ctx context.Context
 			fmt.Sprintf(`float64_attribute expected to be basetypes.Float64Value, was: %T`, float64AttributeAttribute))
 	}
 
+	int32AttributeAttribute, ok := attributes["int32_attribute"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`int32_attribute is missing from object`)
+
+		return nil, diags
+	}
+
+	int32AttributeVal, ok := int32AttributeAttribute.(basetypes.Int32Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`int32_attribute expected to be basetypes.Int32Value, was: %T`, int32AttributeAttribute))
+	}
+
 	int64AttributeAttribute, ok := attributes["int64_attribute"]
 
 	if !ok {
@@ -342,6 +376,7 @@ func (t ListNestedAttributeAssocExtTypeType) ValueFromObject(ctx context.Context
 	return ListNestedAttributeAssocExtTypeValue{
 		BoolAttribute:    boolAttributeVal,
 		Float64Attribute: float64AttributeVal,
+		Int32Attribute:   int32AttributeVal,
 		Int64Attribute:   int64AttributeVal,
 		NumberAttribute:  numberAttributeVal,
 		StringAttribute:  stringAttributeVal,
@@ -448,6 +483,24 @@ func NewListNestedAttributeAssocExtTypeValue(attributeTypes map[string]attr.Type
 			fmt.Sprintf(`float64_attribute expected to be basetypes.Float64Value, was: %T`, float64AttributeAttribute))
 	}
 
+	int32AttributeAttribute, ok := attributes["int32_attribute"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`int32_attribute is missing from object`)
+
+		return NewListNestedAttributeAssocExtTypeValueUnknown(), diags
+	}
+
+	int32AttributeVal, ok := int32AttributeAttribute.(basetypes.Int32Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`int32_attribute expected to be basetypes.Int32Value, was: %T`, int32AttributeAttribute))
+	}
+
 	int64AttributeAttribute, ok := attributes["int64_attribute"]
 
 	if !ok {
@@ -509,6 +562,7 @@ func NewListNestedAttributeAssocExtTypeValue(attributeTypes map[string]attr.Type
 	return ListNestedAttributeAssocExtTypeValue{
 		BoolAttribute:    boolAttributeVal,
 		Float64Attribute: float64AttributeVal,
+		Int32Attribute:   int32AttributeVal,
 		Int64Attribute:   int64AttributeVal,
 		NumberAttribute:  numberAttributeVal,
 		StringAttribute:  stringAttributeVal,
@@ -586,6 +640,7 @@ var _ basetypes.ObjectValuable = ListNestedAttributeAssocExtTypeValue{}
 type ListNestedAttributeAssocExtTypeValue struct {
 	BoolAttribute    basetypes.BoolValue    `tfsdk:"bool_attribute"`
 	Float64Attribute basetypes.Float64Value `tfsdk:"float64_attribute"`
+	Int32Attribute   basetypes.Int32Value   `tfsdk:"int32_attribute"`
 	Int64Attribute   basetypes.Int64Value   `tfsdk:"int64_attribute"`
 	NumberAttribute  basetypes.NumberValue  `tfsdk:"number_attribute"`
 	StringAttribute  basetypes.StringValue  `tfsdk:"string_attribute"`
@@ -593,13 +648,14 @@ type ListNestedAttributeAssocExtTypeValue struct {
 }
 
 func (v ListNestedAttributeAssocExtTypeValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 5)
+	attrTypes := make(map[string]tftypes.Type, 6)
 
 	var val tftypes.Value
 	var err error
 
 	attrTypes["bool_attribute"] = basetypes.BoolType{}.TerraformType(ctx)
 	attrTypes["float64_attribute"] = basetypes.Float64Type{}.TerraformType(ctx)
+	attrTypes["int32_attribute"] = basetypes.Int32Type{}.TerraformType(ctx)
 	attrTypes["int64_attribute"] = basetypes.Int64Type{}.TerraformType(ctx)
 	attrTypes["number_attribute"] = basetypes.NumberType{}.TerraformType(ctx)
 	attrTypes["string_attribute"] = basetypes.StringType{}.TerraformType(ctx)
@@ -608,7 +664,7 @@ func (v ListNestedAttributeAssocExtTypeValue) ToTerraformValue(ctx context.Conte
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 5)
+		vals := make(map[string]tftypes.Value, 6)
 
 		val, err = v.BoolAttribute.ToTerraformValue(ctx)
 
@@ -626,6 +682,14 @@ func (v ListNestedAttributeAssocExtTypeValue) ToTerraformValue(ctx context.Conte
 
 		vals["float64_attribute"] = val
 
+		val, err = v.Int32Attribute.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["int32_attribute"] = val
+
 		val, err = v.Int64Attribute.ToTerraformValue(ctx)
 
 		if err != nil {
@@ -682,6 +746,7 @@ func (v ListNestedAttributeAssocExtTypeValue) ToObjectValue(ctx context.Context)
 	attributeTypes := map[string]attr.Type{
 		"bool_attribute":    basetypes.BoolType{},
 		"float64_attribute": basetypes.Float64Type{},
+		"int32_attribute":   basetypes.Int32Type{},
 		"int64_attribute":   basetypes.Int64Type{},
 		"number_attribute":  basetypes.NumberType{},
 		"string_attribute":  basetypes.StringType{},
@@ -700,6 +765,7 @@ func (v ListNestedAttributeAssocExtTypeValue) ToObjectValue(ctx context.Context)
 		map[string]attr.Value{
 			"bool_attribute":    v.BoolAttribute,
 			"float64_attribute": v.Float64Attribute,
+			"int32_attribute":   v.Int32Attribute,
 			"int64_attribute":   v.Int64Attribute,
 			"number_attribute":  v.NumberAttribute,
 			"string_attribute":  v.StringAttribute,
@@ -731,6 +797,10 @@ func (v ListNestedAttributeAssocExtTypeValue) Equal(o attr.Value) bool {
 		return false
 	}
 
+	if !v.Int32Attribute.Equal(other.Int32Attribute) {
+		return false
+	}
+
 	if !v.Int64Attribute.Equal(other.Int64Attribute) {
 		return false
 	}
@@ -758,6 +828,7 @@ func (v ListNestedAttributeAssocExtTypeValue) AttributeTypes(ctx context.Context
 	return map[string]attr.Type{
 		"bool_attribute":    basetypes.BoolType{},
 		"float64_attribute": basetypes.Float64Type{},
+		"int32_attribute":   basetypes.Int32Type{},
 		"int64_attribute":   basetypes.Int64Type{},
 		"number_attribute":  basetypes.NumberType{},
 		"string_attribute":  basetypes.StringType{},
@@ -4047,6 +4118,7 @@ func (v ListNestedAttributeAssocExtTypeValue) ToApisdkType(ctx context.Context)
 	return &apisdk.Type{
 		BoolAttribute:    v.BoolAttribute.ValueBoolPointer(),
 		Float64Attribute: v.Float64Attribute.ValueFloat64Pointer(),
+		Int32Attribute:   v.Int32Attribute.ValueInt32Pointer(),
 		Int64Attribute:   v.Int64Attribute.ValueInt64Pointer(),
 		NumberAttribute:  v.NumberAttribute.ValueBigFloat(),
 		StringAttribute:  v.StringAttribute.ValueStringPointer(),
@@ -4063,6 +4135,7 @@ func (v ListNestedAttributeAssocExtTypeValue) FromApisdkType(ctx context.Context
 	return ListNestedAttributeAssocExtTypeValue{
 		BoolAttribute:    types.BoolPointerValue(apiObject.BoolAttribute),
 		Float64Attribute: types.Float64PointerValue(apiObject.Float64Attribute),
+		Int32Attribute:   types.Int32PointerValue(apiObject.Int32Attribute),
 		Int64Attribute:   types.Int64PointerValue(apiObject.Int64Attribute),
 		NumberAttribute:  types.NumberValue(apiObject.NumberAttribute),
 		StringAttribute:  types.StringPointerValue(apiObject.StringAttribute),
@@ -3,14 +3,31 @@
 package generated
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"example.com/apisdk"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -18,6 +35,7 @@ import (
 	planmodifieralias "github.com/my_account/my_project/myboolplanmodifier"
 	validatoralias "github.com/my_account/my_project/myboolvalidator"
 	boolalias "github.com/my_account_my_project/bool"
+	"math/big"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -38,21 +56,97 @@ func ExampleResourceSchema(ctx context.Context) schema.Schema {
 				},
 				Default: booldefault.StaticBool(true),
 			},
+			"dynamic_attribute": schema.DynamicAttribute{
+				Optional:            true,
+				Description:         "A free-form JSON document.",
+				MarkdownDescription: "A free-form JSON document.",
+			},
+			"dynamic_attribute_assoc_ext_type": schema.DynamicAttribute{
+				CustomType: DynamicAttributeAssocExtTypeType{},
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.Dynamic{
+					dynamicplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"float32_attribute": schema.Float32Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  float32default.StaticFloat32(1.5),
+			},
+			"list_attribute_constraints": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 3),
+				},
+			},
+			"list_attribute_default": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default: listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("a"),
+					types.StringValue("b"),
+				})),
+			},
+			"list_list_attribute_assoc_ext_type": schema.ListAttribute{
+				CustomType: ListListAttributeAssocExtTypeType{
+					types.ListType{
+						ElemType: types.ListType{
+							ElemType: types.StringType,
+						},
+					},
+				},
+				Optional: true,
+			},
 			"list_nested_attribute_assoc_ext_type": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"bool_attribute": schema.BoolAttribute{
 							Optional: true,
 						},
+						"float32_attribute": schema.Float32Attribute{
+							Optional: true,
+						},
 						"float64_attribute": schema.Float64Attribute{
 							Optional: true,
 						},
+						"int32_attribute": schema.Int32Attribute{
+							Optional: true,
+						},
 						"int64_attribute": schema.Int64Attribute{
 							Optional: true,
 						},
+						"list_list_attribute": schema.ListAttribute{
+							ElementType: types.ListType{
+								ElemType: types.StringType,
+							},
+							Optional: true,
+						},
+						"map_object_attribute": schema.MapAttribute{
+							ElementType: types.ObjectType{
+								AttrTypes: map[string]attr.Type{
+									"name": types.StringType,
+									"values": types.ListType{
+										ElemType: types.Int64Type,
+									},
+								},
+							},
+							Optional: true,
+						},
 						"number_attribute": schema.NumberAttribute{
 							Optional: true,
 						},
+						"object_attribute": schema.ObjectAttribute{
+							AttributeTypes: map[string]attr.Type{
+								"int32_attribute": types.Int32Type,
+								"list_attribute": types.ListType{
+									ElemType: types.StringType,
+								},
+							},
+							Optional: true,
+						},
 						"string_attribute": schema.StringAttribute{
 							Optional: true,
 						},
@@ -64,6 +158,18 @@ func ExampleResourceSchema(ctx context.Context) schema.Schema {
 					},
 				},
 				Optional: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"map_attribute_default": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Computed:    true,
+				Default: mapdefault.StaticValue(types.MapValueMust(types.Int64Type, map[string]attr.Value{
+					"one": types.Int64Value(1),
+					"two": types.Int64Value(2),
+				})),
 			},
 			"map_nested_attribute_assoc_ext_type": schema.MapNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
@@ -92,6 +198,44 @@ func ExampleResourceSchema(ctx context.Context) schema.Schema {
 				},
 				Optional: true,
 			},
+			"object_attribute_default": schema.ObjectAttribute{
+				AttributeTypes: map[string]attr.Type{
+					"name":  types.StringType,
+					"ratio": types.Float32Type,
+					"tags": types.SetType{
+						ElemType: types.StringType,
+					},
+				},
+				Optional: true,
+				Computed: true,
+				Default: objectdefault.StaticValue(types.ObjectValueMust(map[string]attr.Type{
+					"name":  types.StringType,
+					"ratio": types.Float32Type,
+					"tags": types.SetType{
+						ElemType: types.StringType,
+					},
+				}, map[string]attr.Value{
+					"name":  types.StringValue("example"),
+					"ratio": types.Float32Null(),
+					"tags": types.SetValueMust(types.StringType, []attr.Value{
+						types.StringValue("a"),
+					}),
+				})),
+			},
+			"object_float32_attribute": schema.ObjectAttribute{
+				AttributeTypes: map[string]attr.Type{
+					"float32_attribute": types.Float32Type,
+				},
+				Optional: true,
+			},
+			"set_attribute_default": schema.SetAttribute{
+				ElementType: types.NumberType,
+				Optional:    true,
+				Computed:    true,
+				Default: setdefault.StaticValue(types.SetValueMust(types.NumberType, []attr.Value{
+					types.NumberValue(big.NewFloat(1.5)),
+				})),
+			},
 			"set_nested_attribute_assoc_ext_type": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -118,6 +262,9 @@ func ExampleResourceSchema(ctx context.Context) schema.Schema {
 					},
 				},
 				Optional: true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(2),
+				},
 			},
 			"single_nested_attribute_assoc_ext_type": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
@@ -136,6 +283,10 @@ func ExampleResourceSchema(ctx context.Context) schema.Schema {
 					"string_attribute": schema.StringAttribute{
 						Optional: true,
 					},
+					"write_only_attribute": schema.StringAttribute{
+						Optional:  true,
+						WriteOnly: true,
+					},
 				},
 				CustomType: SingleNestedAttributeAssocExtTypeType{
 					ObjectType: types.ObjectType{
@@ -144,6 +295,28 @@ func ExampleResourceSchema(ctx context.Context) schema.Schema {
 				},
 				Optional: true,
 			},
+			"string_attribute_constraints": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("one", "two"),
+					stringvalidator.AlsoRequires(
+						path.MatchRelative().AtParent().AtName("bool_attribute"),
+					),
+				},
+			},
+			"string_attribute_plan_modifiers": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"write_only_attribute": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"list_nested_block_assoc_ext_type": schema.ListNestedBlock{
@@ -171,6 +344,9 @@ func ExampleResourceSchema(ctx context.Context) schema.Schema {
 						},
 					},
 				},
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 1),
+				},
 			},
 			"set_nested_block_assoc_ext_type": schema.SetNestedBlock{
 				NestedObject: schema.NestedBlockObject{
@@ -229,17 +405,222 @@ func ExampleResourceSchema(ctx context.Context) schema.Schema {
 	}
 }
 
+func ExampleResourceIdentitySchema(ctx context.Context) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The identifier of the example.",
+			},
+			"region": identityschema.StringAttribute{
+				OptionalForImport: true,
+			},
+			"tags": identityschema.ListAttribute{
+				ElementType:       types.StringType,
+				OptionalForImport: true,
+			},
+			"weight": identityschema.Float32Attribute{
+				OptionalForImport: true,
+				Description:       "The weight of the example.",
+			},
+		},
+	}
+}
+
+func ExampleResourceConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("list_attribute_constraints"),
+			path.MatchRoot("string_attribute_plan_modifiers"),
+		),
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("list_nested_block_assoc_ext_type").AtAnyListIndex().AtName("string_attribute"),
+			path.MatchRoot("single_nested_block_assoc_ext_type").AtName("string_attribute"),
+		),
+	}
+}
+
 type ExampleModel struct {
 	BoolAttribute                     my_bool_value                          `tfsdk:"bool_attribute"`
+	DynamicAttribute                  types.Dynamic                          `tfsdk:"dynamic_attribute"`
+	DynamicAttributeAssocExtType      DynamicAttributeAssocExtTypeValue      `tfsdk:"dynamic_attribute_assoc_ext_type"`
+	Float32Attribute                  types.Float32                          `tfsdk:"float32_attribute"`
+	ListAttributeConstraints          types.List                             `tfsdk:"list_attribute_constraints"`
+	ListAttributeDefault              types.List                             `tfsdk:"list_attribute_default"`
+	ListListAttributeAssocExtType     ListListAttributeAssocExtTypeValue     `tfsdk:"list_list_attribute_assoc_ext_type"`
 	ListNestedAttributeAssocExtType   types.List                             `tfsdk:"list_nested_attribute_assoc_ext_type"`
+	MapAttributeDefault               types.Map                              `tfsdk:"map_attribute_default"`
 	MapNestedAttributeAssocExtType    types.Map                              `tfsdk:"map_nested_attribute_assoc_ext_type"`
+	ObjectAttributeDefault            types.Object                           `tfsdk:"object_attribute_default"`
+	ObjectFloat32Attribute            types.Object                           `tfsdk:"object_float32_attribute"`
+	SetAttributeDefault               types.Set                              `tfsdk:"set_attribute_default"`
 	SetNestedAttributeAssocExtType    types.Set                              `tfsdk:"set_nested_attribute_assoc_ext_type"`
 	SingleNestedAttributeAssocExtType SingleNestedAttributeAssocExtTypeValue `tfsdk:"single_nested_attribute_assoc_ext_type"`
+	StringAttributeConstraints        types.String                           `tfsdk:"string_attribute_constraints"`
+	StringAttributePlanModifiers      types.String                           `tfsdk:"string_attribute_plan_modifiers"`
+	WriteOnlyAttribute                types.String                           `tfsdk:"write_only_attribute"`
 	ListNestedBlockAssocExtType       types.List                             `tfsdk:"list_nested_block_assoc_ext_type"`
 	SetNestedBlockAssocExtType        types.Set                              `tfsdk:"set_nested_block_assoc_ext_type"`
 	SingleNestedBlockAssocExtType     SingleNestedBlockAssocExtTypeValue     `tfsdk:"single_nested_block_assoc_ext_type"`
 }
 
+type ExampleIdentityModel struct {
+	Id     types.String  `tfsdk:"id"`
+	Region types.String  `tfsdk:"region"`
+	Tags   types.List    `tfsdk:"tags"`
+	Weight types.Float32 `tfsdk:"weight"`
+}
+
+var _ basetypes.DynamicTypable = DynamicAttributeAssocExtTypeType{}
+
+type DynamicAttributeAssocExtTypeType struct {
+	basetypes.DynamicType
+}
+
+func (t DynamicAttributeAssocExtTypeType) Equal(o attr.Type) bool {
+	other, ok := o.(DynamicAttributeAssocExtTypeType)
+
+	if !ok {
+		return false
+	}
+
+	return t.DynamicType.Equal(other.DynamicType)
+}
+
+func (t DynamicAttributeAssocExtTypeType) String() string {
+	return "DynamicAttributeAssocExtTypeType"
+}
+
+func (t DynamicAttributeAssocExtTypeType) ValueFromDynamic(ctx context.Context, in basetypes.DynamicValue) (basetypes.DynamicValuable, diag.Diagnostics) {
+	return DynamicAttributeAssocExtTypeValue{
+		DynamicValue: in,
+	}, nil
+}
+
+func (t DynamicAttributeAssocExtTypeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.DynamicType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicValue, ok := attrValue.(basetypes.DynamicValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	dynamicValuable, diags := t.ValueFromDynamic(ctx, dynamicValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting DynamicValue to DynamicValuable: %v", diags)
+	}
+
+	return dynamicValuable, nil
+}
+
+func (t DynamicAttributeAssocExtTypeType) ValueType(ctx context.Context) attr.Value {
+	return DynamicAttributeAssocExtTypeValue{}
+}
+
+var _ basetypes.DynamicValuable = DynamicAttributeAssocExtTypeValue{}
+
+type DynamicAttributeAssocExtTypeValue struct {
+	basetypes.DynamicValue
+}
+
+func (v DynamicAttributeAssocExtTypeValue) Equal(o attr.Value) bool {
+	other, ok := o.(DynamicAttributeAssocExtTypeValue)
+
+	if !ok {
+		return false
+	}
+
+	return v.DynamicValue.Equal(other.DynamicValue)
+}
+
+func (v DynamicAttributeAssocExtTypeValue) Type(ctx context.Context) attr.Type {
+	return DynamicAttributeAssocExtTypeType{}
+}
+
+var _ basetypes.ListTypable = ListListAttributeAssocExtTypeType{}
+
+type ListListAttributeAssocExtTypeType struct {
+	basetypes.ListType
+}
+
+func (t ListListAttributeAssocExtTypeType) Equal(o attr.Type) bool {
+	other, ok := o.(ListListAttributeAssocExtTypeType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ListType.Equal(other.ListType)
+}
+
+func (t ListListAttributeAssocExtTypeType) String() string {
+	return "ListListAttributeAssocExtTypeType"
+}
+
+func (t ListListAttributeAssocExtTypeType) ValueFromList(ctx context.Context, in basetypes.ListValue) (basetypes.ListValuable, diag.Diagnostics) {
+	return ListListAttributeAssocExtTypeValue{
+		ListValue: in,
+	}, nil
+}
+
+func (t ListListAttributeAssocExtTypeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.ListType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	listValue, ok := attrValue.(basetypes.ListValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	listValuable, diags := t.ValueFromList(ctx, listValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting ListValue to ListValuable: %v", diags)
+	}
+
+	return listValuable, nil
+}
+
+func (t ListListAttributeAssocExtTypeType) ValueType(ctx context.Context) attr.Value {
+	return ListListAttributeAssocExtTypeValue{}
+}
+
+var _ basetypes.ListValuable = ListListAttributeAssocExtTypeValue{}
+
+type ListListAttributeAssocExtTypeValue struct {
+	basetypes.ListValue
+}
+
+func (v ListListAttributeAssocExtTypeValue) Equal(o attr.Value) bool {
+	other, ok := o.(ListListAttributeAssocExtTypeValue)
+
+	if !ok {
+		return false
+	}
+
+	return v.ListValue.Equal(other.ListValue)
+}
+
+func (v ListListAttributeAssocExtTypeValue) Type(ctx context.Context) attr.Type {
+	return ListListAttributeAssocExtTypeType{
+		ListType: basetypes.ListType{
+			ElemType: types.ListType{
+				ElemType: types.StringType,
+			},
+		},
+	}
+}
+
 var _ basetypes.ObjectTypable = ListNestedAttributeAssocExtTypeType{}
 
 type ListNestedAttributeAssocExtTypeType struct {
@@ -283,6 +664,24 @@ func (t ListNestedAttributeAssocExtTypeType) ValueFromObject(ctx context.Context
 			fmt.Sprintf(`bool_attribute expected to be basetypes.BoolValue, was: %T`, boolAttributeAttribute))
 	}
 
+	float32AttributeAttribute, ok := attributes["float32_attribute"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`float32_attribute is missing from object`)
+
+		return nil, diags
+	}
+
+	float32AttributeVal, ok := float32AttributeAttribute.(basetypes.Float32Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`float32_attribute expected to be basetypes.Float32Value, was: %T`, float32AttributeAttribute))
+	}
+
 	float64AttributeAttribute, ok := attributes["float64_attribute"]
 
 	if !ok {
@@ -301,6 +700,24 @@ func (t ListNestedAttributeAssocExtTypeType) ValueFromObject(ctx context.Context
 			fmt.Sprintf(`float64_attribute expected to be basetypes.Float64Value, was: %T`, float64AttributeAttribute))
 	}
 
+	int32AttributeAttribute, ok := attributes["int32_attribute"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`int32_attribute is missing from object`)
+
+		return nil, diags
+	}
+
+	int32AttributeVal, ok := int32AttributeAttribute.(basetypes.Int32Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`int32_attribute expected to be basetypes.Int32Value, was: %T`, int32AttributeAttribute))
+	}
+
 	int64AttributeAttribute, ok := attributes["int64_attribute"]
 
 	if !ok {
@@ -319,6 +736,42 @@ func (t ListNestedAttributeAssocExtTypeType) ValueFromObject(ctx context.Context
 			fmt.Sprintf(`int64_attribute expected to be basetypes.Int64Value, was: %T`, int64AttributeAttribute))
 	}
 
+	listListAttributeAttribute, ok := attributes["list_list_attribute"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`list_list_attribute is missing from object`)
+
+		return nil, diags
+	}
+
+	listListAttributeVal, ok := listListAttributeAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`list_list_attribute expected to be basetypes.ListValue, was: %T`, listListAttributeAttribute))
+	}
+
+	mapObjectAttributeAttribute, ok := attributes["map_object_attribute"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`map_object_attribute is missing from object`)
+
+		return nil, diags
+	}
+
+	mapObjectAttributeVal, ok := mapObjectAttributeAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`map_object_attribute expected to be basetypes.MapValue, was: %T`, mapObjectAttributeAttribute))
+	}
+
 	numberAttributeAttribute, ok := attributes["number_attribute"]
 
 	if !ok {
@@ -337,6 +790,24 @@ func (t ListNestedAttributeAssocExtTypeType) ValueFromObject(ctx context.Context
 			fmt.Sprintf(`number_attribute expected to be basetypes.NumberValue, was: %T`, numberAttributeAttribute))
 	}
 
+	objectAttributeAttribute, ok := attributes["object_attribute"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`object_attribute is missing from object`)
+
+		return nil, diags
+	}
+
+	objectAttributeVal, ok := objectAttributeAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`object_attribute expected to be basetypes.ObjectValue, was: %T`, objectAttributeAttribute))
+	}
+
 	stringAttributeAttribute, ok := attributes["string_attribute"]
 
 	if !ok {
@@ -360,12 +831,17 @@ func (t ListNestedAttributeAssocExtTypeType) ValueFromObject(ctx context.Context
 	}
 
 	return ListNestedAttributeAssocExtTypeValue{
-		BoolAttribute:    boolAttributeVal,
-		Float64Attribute: float64AttributeVal,
-		Int64Attribute:   int64AttributeVal,
-		NumberAttribute:  numberAttributeVal,
-		StringAttribute:  stringAttributeVal,
-		state:            attr.ValueStateKnown,
+		BoolAttribute:      boolAttributeVal,
+		Float32Attribute:   float32AttributeVal,
+		Float64Attribute:   float64AttributeVal,
+		Int32Attribute:     int32AttributeVal,
+		Int64Attribute:     int64AttributeVal,
+		ListListAttribute:  listListAttributeVal,
+		MapObjectAttribute: mapObjectAttributeVal,
+		NumberAttribute:    numberAttributeVal,
+		ObjectAttribute:    objectAttributeVal,
+		StringAttribute:    stringAttributeVal,
+		state:              attr.ValueStateKnown,
 	}, diags
 }
 
@@ -450,6 +926,24 @@ func NewListNestedAttributeAssocExtTypeValue(attributeTypes map[string]attr.Type
 			fmt.Sprintf(`bool_attribute expected to be basetypes.BoolValue, was: %T`, boolAttributeAttribute))
 	}
 
+	float32AttributeAttribute, ok := attributes["float32_attribute"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`float32_attribute is missing from object`)
+
+		return NewListNestedAttributeAssocExtTypeValueUnknown(), diags
+	}
+
+	float32AttributeVal, ok := float32AttributeAttribute.(basetypes.Float32Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`float32_attribute expected to be basetypes.Float32Value, was: %T`, float32AttributeAttribute))
+	}
+
 	float64AttributeAttribute, ok := attributes["float64_attribute"]
 
 	if !ok {
@@ -468,71 +962,148 @@ func NewListNestedAttributeAssocExtTypeValue(attributeTypes map[string]attr.Type
 			fmt.Sprintf(`float64_attribute expected to be basetypes.Float64Value, was: %T`, float64AttributeAttribute))
 	}
 
-	int64AttributeAttribute, ok := attributes["int64_attribute"]
+	int32AttributeAttribute, ok := attributes["int32_attribute"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`int64_attribute is missing from object`)
+			`int32_attribute is missing from object`)
 
 		return NewListNestedAttributeAssocExtTypeValueUnknown(), diags
 	}
 
-	int64AttributeVal, ok := int64AttributeAttribute.(basetypes.Int64Value)
+	int32AttributeVal, ok := int32AttributeAttribute.(basetypes.Int32Value)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`int64_attribute expected to be basetypes.Int64Value, was: %T`, int64AttributeAttribute))
+			fmt.Sprintf(`int32_attribute expected to be basetypes.Int32Value, was: %T`, int32AttributeAttribute))
 	}
 
-	numberAttributeAttribute, ok := attributes["number_attribute"]
+	int64AttributeAttribute, ok := attributes["int64_attribute"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`number_attribute is missing from object`)
+			`int64_attribute is missing from object`)
 
 		return NewListNestedAttributeAssocExtTypeValueUnknown(), diags
 	}
 
-	numberAttributeVal, ok := numberAttributeAttribute.(basetypes.NumberValue)
+	int64AttributeVal, ok := int64AttributeAttribute.(basetypes.Int64Value)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`number_attribute expected to be basetypes.NumberValue, was: %T`, numberAttributeAttribute))
+			fmt.Sprintf(`int64_attribute expected to be basetypes.Int64Value, was: %T`, int64AttributeAttribute))
 	}
 
-	stringAttributeAttribute, ok := attributes["string_attribute"]
+	listListAttributeAttribute, ok := attributes["list_list_attribute"]
 
 	if !ok {
 		diags.AddError(
 			"Attribute Missing",
-			`string_attribute is missing from object`)
+			`list_list_attribute is missing from object`)
 
 		return NewListNestedAttributeAssocExtTypeValueUnknown(), diags
 	}
 
-	stringAttributeVal, ok := stringAttributeAttribute.(basetypes.StringValue)
+	listListAttributeVal, ok := listListAttributeAttribute.(basetypes.ListValue)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`string_attribute expected to be basetypes.StringValue, was: %T`, stringAttributeAttribute))
+			fmt.Sprintf(`list_list_attribute expected to be basetypes.ListValue, was: %T`, listListAttributeAttribute))
 	}
 
-	if diags.HasError() {
-		return NewListNestedAttributeAssocExtTypeValueUnknown(), diags
-	}
+	mapObjectAttributeAttribute, ok := attributes["map_object_attribute"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`map_object_attribute is missing from object`)
+
+		return NewListNestedAttributeAssocExtTypeValueUnknown(), diags
+	}
+
+	mapObjectAttributeVal, ok := mapObjectAttributeAttribute.(basetypes.MapValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`map_object_attribute expected to be basetypes.MapValue, was: %T`, mapObjectAttributeAttribute))
+	}
+
+	numberAttributeAttribute, ok := attributes["number_attribute"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`number_attribute is missing from object`)
+
+		return NewListNestedAttributeAssocExtTypeValueUnknown(), diags
+	}
+
+	numberAttributeVal, ok := numberAttributeAttribute.(basetypes.NumberValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`number_attribute expected to be basetypes.NumberValue, was: %T`, numberAttributeAttribute))
+	}
+
+	objectAttributeAttribute, ok := attributes["object_attribute"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`object_attribute is missing from object`)
+
+		return NewListNestedAttributeAssocExtTypeValueUnknown(), diags
+	}
+
+	objectAttributeVal, ok := objectAttributeAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`object_attribute expected to be basetypes.ObjectValue, was: %T`, objectAttributeAttribute))
+	}
+
+	stringAttributeAttribute, ok := attributes["string_attribute"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`string_attribute is missing from object`)
+
+		return NewListNestedAttributeAssocExtTypeValueUnknown(), diags
+	}
+
+	stringAttributeVal, ok := stringAttributeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`string_attribute expected to be basetypes.StringValue, was: %T`, stringAttributeAttribute))
+	}
+
+	if diags.HasError() {
+		return NewListNestedAttributeAssocExtTypeValueUnknown(), diags
+	}
 
 	return ListNestedAttributeAssocExtTypeValue{
-		BoolAttribute:    boolAttributeVal,
-		Float64Attribute: float64AttributeVal,
-		Int64Attribute:   int64AttributeVal,
-		NumberAttribute:  numberAttributeVal,
-		StringAttribute:  stringAttributeVal,
-		state:            attr.ValueStateKnown,
+		BoolAttribute:      boolAttributeVal,
+		Float32Attribute:   float32AttributeVal,
+		Float64Attribute:   float64AttributeVal,
+		Int32Attribute:     int32AttributeVal,
+		Int64Attribute:     int64AttributeVal,
+		ListListAttribute:  listListAttributeVal,
+		MapObjectAttribute: mapObjectAttributeVal,
+		NumberAttribute:    numberAttributeVal,
+		ObjectAttribute:    objectAttributeVal,
+		StringAttribute:    stringAttributeVal,
+		state:              attr.ValueStateKnown,
 	}, diags
 }
 
@@ -604,31 +1175,61 @@ func (t ListNestedAttributeAssocExtTypeType) ValueType(ctx context.Context) attr
 var _ basetypes.ObjectValuable = ListNestedAttributeAssocExtTypeValue{}
 
 type ListNestedAttributeAssocExtTypeValue struct {
-	BoolAttribute    basetypes.BoolValue    `tfsdk:"bool_attribute"`
-	Float64Attribute basetypes.Float64Value `tfsdk:"float64_attribute"`
-	Int64Attribute   basetypes.Int64Value   `tfsdk:"int64_attribute"`
-	NumberAttribute  basetypes.NumberValue  `tfsdk:"number_attribute"`
-	StringAttribute  basetypes.StringValue  `tfsdk:"string_attribute"`
-	state            attr.ValueState
+	BoolAttribute      basetypes.BoolValue    `tfsdk:"bool_attribute"`
+	Float32Attribute   basetypes.Float32Value `tfsdk:"float32_attribute"`
+	Float64Attribute   basetypes.Float64Value `tfsdk:"float64_attribute"`
+	Int32Attribute     basetypes.Int32Value   `tfsdk:"int32_attribute"`
+	Int64Attribute     basetypes.Int64Value   `tfsdk:"int64_attribute"`
+	ListListAttribute  basetypes.ListValue    `tfsdk:"list_list_attribute"`
+	MapObjectAttribute basetypes.MapValue     `tfsdk:"map_object_attribute"`
+	NumberAttribute    basetypes.NumberValue  `tfsdk:"number_attribute"`
+	ObjectAttribute    basetypes.ObjectValue  `tfsdk:"object_attribute"`
+	StringAttribute    basetypes.StringValue  `tfsdk:"string_attribute"`
+	state              attr.ValueState
 }
 
 func (v ListNestedAttributeAssocExtTypeValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 5)
+	attrTypes := make(map[string]tftypes.Type, 10)
 
 	var val tftypes.Value
 	var err error
 
 	attrTypes["bool_attribute"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["float32_attribute"] = basetypes.Float32Type{}.TerraformType(ctx)
 	attrTypes["float64_attribute"] = basetypes.Float64Type{}.TerraformType(ctx)
+	attrTypes["int32_attribute"] = basetypes.Int32Type{}.TerraformType(ctx)
 	attrTypes["int64_attribute"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["list_list_attribute"] = basetypes.ListType{
+		ElemType: types.ListType{
+			ElemType: types.StringType,
+		},
+	}.TerraformType(ctx)
+	attrTypes["map_object_attribute"] = basetypes.MapType{
+		ElemType: types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"name": types.StringType,
+				"values": types.ListType{
+					ElemType: types.Int64Type,
+				},
+			},
+		},
+	}.TerraformType(ctx)
 	attrTypes["number_attribute"] = basetypes.NumberType{}.TerraformType(ctx)
+	attrTypes["object_attribute"] = basetypes.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"int32_attribute": types.Int32Type,
+			"list_attribute": types.ListType{
+				ElemType: types.StringType,
+			},
+		},
+	}.TerraformType(ctx)
 	attrTypes["string_attribute"] = basetypes.StringType{}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 5)
+		vals := make(map[string]tftypes.Value, 10)
 
 		val, err = v.BoolAttribute.ToTerraformValue(ctx)
 
@@ -638,6 +1239,14 @@ func (v ListNestedAttributeAssocExtTypeValue) ToTerraformValue(ctx context.Conte
 
 		vals["bool_attribute"] = val
 
+		val, err = v.Float32Attribute.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["float32_attribute"] = val
+
 		val, err = v.Float64Attribute.ToTerraformValue(ctx)
 
 		if err != nil {
@@ -646,6 +1255,14 @@ func (v ListNestedAttributeAssocExtTypeValue) ToTerraformValue(ctx context.Conte
 
 		vals["float64_attribute"] = val
 
+		val, err = v.Int32Attribute.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["int32_attribute"] = val
+
 		val, err = v.Int64Attribute.ToTerraformValue(ctx)
 
 		if err != nil {
@@ -654,6 +1271,22 @@ func (v ListNestedAttributeAssocExtTypeValue) ToTerraformValue(ctx context.Conte
 
 		vals["int64_attribute"] = val
 
+		val, err = v.ListListAttribute.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["list_list_attribute"] = val
+
+		val, err = v.MapObjectAttribute.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["map_object_attribute"] = val
+
 		val, err = v.NumberAttribute.ToTerraformValue(ctx)
 
 		if err != nil {
@@ -662,6 +1295,14 @@ func (v ListNestedAttributeAssocExtTypeValue) ToTerraformValue(ctx context.Conte
 
 		vals["number_attribute"] = val
 
+		val, err = v.ObjectAttribute.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["object_attribute"] = val
+
 		val, err = v.StringAttribute.ToTerraformValue(ctx)
 
 		if err != nil {
@@ -699,12 +1340,192 @@ func (v ListNestedAttributeAssocExtTypeValue) String() string {
 func (v ListNestedAttributeAssocExtTypeValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
+	var listListAttributeVal basetypes.ListValue
+	switch {
+	case v.ListListAttribute.IsUnknown():
+		listListAttributeVal = types.ListUnknown(types.ListType{
+			ElemType: types.StringType,
+		})
+	case v.ListListAttribute.IsNull():
+		listListAttributeVal = types.ListNull(types.ListType{
+			ElemType: types.StringType,
+		})
+	default:
+		var d diag.Diagnostics
+		listListAttributeVal, d = types.ListValue(types.ListType{
+			ElemType: types.StringType,
+		}, v.ListListAttribute.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"bool_attribute":    basetypes.BoolType{},
+			"float32_attribute": basetypes.Float32Type{},
+			"float64_attribute": basetypes.Float64Type{},
+			"int32_attribute":   basetypes.Int32Type{},
+			"int64_attribute":   basetypes.Int64Type{},
+			"list_list_attribute": basetypes.ListType{
+				ElemType: types.ListType{
+					ElemType: types.StringType,
+				},
+			},
+			"map_object_attribute": basetypes.MapType{
+				ElemType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"name": types.StringType,
+						"values": types.ListType{
+							ElemType: types.Int64Type,
+						},
+					},
+				},
+			},
+			"number_attribute": basetypes.NumberType{},
+			"object_attribute": basetypes.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"int32_attribute": types.Int32Type,
+					"list_attribute": types.ListType{
+						ElemType: types.StringType,
+					},
+				},
+			},
+			"string_attribute": basetypes.StringType{},
+		}), diags
+	}
+
+	var mapObjectAttributeVal basetypes.MapValue
+	switch {
+	case v.MapObjectAttribute.IsUnknown():
+		mapObjectAttributeVal = types.MapUnknown(types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"name": types.StringType,
+				"values": types.ListType{
+					ElemType: types.Int64Type,
+				},
+			},
+		})
+	case v.MapObjectAttribute.IsNull():
+		mapObjectAttributeVal = types.MapNull(types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"name": types.StringType,
+				"values": types.ListType{
+					ElemType: types.Int64Type,
+				},
+			},
+		})
+	default:
+		var d diag.Diagnostics
+		mapObjectAttributeVal, d = types.MapValue(types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"name": types.StringType,
+				"values": types.ListType{
+					ElemType: types.Int64Type,
+				},
+			},
+		}, v.MapObjectAttribute.Elements())
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"bool_attribute":    basetypes.BoolType{},
+			"float32_attribute": basetypes.Float32Type{},
+			"float64_attribute": basetypes.Float64Type{},
+			"int32_attribute":   basetypes.Int32Type{},
+			"int64_attribute":   basetypes.Int64Type{},
+			"list_list_attribute": basetypes.ListType{
+				ElemType: types.ListType{
+					ElemType: types.StringType,
+				},
+			},
+			"map_object_attribute": basetypes.MapType{
+				ElemType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"name": types.StringType,
+						"values": types.ListType{
+							ElemType: types.Int64Type,
+						},
+					},
+				},
+			},
+			"number_attribute": basetypes.NumberType{},
+			"object_attribute": basetypes.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"int32_attribute": types.Int32Type,
+					"list_attribute": types.ListType{
+						ElemType: types.StringType,
+					},
+				},
+			},
+			"string_attribute": basetypes.StringType{},
+		}), diags
+	}
+
+	objectAttributeVal, d := types.ObjectValue(v.ObjectAttribute.AttributeTypes(ctx), v.ObjectAttribute.Attributes())
+
+	diags.Append(d...)
+
+	if d.HasError() {
+		return types.ObjectUnknown(map[string]attr.Type{
+			"bool_attribute": basetypes.ObjectType{
+				AttrTypes: v.ObjectAttribute.AttributeTypes(ctx),
+			},
+			"float32_attribute": basetypes.ObjectType{
+				AttrTypes: v.ObjectAttribute.AttributeTypes(ctx),
+			},
+			"float64_attribute": basetypes.ObjectType{
+				AttrTypes: v.ObjectAttribute.AttributeTypes(ctx),
+			},
+			"int32_attribute": basetypes.ObjectType{
+				AttrTypes: v.ObjectAttribute.AttributeTypes(ctx),
+			},
+			"int64_attribute": basetypes.ObjectType{
+				AttrTypes: v.ObjectAttribute.AttributeTypes(ctx),
+			},
+			"list_list_attribute": basetypes.ObjectType{
+				AttrTypes: v.ObjectAttribute.AttributeTypes(ctx),
+			},
+			"map_object_attribute": basetypes.ObjectType{
+				AttrTypes: v.ObjectAttribute.AttributeTypes(ctx),
+			},
+			"number_attribute": basetypes.ObjectType{
+				AttrTypes: v.ObjectAttribute.AttributeTypes(ctx),
+			},
+			"object_attribute": basetypes.ObjectType{
+				AttrTypes: v.ObjectAttribute.AttributeTypes(ctx),
+			},
+			"string_attribute": basetypes.ObjectType{
+				AttrTypes: v.ObjectAttribute.AttributeTypes(ctx),
+			},
+		}), diags
+	}
+
 	attributeTypes := map[string]attr.Type{
 		"bool_attribute":    basetypes.BoolType{},
+		"float32_attribute": basetypes.Float32Type{},
 		"float64_attribute": basetypes.Float64Type{},
+		"int32_attribute":   basetypes.Int32Type{},
 		"int64_attribute":   basetypes.Int64Type{},
-		"number_attribute":  basetypes.NumberType{},
-		"string_attribute":  basetypes.StringType{},
+		"list_list_attribute": basetypes.ListType{
+			ElemType: types.ListType{
+				ElemType: types.StringType,
+			},
+		},
+		"map_object_attribute": basetypes.MapType{
+			ElemType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name": types.StringType,
+					"values": types.ListType{
+						ElemType: types.Int64Type,
+					},
+				},
+			},
+		},
+		"number_attribute": basetypes.NumberType{},
+		"object_attribute": basetypes.ObjectType{
+			AttrTypes: v.ObjectAttribute.AttributeTypes(ctx),
+		},
+		"string_attribute": basetypes.StringType{},
 	}
 
 	if v.IsNull() {
@@ -718,11 +1539,16 @@ func (v ListNestedAttributeAssocExtTypeValue) ToObjectValue(ctx context.Context)
 	objVal, diags := types.ObjectValue(
 		attributeTypes,
 		map[string]attr.Value{
-			"bool_attribute":    v.BoolAttribute,
-			"float64_attribute": v.Float64Attribute,
-			"int64_attribute":   v.Int64Attribute,
-			"number_attribute":  v.NumberAttribute,
-			"string_attribute":  v.StringAttribute,
+			"bool_attribute":       v.BoolAttribute,
+			"float32_attribute":    v.Float32Attribute,
+			"float64_attribute":    v.Float64Attribute,
+			"int32_attribute":      v.Int32Attribute,
+			"int64_attribute":      v.Int64Attribute,
+			"list_list_attribute":  listListAttributeVal,
+			"map_object_attribute": mapObjectAttributeVal,
+			"number_attribute":     v.NumberAttribute,
+			"object_attribute":     objectAttributeVal,
+			"string_attribute":     v.StringAttribute,
 		})
 
 	return objVal, diags
@@ -747,18 +1573,38 @@ func (v ListNestedAttributeAssocExtTypeValue) Equal(o attr.Value) bool {
 		return false
 	}
 
+	if !v.Float32Attribute.Equal(other.Float32Attribute) {
+		return false
+	}
+
 	if !v.Float64Attribute.Equal(other.Float64Attribute) {
 		return false
 	}
 
+	if !v.Int32Attribute.Equal(other.Int32Attribute) {
+		return false
+	}
+
 	if !v.Int64Attribute.Equal(other.Int64Attribute) {
 		return false
 	}
 
+	if !v.ListListAttribute.Equal(other.ListListAttribute) {
+		return false
+	}
+
+	if !v.MapObjectAttribute.Equal(other.MapObjectAttribute) {
+		return false
+	}
+
 	if !v.NumberAttribute.Equal(other.NumberAttribute) {
 		return false
 	}
 
+	if !v.ObjectAttribute.Equal(other.ObjectAttribute) {
+		return false
+	}
+
 	if !v.StringAttribute.Equal(other.StringAttribute) {
 		return false
 	}
@@ -777,10 +1623,35 @@ func (v ListNestedAttributeAssocExtTypeValue) Type(ctx context.Context) attr.Typ
 func (v ListNestedAttributeAssocExtTypeValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
 		"bool_attribute":    basetypes.BoolType{},
+		"float32_attribute": basetypes.Float32Type{},
 		"float64_attribute": basetypes.Float64Type{},
+		"int32_attribute":   basetypes.Int32Type{},
 		"int64_attribute":   basetypes.Int64Type{},
-		"number_attribute":  basetypes.NumberType{},
-		"string_attribute":  basetypes.StringType{},
+		"list_list_attribute": basetypes.ListType{
+			ElemType: types.ListType{
+				ElemType: types.StringType,
+			},
+		},
+		"map_object_attribute": basetypes.MapType{
+			ElemType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name": types.StringType,
+					"values": types.ListType{
+						ElemType: types.Int64Type,
+					},
+				},
+			},
+		},
+		"number_attribute": basetypes.NumberType{},
+		"object_attribute": basetypes.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"int32_attribute": types.Int32Type,
+				"list_attribute": types.ListType{
+					ElemType: types.StringType,
+				},
+			},
+		},
+		"string_attribute": basetypes.StringType{},
 	}
 }
 
@@ -1987,17 +2858,36 @@ func (t SingleNestedAttributeAssocExtTypeType) ValueFromObject(ctx context.Conte
 			fmt.Sprintf(`string_attribute expected to be basetypes.StringValue, was: %T`, stringAttributeAttribute))
 	}
 
+	writeOnlyAttributeAttribute, ok := attributes["write_only_attribute"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`write_only_attribute is missing from object`)
+
+		return nil, diags
+	}
+
+	writeOnlyAttributeVal, ok := writeOnlyAttributeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`write_only_attribute expected to be basetypes.StringValue, was: %T`, writeOnlyAttributeAttribute))
+	}
+
 	if diags.HasError() {
 		return nil, diags
 	}
 
 	return SingleNestedAttributeAssocExtTypeValue{
-		BoolAttribute:    boolAttributeVal,
-		Float64Attribute: float64AttributeVal,
-		Int64Attribute:   int64AttributeVal,
-		NumberAttribute:  numberAttributeVal,
-		StringAttribute:  stringAttributeVal,
-		state:            attr.ValueStateKnown,
+		BoolAttribute:      boolAttributeVal,
+		Float64Attribute:   float64AttributeVal,
+		Int64Attribute:     int64AttributeVal,
+		NumberAttribute:    numberAttributeVal,
+		StringAttribute:    stringAttributeVal,
+		WriteOnlyAttribute: writeOnlyAttributeVal,
+		state:              attr.ValueStateKnown,
 	}, diags
 }
 
@@ -2154,17 +3044,36 @@ func NewSingleNestedAttributeAssocExtTypeValue(attributeTypes map[string]attr.Ty
 			fmt.Sprintf(`string_attribute expected to be basetypes.StringValue, was: %T`, stringAttributeAttribute))
 	}
 
+	writeOnlyAttributeAttribute, ok := attributes["write_only_attribute"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`write_only_attribute is missing from object`)
+
+		return NewSingleNestedAttributeAssocExtTypeValueUnknown(), diags
+	}
+
+	writeOnlyAttributeVal, ok := writeOnlyAttributeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`write_only_attribute expected to be basetypes.StringValue, was: %T`, writeOnlyAttributeAttribute))
+	}
+
 	if diags.HasError() {
 		return NewSingleNestedAttributeAssocExtTypeValueUnknown(), diags
 	}
 
 	return SingleNestedAttributeAssocExtTypeValue{
-		BoolAttribute:    boolAttributeVal,
-		Float64Attribute: float64AttributeVal,
-		Int64Attribute:   int64AttributeVal,
-		NumberAttribute:  numberAttributeVal,
-		StringAttribute:  stringAttributeVal,
-		state:            attr.ValueStateKnown,
+		BoolAttribute:      boolAttributeVal,
+		Float64Attribute:   float64AttributeVal,
+		Int64Attribute:     int64AttributeVal,
+		NumberAttribute:    numberAttributeVal,
+		StringAttribute:    stringAttributeVal,
+		WriteOnlyAttribute: writeOnlyAttributeVal,
+		state:              attr.ValueStateKnown,
 	}, diags
 }
 
@@ -2236,16 +3145,17 @@ func (t SingleNestedAttributeAssocExtTypeType) ValueType(ctx context.Context) at
 var _ basetypes.ObjectValuable = SingleNestedAttributeAssocExtTypeValue{}
 
 type SingleNestedAttributeAssocExtTypeValue struct {
-	BoolAttribute    basetypes.BoolValue    `tfsdk:"bool_attribute"`
-	Float64Attribute basetypes.Float64Value `tfsdk:"float64_attribute"`
-	Int64Attribute   basetypes.Int64Value   `tfsdk:"int64_attribute"`
-	NumberAttribute  basetypes.NumberValue  `tfsdk:"number_attribute"`
-	StringAttribute  basetypes.StringValue  `tfsdk:"string_attribute"`
-	state            attr.ValueState
+	BoolAttribute      basetypes.BoolValue    `tfsdk:"bool_attribute"`
+	Float64Attribute   basetypes.Float64Value `tfsdk:"float64_attribute"`
+	Int64Attribute     basetypes.Int64Value   `tfsdk:"int64_attribute"`
+	NumberAttribute    basetypes.NumberValue  `tfsdk:"number_attribute"`
+	StringAttribute    basetypes.StringValue  `tfsdk:"string_attribute"`
+	WriteOnlyAttribute basetypes.StringValue  `tfsdk:"write_only_attribute"`
+	state              attr.ValueState
 }
 
 func (v SingleNestedAttributeAssocExtTypeValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 5)
+	attrTypes := make(map[string]tftypes.Type, 6)
 
 	var val tftypes.Value
 	var err error
@@ -2255,12 +3165,13 @@ func (v SingleNestedAttributeAssocExtTypeValue) ToTerraformValue(ctx context.Con
 	attrTypes["int64_attribute"] = basetypes.Int64Type{}.TerraformType(ctx)
 	attrTypes["number_attribute"] = basetypes.NumberType{}.TerraformType(ctx)
 	attrTypes["string_attribute"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["write_only_attribute"] = basetypes.StringType{}.TerraformType(ctx)
 
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 5)
+		vals := make(map[string]tftypes.Value, 6)
 
 		val, err = v.BoolAttribute.ToTerraformValue(ctx)
 
@@ -2302,6 +3213,14 @@ func (v SingleNestedAttributeAssocExtTypeValue) ToTerraformValue(ctx context.Con
 
 		vals["string_attribute"] = val
 
+		val, err = v.WriteOnlyAttribute.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["write_only_attribute"] = val
+
 		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
@@ -2332,11 +3251,12 @@ func (v SingleNestedAttributeAssocExtTypeValue) ToObjectValue(ctx context.Contex
 	var diags diag.Diagnostics
 
 	attributeTypes := map[string]attr.Type{
-		"bool_attribute":    basetypes.BoolType{},
-		"float64_attribute": basetypes.Float64Type{},
-		"int64_attribute":   basetypes.Int64Type{},
-		"number_attribute":  basetypes.NumberType{},
-		"string_attribute":  basetypes.StringType{},
+		"bool_attribute":       basetypes.BoolType{},
+		"float64_attribute":    basetypes.Float64Type{},
+		"int64_attribute":      basetypes.Int64Type{},
+		"number_attribute":     basetypes.NumberType{},
+		"string_attribute":     basetypes.StringType{},
+		"write_only_attribute": basetypes.StringType{},
 	}
 
 	if v.IsNull() {
@@ -2350,11 +3270,12 @@ func (v SingleNestedAttributeAssocExtTypeValue) ToObjectValue(ctx context.Contex
 	objVal, diags := types.ObjectValue(
 		attributeTypes,
 		map[string]attr.Value{
-			"bool_attribute":    v.BoolAttribute,
-			"float64_attribute": v.Float64Attribute,
-			"int64_attribute":   v.Int64Attribute,
-			"number_attribute":  v.NumberAttribute,
-			"string_attribute":  v.StringAttribute,
+			"bool_attribute":       v.BoolAttribute,
+			"float64_attribute":    v.Float64Attribute,
+			"int64_attribute":      v.Int64Attribute,
+			"number_attribute":     v.NumberAttribute,
+			"string_attribute":     v.StringAttribute,
+			"write_only_attribute": v.WriteOnlyAttribute,
 		})
 
 	return objVal, diags
@@ -2395,6 +3316,10 @@ func (v SingleNestedAttributeAssocExtTypeValue) Equal(o attr.Value) bool {
 		return false
 	}
 
+	if !v.WriteOnlyAttribute.Equal(other.WriteOnlyAttribute) {
+		return false
+	}
+
 	return true
 }
 
@@ -2408,11 +3333,12 @@ func (v SingleNestedAttributeAssocExtTypeValue) Type(ctx context.Context) attr.T
 
 func (v SingleNestedAttributeAssocExtTypeValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"bool_attribute":    basetypes.BoolType{},
-		"float64_attribute": basetypes.Float64Type{},
-		"int64_attribute":   basetypes.Int64Type{},
-		"number_attribute":  basetypes.NumberType{},
-		"string_attribute":  basetypes.StringType{},
+		"bool_attribute":       basetypes.BoolType{},
+		"float64_attribute":    basetypes.Float64Type{},
+		"int64_attribute":      basetypes.Int64Type{},
+		"number_attribute":     basetypes.NumberType{},
+		"string_attribute":     basetypes.StringType{},
+		"write_only_attribute": basetypes.StringType{},
 	}
 }
 
@@ -4048,6 +4974,364 @@ func (v SingleNestedBlockAssocExtTypeValue) AttributeTypes(ctx context.Context)
 	}
 }
 
+func (v DynamicAttributeAssocExtTypeValue) ToJsonRawMessage(ctx context.Context) (json.RawMessage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnderlyingValueNull() {
+		return nil, diags
+	}
+
+	if v.IsUnknown() || v.IsUnderlyingValueUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(
+			"DynamicAttributeAssocExtTypeValue Value Is Unknown",
+			`"DynamicAttributeAssocExtTypeValue" is unknown.`,
+		))
+
+		return nil, diags
+	}
+
+	var toAny func(value attr.Value) (any, diag.Diagnostics)
+
+	toAny = func(value attr.Value) (any, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		if value.IsNull() {
+			return nil, diags
+		}
+
+		if value.IsUnknown() {
+			diags.Append(diag.NewErrorDiagnostic(
+				"DynamicAttributeAssocExtTypeValue Value Is Unknown",
+				`"DynamicAttributeAssocExtTypeValue" contains an unknown value.`,
+			))
+
+			return nil, diags
+		}
+
+		switch value := value.(type) {
+		case basetypes.DynamicValue:
+			return toAny(value.UnderlyingValue())
+		case basetypes.BoolValue:
+			return value.ValueBool(), diags
+		case basetypes.NumberValue:
+			return json.Number(value.ValueBigFloat().Text('g', -1)), diags
+		case basetypes.StringValue:
+			return value.ValueString(), diags
+		case basetypes.MapValue:
+			m := make(map[string]any, len(value.Elements()))
+
+			for k, e := range value.Elements() {
+				a, d := toAny(e)
+
+				diags.Append(d...)
+
+				m[k] = a
+			}
+
+			return m, diags
+		case basetypes.ObjectValue:
+			m := make(map[string]any, len(value.Attributes()))
+
+			for k, e := range value.Attributes() {
+				a, d := toAny(e)
+
+				diags.Append(d...)
+
+				m[k] = a
+			}
+
+			return m, diags
+		case interface{ Elements() []attr.Value }:
+			s := make([]any, 0, len(value.Elements()))
+
+			for _, e := range value.Elements() {
+				a, d := toAny(e)
+
+				diags.Append(d...)
+
+				s = append(s, a)
+			}
+
+			return s, diags
+		}
+
+		diags.Append(diag.NewErrorDiagnostic(
+			"DynamicAttributeAssocExtTypeValue Value Is Unsupported",
+			fmt.Sprintf(`"DynamicAttributeAssocExtTypeValue" contains a value of unsupported type %T.`, value),
+		))
+
+		return nil, diags
+	}
+
+	a, d := toAny(v.UnderlyingValue())
+
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	b, err := json.Marshal(a)
+
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic(
+			"DynamicAttributeAssocExtTypeValue Value Cannot Be Encoded",
+			fmt.Sprintf(`"DynamicAttributeAssocExtTypeValue" cannot be encoded as JSON: %s`, err),
+		))
+
+		return nil, diags
+	}
+
+	return b, diags
+}
+
+func (v DynamicAttributeAssocExtTypeValue) FromJsonRawMessage(ctx context.Context, apiObject json.RawMessage) (DynamicAttributeAssocExtTypeValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return DynamicAttributeAssocExtTypeValue{
+			types.DynamicNull(),
+		}, diags
+	}
+
+	var a any
+
+	decoder := json.NewDecoder(bytes.NewReader(apiObject))
+
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&a); err != nil {
+		diags.Append(diag.NewErrorDiagnostic(
+			"DynamicAttributeAssocExtTypeValue Value Cannot Be Decoded",
+			fmt.Sprintf(`"DynamicAttributeAssocExtTypeValue" cannot be decoded from JSON: %s`, err),
+		))
+
+		return DynamicAttributeAssocExtTypeValue{
+			types.DynamicUnknown(),
+		}, diags
+	}
+
+	var fromAny func(a any) (attr.Value, diag.Diagnostics)
+
+	fromAny = func(a any) (attr.Value, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		switch a := a.(type) {
+		case nil:
+			return types.DynamicNull(), diags
+		case bool:
+			return types.BoolValue(a), diags
+		case float64:
+			return types.NumberValue(big.NewFloat(a)), diags
+		case int:
+			return types.NumberValue(new(big.Float).SetInt64(int64(a))), diags
+		case int64:
+			return types.NumberValue(new(big.Float).SetInt64(a)), diags
+		case json.Number:
+			// The precision matches that of numbers in Terraform, so that large
+			// integers keep their value.
+			f, _, err := big.ParseFloat(a.String(), 10, 512, big.ToNearestEven)
+
+			if err != nil {
+				diags.Append(diag.NewErrorDiagnostic(
+					"DynamicAttributeAssocExtTypeValue Value Is Invalid",
+					fmt.Sprintf(`"DynamicAttributeAssocExtTypeValue" contains an invalid number %q.`, a),
+				))
+
+				return nil, diags
+			}
+
+			return types.NumberValue(f), diags
+		case string:
+			return types.StringValue(a), diags
+		case []any:
+			elementTypes := make([]attr.Type, 0, len(a))
+			elements := make([]attr.Value, 0, len(a))
+
+			for _, e := range a {
+				element, d := fromAny(e)
+
+				diags.Append(d...)
+
+				if diags.HasError() {
+					return nil, diags
+				}
+
+				elementTypes = append(elementTypes, element.Type(ctx))
+				elements = append(elements, element)
+			}
+
+			tupleValue, d := basetypes.NewTupleValue(elementTypes, elements)
+
+			diags.Append(d...)
+
+			return tupleValue, diags
+		case map[string]any:
+			attributeTypes := make(map[string]attr.Type, len(a))
+			attributes := make(map[string]attr.Value, len(a))
+
+			for k, e := range a {
+				attribute, d := fromAny(e)
+
+				diags.Append(d...)
+
+				if diags.HasError() {
+					return nil, diags
+				}
+
+				attributeTypes[k] = attribute.Type(ctx)
+				attributes[k] = attribute
+			}
+
+			objectValue, d := basetypes.NewObjectValue(attributeTypes, attributes)
+
+			diags.Append(d...)
+
+			return objectValue, diags
+		}
+
+		diags.Append(diag.NewErrorDiagnostic(
+			"DynamicAttributeAssocExtTypeValue Value Is Unsupported",
+			fmt.Sprintf(`"DynamicAttributeAssocExtTypeValue" cannot be created from a value of unsupported type %T.`, a),
+		))
+
+		return nil, diags
+	}
+
+	value, d := fromAny(a)
+
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return DynamicAttributeAssocExtTypeValue{
+			types.DynamicUnknown(),
+		}, diags
+	}
+
+	return DynamicAttributeAssocExtTypeValue{
+		types.DynamicValue(value),
+	}, diags
+}
+
+func (v ListListAttributeAssocExtTypeValue) ToApisdkLists(ctx context.Context) (*apisdk.Lists, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		return nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(
+			"ListListAttributeAssocExtTypeValue Value Is Unknown",
+			`"ListListAttributeAssocExtTypeValue" is unknown.`,
+		))
+
+		return nil, diags
+	}
+
+	apisdkLists := make(apisdk.Lists, 0, len(v.Elements()))
+
+	for _, e := range v.Elements() {
+		apisdkLists = append(apisdkLists, func(v attr.Value) (result []*string) {
+			valuable, ok := v.(basetypes.ListValuable)
+
+			if !ok {
+				diags.AddError("Unexpected Value Type", fmt.Sprintf(`expected basetypes.ListValuable, was: %T`, v))
+
+				return
+			}
+
+			c, d := valuable.ToListValue(ctx)
+
+			diags.Append(d...)
+
+			if c.IsNull() || c.IsUnknown() {
+				return
+			}
+
+			result = make([]*string, 0, len(c.Elements()))
+
+			for _, e := range c.Elements() {
+				result = append(result, func(v attr.Value) (result *string) {
+					valuable, ok := v.(basetypes.StringValuable)
+
+					if !ok {
+						diags.AddError("Unexpected Value Type", fmt.Sprintf(`expected basetypes.StringValuable, was: %T`, v))
+
+						return
+					}
+
+					p, d := valuable.ToStringValue(ctx)
+
+					diags.Append(d...)
+
+					return p.ValueStringPointer()
+				}(e))
+			}
+
+			return
+		}(e))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &apisdkLists, diags
+}
+
+func (v ListListAttributeAssocExtTypeValue) FromApisdkLists(ctx context.Context, apiObject *apisdk.Lists) (ListListAttributeAssocExtTypeValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return ListListAttributeAssocExtTypeValue{
+			types.ListNull(types.ListType{
+				ElemType: types.StringType,
+			}),
+		}, diags
+	}
+
+	elems := make([]types.List, 0, len(*apiObject))
+
+	for _, e := range *apiObject {
+		elems = append(elems, func(v []*string) types.List {
+			if v == nil {
+				return types.ListNull(types.StringType)
+			}
+
+			elems := make([]attr.Value, 0, len(v))
+
+			for _, e := range v {
+				elems = append(elems, types.StringPointerValue(e))
+			}
+
+			c, d := types.ListValue(types.StringType, elems)
+
+			diags.Append(d...)
+
+			return c
+		}(e))
+	}
+
+	l, d := basetypes.NewListValueFrom(ctx, types.ListType{
+		ElemType: types.StringType,
+	}, elems)
+
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return ListListAttributeAssocExtTypeValue{
+			types.ListUnknown(types.ListType{
+				ElemType: types.StringType,
+			}),
+		}, diags
+	}
+
+	return ListListAttributeAssocExtTypeValue{
+		l,
+	}, diags
+}
+
 func (v ListNestedAttributeAssocExtTypeValue) ToApisdkType(ctx context.Context) (*apisdk.Type, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -4064,12 +5348,268 @@ func (v ListNestedAttributeAssocExtTypeValue) ToApisdkType(ctx context.Context)
 		return nil, diags
 	}
 
+	listListAttributeField := func(v attr.Value) (result [][]*string) {
+		valuable, ok := v.(basetypes.ListValuable)
+
+		if !ok {
+			diags.AddError("Unexpected Value Type", fmt.Sprintf(`expected basetypes.ListValuable, was: %T`, v))
+
+			return
+		}
+
+		c, d := valuable.ToListValue(ctx)
+
+		diags.Append(d...)
+
+		if c.IsNull() || c.IsUnknown() {
+			return
+		}
+
+		result = make([][]*string, 0, len(c.Elements()))
+
+		for _, e := range c.Elements() {
+			result = append(result, func(v attr.Value) (result []*string) {
+				valuable, ok := v.(basetypes.ListValuable)
+
+				if !ok {
+					diags.AddError("Unexpected Value Type", fmt.Sprintf(`expected basetypes.ListValuable, was: %T`, v))
+
+					return
+				}
+
+				c, d := valuable.ToListValue(ctx)
+
+				diags.Append(d...)
+
+				if c.IsNull() || c.IsUnknown() {
+					return
+				}
+
+				result = make([]*string, 0, len(c.Elements()))
+
+				for _, e := range c.Elements() {
+					result = append(result, func(v attr.Value) (result *string) {
+						valuable, ok := v.(basetypes.StringValuable)
+
+						if !ok {
+							diags.AddError("Unexpected Value Type", fmt.Sprintf(`expected basetypes.StringValuable, was: %T`, v))
+
+							return
+						}
+
+						p, d := valuable.ToStringValue(ctx)
+
+						diags.Append(d...)
+
+						return p.ValueStringPointer()
+					}(e))
+				}
+
+				return
+			}(e))
+		}
+
+		return
+	}(v.ListListAttribute)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	mapObjectAttributeField := func(v attr.Value) (result map[string]struct {
+		Name   *string
+		Values []*int64
+	}) {
+		valuable, ok := v.(basetypes.MapValuable)
+
+		if !ok {
+			diags.AddError("Unexpected Value Type", fmt.Sprintf(`expected basetypes.MapValuable, was: %T`, v))
+
+			return
+		}
+
+		c, d := valuable.ToMapValue(ctx)
+
+		diags.Append(d...)
+
+		if c.IsNull() || c.IsUnknown() {
+			return
+		}
+
+		result = make(map[string]struct {
+			Name   *string
+			Values []*int64
+		}, len(c.Elements()))
+
+		for k, e := range c.Elements() {
+			result[k] = func(v attr.Value) (result struct {
+				Name   *string
+				Values []*int64
+			}) {
+				valuable, ok := v.(basetypes.ObjectValuable)
+
+				if !ok {
+					diags.AddError("Unexpected Value Type", fmt.Sprintf(`expected basetypes.ObjectValuable, was: %T`, v))
+
+					return
+				}
+
+				o, d := valuable.ToObjectValue(ctx)
+
+				diags.Append(d...)
+
+				if o.IsNull() || o.IsUnknown() {
+					return
+				}
+
+				attributes := o.Attributes()
+
+				result.Name = func(v attr.Value) (result *string) {
+					valuable, ok := v.(basetypes.StringValuable)
+
+					if !ok {
+						diags.AddError("Unexpected Value Type", fmt.Sprintf(`expected basetypes.StringValuable, was: %T`, v))
+
+						return
+					}
+
+					p, d := valuable.ToStringValue(ctx)
+
+					diags.Append(d...)
+
+					return p.ValueStringPointer()
+				}(attributes["name"])
+
+				result.Values = func(v attr.Value) (result []*int64) {
+					valuable, ok := v.(basetypes.ListValuable)
+
+					if !ok {
+						diags.AddError("Unexpected Value Type", fmt.Sprintf(`expected basetypes.ListValuable, was: %T`, v))
+
+						return
+					}
+
+					c, d := valuable.ToListValue(ctx)
+
+					diags.Append(d...)
+
+					if c.IsNull() || c.IsUnknown() {
+						return
+					}
+
+					result = make([]*int64, 0, len(c.Elements()))
+
+					for _, e := range c.Elements() {
+						result = append(result, func(v attr.Value) (result *int64) {
+							valuable, ok := v.(basetypes.Int64Valuable)
+
+							if !ok {
+								diags.AddError("Unexpected Value Type", fmt.Sprintf(`expected basetypes.Int64Valuable, was: %T`, v))
+
+								return
+							}
+
+							p, d := valuable.ToInt64Value(ctx)
+
+							diags.Append(d...)
+
+							return p.ValueInt64Pointer()
+						}(e))
+					}
+
+					return
+				}(attributes["values"])
+
+				return
+			}(e)
+		}
+
+		return
+	}(v.MapObjectAttribute)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	attributes := v.ObjectAttribute.Attributes()
+
+	objectAttributeFieldInt32Attribute, ok := attributes["int32_attribute"].(types.Int32)
+
+	if !ok {
+		diags.Append(diag.NewErrorDiagnostic(
+			"ObjectAttribute Field int32_attribute Is Wrong Type",
+			fmt.Sprintf(`ObjectAttribute field int32_attribute expected to be types.Int32, was: %T`, attributes["bool"]),
+		))
+
+		return nil, diags
+	}
+
+	objectAttributeFieldListAttribute, ok := attributes["list_attribute"].(types.List)
+
+	if !ok {
+		diags.Append(diag.NewErrorDiagnostic(
+			"ObjectAttribute Field list_attribute Is Wrong Type",
+			fmt.Sprintf(`ObjectAttribute field list_attribute expected to be types.List, was: %T`, attributes["bool"]),
+		))
+
+		return nil, diags
+	}
+
 	return &apisdk.Type{
-		BoolAttribute:    v.BoolAttribute.ValueBoolPointer(),
-		Float64Attribute: v.Float64Attribute.ValueFloat64Pointer(),
-		Int64Attribute:   v.Int64Attribute.ValueInt64Pointer(),
-		NumberAttribute:  v.NumberAttribute.ValueBigFloat(),
-		StringAttribute:  v.StringAttribute.ValueStringPointer(),
+		BoolAttribute:      v.BoolAttribute.ValueBoolPointer(),
+		Float32Attribute:   v.Float32Attribute.ValueFloat32Pointer(),
+		Float64Attribute:   v.Float64Attribute.ValueFloat64Pointer(),
+		Int32Attribute:     v.Int32Attribute.ValueInt32Pointer(),
+		Int64Attribute:     v.Int64Attribute.ValueInt64Pointer(),
+		ListListAttribute:  listListAttributeField,
+		MapObjectAttribute: mapObjectAttributeField,
+		NumberAttribute:    v.NumberAttribute.ValueBigFloat(),
+		ObjectAttribute: struct {
+			Int32Attribute *int32
+			ListAttribute  []*string
+		}{
+			Int32Attribute: objectAttributeFieldInt32Attribute.ValueInt32Pointer(),
+			ListAttribute: func(v attr.Value) (result []*string) {
+				valuable, ok := v.(basetypes.ListValuable)
+
+				if !ok {
+					diags.AddError("Unexpected Value Type", fmt.Sprintf(`expected basetypes.ListValuable, was: %T`, v))
+
+					return
+				}
+
+				c, d := valuable.ToListValue(ctx)
+
+				diags.Append(d...)
+
+				if c.IsNull() || c.IsUnknown() {
+					return
+				}
+
+				result = make([]*string, 0, len(c.Elements()))
+
+				for _, e := range c.Elements() {
+					result = append(result, func(v attr.Value) (result *string) {
+						valuable, ok := v.(basetypes.StringValuable)
+
+						if !ok {
+							diags.AddError("Unexpected Value Type", fmt.Sprintf(`expected basetypes.StringValuable, was: %T`, v))
+
+							return
+						}
+
+						p, d := valuable.ToStringValue(ctx)
+
+						diags.Append(d...)
+
+						return p.ValueStringPointer()
+					}(e))
+				}
+
+				return
+			}(objectAttributeFieldListAttribute),
+		},
+		StringAttribute: v.StringAttribute.ValueStringPointer(),
 	}, diags
 }
 
@@ -4080,13 +5620,165 @@ func (v ListNestedAttributeAssocExtTypeValue) FromApisdkType(ctx context.Context
 		return NewListNestedAttributeAssocExtTypeValueNull(), diags
 	}
 
+	listListAttributeVal := func(v [][]*string) types.List {
+		if v == nil {
+			return types.ListNull(types.ListType{
+				ElemType: types.StringType,
+			})
+		}
+
+		elems := make([]attr.Value, 0, len(v))
+
+		for _, e := range v {
+			elems = append(elems, func(v []*string) types.List {
+				if v == nil {
+					return types.ListNull(types.StringType)
+				}
+
+				elems := make([]attr.Value, 0, len(v))
+
+				for _, e := range v {
+					elems = append(elems, types.StringPointerValue(e))
+				}
+
+				c, d := types.ListValue(types.StringType, elems)
+
+				diags.Append(d...)
+
+				return c
+			}(e))
+		}
+
+		c, d := types.ListValue(types.ListType{
+			ElemType: types.StringType,
+		}, elems)
+
+		diags.Append(d...)
+
+		return c
+	}(apiObject.ListListAttribute)
+
+	if diags.HasError() {
+		return NewListNestedAttributeAssocExtTypeValueUnknown(), diags
+	}
+
+	mapObjectAttributeVal := func(v map[string]struct {
+		Name   *string
+		Values []*int64
+	}) types.Map {
+		if v == nil {
+			return types.MapNull(types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name": types.StringType,
+					"values": types.ListType{
+						ElemType: types.Int64Type,
+					},
+				},
+			})
+		}
+
+		elems := make(map[string]attr.Value, len(v))
+
+		for k, e := range v {
+			elems[k] = func(v struct {
+				Name   *string
+				Values []*int64
+			}) types.Object {
+				o, d := types.ObjectValue(map[string]attr.Type{
+					"name": types.StringType,
+					"values": types.ListType{
+						ElemType: types.Int64Type,
+					},
+				}, map[string]attr.Value{
+					"name": types.StringPointerValue(v.Name),
+					"values": func(v []*int64) types.List {
+						if v == nil {
+							return types.ListNull(types.Int64Type)
+						}
+
+						elems := make([]attr.Value, 0, len(v))
+
+						for _, e := range v {
+							elems = append(elems, types.Int64PointerValue(e))
+						}
+
+						c, d := types.ListValue(types.Int64Type, elems)
+
+						diags.Append(d...)
+
+						return c
+					}(v.Values),
+				})
+
+				diags.Append(d...)
+
+				return o
+			}(e)
+		}
+
+		c, d := types.MapValue(types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"name": types.StringType,
+				"values": types.ListType{
+					ElemType: types.Int64Type,
+				},
+			},
+		}, elems)
+
+		diags.Append(d...)
+
+		return c
+	}(apiObject.MapObjectAttribute)
+
+	if diags.HasError() {
+		return NewListNestedAttributeAssocExtTypeValueUnknown(), diags
+	}
+
+	objectAttributeVal, d := basetypes.NewObjectValue(
+		map[string]attr.Type{
+			"int32_attribute": types.Int32Type,
+			"list_attribute": types.ListType{
+				ElemType: types.StringType,
+			},
+		}, map[string]attr.Value{
+			"int32_attribute": types.Int32PointerValue(apiObject.ObjectAttribute.Int32Attribute),
+			"list_attribute": func(v []*string) types.List {
+				if v == nil {
+					return types.ListNull(types.StringType)
+				}
+
+				elems := make([]attr.Value, 0, len(v))
+
+				for _, e := range v {
+					elems = append(elems, types.StringPointerValue(e))
+				}
+
+				c, d := types.ListValue(types.StringType, elems)
+
+				diags.Append(d...)
+
+				return c
+			}(apiObject.ObjectAttribute.ListAttribute),
+		})
+
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return NewListNestedAttributeAssocExtTypeValueUnknown(), diags
+	}
+
 	return ListNestedAttributeAssocExtTypeValue{
-		BoolAttribute:    types.BoolPointerValue(apiObject.BoolAttribute),
-		Float64Attribute: types.Float64PointerValue(apiObject.Float64Attribute),
-		Int64Attribute:   types.Int64PointerValue(apiObject.Int64Attribute),
-		NumberAttribute:  types.NumberValue(apiObject.NumberAttribute),
-		StringAttribute:  types.StringPointerValue(apiObject.StringAttribute),
-		state:            attr.ValueStateKnown,
+		BoolAttribute:      types.BoolPointerValue(apiObject.BoolAttribute),
+		Float32Attribute:   types.Float32PointerValue(apiObject.Float32Attribute),
+		Float64Attribute:   types.Float64PointerValue(apiObject.Float64Attribute),
+		Int32Attribute:     types.Int32PointerValue(apiObject.Int32Attribute),
+		Int64Attribute:     types.Int64PointerValue(apiObject.Int64Attribute),
+		ListListAttribute:  listListAttributeVal,
+		MapObjectAttribute: mapObjectAttributeVal,
+		NumberAttribute:    types.NumberValue(apiObject.NumberAttribute),
+		ObjectAttribute:    objectAttributeVal,
+		StringAttribute:    types.StringPointerValue(apiObject.StringAttribute),
+		state:              attr.ValueStateKnown,
 	}, diags
 }
 
@@ -4191,11 +5883,12 @@ func (v SingleNestedAttributeAssocExtTypeValue) ToApisdkType(ctx context.Context
 	}
 
 	return &apisdk.Type{
-		BoolAttribute:    v.BoolAttribute.ValueBoolPointer(),
-		Float64Attribute: v.Float64Attribute.ValueFloat64Pointer(),
-		Int64Attribute:   v.Int64Attribute.ValueInt64Pointer(),
-		NumberAttribute:  v.NumberAttribute.ValueBigFloat(),
-		StringAttribute:  v.StringAttribute.ValueStringPointer(),
+		BoolAttribute:      v.BoolAttribute.ValueBoolPointer(),
+		Float64Attribute:   v.Float64Attribute.ValueFloat64Pointer(),
+		Int64Attribute:     v.Int64Attribute.ValueInt64Pointer(),
+		NumberAttribute:    v.NumberAttribute.ValueBigFloat(),
+		StringAttribute:    v.StringAttribute.ValueStringPointer(),
+		WriteOnlyAttribute: v.WriteOnlyAttribute.ValueStringPointer(),
 	}, diags
 }
 
@@ -4207,12 +5900,13 @@ func (v SingleNestedAttributeAssocExtTypeValue) FromApisdkType(ctx context.Conte
 	}
 
 	return SingleNestedAttributeAssocExtTypeValue{
-		BoolAttribute:    types.BoolPointerValue(apiObject.BoolAttribute),
-		Float64Attribute: types.Float64PointerValue(apiObject.Float64Attribute),
-		Int64Attribute:   types.Int64PointerValue(apiObject.Int64Attribute),
-		NumberAttribute:  types.NumberValue(apiObject.NumberAttribute),
-		StringAttribute:  types.StringPointerValue(apiObject.StringAttribute),
-		state:            attr.ValueStateKnown,
+		BoolAttribute:      types.BoolPointerValue(apiObject.BoolAttribute),
+		Float64Attribute:   types.Float64PointerValue(apiObject.Float64Attribute),
+		Int64Attribute:     types.Int64PointerValue(apiObject.Int64Attribute),
+		NumberAttribute:    types.NumberValue(apiObject.NumberAttribute),
+		StringAttribute:    types.StringPointerValue(apiObject.StringAttribute),
+		WriteOnlyAttribute: types.StringPointerValue(nil),
+		state:              attr.ValueStateKnown,
 	}, diags
 }
 
@@ -0,0 +1,65 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"example.com/tagtypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+func ParseThingFunctionDefinition(ctx context.Context) function.Definition {
+	return function.Definition{
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "input",
+				Description:         "String to parse.",
+				MarkdownDescription: "String to parse.",
+			},
+			function.BoolParameter{
+				Name:           "strict",
+				AllowNullValue: true,
+			},
+			function.ListParameter{
+				Name:               "limits",
+				ElementType:        types.Int64Type,
+				AllowUnknownValues: true,
+			},
+			function.ObjectParameter{
+				Name: "options",
+				AttributeTypes: map[string]attr.Type{
+					"separator": types.StringType,
+					"weight":    types.Float64Type,
+				},
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:       "tags",
+			CustomType: tagtypes.TagType{},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"name":  types.StringType,
+				"count": types.Int64Type,
+			},
+		},
+		Summary:             "Parse a thing",
+		Description:         "Parses a thing from its string representation.",
+		MarkdownDescription: "Parses a thing from its `string` representation.",
+	}
+}
+
+type ParseThingFunctionArguments struct {
+	Input   types.String
+	Strict  types.Bool
+	Limits  types.List
+	Options types.Object
+	Tags    []tagtypes.Tag
+}
+
+func (a *ParseThingFunctionArguments) Get(ctx context.Context, args function.ArgumentsData) *function.FuncError {
+	return args.Get(ctx, &a.Input, &a.Strict, &a.Limits, &a.Options, &a.Tags)
+}
@@ -0,0 +1,230 @@
+package scaffold
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"example.com/apisdk"
+	datasource_example "example.com/examplecloud/internal/datasource_example"
+)
+
+var _ datasource.DataSource = (*exampleDataSource)(nil)
+var _ datasource.DataSourceWithConfigValidators = (*exampleDataSource)(nil)
+
+func NewExampleDataSource() datasource.DataSource {
+	return &exampleDataSource{}
+}
+
+type exampleDataSource struct{}
+
+func (d *exampleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_example"
+}
+
+func (d *exampleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_example.ExampleDataSourceSchema(ctx)
+}
+
+func (d *exampleDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return datasource_example.ExampleDataSourceConfigValidators(ctx)
+}
+
+func (d *exampleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_example.ExampleModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+
+	// Convert configuration data into API types
+	var listNestedAttributeAssocExtTypeAPI []*apisdk.Type
+	var mapNestedAttributeAssocExtTypeAPI map[string]*apisdk.Type
+	var setNestedAttributeAssocExtTypeAPI []*apisdk.Type
+	var singleNestedAttributeAssocExtTypeAPI *apisdk.Type
+	var listNestedBlockAssocExtTypeAPI []*apisdk.Type
+	var setNestedBlockAssocExtTypeAPI []*apisdk.Type
+	var singleNestedBlockAssocExtTypeAPI *apisdk.Type
+
+	if !data.ListNestedAttributeAssocExtType.IsNull() && !data.ListNestedAttributeAssocExtType.IsUnknown() {
+		var listNestedAttributeAssocExtTypeElements []datasource_example.ListNestedAttributeAssocExtTypeValue
+
+		resp.Diagnostics.Append(data.ListNestedAttributeAssocExtType.ElementsAs(ctx, &listNestedAttributeAssocExtTypeElements, false)...)
+
+		listNestedAttributeAssocExtTypeAPI = make([]*apisdk.Type, 0, len(listNestedAttributeAssocExtTypeElements))
+
+		for _, element := range listNestedAttributeAssocExtTypeElements {
+			apiObject, elementDiags := element.ToApisdkType(ctx)
+			resp.Diagnostics.Append(elementDiags...)
+			listNestedAttributeAssocExtTypeAPI = append(listNestedAttributeAssocExtTypeAPI, apiObject)
+		}
+	}
+
+	if !data.MapNestedAttributeAssocExtType.IsNull() && !data.MapNestedAttributeAssocExtType.IsUnknown() {
+		var mapNestedAttributeAssocExtTypeElements map[string]datasource_example.MapNestedAttributeAssocExtTypeValue
+
+		resp.Diagnostics.Append(data.MapNestedAttributeAssocExtType.ElementsAs(ctx, &mapNestedAttributeAssocExtTypeElements, false)...)
+
+		mapNestedAttributeAssocExtTypeAPI = make(map[string]*apisdk.Type, len(mapNestedAttributeAssocExtTypeElements))
+
+		for k, element := range mapNestedAttributeAssocExtTypeElements {
+			apiObject, elementDiags := element.ToApisdkType(ctx)
+			resp.Diagnostics.Append(elementDiags...)
+			mapNestedAttributeAssocExtTypeAPI[k] = apiObject
+		}
+	}
+
+	if !data.SetNestedAttributeAssocExtType.IsNull() && !data.SetNestedAttributeAssocExtType.IsUnknown() {
+		var setNestedAttributeAssocExtTypeElements []datasource_example.SetNestedAttributeAssocExtTypeValue
+
+		resp.Diagnostics.Append(data.SetNestedAttributeAssocExtType.ElementsAs(ctx, &setNestedAttributeAssocExtTypeElements, false)...)
+
+		setNestedAttributeAssocExtTypeAPI = make([]*apisdk.Type, 0, len(setNestedAttributeAssocExtTypeElements))
+
+		for _, element := range setNestedAttributeAssocExtTypeElements {
+			apiObject, elementDiags := element.ToApisdkType(ctx)
+			resp.Diagnostics.Append(elementDiags...)
+			setNestedAttributeAssocExtTypeAPI = append(setNestedAttributeAssocExtTypeAPI, apiObject)
+		}
+	}
+
+	if !data.SingleNestedAttributeAssocExtType.IsNull() && !data.SingleNestedAttributeAssocExtType.IsUnknown() {
+		singleNestedAttributeAssocExtTypeAPI, diags = data.SingleNestedAttributeAssocExtType.ToApisdkType(ctx)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if !data.ListNestedBlockAssocExtType.IsNull() && !data.ListNestedBlockAssocExtType.IsUnknown() {
+		var listNestedBlockAssocExtTypeElements []datasource_example.ListNestedBlockAssocExtTypeValue
+
+		resp.Diagnostics.Append(data.ListNestedBlockAssocExtType.ElementsAs(ctx, &listNestedBlockAssocExtTypeElements, false)...)
+
+		listNestedBlockAssocExtTypeAPI = make([]*apisdk.Type, 0, len(listNestedBlockAssocExtTypeElements))
+
+		for _, element := range listNestedBlockAssocExtTypeElements {
+			apiObject, elementDiags := element.ToApisdkType(ctx)
+			resp.Diagnostics.Append(elementDiags...)
+			listNestedBlockAssocExtTypeAPI = append(listNestedBlockAssocExtTypeAPI, apiObject)
+		}
+	}
+
+	if !data.SetNestedBlockAssocExtType.IsNull() && !data.SetNestedBlockAssocExtType.IsUnknown() {
+		var setNestedBlockAssocExtTypeElements []datasource_example.SetNestedBlockAssocExtTypeValue
+
+		resp.Diagnostics.Append(data.SetNestedBlockAssocExtType.ElementsAs(ctx, &setNestedBlockAssocExtTypeElements, false)...)
+
+		setNestedBlockAssocExtTypeAPI = make([]*apisdk.Type, 0, len(setNestedBlockAssocExtTypeElements))
+
+		for _, element := range setNestedBlockAssocExtTypeElements {
+			apiObject, elementDiags := element.ToApisdkType(ctx)
+			resp.Diagnostics.Append(elementDiags...)
+			setNestedBlockAssocExtTypeAPI = append(setNestedBlockAssocExtTypeAPI, apiObject)
+		}
+	}
+
+	if !data.SingleNestedBlockAssocExtType.IsNull() && !data.SingleNestedBlockAssocExtType.IsUnknown() {
+		singleNestedBlockAssocExtTypeAPI, diags = data.SingleNestedBlockAssocExtType.ToApisdkType(ctx)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic, sending and returning the API types
+
+	// Convert API types into state data
+	if listNestedAttributeAssocExtTypeAPI == nil {
+		data.ListNestedAttributeAssocExtType = types.ListNull(datasource_example.ListNestedAttributeAssocExtTypeValue{}.Type(ctx))
+	} else {
+		listNestedAttributeAssocExtTypeElementsFromAPI := make([]datasource_example.ListNestedAttributeAssocExtTypeValue, 0, len(listNestedAttributeAssocExtTypeAPI))
+
+		for _, apiObject := range listNestedAttributeAssocExtTypeAPI {
+			element, elementDiags := datasource_example.ListNestedAttributeAssocExtTypeValue{}.FromApisdkType(ctx, apiObject)
+			resp.Diagnostics.Append(elementDiags...)
+			listNestedAttributeAssocExtTypeElementsFromAPI = append(listNestedAttributeAssocExtTypeElementsFromAPI, element)
+		}
+
+		data.ListNestedAttributeAssocExtType, diags = types.ListValueFrom(ctx, datasource_example.ListNestedAttributeAssocExtTypeValue{}.Type(ctx), listNestedAttributeAssocExtTypeElementsFromAPI)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if mapNestedAttributeAssocExtTypeAPI == nil {
+		data.MapNestedAttributeAssocExtType = types.MapNull(datasource_example.MapNestedAttributeAssocExtTypeValue{}.Type(ctx))
+	} else {
+		mapNestedAttributeAssocExtTypeElementsFromAPI := make(map[string]datasource_example.MapNestedAttributeAssocExtTypeValue, len(mapNestedAttributeAssocExtTypeAPI))
+
+		for k, apiObject := range mapNestedAttributeAssocExtTypeAPI {
+			element, elementDiags := datasource_example.MapNestedAttributeAssocExtTypeValue{}.FromApisdkType(ctx, apiObject)
+			resp.Diagnostics.Append(elementDiags...)
+			mapNestedAttributeAssocExtTypeElementsFromAPI[k] = element
+		}
+
+		data.MapNestedAttributeAssocExtType, diags = types.MapValueFrom(ctx, datasource_example.MapNestedAttributeAssocExtTypeValue{}.Type(ctx), mapNestedAttributeAssocExtTypeElementsFromAPI)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if setNestedAttributeAssocExtTypeAPI == nil {
+		data.SetNestedAttributeAssocExtType = types.SetNull(datasource_example.SetNestedAttributeAssocExtTypeValue{}.Type(ctx))
+	} else {
+		setNestedAttributeAssocExtTypeElementsFromAPI := make([]datasource_example.SetNestedAttributeAssocExtTypeValue, 0, len(setNestedAttributeAssocExtTypeAPI))
+
+		for _, apiObject := range setNestedAttributeAssocExtTypeAPI {
+			element, elementDiags := datasource_example.SetNestedAttributeAssocExtTypeValue{}.FromApisdkType(ctx, apiObject)
+			resp.Diagnostics.Append(elementDiags...)
+			setNestedAttributeAssocExtTypeElementsFromAPI = append(setNestedAttributeAssocExtTypeElementsFromAPI, element)
+		}
+
+		data.SetNestedAttributeAssocExtType, diags = types.SetValueFrom(ctx, datasource_example.SetNestedAttributeAssocExtTypeValue{}.Type(ctx), setNestedAttributeAssocExtTypeElementsFromAPI)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	data.SingleNestedAttributeAssocExtType, diags = data.SingleNestedAttributeAssocExtType.FromApisdkType(ctx, singleNestedAttributeAssocExtTypeAPI)
+	resp.Diagnostics.Append(diags...)
+
+	if listNestedBlockAssocExtTypeAPI == nil {
+		data.ListNestedBlockAssocExtType = types.ListNull(datasource_example.ListNestedBlockAssocExtTypeValue{}.Type(ctx))
+	} else {
+		listNestedBlockAssocExtTypeElementsFromAPI := make([]datasource_example.ListNestedBlockAssocExtTypeValue, 0, len(listNestedBlockAssocExtTypeAPI))
+
+		for _, apiObject := range listNestedBlockAssocExtTypeAPI {
+			element, elementDiags := datasource_example.ListNestedBlockAssocExtTypeValue{}.FromApisdkType(ctx, apiObject)
+			resp.Diagnostics.Append(elementDiags...)
+			listNestedBlockAssocExtTypeElementsFromAPI = append(listNestedBlockAssocExtTypeElementsFromAPI, element)
+		}
+
+		data.ListNestedBlockAssocExtType, diags = types.ListValueFrom(ctx, datasource_example.ListNestedBlockAssocExtTypeValue{}.Type(ctx), listNestedBlockAssocExtTypeElementsFromAPI)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if setNestedBlockAssocExtTypeAPI == nil {
+		data.SetNestedBlockAssocExtType = types.SetNull(datasource_example.SetNestedBlockAssocExtTypeValue{}.Type(ctx))
+	} else {
+		setNestedBlockAssocExtTypeElementsFromAPI := make([]datasource_example.SetNestedBlockAssocExtTypeValue, 0, len(setNestedBlockAssocExtTypeAPI))
+
+		for _, apiObject := range setNestedBlockAssocExtTypeAPI {
+			element, elementDiags := datasource_example.SetNestedBlockAssocExtTypeValue{}.FromApisdkType(ctx, apiObject)
+			resp.Diagnostics.Append(elementDiags...)
+			setNestedBlockAssocExtTypeElementsFromAPI = append(setNestedBlockAssocExtTypeElementsFromAPI, element)
+		}
+
+		data.SetNestedBlockAssocExtType, diags = types.SetValueFrom(ctx, datasource_example.SetNestedBlockAssocExtTypeValue{}.Type(ctx), setNestedBlockAssocExtTypeElementsFromAPI)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	data.SingleNestedBlockAssocExtType, diags = data.SingleNestedBlockAssocExtType.FromApisdkType(ctx, singleNestedBlockAssocExtTypeAPI)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
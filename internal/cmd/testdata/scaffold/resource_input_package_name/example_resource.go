@@ -0,0 +1,570 @@
+package scaffold
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"example.com/apisdk"
+	t3 "example.com/examplecloud/internal/gen"
+)
+
+var _ resource.Resource = (*exampleResource)(nil)
+var _ resource.ResourceWithIdentity = (*exampleResource)(nil)
+var _ resource.ResourceWithConfigValidators = (*exampleResource)(nil)
+
+func NewExampleResource() resource.Resource {
+	return &exampleResource{}
+}
+
+type exampleResource struct{}
+
+func (r *exampleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_example"
+}
+
+func (r *exampleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = t3.ExampleResourceSchema(ctx)
+}
+
+func (r *exampleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = t3.ExampleResourceIdentitySchema(ctx)
+}
+
+func (r *exampleResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return t3.ExampleResourceConfigValidators(ctx)
+}
+
+func (r *exampleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data t3.ExampleModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+
+	// Convert plan data into API types
+	var listNestedAttributeAssocExtTypeAPI []*apisdk.Type
+	var mapNestedAttributeAssocExtTypeAPI map[string]*apisdk.Type
+	var setNestedAttributeAssocExtTypeAPI []*apisdk.Type
+	var singleNestedAttributeAssocExtTypeAPI *apisdk.Type
+	var listNestedBlockAssocExtTypeAPI []*apisdk.Type
+	var setNestedBlockAssocExtTypeAPI []*apisdk.Type
+	var singleNestedBlockAssocExtTypeAPI *apisdk.Type
+
+	if !data.ListNestedAttributeAssocExtType.IsNull() && !data.ListNestedAttributeAssocExtType.IsUnknown() {
+		var listNestedAttributeAssocExtTypeElements []t3.ListNestedAttributeAssocExtTypeValue
+
+		resp.Diagnostics.Append(data.ListNestedAttributeAssocExtType.ElementsAs(ctx, &listNestedAttributeAssocExtTypeElements, false)...)
+
+		listNestedAttributeAssocExtTypeAPI = make([]*apisdk.Type, 0, len(listNestedAttributeAssocExtTypeElements))
+
+		for _, element := range listNestedAttributeAssocExtTypeElements {
+			apiObject, elementDiags := element.ToApisdkType(ctx)
+			resp.Diagnostics.Append(elementDiags...)
+			listNestedAttributeAssocExtTypeAPI = append(listNestedAttributeAssocExtTypeAPI, apiObject)
+		}
+	}
+
+	if !data.MapNestedAttributeAssocExtType.IsNull() && !data.MapNestedAttributeAssocExtType.IsUnknown() {
+		var mapNestedAttributeAssocExtTypeElements map[string]t3.MapNestedAttributeAssocExtTypeValue
+
+		resp.Diagnostics.Append(data.MapNestedAttributeAssocExtType.ElementsAs(ctx, &mapNestedAttributeAssocExtTypeElements, false)...)
+
+		mapNestedAttributeAssocExtTypeAPI = make(map[string]*apisdk.Type, len(mapNestedAttributeAssocExtTypeElements))
+
+		for k, element := range mapNestedAttributeAssocExtTypeElements {
+			apiObject, elementDiags := element.ToApisdkType(ctx)
+			resp.Diagnostics.Append(elementDiags...)
+			mapNestedAttributeAssocExtTypeAPI[k] = apiObject
+		}
+	}
+
+	if !data.SetNestedAttributeAssocExtType.IsNull() && !data.SetNestedAttributeAssocExtType.IsUnknown() {
+		var setNestedAttributeAssocExtTypeElements []t3.SetNestedAttributeAssocExtTypeValue
+
+		resp.Diagnostics.Append(data.SetNestedAttributeAssocExtType.ElementsAs(ctx, &setNestedAttributeAssocExtTypeElements, false)...)
+
+		setNestedAttributeAssocExtTypeAPI = make([]*apisdk.Type, 0, len(setNestedAttributeAssocExtTypeElements))
+
+		for _, element := range setNestedAttributeAssocExtTypeElements {
+			apiObject, elementDiags := element.ToApisdkType(ctx)
+			resp.Diagnostics.Append(elementDiags...)
+			setNestedAttributeAssocExtTypeAPI = append(setNestedAttributeAssocExtTypeAPI, apiObject)
+		}
+	}
+
+	if !data.SingleNestedAttributeAssocExtType.IsNull() && !data.SingleNestedAttributeAssocExtType.IsUnknown() {
+		singleNestedAttributeAssocExtTypeAPI, diags = data.SingleNestedAttributeAssocExtType.ToApisdkType(ctx)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if !data.ListNestedBlockAssocExtType.IsNull() && !data.ListNestedBlockAssocExtType.IsUnknown() {
+		var listNestedBlockAssocExtTypeElements []t3.ListNestedBlockAssocExtTypeValue
+
+		resp.Diagnostics.Append(data.ListNestedBlockAssocExtType.ElementsAs(ctx, &listNestedBlockAssocExtTypeElements, false)...)
+
+		listNestedBlockAssocExtTypeAPI = make([]*apisdk.Type, 0, len(listNestedBlockAssocExtTypeElements))
+
+		for _, element := range listNestedBlockAssocExtTypeElements {
+			apiObject, elementDiags := element.ToApisdkType(ctx)
+			resp.Diagnostics.Append(elementDiags...)
+			listNestedBlockAssocExtTypeAPI = append(listNestedBlockAssocExtTypeAPI, apiObject)
+		}
+	}
+
+	if !data.SetNestedBlockAssocExtType.IsNull() && !data.SetNestedBlockAssocExtType.IsUnknown() {
+		var setNestedBlockAssocExtTypeElements []t3.SetNestedBlockAssocExtTypeValue
+
+		resp.Diagnostics.Append(data.SetNestedBlockAssocExtType.ElementsAs(ctx, &setNestedBlockAssocExtTypeElements, false)...)
+
+		setNestedBlockAssocExtTypeAPI = make([]*apisdk.Type, 0, len(setNestedBlockAssocExtTypeElements))
+
+		for _, element := range setNestedBlockAssocExtTypeElements {
+			apiObject, elementDiags := element.ToApisdkType(ctx)
+			resp.Diagnostics.Append(elementDiags...)
+			setNestedBlockAssocExtTypeAPI = append(setNestedBlockAssocExtTypeAPI, apiObject)
+		}
+	}
+
+	if !data.SingleNestedBlockAssocExtType.IsNull() && !data.SingleNestedBlockAssocExtType.IsUnknown() {
+		singleNestedBlockAssocExtTypeAPI, diags = data.SingleNestedBlockAssocExtType.ToApisdkType(ctx)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API call logic, sending and returning the API types
+
+	// Convert API types into state data
+	if listNestedAttributeAssocExtTypeAPI == nil {
+		data.ListNestedAttributeAssocExtType = types.ListNull(t3.ListNestedAttributeAssocExtTypeValue{}.Type(ctx))
+	} else {
+		listNestedAttributeAssocExtTypeElementsFromAPI := make([]t3.ListNestedAttributeAssocExtTypeValue, 0, len(listNestedAttributeAssocExtTypeAPI))
+
+		for _, apiObject := range listNestedAttributeAssocExtTypeAPI {
+			element, elementDiags := t3.ListNestedAttributeAssocExtTypeValue{}.FromApisdkType(ctx, apiObject)
+			resp.Diagnostics.Append(elementDiags...)
+			listNestedAttributeAssocExtTypeElementsFromAPI = append(listNestedAttributeAssocExtTypeElementsFromAPI, element)
+		}
+
+		data.ListNestedAttributeAssocExtType, diags = types.ListValueFrom(ctx, t3.ListNestedAttributeAssocExtTypeValue{}.Type(ctx), listNestedAttributeAssocExtTypeElementsFromAPI)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if mapNestedAttributeAssocExtTypeAPI == nil {
+		data.MapNestedAttributeAssocExtType = types.MapNull(t3.MapNestedAttributeAssocExtTypeValue{}.Type(ctx))
+	} else {
+		mapNestedAttributeAssocExtTypeElementsFromAPI := make(map[string]t3.MapNestedAttributeAssocExtTypeValue, len(mapNestedAttributeAssocExtTypeAPI))
+
+		for k, apiObject := range mapNestedAttributeAssocExtTypeAPI {
+			element, elementDiags := t3.MapNestedAttributeAssocExtTypeValue{}.FromApisdkType(ctx, apiObject)
+			resp.Diagnostics.Append(elementDiags...)
+			mapNestedAttributeAssocExtTypeElementsFromAPI[k] = element
+		}
+
+		data.MapNestedAttributeAssocExtType, diags = types.MapValueFrom(ctx, t3.MapNestedAttributeAssocExtTypeValue{}.Type(ctx), mapNestedAttributeAssocExtTypeElementsFromAPI)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if setNestedAttributeAssocExtTypeAPI == nil {
+		data.SetNestedAttributeAssocExtType = types.SetNull(t3.SetNestedAttributeAssocExtTypeValue{}.Type(ctx))
+	} else {
+		setNestedAttributeAssocExtTypeElementsFromAPI := make([]t3.SetNestedAttributeAssocExtTypeValue, 0, len(setNestedAttributeAssocExtTypeAPI))
+
+		for _, apiObject := range setNestedAttributeAssocExtTypeAPI {
+			element, elementDiags := t3.SetNestedAttributeAssocExtTypeValue{}.FromApisdkType(ctx, apiObject)
+			resp.Diagnostics.Append(elementDiags...)
+			setNestedAttributeAssocExtTypeElementsFromAPI = append(setNestedAttributeAssocExtTypeElementsFromAPI, element)
+		}
+
+		data.SetNestedAttributeAssocExtType, diags = types.SetValueFrom(ctx, t3.SetNestedAttributeAssocExtTypeValue{}.Type(ctx), setNestedAttributeAssocExtTypeElementsFromAPI)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	data.SingleNestedAttributeAssocExtType, diags = data.SingleNestedAttributeAssocExtType.FromApisdkType(ctx, singleNestedAttributeAssocExtTypeAPI)
+	resp.Diagnostics.Append(diags...)
+
+	if listNestedBlockAssocExtTypeAPI == nil {
+		data.ListNestedBlockAssocExtType = types.ListNull(t3.ListNestedBlockAssocExtTypeValue{}.Type(ctx))
+	} else {
+		listNestedBlockAssocExtTypeElementsFromAPI := make([]t3.ListNestedBlockAssocExtTypeValue, 0, len(listNestedBlockAssocExtTypeAPI))
+
+		for _, apiObject := range listNestedBlockAssocExtTypeAPI {
+			element, elementDiags := t3.ListNestedBlockAssocExtTypeValue{}.FromApisdkType(ctx, apiObject)
+			resp.Diagnostics.Append(elementDiags...)
+			listNestedBlockAssocExtTypeElementsFromAPI = append(listNestedBlockAssocExtTypeElementsFromAPI, element)
+		}
+
+		data.ListNestedBlockAssocExtType, diags = types.ListValueFrom(ctx, t3.ListNestedBlockAssocExtTypeValue{}.Type(ctx), listNestedBlockAssocExtTypeElementsFromAPI)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if setNestedBlockAssocExtTypeAPI == nil {
+		data.SetNestedBlockAssocExtType = types.SetNull(t3.SetNestedBlockAssocExtTypeValue{}.Type(ctx))
+	} else {
+		setNestedBlockAssocExtTypeElementsFromAPI := make([]t3.SetNestedBlockAssocExtTypeValue, 0, len(setNestedBlockAssocExtTypeAPI))
+
+		for _, apiObject := range setNestedBlockAssocExtTypeAPI {
+			element, elementDiags := t3.SetNestedBlockAssocExtTypeValue{}.FromApisdkType(ctx, apiObject)
+			resp.Diagnostics.Append(elementDiags...)
+			setNestedBlockAssocExtTypeElementsFromAPI = append(setNestedBlockAssocExtTypeElementsFromAPI, element)
+		}
+
+		data.SetNestedBlockAssocExtType, diags = types.SetValueFrom(ctx, t3.SetNestedBlockAssocExtTypeValue{}.Type(ctx), setNestedBlockAssocExtTypeElementsFromAPI)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	data.SingleNestedBlockAssocExtType, diags = data.SingleNestedBlockAssocExtType.FromApisdkType(ctx, singleNestedBlockAssocExtTypeAPI)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Save identity data into Terraform state
+	var identity t3.ExampleIdentityModel
+
+	// Example identity data value setting, from the API response
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, &identity)...)
+}
+
+func (r *exampleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data t3.ExampleModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+
+	// Read API call logic, returning the API types
+	var listNestedAttributeAssocExtTypeAPI []*apisdk.Type
+	var mapNestedAttributeAssocExtTypeAPI map[string]*apisdk.Type
+	var setNestedAttributeAssocExtTypeAPI []*apisdk.Type
+	var singleNestedAttributeAssocExtTypeAPI *apisdk.Type
+	var listNestedBlockAssocExtTypeAPI []*apisdk.Type
+	var setNestedBlockAssocExtTypeAPI []*apisdk.Type
+	var singleNestedBlockAssocExtTypeAPI *apisdk.Type
+
+	// Convert API types into state data
+	if listNestedAttributeAssocExtTypeAPI == nil {
+		data.ListNestedAttributeAssocExtType = types.ListNull(t3.ListNestedAttributeAssocExtTypeValue{}.Type(ctx))
+	} else {
+		listNestedAttributeAssocExtTypeElementsFromAPI := make([]t3.ListNestedAttributeAssocExtTypeValue, 0, len(listNestedAttributeAssocExtTypeAPI))
+
+		for _, apiObject := range listNestedAttributeAssocExtTypeAPI {
+			element, elementDiags := t3.ListNestedAttributeAssocExtTypeValue{}.FromApisdkType(ctx, apiObject)
+			resp.Diagnostics.Append(elementDiags...)
+			listNestedAttributeAssocExtTypeElementsFromAPI = append(listNestedAttributeAssocExtTypeElementsFromAPI, element)
+		}
+
+		data.ListNestedAttributeAssocExtType, diags = types.ListValueFrom(ctx, t3.ListNestedAttributeAssocExtTypeValue{}.Type(ctx), listNestedAttributeAssocExtTypeElementsFromAPI)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if mapNestedAttributeAssocExtTypeAPI == nil {
+		data.MapNestedAttributeAssocExtType = types.MapNull(t3.MapNestedAttributeAssocExtTypeValue{}.Type(ctx))
+	} else {
+		mapNestedAttributeAssocExtTypeElementsFromAPI := make(map[string]t3.MapNestedAttributeAssocExtTypeValue, len(mapNestedAttributeAssocExtTypeAPI))
+
+		for k, apiObject := range mapNestedAttributeAssocExtTypeAPI {
+			element, elementDiags := t3.MapNestedAttributeAssocExtTypeValue{}.FromApisdkType(ctx, apiObject)
+			resp.Diagnostics.Append(elementDiags...)
+			mapNestedAttributeAssocExtTypeElementsFromAPI[k] = element
+		}
+
+		data.MapNestedAttributeAssocExtType, diags = types.MapValueFrom(ctx, t3.MapNestedAttributeAssocExtTypeValue{}.Type(ctx), mapNestedAttributeAssocExtTypeElementsFromAPI)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if setNestedAttributeAssocExtTypeAPI == nil {
+		data.SetNestedAttributeAssocExtType = types.SetNull(t3.SetNestedAttributeAssocExtTypeValue{}.Type(ctx))
+	} else {
+		setNestedAttributeAssocExtTypeElementsFromAPI := make([]t3.SetNestedAttributeAssocExtTypeValue, 0, len(setNestedAttributeAssocExtTypeAPI))
+
+		for _, apiObject := range setNestedAttributeAssocExtTypeAPI {
+			element, elementDiags := t3.SetNestedAttributeAssocExtTypeValue{}.FromApisdkType(ctx, apiObject)
+			resp.Diagnostics.Append(elementDiags...)
+			setNestedAttributeAssocExtTypeElementsFromAPI = append(setNestedAttributeAssocExtTypeElementsFromAPI, element)
+		}
+
+		data.SetNestedAttributeAssocExtType, diags = types.SetValueFrom(ctx, t3.SetNestedAttributeAssocExtTypeValue{}.Type(ctx), setNestedAttributeAssocExtTypeElementsFromAPI)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	data.SingleNestedAttributeAssocExtType, diags = data.SingleNestedAttributeAssocExtType.FromApisdkType(ctx, singleNestedAttributeAssocExtTypeAPI)
+	resp.Diagnostics.Append(diags...)
+
+	if listNestedBlockAssocExtTypeAPI == nil {
+		data.ListNestedBlockAssocExtType = types.ListNull(t3.ListNestedBlockAssocExtTypeValue{}.Type(ctx))
+	} else {
+		listNestedBlockAssocExtTypeElementsFromAPI := make([]t3.ListNestedBlockAssocExtTypeValue, 0, len(listNestedBlockAssocExtTypeAPI))
+
+		for _, apiObject := range listNestedBlockAssocExtTypeAPI {
+			element, elementDiags := t3.ListNestedBlockAssocExtTypeValue{}.FromApisdkType(ctx, apiObject)
+			resp.Diagnostics.Append(elementDiags...)
+			listNestedBlockAssocExtTypeElementsFromAPI = append(listNestedBlockAssocExtTypeElementsFromAPI, element)
+		}
+
+		data.ListNestedBlockAssocExtType, diags = types.ListValueFrom(ctx, t3.ListNestedBlockAssocExtTypeValue{}.Type(ctx), listNestedBlockAssocExtTypeElementsFromAPI)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if setNestedBlockAssocExtTypeAPI == nil {
+		data.SetNestedBlockAssocExtType = types.SetNull(t3.SetNestedBlockAssocExtTypeValue{}.Type(ctx))
+	} else {
+		setNestedBlockAssocExtTypeElementsFromAPI := make([]t3.SetNestedBlockAssocExtTypeValue, 0, len(setNestedBlockAssocExtTypeAPI))
+
+		for _, apiObject := range setNestedBlockAssocExtTypeAPI {
+			element, elementDiags := t3.SetNestedBlockAssocExtTypeValue{}.FromApisdkType(ctx, apiObject)
+			resp.Diagnostics.Append(elementDiags...)
+			setNestedBlockAssocExtTypeElementsFromAPI = append(setNestedBlockAssocExtTypeElementsFromAPI, element)
+		}
+
+		data.SetNestedBlockAssocExtType, diags = types.SetValueFrom(ctx, t3.SetNestedBlockAssocExtTypeValue{}.Type(ctx), setNestedBlockAssocExtTypeElementsFromAPI)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	data.SingleNestedBlockAssocExtType, diags = data.SingleNestedBlockAssocExtType.FromApisdkType(ctx, singleNestedBlockAssocExtTypeAPI)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Save identity data into Terraform state
+	var identity t3.ExampleIdentityModel
+
+	// Example identity data value setting, from the API response
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, &identity)...)
+}
+
+func (r *exampleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data t3.ExampleModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+
+	// Convert plan data into API types
+	var listNestedAttributeAssocExtTypeAPI []*apisdk.Type
+	var mapNestedAttributeAssocExtTypeAPI map[string]*apisdk.Type
+	var setNestedAttributeAssocExtTypeAPI []*apisdk.Type
+	var singleNestedAttributeAssocExtTypeAPI *apisdk.Type
+	var listNestedBlockAssocExtTypeAPI []*apisdk.Type
+	var setNestedBlockAssocExtTypeAPI []*apisdk.Type
+	var singleNestedBlockAssocExtTypeAPI *apisdk.Type
+
+	if !data.ListNestedAttributeAssocExtType.IsNull() && !data.ListNestedAttributeAssocExtType.IsUnknown() {
+		var listNestedAttributeAssocExtTypeElements []t3.ListNestedAttributeAssocExtTypeValue
+
+		resp.Diagnostics.Append(data.ListNestedAttributeAssocExtType.ElementsAs(ctx, &listNestedAttributeAssocExtTypeElements, false)...)
+
+		listNestedAttributeAssocExtTypeAPI = make([]*apisdk.Type, 0, len(listNestedAttributeAssocExtTypeElements))
+
+		for _, element := range listNestedAttributeAssocExtTypeElements {
+			apiObject, elementDiags := element.ToApisdkType(ctx)
+			resp.Diagnostics.Append(elementDiags...)
+			listNestedAttributeAssocExtTypeAPI = append(listNestedAttributeAssocExtTypeAPI, apiObject)
+		}
+	}
+
+	if !data.MapNestedAttributeAssocExtType.IsNull() && !data.MapNestedAttributeAssocExtType.IsUnknown() {
+		var mapNestedAttributeAssocExtTypeElements map[string]t3.MapNestedAttributeAssocExtTypeValue
+
+		resp.Diagnostics.Append(data.MapNestedAttributeAssocExtType.ElementsAs(ctx, &mapNestedAttributeAssocExtTypeElements, false)...)
+
+		mapNestedAttributeAssocExtTypeAPI = make(map[string]*apisdk.Type, len(mapNestedAttributeAssocExtTypeElements))
+
+		for k, element := range mapNestedAttributeAssocExtTypeElements {
+			apiObject, elementDiags := element.ToApisdkType(ctx)
+			resp.Diagnostics.Append(elementDiags...)
+			mapNestedAttributeAssocExtTypeAPI[k] = apiObject
+		}
+	}
+
+	if !data.SetNestedAttributeAssocExtType.IsNull() && !data.SetNestedAttributeAssocExtType.IsUnknown() {
+		var setNestedAttributeAssocExtTypeElements []t3.SetNestedAttributeAssocExtTypeValue
+
+		resp.Diagnostics.Append(data.SetNestedAttributeAssocExtType.ElementsAs(ctx, &setNestedAttributeAssocExtTypeElements, false)...)
+
+		setNestedAttributeAssocExtTypeAPI = make([]*apisdk.Type, 0, len(setNestedAttributeAssocExtTypeElements))
+
+		for _, element := range setNestedAttributeAssocExtTypeElements {
+			apiObject, elementDiags := element.ToApisdkType(ctx)
+			resp.Diagnostics.Append(elementDiags...)
+			setNestedAttributeAssocExtTypeAPI = append(setNestedAttributeAssocExtTypeAPI, apiObject)
+		}
+	}
+
+	if !data.SingleNestedAttributeAssocExtType.IsNull() && !data.SingleNestedAttributeAssocExtType.IsUnknown() {
+		singleNestedAttributeAssocExtTypeAPI, diags = data.SingleNestedAttributeAssocExtType.ToApisdkType(ctx)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if !data.ListNestedBlockAssocExtType.IsNull() && !data.ListNestedBlockAssocExtType.IsUnknown() {
+		var listNestedBlockAssocExtTypeElements []t3.ListNestedBlockAssocExtTypeValue
+
+		resp.Diagnostics.Append(data.ListNestedBlockAssocExtType.ElementsAs(ctx, &listNestedBlockAssocExtTypeElements, false)...)
+
+		listNestedBlockAssocExtTypeAPI = make([]*apisdk.Type, 0, len(listNestedBlockAssocExtTypeElements))
+
+		for _, element := range listNestedBlockAssocExtTypeElements {
+			apiObject, elementDiags := element.ToApisdkType(ctx)
+			resp.Diagnostics.Append(elementDiags...)
+			listNestedBlockAssocExtTypeAPI = append(listNestedBlockAssocExtTypeAPI, apiObject)
+		}
+	}
+
+	if !data.SetNestedBlockAssocExtType.IsNull() && !data.SetNestedBlockAssocExtType.IsUnknown() {
+		var setNestedBlockAssocExtTypeElements []t3.SetNestedBlockAssocExtTypeValue
+
+		resp.Diagnostics.Append(data.SetNestedBlockAssocExtType.ElementsAs(ctx, &setNestedBlockAssocExtTypeElements, false)...)
+
+		setNestedBlockAssocExtTypeAPI = make([]*apisdk.Type, 0, len(setNestedBlockAssocExtTypeElements))
+
+		for _, element := range setNestedBlockAssocExtTypeElements {
+			apiObject, elementDiags := element.ToApisdkType(ctx)
+			resp.Diagnostics.Append(elementDiags...)
+			setNestedBlockAssocExtTypeAPI = append(setNestedBlockAssocExtTypeAPI, apiObject)
+		}
+	}
+
+	if !data.SingleNestedBlockAssocExtType.IsNull() && !data.SingleNestedBlockAssocExtType.IsUnknown() {
+		singleNestedBlockAssocExtTypeAPI, diags = data.SingleNestedBlockAssocExtType.ToApisdkType(ctx)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update API call logic, sending and returning the API types
+
+	// Convert API types into state data
+	if listNestedAttributeAssocExtTypeAPI == nil {
+		data.ListNestedAttributeAssocExtType = types.ListNull(t3.ListNestedAttributeAssocExtTypeValue{}.Type(ctx))
+	} else {
+		listNestedAttributeAssocExtTypeElementsFromAPI := make([]t3.ListNestedAttributeAssocExtTypeValue, 0, len(listNestedAttributeAssocExtTypeAPI))
+
+		for _, apiObject := range listNestedAttributeAssocExtTypeAPI {
+			element, elementDiags := t3.ListNestedAttributeAssocExtTypeValue{}.FromApisdkType(ctx, apiObject)
+			resp.Diagnostics.Append(elementDiags...)
+			listNestedAttributeAssocExtTypeElementsFromAPI = append(listNestedAttributeAssocExtTypeElementsFromAPI, element)
+		}
+
+		data.ListNestedAttributeAssocExtType, diags = types.ListValueFrom(ctx, t3.ListNestedAttributeAssocExtTypeValue{}.Type(ctx), listNestedAttributeAssocExtTypeElementsFromAPI)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if mapNestedAttributeAssocExtTypeAPI == nil {
+		data.MapNestedAttributeAssocExtType = types.MapNull(t3.MapNestedAttributeAssocExtTypeValue{}.Type(ctx))
+	} else {
+		mapNestedAttributeAssocExtTypeElementsFromAPI := make(map[string]t3.MapNestedAttributeAssocExtTypeValue, len(mapNestedAttributeAssocExtTypeAPI))
+
+		for k, apiObject := range mapNestedAttributeAssocExtTypeAPI {
+			element, elementDiags := t3.MapNestedAttributeAssocExtTypeValue{}.FromApisdkType(ctx, apiObject)
+			resp.Diagnostics.Append(elementDiags...)
+			mapNestedAttributeAssocExtTypeElementsFromAPI[k] = element
+		}
+
+		data.MapNestedAttributeAssocExtType, diags = types.MapValueFrom(ctx, t3.MapNestedAttributeAssocExtTypeValue{}.Type(ctx), mapNestedAttributeAssocExtTypeElementsFromAPI)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if setNestedAttributeAssocExtTypeAPI == nil {
+		data.SetNestedAttributeAssocExtType = types.SetNull(t3.SetNestedAttributeAssocExtTypeValue{}.Type(ctx))
+	} else {
+		setNestedAttributeAssocExtTypeElementsFromAPI := make([]t3.SetNestedAttributeAssocExtTypeValue, 0, len(setNestedAttributeAssocExtTypeAPI))
+
+		for _, apiObject := range setNestedAttributeAssocExtTypeAPI {
+			element, elementDiags := t3.SetNestedAttributeAssocExtTypeValue{}.FromApisdkType(ctx, apiObject)
+			resp.Diagnostics.Append(elementDiags...)
+			setNestedAttributeAssocExtTypeElementsFromAPI = append(setNestedAttributeAssocExtTypeElementsFromAPI, element)
+		}
+
+		data.SetNestedAttributeAssocExtType, diags = types.SetValueFrom(ctx, t3.SetNestedAttributeAssocExtTypeValue{}.Type(ctx), setNestedAttributeAssocExtTypeElementsFromAPI)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	data.SingleNestedAttributeAssocExtType, diags = data.SingleNestedAttributeAssocExtType.FromApisdkType(ctx, singleNestedAttributeAssocExtTypeAPI)
+	resp.Diagnostics.Append(diags...)
+
+	if listNestedBlockAssocExtTypeAPI == nil {
+		data.ListNestedBlockAssocExtType = types.ListNull(t3.ListNestedBlockAssocExtTypeValue{}.Type(ctx))
+	} else {
+		listNestedBlockAssocExtTypeElementsFromAPI := make([]t3.ListNestedBlockAssocExtTypeValue, 0, len(listNestedBlockAssocExtTypeAPI))
+
+		for _, apiObject := range listNestedBlockAssocExtTypeAPI {
+			element, elementDiags := t3.ListNestedBlockAssocExtTypeValue{}.FromApisdkType(ctx, apiObject)
+			resp.Diagnostics.Append(elementDiags...)
+			listNestedBlockAssocExtTypeElementsFromAPI = append(listNestedBlockAssocExtTypeElementsFromAPI, element)
+		}
+
+		data.ListNestedBlockAssocExtType, diags = types.ListValueFrom(ctx, t3.ListNestedBlockAssocExtTypeValue{}.Type(ctx), listNestedBlockAssocExtTypeElementsFromAPI)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if setNestedBlockAssocExtTypeAPI == nil {
+		data.SetNestedBlockAssocExtType = types.SetNull(t3.SetNestedBlockAssocExtTypeValue{}.Type(ctx))
+	} else {
+		setNestedBlockAssocExtTypeElementsFromAPI := make([]t3.SetNestedBlockAssocExtTypeValue, 0, len(setNestedBlockAssocExtTypeAPI))
+
+		for _, apiObject := range setNestedBlockAssocExtTypeAPI {
+			element, elementDiags := t3.SetNestedBlockAssocExtTypeValue{}.FromApisdkType(ctx, apiObject)
+			resp.Diagnostics.Append(elementDiags...)
+			setNestedBlockAssocExtTypeElementsFromAPI = append(setNestedBlockAssocExtTypeElementsFromAPI, element)
+		}
+
+		data.SetNestedBlockAssocExtType, diags = types.SetValueFrom(ctx, t3.SetNestedBlockAssocExtTypeValue{}.Type(ctx), setNestedBlockAssocExtTypeElementsFromAPI)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	data.SingleNestedBlockAssocExtType, diags = data.SingleNestedBlockAssocExtType.FromApisdkType(ctx, singleNestedBlockAssocExtTypeAPI)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *exampleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data t3.ExampleModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic
+}
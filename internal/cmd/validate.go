@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/datasource"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/ephemeralresource"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/function"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/provider"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/resource"
+)
+
+type ValidateCommand struct {
+	UI              cli.Ui
+	flagIRInputPath string
+	flagLogLevel    string
+	flagLogFormat   string
+}
+
+func (cmd *ValidateCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	fs.StringVar(&cmd.flagIRInputPath, "input", "./ir.json", "path to intermediate representation (JSON)")
+	addLogFlags(fs, &cmd.flagLogLevel, &cmd.flagLogFormat)
+
+	return fs
+}
+
+func (cmd *ValidateCommand) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugingen-framework validate [<args>]\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+
+	return strBuilder.String()
+}
+
+func (a *ValidateCommand) Synopsis() string {
+	return "Validate an Intermediate Representation (IR) JSON file, reporting the location of each error."
+}
+
+func (cmd *ValidateCommand) Run(args []string) int {
+	ctx := context.Background()
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
+	logger, err := newLogger(cmd.flagLogLevel, cmd.flagLogFormat)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
+	err = cmd.runInternal(ctx)
+	if err != nil {
+		outputError(cmd.UI, logger, cmd.flagLogFormat, err)
+		return 1
+	}
+
+	cmd.UI.Output(fmt.Sprintf("%s is valid", cmd.flagIRInputPath))
+
+	return 0
+}
+
+func (cmd *ValidateCommand) runInternal(ctx context.Context) error {
+	// read, validate and parse input file
+	src, spec, ext, err := readSpecification(ctx, cmd.flagIRInputPath)
+	if err != nil {
+		return err
+	}
+
+	// errors are collected across all conversions, so that every schema
+	// which cannot be converted is reported
+	var errs []error
+
+	_, err = datasource.NewSchemas(spec, ext)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	_, err = ephemeralresource.NewSchemas(ext)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	_, err = function.NewFunctions(ext)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	_, err = provider.NewSchemas(spec, ext)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	_, err = resource.NewSchemas(spec, ext)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("error converting IR to Plugin Framework schema: %w", diagnostic.WithPositions(src, errors.Join(errs...)))
+	}
+
+	return nil
+}
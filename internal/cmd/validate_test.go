@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/cmd"
+)
+
+func TestValidateCommand(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		irInputPath    string
+		expectedCode   int
+		expectedOutput []string
+	}{
+		"valid": {
+			irInputPath:  "testdata/custom_and_external/ir.json",
+			expectedCode: 0,
+			expectedOutput: []string{
+				"testdata/custom_and_external/ir.json is valid",
+			},
+		},
+		"invalid": {
+			irInputPath:  "testdata/invalid/ir.json",
+			expectedCode: 1,
+			expectedOutput: []string{
+				"/resources/0/schema/attributes/1/list/element_type, line 20, column 31: Additional property strin is not allowed",
+				"/resources/0/schema/attributes/2/bool/computed_optional_required, line 28, column 45: ",
+				"/resources/0/schema/attributes/3/name, line 32, column 21: resource \"example\" attribute \"string_attribute\" is duplicated",
+				"/resources/0/schema/attributes/3/string, line 33, column 23: resource \"example\" attribute \"string_attribute\" is write-only and cannot be computed",
+				"/resources/0/schema/attributes/4/float32/computed_optional_required, line 41, column 45: resources.0.schema.attributes.4.float32.computed_optional_required must be one of the following",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mockUi := cli.NewMockUi()
+			c := cmd.ValidateCommand{
+				UI: mockUi,
+			}
+
+			exitCode := c.Run([]string{"--input", testCase.irInputPath})
+			if exitCode != testCase.expectedCode {
+				t.Fatalf("expected exit code %d, got %d: %s", testCase.expectedCode, exitCode, mockUi.ErrorWriter.String())
+			}
+
+			output := mockUi.OutputWriter.String() + mockUi.ErrorWriter.String()
+
+			for _, expected := range testCase.expectedOutput {
+				if !strings.Contains(output, expected) {
+					t.Errorf("expected output to contain %q, got: %s", expected, output)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package convert
+
+type AllowNullValue struct {
+	allowNullValue bool
+}
+
+func NewAllowNullValue(a bool) AllowNullValue {
+	return AllowNullValue{
+		allowNullValue: a,
+	}
+}
+
+func (a AllowNullValue) Equal(other AllowNullValue) bool {
+	return a.allowNullValue == other.allowNullValue
+}
+
+func (a AllowNullValue) Schema() []byte {
+	if a.allowNullValue {
+		return []byte("AllowNullValue: true,\n")
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package convert
+
+type AllowUnknownValues struct {
+	allowUnknownValues bool
+}
+
+func NewAllowUnknownValues(a bool) AllowUnknownValues {
+	return AllowUnknownValues{
+		allowUnknownValues: a,
+	}
+}
+
+func (a AllowUnknownValues) Equal(other AllowUnknownValues) bool {
+	return a.allowUnknownValues == other.allowUnknownValues
+}
+
+func (a AllowUnknownValues) Schema() []byte {
+	if a.allowUnknownValues {
+		return []byte("AllowUnknownValues: true,\n")
+	}
+
+	return nil
+}
@@ -67,3 +67,13 @@ func (d DefaultBool) Schema() []byte {
 
 	return nil
 }
+
+// StaticValue returns the static default as a Terraform configuration
+// expression, or an empty string if there is no static default.
+func (d DefaultBool) StaticValue() string {
+	if d.boolDefault == nil || d.boolDefault.Static == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%t", *d.boolDefault.Static)
+}
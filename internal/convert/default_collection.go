@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package convert
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+const (
+	DefaultCollectionTypeList DefaultCollectionTypes = "List"
+	DefaultCollectionTypeMap  DefaultCollectionTypes = "Map"
+	DefaultCollectionTypeSet  DefaultCollectionTypes = "Set"
+)
+
+type DefaultCollectionTypes string
+
+func (d DefaultCollectionTypes) Equal(other DefaultCollectionTypes) bool {
+	return d == other
+}
+
+// Import returns the path of the framework package which defines the static
+// default of the collection type, such as listdefault.
+func (d DefaultCollectionTypes) Import() string {
+	return fmt.Sprintf("github.com/hashicorp/terraform-plugin-framework/resource/schema/%sdefault", strings.ToLower(string(d)))
+}
+
+// DefaultCollection is the default of a list, map or set attribute, which is
+// either a custom default, or a static default, which is an addition to the
+// specification.
+type DefaultCollection struct {
+	collectionType DefaultCollectionTypes
+	custom         *specschema.CustomDefault
+	static         *staticDefault
+}
+
+func NewDefaultCollection(c *specschema.CustomDefault) DefaultCollection {
+	return DefaultCollection{
+		custom: c,
+	}
+}
+
+// WithStatic returns the DefaultCollection with the static default, which is
+// checked against the element type of the collection.
+func (d DefaultCollection) WithStatic(t DefaultCollectionTypes, v any, elementType specschema.ElementType) (DefaultCollection, error) {
+	if v == nil {
+		return d, nil
+	}
+
+	e := specschema.ElementType{}
+
+	switch t {
+	case DefaultCollectionTypeList:
+		e.List = &specschema.ListType{ElementType: elementType}
+	case DefaultCollectionTypeMap:
+		e.Map = &specschema.MapType{ElementType: elementType}
+	case DefaultCollectionTypeSet:
+		e.Set = &specschema.SetType{ElementType: elementType}
+	}
+
+	s, err := newStaticDefault(v, e)
+
+	if err != nil {
+		return d, err
+	}
+
+	d.collectionType = t
+	d.static = s
+
+	return d, nil
+}
+
+func (d DefaultCollection) Equal(other DefaultCollection) bool {
+	if !d.collectionType.Equal(other.collectionType) {
+		return false
+	}
+
+	if !d.custom.Equal(other.custom) {
+		return false
+	}
+
+	return d.static.Equal(other.static)
+}
+
+func (d DefaultCollection) Imports() *generatorschema.Imports {
+	imports := NewDefaultCustom(d.custom).Imports()
+
+	if d.static != nil {
+		imports.Add(code.Import{
+			Path: d.collectionType.Import(),
+		})
+
+		imports.Append(d.static.Imports())
+	}
+
+	return imports
+}
+
+func (d DefaultCollection) Schema() []byte {
+	if d.static != nil {
+		return []byte(fmt.Sprintf("Default: %sdefault.StaticValue(%s),\n", strings.ToLower(string(d.collectionType)), d.static.expression))
+	}
+
+	return NewDefaultCustom(d.custom).Schema()
+}
+
+// StaticValue returns the static default as a Terraform configuration
+// expression, or an empty string if there is no static default.
+func (d DefaultCollection) StaticValue() string {
+	if d.static == nil {
+		return ""
+	}
+
+	return d.static.value
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package convert
+
+import (
+	"fmt"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+const defaultFloat32Import = "github.com/hashicorp/terraform-plugin-framework/resource/schema/float32default"
+
+// DefaultFloat32 is the default of a float32 attribute, which is defined
+// as a float64 attribute in the specification.
+type DefaultFloat32 struct {
+	float32Default *specschema.Float64Default
+}
+
+func NewDefaultFloat32(b *specschema.Float64Default) DefaultFloat32 {
+	return DefaultFloat32{
+		float32Default: b,
+	}
+}
+
+func (d DefaultFloat32) Equal(other DefaultFloat32) bool {
+	return d.float32Default.Equal(other.float32Default)
+}
+
+func (d DefaultFloat32) Imports() *generatorschema.Imports {
+	imports := generatorschema.NewImports()
+
+	if d.float32Default == nil {
+		return imports
+	}
+
+	if d.float32Default.Static != nil {
+		imports.Add(code.Import{
+			Path: defaultFloat32Import,
+		})
+	}
+
+	if d.float32Default.Custom != nil {
+		for _, i := range d.float32Default.Custom.Imports {
+			if len(i.Path) > 0 {
+				imports.Add(i)
+			}
+		}
+	}
+
+	return imports
+}
+
+func (d DefaultFloat32) Schema() []byte {
+	if d.float32Default == nil {
+		return nil
+	}
+
+	if d.float32Default.Static != nil {
+		return []byte(fmt.Sprintf("Default: float32default.StaticFloat32(%g),\n", *d.float32Default.Static))
+	}
+
+	if d.float32Default.Custom != nil && d.float32Default.Custom.SchemaDefinition != "" {
+		return []byte(fmt.Sprintf("Default: %s,\n", d.float32Default.Custom.SchemaDefinition))
+	}
+
+	return nil
+}
+
+// StaticValue returns the static default as a Terraform configuration
+// expression, or an empty string if there is no static default.
+func (d DefaultFloat32) StaticValue() string {
+	if d.float32Default == nil || d.float32Default.Static == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%g", *d.float32Default.Static)
+}
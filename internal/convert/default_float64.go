@@ -67,3 +67,13 @@ func (d DefaultFloat64) Schema() []byte {
 
 	return nil
 }
+
+// StaticValue returns the static default as a Terraform configuration
+// expression, or an empty string if there is no static default.
+func (d DefaultFloat64) StaticValue() string {
+	if d.float64Default == nil || d.float64Default.Static == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%g", *d.float64Default.Static)
+}
@@ -67,3 +67,13 @@ func (d DefaultInt32) Schema() []byte {
 
 	return nil
 }
+
+// StaticValue returns the static default as a Terraform configuration
+// expression, or an empty string if there is no static default.
+func (d DefaultInt32) StaticValue() string {
+	if d.int32Default == nil || d.int32Default.Static == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%d", *d.int32Default.Static)
+}
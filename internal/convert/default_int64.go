@@ -67,3 +67,13 @@ func (d DefaultInt64) Schema() []byte {
 
 	return nil
 }
+
+// StaticValue returns the static default as a Terraform configuration
+// expression, or an empty string if there is no static default.
+func (d DefaultInt64) StaticValue() string {
+	if d.int64Default == nil || d.int64Default.Static == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%d", *d.int64Default.Static)
+}
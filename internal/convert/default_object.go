@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package convert
+
+import (
+	"fmt"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+const defaultObjectImport = "github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+
+// DefaultObject is the default of an object attribute, which is either a
+// custom default, or a static default, which is an addition to the
+// specification.
+type DefaultObject struct {
+	custom *specschema.CustomDefault
+	static *staticDefault
+}
+
+func NewDefaultObject(c *specschema.CustomDefault) DefaultObject {
+	return DefaultObject{
+		custom: c,
+	}
+}
+
+// WithStatic returns the DefaultObject with the static default, which is
+// checked against the attribute types of the object. Attributes which are
+// not defined in the static default are null.
+func (d DefaultObject) WithStatic(v any, attributeTypes specschema.ObjectAttributeTypes) (DefaultObject, error) {
+	if v == nil {
+		return d, nil
+	}
+
+	s, err := newStaticDefault(v, specschema.ElementType{
+		Object: &specschema.ObjectType{
+			AttributeTypes: attributeTypes,
+		},
+	})
+
+	if err != nil {
+		return d, err
+	}
+
+	d.static = s
+
+	return d, nil
+}
+
+func (d DefaultObject) Equal(other DefaultObject) bool {
+	if !d.custom.Equal(other.custom) {
+		return false
+	}
+
+	return d.static.Equal(other.static)
+}
+
+func (d DefaultObject) Imports() *generatorschema.Imports {
+	imports := NewDefaultCustom(d.custom).Imports()
+
+	if d.static != nil {
+		imports.Add(code.Import{
+			Path: defaultObjectImport,
+		})
+
+		imports.Append(d.static.Imports())
+	}
+
+	return imports
+}
+
+func (d DefaultObject) Schema() []byte {
+	if d.static != nil {
+		return []byte(fmt.Sprintf("Default: objectdefault.StaticValue(%s),\n", d.static.expression))
+	}
+
+	return NewDefaultCustom(d.custom).Schema()
+}
+
+// StaticValue returns the static default as a Terraform configuration
+// expression, or an empty string if there is no static default.
+func (d DefaultObject) StaticValue() string {
+	if d.static == nil {
+		return ""
+	}
+
+	return d.static.value
+}
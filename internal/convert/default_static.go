@@ -0,0 +1,458 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package convert
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// staticDefault holds the framework value expression and the Terraform
+// configuration expression of the static default of a list, map, object or
+// set attribute.
+type staticDefault struct {
+	expression string
+	value      string
+	// bigFloat is set if the expression contains number values, which are
+	// constructed with math/big.
+	bigFloat bool
+}
+
+// newStaticDefault builds a staticDefault from the supplied value, which is
+// decoded from JSON with numbers as json.Number, checking the value against
+// the type of the attribute.
+func newStaticDefault(v any, e specschema.ElementType) (*staticDefault, error) {
+	s := &staticDefault{}
+
+	expression, value, err := s.build(v, e, staticPath{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.expression = expression
+	s.value = value
+
+	return s, nil
+}
+
+func (s *staticDefault) Equal(other *staticDefault) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return s.expression == other.expression
+}
+
+// Imports returns the imports of the framework value expression.
+func (s *staticDefault) Imports() *generatorschema.Imports {
+	imports := generatorschema.NewImports()
+
+	imports.Add([]code.Import{
+		{
+			Path: generatorschema.AttrImport,
+		},
+		{
+			Path: generatorschema.TypesImport,
+		},
+	}...)
+
+	if s.bigFloat {
+		imports.Add(code.Import{
+			Path: generatorschema.MathBigImport,
+		})
+	}
+
+	return imports
+}
+
+// staticPath identifies a value within the static default, both in the
+// message of errors, such as value[0].name, and as the reference tokens of
+// its JSON pointer.
+type staticPath struct {
+	text   string
+	tokens []any
+}
+
+func (p staticPath) index(i int) staticPath {
+	return staticPath{
+		text:   fmt.Sprintf("%s[%d]", p.text, i),
+		tokens: append(slices.Clone(p.tokens), i),
+	}
+}
+
+func (p staticPath) key(k string) staticPath {
+	return staticPath{
+		text:   fmt.Sprintf("%s[%q]", p.text, k),
+		tokens: append(slices.Clone(p.tokens), k),
+	}
+}
+
+func (p staticPath) attribute(name string) staticPath {
+	return staticPath{
+		text:   fmt.Sprintf("%s.%s", p.text, name),
+		tokens: append(slices.Clone(p.tokens), name),
+	}
+}
+
+// errorf returns an error for the value, with the JSON pointer of the value
+// relative to the static default.
+func (p staticPath) errorf(format string, args ...any) error {
+	return diagnostic.NewError(fmt.Errorf("value%s "+format, append([]any{p.text}, args...)...), p.tokens...)
+}
+
+// build returns the framework value expression and the Terraform
+// configuration expression for the value. The path identifies the value
+// within the static default in errors.
+func (s *staticDefault) build(v any, e specschema.ElementType, path staticPath) (string, string, error) {
+	if elementTypeCustomType(e) != nil {
+		return "", "", path.errorf("has a custom type, which is not supported")
+	}
+
+	if v == nil {
+		return s.null(e)
+	}
+
+	switch {
+	case e.Bool != nil:
+		b, ok := v.(bool)
+
+		if !ok {
+			return "", "", path.errorf("must be a bool")
+		}
+
+		return fmt.Sprintf("types.BoolValue(%t)", b), strconv.FormatBool(b), nil
+	case e.Float64 != nil && extension.IsFloat32(e.Float64):
+		n, err := staticNumber(v, path, func(n string) error {
+			_, err := strconv.ParseFloat(n, 32)
+			return err
+		})
+
+		return fmt.Sprintf("types.Float32Value(%s)", n), n, err
+	case e.Float64 != nil:
+		n, err := staticNumber(v, path, func(n string) error {
+			_, err := strconv.ParseFloat(n, 64)
+			return err
+		})
+
+		return fmt.Sprintf("types.Float64Value(%s)", n), n, err
+	case e.Int32 != nil:
+		n, err := staticNumber(v, path, func(n string) error {
+			_, err := strconv.ParseInt(n, 10, 32)
+			return err
+		})
+
+		return fmt.Sprintf("types.Int32Value(%s)", n), n, err
+	case e.Int64 != nil:
+		n, err := staticNumber(v, path, func(n string) error {
+			_, err := strconv.ParseInt(n, 10, 64)
+			return err
+		})
+
+		return fmt.Sprintf("types.Int64Value(%s)", n), n, err
+	case e.List != nil:
+		return s.buildList("types.ListValueMust", v, e.List.ElementType, path)
+	case e.Map != nil:
+		return s.buildMap(v, e.Map.ElementType, path)
+	case e.Number != nil:
+		n, err := staticNumber(v, path, func(n string) error {
+			_, err := strconv.ParseFloat(n, 64)
+			return err
+		})
+
+		s.bigFloat = true
+
+		return fmt.Sprintf("types.NumberValue(big.NewFloat(%s))", n), n, err
+	case e.Object != nil:
+		return s.buildObject(v, e.Object.AttributeTypes, path)
+	case e.Set != nil:
+		return s.buildList("types.SetValueMust", v, e.Set.ElementType, path)
+	case e.String != nil:
+		str, ok := v.(string)
+
+		if !ok {
+			return "", "", path.errorf("must be a string")
+		}
+
+		// template sequences are escaped, as they would otherwise be interpreted
+		value := strings.NewReplacer("${", "$${", "%{", "%%{").Replace(fmt.Sprintf("%q", str))
+
+		return fmt.Sprintf("types.StringValue(%q)", str), value, nil
+	}
+
+	return "", "", path.errorf("has no matching type")
+}
+
+// buildList builds list and set values, which are both defined as JSON
+// arrays.
+func (s *staticDefault) buildList(valueMust string, v any, e specschema.ElementType, path staticPath) (string, string, error) {
+	elems, ok := v.([]any)
+
+	if !ok {
+		return "", "", path.errorf("must be an array")
+	}
+
+	elemType, err := generatorschema.ElementTypeString(e)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	var expressions, values []string
+
+	for i, elem := range elems {
+		expression, value, err := s.build(elem, e, path.index(i))
+
+		if err != nil {
+			return "", "", err
+		}
+
+		expressions = append(expressions, expression+",\n")
+		values = append(values, value)
+	}
+
+	return fmt.Sprintf("%s(%s, []attr.Value{\n%s})", valueMust, elemType, strings.Join(expressions, "")), "[" + strings.Join(values, ", ") + "]", nil
+}
+
+func (s *staticDefault) buildMap(v any, e specschema.ElementType, path staticPath) (string, string, error) {
+	elems, ok := v.(map[string]any)
+
+	if !ok {
+		return "", "", path.errorf("must be an object")
+	}
+
+	elemType, err := generatorschema.ElementTypeString(e)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	keys := make([]string, 0, len(elems))
+
+	for k := range elems {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	var expressions, values []string
+
+	for _, k := range keys {
+		expression, value, err := s.build(elems[k], e, path.key(k))
+
+		if err != nil {
+			return "", "", err
+		}
+
+		expressions = append(expressions, fmt.Sprintf("%q: %s,\n", k, expression))
+		values = append(values, fmt.Sprintf("%q = %s", k, value))
+	}
+
+	return fmt.Sprintf("types.MapValueMust(%s, map[string]attr.Value{\n%s})", elemType, strings.Join(expressions, "")), staticObjectValue(values), nil
+}
+
+// buildObject builds object values, in which attributes which are not
+// defined in the value are null.
+func (s *staticDefault) buildObject(v any, attributeTypes specschema.ObjectAttributeTypes, path staticPath) (string, string, error) {
+	attributes, ok := v.(map[string]any)
+
+	if !ok {
+		return "", "", path.errorf("must be an object")
+	}
+
+	for k := range attributes {
+		if !objectAttributeTypesContain(attributeTypes, k) {
+			return "", "", diagnostic.NewError(fmt.Errorf("value%s has no attribute type %q", path.text, k), path.attribute(k).tokens...)
+		}
+	}
+
+	attrTypes, err := generatorschema.AttrTypesString(attributeTypes)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	var expressions, values []string
+
+	for _, a := range attributeTypes {
+		attribute, ok := attributes[a.Name]
+
+		if a.Dynamic != nil {
+			if ok && attribute != nil {
+				return "", "", path.attribute(a.Name).errorf("is dynamic, which is not supported")
+			}
+
+			expressions = append(expressions, fmt.Sprintf("%q: types.DynamicNull(),\n", a.Name))
+
+			continue
+		}
+
+		expression, value, err := s.build(attribute, objectAttributeTypeElementType(a), path.attribute(a.Name))
+
+		if err != nil {
+			return "", "", err
+		}
+
+		expressions = append(expressions, fmt.Sprintf("%q: %s,\n", a.Name, expression))
+
+		if ok {
+			values = append(values, fmt.Sprintf("%s = %s", a.Name, value))
+		}
+	}
+
+	return fmt.Sprintf("types.ObjectValueMust(map[string]attr.Type{\n%s,\n}, map[string]attr.Value{\n%s})", attrTypes, strings.Join(expressions, "")), staticObjectValue(values), nil
+}
+
+// null returns the null value of the type.
+func (s *staticDefault) null(e specschema.ElementType) (string, string, error) {
+	switch {
+	case e.Bool != nil:
+		return "types.BoolNull()", "null", nil
+	case e.Float64 != nil && extension.IsFloat32(e.Float64):
+		return "types.Float32Null()", "null", nil
+	case e.Float64 != nil:
+		return "types.Float64Null()", "null", nil
+	case e.Int32 != nil:
+		return "types.Int32Null()", "null", nil
+	case e.Int64 != nil:
+		return "types.Int64Null()", "null", nil
+	case e.Number != nil:
+		return "types.NumberNull()", "null", nil
+	case e.String != nil:
+		return "types.StringNull()", "null", nil
+	}
+
+	var nullFunc string
+
+	switch {
+	case e.List != nil:
+		nullFunc = "types.ListNull"
+	case e.Map != nil:
+		nullFunc = "types.MapNull"
+	case e.Object != nil:
+		attrTypes, err := generatorschema.AttrTypesString(e.Object.AttributeTypes)
+
+		if err != nil {
+			return "", "", err
+		}
+
+		return fmt.Sprintf("types.ObjectNull(map[string]attr.Type{\n%s,\n})", attrTypes), "null", nil
+	case e.Set != nil:
+		nullFunc = "types.SetNull"
+	default:
+		return "", "", errors.New("no matching type found")
+	}
+
+	elemType, err := generatorschema.ElementTypeString(collectionElementType(e))
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return fmt.Sprintf("%s(%s)", nullFunc, elemType), "null", nil
+}
+
+// staticNumber returns the JSON number as a string, once it has been checked
+// by the supplied parse function.
+func staticNumber(v any, path staticPath, parse func(string) error) (string, error) {
+	n, ok := v.(json.Number)
+
+	if !ok {
+		return "", path.errorf("must be a number")
+	}
+
+	if err := parse(n.String()); err != nil {
+		return "", path.errorf("is not a valid number: %w", err)
+	}
+
+	return n.String(), nil
+}
+
+// staticObjectValue returns a Terraform object expression for map and object
+// values.
+func staticObjectValue(values []string) string {
+	if len(values) == 0 {
+		return "{}"
+	}
+
+	return "{ " + strings.Join(values, ", ") + " }"
+}
+
+func collectionElementType(e specschema.ElementType) specschema.ElementType {
+	switch {
+	case e.List != nil:
+		return e.List.ElementType
+	case e.Map != nil:
+		return e.Map.ElementType
+	case e.Set != nil:
+		return e.Set.ElementType
+	}
+
+	return specschema.ElementType{}
+}
+
+func objectAttributeTypesContain(attributeTypes specschema.ObjectAttributeTypes, name string) bool {
+	for _, a := range attributeTypes {
+		if a.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func objectAttributeTypeElementType(a specschema.ObjectAttributeType) specschema.ElementType {
+	return specschema.ElementType{
+		Bool:    a.Bool,
+		Float64: a.Float64,
+		Int32:   a.Int32,
+		Int64:   a.Int64,
+		List:    a.List,
+		Map:     a.Map,
+		Number:  a.Number,
+		Object:  a.Object,
+		Set:     a.Set,
+		String:  a.String,
+	}
+}
+
+// elementTypeCustomType returns the custom type of whichever type is set.
+func elementTypeCustomType(e specschema.ElementType) *specschema.CustomType {
+	switch {
+	case e.Bool != nil:
+		return e.Bool.CustomType
+	case e.Float64 != nil:
+		return e.Float64.CustomType
+	case e.Int32 != nil:
+		return e.Int32.CustomType
+	case e.Int64 != nil:
+		return e.Int64.CustomType
+	case e.List != nil:
+		return e.List.CustomType
+	case e.Map != nil:
+		return e.Map.CustomType
+	case e.Number != nil:
+		return e.Number.CustomType
+	case e.Object != nil:
+		return e.Object.CustomType
+	case e.Set != nil:
+		return e.Set.CustomType
+	case e.String != nil:
+		return e.String.CustomType
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package convert
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/greatman/terraform-plugin-codegen-spec/code"
 	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
@@ -67,3 +68,14 @@ func (d DefaultString) Schema() []byte {
 
 	return nil
 }
+
+// StaticValue returns the static default as a Terraform configuration
+// expression, or an empty string if there is no static default.
+func (d DefaultString) StaticValue() string {
+	if d.stringDefault == nil || d.stringDefault.Static == nil {
+		return ""
+	}
+
+	// template sequences are escaped, as they would otherwise be interpreted
+	return strings.NewReplacer("${", "$${", "%{", "%%{").Replace(fmt.Sprintf("%q", *d.stringDefault.Static))
+}
@@ -51,3 +51,15 @@ func (d Description) Schema() []byte {
 
 	return b.Bytes()
 }
+
+// SchemaDescription writes only the Description field, for schemas such as
+// resource identity schemas which do not define MarkdownDescription.
+func (d Description) SchemaDescription() []byte {
+	var b bytes.Buffer
+
+	if d.description != nil {
+		b.WriteString(fmt.Sprintf("Description: %s,\n", strconv.Quote(*d.description)))
+	}
+
+	return b.Bytes()
+}
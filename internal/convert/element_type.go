@@ -10,6 +10,7 @@ import (
 	"github.com/greatman/terraform-plugin-codegen-spec/code"
 	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
 
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
 
@@ -33,6 +34,12 @@ func (e ElementType) ElementType() []byte {
 		} else {
 			b.WriteString("types.BoolType")
 		}
+	case e.elementType.Float64 != nil && extension.IsFloat32(e.elementType.Float64):
+		if e.elementType.Float64.CustomType != nil {
+			b.WriteString(e.elementType.Float64.CustomType.Type)
+		} else {
+			b.WriteString("types.Float32Type")
+		}
 	case e.elementType.Float64 != nil:
 		if e.elementType.Float64.CustomType != nil {
 			b.WriteString(e.elementType.Float64.CustomType.Type)
@@ -127,6 +134,15 @@ func (e ElementType) Imports() *schema.Imports {
 			Path: schema.TypesImport,
 		})
 		return imports
+	case e.elementType.Int32 != nil:
+		if e.elementType.Int32.CustomType != nil && e.elementType.Int32.CustomType.HasImport() {
+			imports.Add(*e.elementType.Int32.CustomType.Import)
+			return imports
+		}
+		imports.Add(code.Import{
+			Path: schema.TypesImport,
+		})
+		return imports
 	case e.elementType.List != nil:
 		imports.Add(NewElementType(e.elementType.List.ElementType).Imports().All()...)
 		return imports
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package convert
+
+type ImportRequirement struct {
+	optionalForImport bool
+	requiredForImport bool
+}
+
+// NewImportRequirement constructs an ImportRequirement which is used to determine
+// whether an identity attribute is optional or required for import.
+func NewImportRequirement(optionalForImport, requiredForImport bool) ImportRequirement {
+	return ImportRequirement{
+		optionalForImport: optionalForImport,
+		requiredForImport: requiredForImport,
+	}
+}
+
+func (i ImportRequirement) Equal(other ImportRequirement) bool {
+	return i.optionalForImport == other.optionalForImport && i.requiredForImport == other.requiredForImport
+}
+
+func (i ImportRequirement) Schema() []byte {
+	switch {
+	case i.requiredForImport:
+		return []byte("RequiredForImport: true,\n")
+	case i.optionalForImport:
+		return []byte("OptionalForImport: true,\n")
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package convert
+
+// Items holds the minimum and maximum number of elements of a list or set
+// nested attribute or block, which are documented alongside the validators
+// that enforce them.
+type Items struct {
+	minItems *int64
+	maxItems *int64
+}
+
+func NewItems(minItems, maxItems *int64) Items {
+	return Items{
+		minItems: minItems,
+		maxItems: maxItems,
+	}
+}
+
+func (i Items) Equal(other Items) bool {
+	return int64PointerEqual(i.minItems, other.minItems) && int64PointerEqual(i.maxItems, other.maxItems)
+}
+
+func (i Items) MinItems() *int64 {
+	return i.minItems
+}
+
+func (i Items) MaxItems() *int64 {
+	return i.maxItems
+}
+
+func int64PointerEqual(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/greatman/terraform-plugin-codegen-spec/code"
 	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
 
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
 
@@ -42,12 +43,30 @@ func (o ObjectAttributeTypes) AttributeTypes() []byte {
 			} else {
 				b.WriteString(fmt.Sprintf("%q: types.BoolType,", v.Name))
 			}
+		case v.Dynamic != nil:
+			if v.Dynamic.CustomType != nil {
+				b.WriteString(fmt.Sprintf("%q: %s,", v.Name, v.Dynamic.CustomType.Type))
+			} else {
+				b.WriteString(fmt.Sprintf("%q: types.DynamicType,", v.Name))
+			}
+		case v.Float64 != nil && extension.IsFloat32(v.Float64):
+			if v.Float64.CustomType != nil {
+				b.WriteString(fmt.Sprintf("%q: %s,", v.Name, v.Float64.CustomType.Type))
+			} else {
+				b.WriteString(fmt.Sprintf("%q: types.Float32Type,", v.Name))
+			}
 		case v.Float64 != nil:
 			if v.Float64.CustomType != nil {
 				b.WriteString(fmt.Sprintf("%q: %s,", v.Name, v.Float64.CustomType.Type))
 			} else {
 				b.WriteString(fmt.Sprintf("%q: types.Float64Type,", v.Name))
 			}
+		case v.Int32 != nil:
+			if v.Int32.CustomType != nil {
+				b.WriteString(fmt.Sprintf("%q: %s,", v.Name, v.Int32.CustomType.Type))
+			} else {
+				b.WriteString(fmt.Sprintf("%q: types.Int32Type,", v.Name))
+			}
 		case v.Int64 != nil:
 			if v.Int64.CustomType != nil {
 				b.WriteString(fmt.Sprintf("%q: %s,", v.Name, v.Int64.CustomType.Type))
@@ -111,12 +130,24 @@ func (o ObjectAttributeTypes) Imports() *schema.Imports {
 				continue
 			}
 			imports.Add(code.Import{Path: schema.AttrImport}, code.Import{Path: schema.TypesImport})
+		case v.Dynamic != nil:
+			if v.Dynamic.CustomType != nil && v.Dynamic.CustomType.HasImport() {
+				imports.Add(*v.Dynamic.CustomType.Import)
+				continue
+			}
+			imports.Add(code.Import{Path: schema.AttrImport}, code.Import{Path: schema.TypesImport})
 		case v.Float64 != nil:
 			if v.Float64.CustomType != nil && v.Float64.CustomType.HasImport() {
 				imports.Add(*v.Float64.CustomType.Import)
 				continue
 			}
 			imports.Add(code.Import{Path: schema.AttrImport}, code.Import{Path: schema.TypesImport})
+		case v.Int32 != nil:
+			if v.Int32.CustomType != nil && v.Int32.CustomType.HasImport() {
+				imports.Add(*v.Int32.CustomType.Import)
+				continue
+			}
+			imports.Add(code.Import{Path: schema.AttrImport}, code.Import{Path: schema.TypesImport})
 		case v.Int64 != nil:
 			if v.Int64.CustomType != nil && v.Int64.CustomType.HasImport() {
 				imports.Add(*v.Int64.CustomType.Import)
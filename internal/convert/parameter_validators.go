@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package convert
+
+import (
+	"bytes"
+	"fmt"
+
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// ParameterValidators renders custom validators for function parameters, which
+// use the function.<Type>ParameterValidator interfaces rather than the
+// validator.<Type> interfaces used by schema attributes.
+type ParameterValidators struct {
+	validatorType ValidatorType
+	custom        specschema.CustomValidators
+}
+
+func NewParameterValidators(t ValidatorType, c specschema.CustomValidators) ParameterValidators {
+	return ParameterValidators{
+		validatorType: t,
+		custom:        c,
+	}
+}
+
+func (v ParameterValidators) Equal(other ParameterValidators) bool {
+	return NewValidators(v.validatorType, v.custom).Equal(NewValidators(other.validatorType, other.custom))
+}
+
+func (v ParameterValidators) Imports() *schema.Imports {
+	imports := schema.NewImports()
+
+	for _, c := range v.custom {
+		for _, i := range c.Imports {
+			if len(i.Path) > 0 {
+				imports.Add(i)
+			}
+		}
+	}
+
+	return imports
+}
+
+func (v ParameterValidators) Schema() []byte {
+	var b, cb bytes.Buffer
+
+	for _, c := range v.custom {
+		if c == nil {
+			continue
+		}
+
+		if c.SchemaDefinition == "" {
+			continue
+		}
+
+		cb.WriteString(fmt.Sprintf("%s,\n", c.SchemaDefinition))
+	}
+
+	if cb.Len() > 0 {
+		b.WriteString(fmt.Sprintf("Validators: []function.%sParameterValidator{\n", v.validatorType))
+		b.Write(cb.Bytes())
+		b.WriteString("},\n")
+	}
+
+	return b.Bytes()
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package convert
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// WithShorthands returns the PlanModifiers with the framework plan modifiers
+// which implement the plan modifier shorthands, such as
+// stringplanmodifier.RequiresReplace().
+func (v PlanModifiers) WithShorthands(p extension.PlanModifierShorthands) PlanModifiers {
+	pkg := strings.ToLower(string(v.planModifierType)) + "planmodifier"
+
+	var definitions []string
+
+	if p.UseStateForUnknown {
+		definitions = append(definitions, fmt.Sprintf("%s.UseStateForUnknown()", pkg))
+	}
+
+	if p.RequiresReplace {
+		definitions = append(definitions, fmt.Sprintf("%s.RequiresReplace()", pkg))
+	}
+
+	if p.RequiresReplaceIfConfigured {
+		definitions = append(definitions, fmt.Sprintf("%s.RequiresReplaceIfConfigured()", pkg))
+	}
+
+	if len(definitions) == 0 {
+		return v
+	}
+
+	v.shorthands = definitions
+	v.shorthandImports = []code.Import{
+		{
+			Path: schema.PlanModifierImport,
+		},
+		{
+			Path: fmt.Sprintf("github.com/hashicorp/terraform-plugin-framework/resource/schema/%s", pkg),
+		},
+	}
+
+	return v
+}
@@ -6,6 +6,7 @@ package convert
 import (
 	"bytes"
 	"fmt"
+	"slices"
 
 	"github.com/greatman/terraform-plugin-codegen-spec/code"
 	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
@@ -15,6 +16,8 @@ import (
 
 const (
 	PlanModifierTypeBool    PlanModifierType = "Bool"
+	PlanModifierTypeDynamic PlanModifierType = "Dynamic"
+	PlanModifierTypeFloat32 PlanModifierType = "Float32"
 	PlanModifierTypeFloat64 PlanModifierType = "Float64"
 	PlanModifierTypeInt32   PlanModifierType = "Int32"
 	PlanModifierTypeInt64   PlanModifierType = "Int64"
@@ -31,6 +34,11 @@ type PlanModifierType string
 type PlanModifiers struct {
 	planModifierType PlanModifierType
 	custom           specschema.CustomPlanModifiers
+	// shorthands are the plan modifiers which implement the plan modifier
+	// shorthands defined in the additions to the specification, which are
+	// rendered ahead of the custom plan modifiers.
+	shorthands       []string
+	shorthandImports []code.Import
 }
 
 func NewPlanModifiers(t PlanModifierType, c specschema.CustomPlanModifiers) PlanModifiers {
@@ -45,6 +53,10 @@ func (v PlanModifiers) Equal(other PlanModifiers) bool {
 		return false
 	}
 
+	if !slices.Equal(v.shorthands, other.shorthands) {
+		return false
+	}
+
 	if len(v.custom) == 0 && len(other.custom) == 0 {
 		return true
 	}
@@ -69,6 +81,8 @@ func (v PlanModifiers) Equal(other PlanModifiers) bool {
 func (v PlanModifiers) Imports() *schema.Imports {
 	imports := schema.NewImports()
 
+	imports.Add(v.shorthandImports...)
+
 	if v.custom == nil {
 		return imports
 	}
@@ -91,6 +105,10 @@ func (v PlanModifiers) Imports() *schema.Imports {
 func (v PlanModifiers) Schema() []byte {
 	var b, cb bytes.Buffer
 
+	for _, s := range v.shorthands {
+		cb.WriteString(fmt.Sprintf("%s,\n", s))
+	}
+
 	for _, c := range v.custom {
 		if c == nil {
 			continue
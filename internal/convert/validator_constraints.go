@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package convert
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// WithConstraints returns the Validators with the terraform-plugin-framework-validators
+// validators which implement the constraints. The attributes referenced by
+// conflicts_with and also_requires must be siblings of the attribute, for
+// which the supplied function returns true. Errors are prefixed with the
+// JSON pointer of the constraint.
+func (v Validators) WithConstraints(c *extension.Constraints, sibling func(name string) bool) (Validators, error) {
+	if c == nil {
+		return v, nil
+	}
+
+	pkg := v.constraintsPackage()
+
+	var definitions []string
+
+	imports := []code.Import{
+		{
+			Path: schema.ValidatorImport,
+		},
+		{
+			Path: fmt.Sprintf("%s/%s", schema.FrameworkValidatorsImport, pkg),
+		},
+	}
+
+	if d := constraintRange(pkg, "Length", c.MinLength, c.MaxLength); d != "" {
+		definitions = append(definitions, d)
+	}
+
+	if c.Regex != nil {
+		definitions = append(definitions, fmt.Sprintf("%s.RegexMatches(regexp.MustCompile(%q), %q)", pkg, c.Regex.Pattern, c.Regex.Message))
+
+		imports = append(imports, code.Import{
+			Path: schema.RegexpImport,
+		})
+	}
+
+	if len(c.OneOf) > 0 {
+		values := make([]string, 0, len(c.OneOf))
+
+		for i, o := range c.OneOf {
+			value, err := extension.ConstraintValue(strings.ToLower(string(v.validatorType)), o)
+
+			if err != nil {
+				return v, diagnostic.NewError(fmt.Errorf("constraint one_of[%d] %w", i, err), "one_of", i)
+			}
+
+			values = append(values, value)
+		}
+
+		definitions = append(definitions, fmt.Sprintf("%s.OneOf(%s)", pkg, strings.Join(values, ", ")))
+
+		v.example = values[0]
+	}
+
+	switch {
+	case c.Min != nil && c.Max != nil:
+		definitions = append(definitions, fmt.Sprintf("%s.Between(%s, %s)", pkg, c.Min, c.Max))
+	case c.Min != nil:
+		definitions = append(definitions, fmt.Sprintf("%s.AtLeast(%s)", pkg, c.Min))
+	case c.Max != nil:
+		definitions = append(definitions, fmt.Sprintf("%s.AtMost(%s)", pkg, c.Max))
+	}
+
+	// the minimum, or otherwise the maximum, is within the range
+	if v.example == "" && c.Min != nil {
+		v.example = c.Min.String()
+	} else if v.example == "" && c.Max != nil {
+		v.example = c.Max.String()
+	}
+
+	if d := constraintRange(pkg, "Size", c.MinItems, c.MaxItems); d != "" {
+		definitions = append(definitions, d)
+	}
+
+	for _, p := range []struct {
+		name      string
+		validator string
+		names     []string
+	}{
+		{"conflicts_with", "ConflictsWith", c.ConflictsWith},
+		{"also_requires", "AlsoRequires", c.AlsoRequires},
+	} {
+		if len(p.names) == 0 {
+			continue
+		}
+
+		expressions := make([]string, 0, len(p.names))
+
+		for i, name := range p.names {
+			if !sibling(name) {
+				return v, diagnostic.NewError(fmt.Errorf("constraint %s references %q, which is not a sibling attribute or block", p.name, name), p.name, i)
+			}
+
+			expressions = append(expressions, fmt.Sprintf("path.MatchRelative().AtParent().AtName(%q)", name))
+		}
+
+		definitions = append(definitions, fmt.Sprintf("%s.%s(\n%s,\n)", pkg, p.validator, strings.Join(expressions, ",\n")))
+
+		imports = append(imports, code.Import{
+			Path: schema.PathImport,
+		})
+	}
+
+	v.conflictsWith = c.ConflictsWith
+
+	if len(definitions) == 0 {
+		return v, nil
+	}
+
+	v.constraints = definitions
+	v.constraintImports = imports
+
+	return v, nil
+}
+
+// constraintsPackage returns the name of the terraform-plugin-framework-validators
+// package for the type of validator, such as stringvalidator.
+func (v Validators) constraintsPackage() string {
+	return strings.ToLower(string(v.validatorType)) + "validator"
+}
+
+// constraintRange returns the validator for the minimum and maximum, if
+// either is set, such as LengthBetween or SizeAtLeast.
+func constraintRange(pkg, prefix string, minimum, maximum *int64) string {
+	switch {
+	case minimum != nil && maximum != nil:
+		return fmt.Sprintf("%s.%sBetween(%d, %d)", pkg, prefix, *minimum, *maximum)
+	case minimum != nil:
+		return fmt.Sprintf("%s.%sAtLeast(%d)", pkg, prefix, *minimum)
+	case maximum != nil:
+		return fmt.Sprintf("%s.%sAtMost(%d)", pkg, prefix, *maximum)
+	}
+
+	return ""
+}
@@ -6,6 +6,7 @@ package convert
 import (
 	"bytes"
 	"fmt"
+	"slices"
 
 	"github.com/greatman/terraform-plugin-codegen-spec/code"
 	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
@@ -15,6 +16,8 @@ import (
 
 const (
 	ValidatorTypeBool    ValidatorType = "Bool"
+	ValidatorTypeDynamic ValidatorType = "Dynamic"
+	ValidatorTypeFloat32 ValidatorType = "Float32"
 	ValidatorTypeFloat64 ValidatorType = "Float64"
 	ValidatorTypeInt32   ValidatorType = "Int32"
 	ValidatorTypeInt64   ValidatorType = "Int64"
@@ -31,6 +34,15 @@ type ValidatorType string
 type Validators struct {
 	validatorType ValidatorType
 	custom        specschema.CustomValidators
+	// constraints are the validators which implement the constraints
+	// defined in the additions to the specification, which are rendered
+	// ahead of the custom validators.
+	constraints       []string
+	constraintImports []code.Import
+	// example and conflictsWith are the example value and the names of the
+	// conflicting sibling attributes and blocks defined by the constraints.
+	example       string
+	conflictsWith []string
 }
 
 func NewValidators(t ValidatorType, c specschema.CustomValidators) Validators {
@@ -45,6 +57,10 @@ func (v Validators) Equal(other Validators) bool {
 		return false
 	}
 
+	if !slices.Equal(v.constraints, other.constraints) {
+		return false
+	}
+
 	if len(v.custom) == 0 && len(other.custom) == 0 {
 		return true
 	}
@@ -66,9 +82,25 @@ func (v Validators) Equal(other Validators) bool {
 	return true
 }
 
+// Example returns a value, as a Terraform configuration expression, which
+// satisfies the one_of, min or max constraint, or an empty string if there is
+// no such constraint.
+func (v Validators) Example() string {
+	return v.example
+}
+
+// ConflictsWith returns the names of the sibling attributes and blocks which
+// the conflicts_with constraint prevents from being configured alongside the
+// attribute.
+func (v Validators) ConflictsWith() []string {
+	return v.conflictsWith
+}
+
 func (v Validators) Imports() *schema.Imports {
 	imports := schema.NewImports()
 
+	imports.Add(v.constraintImports...)
+
 	if v.custom == nil {
 		return imports
 	}
@@ -91,6 +123,10 @@ func (v Validators) Imports() *schema.Imports {
 func (v Validators) Schema() []byte {
 	var b, cb bytes.Buffer
 
+	for _, c := range v.constraints {
+		cb.WriteString(fmt.Sprintf("%s,\n", c))
+	}
+
 	for _, c := range v.custom {
 		if c == nil {
 			continue
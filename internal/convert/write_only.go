@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package convert
+
+type WriteOnly struct {
+	writeOnly bool
+}
+
+func NewWriteOnly(w bool) WriteOnly {
+	return WriteOnly{
+		writeOnly: w,
+	}
+}
+
+func (w WriteOnly) Equal(other WriteOnly) bool {
+	return w.writeOnly == other.writeOnly
+}
+
+func (w WriteOnly) IsWriteOnly() bool {
+	return w.writeOnly
+}
+
+func (w WriteOnly) Schema() []byte {
+	if w.writeOnly {
+		return []byte("WriteOnly: true,\n")
+	}
+
+	return nil
+}
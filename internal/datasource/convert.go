@@ -4,26 +4,63 @@
 package datasource
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/greatman/terraform-plugin-codegen-spec/datasource"
 	"github.com/greatman/terraform-plugin-codegen-spec/spec"
 
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
 	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
 
-func NewSchemas(spec spec.Specification) (map[string]generatorschema.GeneratorSchema, error) {
+func NewSchemas(spec spec.Specification, ext extension.Specification) (map[string]generatorschema.GeneratorSchema, error) {
 	dataSourceSchemas := make(map[string]generatorschema.GeneratorSchema, len(spec.DataSources))
 
-	for _, v := range spec.DataSources {
+	var errs []error
+
+	for i, v := range spec.DataSources {
 		s, err := NewSchema(v)
 		if err != nil {
-			return nil, err
+			errs = append(errs, diagnostic.NewErrors(err, "datasources", i)...)
+
+			continue
+		}
+
+		d, _ := ext.DataSources.Get(v.Name)
+
+		// the errors of the additions and config validators are both
+		// reported, rather than only those of the first to fail
+		var dataSourceErrs []error
+
+		if d.Schema != nil {
+			err = applySchemaExtension(fmt.Sprintf("data source %q", v.Name), s.Attributes, s.Blocks, *d.Schema)
+
+			if err != nil {
+				dataSourceErrs = append(dataSourceErrs, diagnostic.NewErrors(err, "datasources", i, "schema")...)
+			}
+		}
+
+		s.ConfigValidators, err = generatorschema.NewConfigValidators("datasource", s, d.ConfigValidators)
+
+		if err != nil {
+			dataSourceErrs = append(dataSourceErrs, diagnostic.NewErrors(diagnostic.Wrapf(err, "data source %q %w", v.Name), "datasources", i, "config_validators")...)
+		}
+
+		if len(dataSourceErrs) > 0 {
+			errs = append(errs, dataSourceErrs...)
+
+			continue
 		}
 
 		dataSourceSchemas[v.Name] = s
 	}
 
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
 	return dataSourceSchemas, nil
 }
 
@@ -33,11 +70,15 @@ func NewSchema(d datasource.DataSource) (generatorschema.GeneratorSchema, error)
 	attributes := make(generatorschema.GeneratorAttributes, len(d.Schema.Attributes))
 	blocks := make(generatorschema.GeneratorBlocks, len(d.Schema.Blocks))
 
-	for _, v := range d.Schema.Attributes {
+	var errs []error
+
+	for i, v := range d.Schema.Attributes {
 		a, err := NewAttribute(v)
 
 		if err != nil {
-			return s, err
+			errs = append(errs, diagnostic.NewErrors(err, "schema", "attributes", i)...)
+
+			continue
 		}
 
 		attributes[v.Name] = a
@@ -45,11 +86,13 @@ func NewSchema(d datasource.DataSource) (generatorschema.GeneratorSchema, error)
 
 	s.Attributes = attributes
 
-	for _, v := range d.Schema.Blocks {
+	for i, v := range d.Schema.Blocks {
 		b, err := NewBlock(v)
 
 		if err != nil {
-			return s, err
+			errs = append(errs, diagnostic.NewErrors(err, "schema", "blocks", i)...)
+
+			continue
 		}
 
 		blocks[v.Name] = b
@@ -57,6 +100,10 @@ func NewSchema(d datasource.DataSource) (generatorschema.GeneratorSchema, error)
 
 	s.Blocks = blocks
 
+	if len(errs) > 0 {
+		return s, errors.Join(errs...)
+	}
+
 	s.Description = d.Schema.Description
 
 	s.MarkdownDescription = d.Schema.MarkdownDescription
@@ -69,16 +116,24 @@ func NewSchema(d datasource.DataSource) (generatorschema.GeneratorSchema, error)
 func NewAttributes(a datasource.Attributes) (generatorschema.GeneratorAttributes, error) {
 	attributes := make(generatorschema.GeneratorAttributes, len(a))
 
-	for _, v := range a {
+	var errs []error
+
+	for i, v := range a {
 		attribute, err := NewAttribute(v)
 
 		if err != nil {
-			return generatorschema.GeneratorAttributes{}, err
+			errs = append(errs, diagnostic.NewErrors(err, "attributes", i)...)
+
+			continue
 		}
 
 		attributes[v.Name] = attribute
 	}
 
+	if len(errs) > 0 {
+		return generatorschema.GeneratorAttributes{}, errors.Join(errs...)
+	}
+
 	return attributes, nil
 }
 
@@ -86,6 +141,10 @@ func NewAttribute(a datasource.Attribute) (generatorschema.GeneratorAttribute, e
 	switch {
 	case a.Bool != nil:
 		return NewGeneratorBoolAttribute(a.Name, a.Bool)
+	case a.Dynamic != nil:
+		return NewGeneratorDynamicAttribute(a.Name, a.Dynamic)
+	case a.Float64 != nil && extension.IsFloat32(a.Float64):
+		return NewGeneratorFloat32Attribute(a.Name, a.Float64)
 	case a.Float64 != nil:
 		return NewGeneratorFloat64Attribute(a.Name, a.Float64)
 	case a.Int32 != nil:
@@ -95,11 +154,15 @@ func NewAttribute(a datasource.Attribute) (generatorschema.GeneratorAttribute, e
 	case a.List != nil:
 		return NewGeneratorListAttribute(a.Name, a.List)
 	case a.ListNested != nil:
-		return NewGeneratorListNestedAttribute(a.Name, a.ListNested)
+		attribute, err := NewGeneratorListNestedAttribute(a.Name, a.ListNested)
+
+		return attribute, diagnostic.WithPointer(err, "list_nested", "nested_object")
 	case a.Map != nil:
 		return NewGeneratorMapAttribute(a.Name, a.Map)
 	case a.MapNested != nil:
-		return NewGeneratorMapNestedAttribute(a.Name, a.MapNested)
+		attribute, err := NewGeneratorMapNestedAttribute(a.Name, a.MapNested)
+
+		return attribute, diagnostic.WithPointer(err, "map_nested", "nested_object")
 	case a.Number != nil:
 		return NewGeneratorNumberAttribute(a.Name, a.Number)
 	case a.Object != nil:
@@ -107,41 +170,59 @@ func NewAttribute(a datasource.Attribute) (generatorschema.GeneratorAttribute, e
 	case a.Set != nil:
 		return NewGeneratorSetAttribute(a.Name, a.Set)
 	case a.SetNested != nil:
-		return NewGeneratorSetNestedAttribute(a.Name, a.SetNested)
+		attribute, err := NewGeneratorSetNestedAttribute(a.Name, a.SetNested)
+
+		return attribute, diagnostic.WithPointer(err, "set_nested", "nested_object")
 	case a.SingleNested != nil:
-		return NewGeneratorSingleNestedAttribute(a.Name, a.SingleNested)
+		attribute, err := NewGeneratorSingleNestedAttribute(a.Name, a.SingleNested)
+
+		return attribute, diagnostic.WithPointer(err, "single_nested")
 	case a.String != nil:
 		return NewGeneratorStringAttribute(a.Name, a.String)
 	}
 
-	return nil, fmt.Errorf("attribute type not defined: %+v", a)
+	return nil, fmt.Errorf("attribute %q type not defined", a.Name)
 }
 
 func NewBlocks(b datasource.Blocks) (generatorschema.GeneratorBlocks, error) {
 	blocks := make(generatorschema.GeneratorBlocks, len(b))
 
-	for _, v := range b {
+	var errs []error
+
+	for i, v := range b {
 		block, err := NewBlock(v)
 
 		if err != nil {
-			return generatorschema.GeneratorBlocks{}, err
+			errs = append(errs, diagnostic.NewErrors(err, "blocks", i)...)
+
+			continue
 		}
 
 		blocks[v.Name] = block
 	}
 
+	if len(errs) > 0 {
+		return generatorschema.GeneratorBlocks{}, errors.Join(errs...)
+	}
+
 	return blocks, nil
 }
 
 func NewBlock(b datasource.Block) (generatorschema.GeneratorBlock, error) {
 	switch {
 	case b.ListNested != nil:
-		return NewGeneratorListNestedBlock(b.Name, b.ListNested)
+		block, err := NewGeneratorListNestedBlock(b.Name, b.ListNested)
+
+		return block, diagnostic.WithPointer(err, "list_nested", "nested_object")
 	case b.SetNested != nil:
-		return NewGeneratorSetNestedBlock(b.Name, b.SetNested)
+		block, err := NewGeneratorSetNestedBlock(b.Name, b.SetNested)
+
+		return block, diagnostic.WithPointer(err, "set_nested", "nested_object")
 	case b.SingleNested != nil:
-		return NewGeneratorSingleNestedBlock(b.Name, b.SingleNested)
+		block, err := NewGeneratorSingleNestedBlock(b.Name, b.SingleNested)
+
+		return block, diagnostic.WithPointer(err, "single_nested")
 	}
 
-	return nil, fmt.Errorf("block type not defined: %+v", b)
+	return nil, fmt.Errorf("block %q type not defined", b.Name)
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/greatman/terraform-plugin-codegen-spec/spec"
 
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
 	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
 
@@ -432,7 +433,7 @@ func Test_NewSchemas(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewSchemas(testCase.spec)
+			got, err := NewSchemas(testCase.spec, extension.Specification{})
 
 			if err != nil {
 				t.Error(err)
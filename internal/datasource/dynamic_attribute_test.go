@@ -0,0 +1,676 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasource
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	"github.com/greatman/terraform-plugin-codegen-spec/datasource"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+func TestGeneratorDynamicAttribute_New(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         *datasource.DynamicAttribute
+		expected      GeneratorDynamicAttribute
+		expectedError error
+	}{
+		"nil": {
+			expectedError: fmt.Errorf("*datasource.DynamicAttribute is nil"),
+		},
+		"computed": {
+			input: &datasource.DynamicAttribute{
+				ComputedOptionalRequired: "computed",
+			},
+			expected: GeneratorDynamicAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				Validators:               convert.NewValidators(convert.ValidatorTypeDynamic, specschema.CustomValidators{}),
+			},
+		},
+		"computed_optional": {
+			input: &datasource.DynamicAttribute{
+				ComputedOptionalRequired: "computed_optional",
+			},
+			expected: GeneratorDynamicAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.ComputedOptional),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				Validators:               convert.NewValidators(convert.ValidatorTypeDynamic, specschema.CustomValidators{}),
+			},
+		},
+		"optional": {
+			input: &datasource.DynamicAttribute{
+				ComputedOptionalRequired: "optional",
+			},
+			expected: GeneratorDynamicAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				Validators:               convert.NewValidators(convert.ValidatorTypeDynamic, specschema.CustomValidators{}),
+			},
+		},
+		"required": {
+			input: &datasource.DynamicAttribute{
+				ComputedOptionalRequired: "required",
+			},
+			expected: GeneratorDynamicAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				Validators:               convert.NewValidators(convert.ValidatorTypeDynamic, specschema.CustomValidators{}),
+			},
+		},
+		"custom_type": {
+			input: &datasource.DynamicAttribute{
+				CustomType: &specschema.CustomType{
+					Import: &code.Import{
+						Path: "github.com/",
+					},
+					Type:      "my_type",
+					ValueType: "myvalue_type",
+				},
+			},
+			expected: GeneratorDynamicAttribute{
+				CustomType: convert.NewCustomTypePrimitive(&specschema.CustomType{
+					Import: &code.Import{
+						Path: "github.com/",
+					},
+					Type:      "my_type",
+					ValueType: "myvalue_type",
+				}, nil, "name"),
+				Validators: convert.NewValidators(convert.ValidatorTypeDynamic, nil),
+			},
+		},
+		"deprecation_message": {
+			input: &datasource.DynamicAttribute{
+				DeprecationMessage: pointer("deprecation message"),
+			},
+			expected: GeneratorDynamicAttribute{
+				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name"),
+				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
+				Validators:         convert.NewValidators(convert.ValidatorTypeDynamic, specschema.CustomValidators{}),
+			},
+		},
+		"description": {
+			input: &datasource.DynamicAttribute{
+				Description: pointer("description"),
+			},
+			expected: GeneratorDynamicAttribute{
+				CustomType:  convert.NewCustomTypePrimitive(nil, nil, "name"),
+				Description: convert.NewDescription(pointer("description")),
+				Validators:  convert.NewValidators(convert.ValidatorTypeDynamic, specschema.CustomValidators{}),
+			},
+		},
+		"sensitive": {
+			input: &datasource.DynamicAttribute{
+				Sensitive: pointer(true),
+			},
+			expected: GeneratorDynamicAttribute{
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				Sensitive:  convert.NewSensitive(pointer(true)),
+				Validators: convert.NewValidators(convert.ValidatorTypeDynamic, specschema.CustomValidators{}),
+			},
+		},
+		"validators": {
+			input: &datasource.DynamicAttribute{
+				Validators: specschema.DynamicValidators{
+					{
+						Custom: &specschema.CustomValidator{
+							Imports: []code.Import{
+								{
+									Path: "github.com/.../myvalidator",
+								},
+							},
+							SchemaDefinition: "myvalidator.Validate()",
+						},
+					},
+				},
+			},
+			expected: GeneratorDynamicAttribute{
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				Validators: convert.NewValidators(convert.ValidatorTypeDynamic, specschema.CustomValidators{
+					&specschema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/.../myvalidator",
+							},
+						},
+						SchemaDefinition: "myvalidator.Validate()",
+					},
+				}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewGeneratorDynamicAttribute("name", testCase.input)
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratorDynamicAttribute_Imports(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    GeneratorDynamicAttribute
+		expected []code.Import
+	}{
+		"default": {
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+			},
+		},
+		"custom-type-without-import": {
+			input: GeneratorDynamicAttribute{
+				CustomType: convert.NewCustomTypePrimitive(&specschema.CustomType{}, nil, ""),
+			},
+			expected: []code.Import{},
+		},
+		"custom-type-with-import-empty-string": {
+			input: GeneratorDynamicAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					&specschema.CustomType{
+						Import: &code.Import{
+							Path: "",
+						},
+					},
+					nil,
+					"",
+				),
+			},
+			expected: []code.Import{},
+		},
+		"custom-type-with-import": {
+			input: GeneratorDynamicAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					&specschema.CustomType{
+						Import: &code.Import{
+							Path: "github.com/my_account/my_project/attribute",
+						},
+					},
+					nil,
+					"",
+				),
+			},
+			expected: []code.Import{
+				{
+					Path: "github.com/my_account/my_project/attribute",
+				},
+			},
+		},
+		"validator-custom-nil": {
+			input: GeneratorDynamicAttribute{
+				Validators: convert.NewValidators(convert.ValidatorTypeDynamic, nil),
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+			},
+		},
+		"validator-custom-import-nil": {
+			input: GeneratorDynamicAttribute{
+				Validators: convert.NewValidators(convert.ValidatorTypeDynamic, specschema.CustomValidators{
+					&specschema.CustomValidator{},
+				}),
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+			},
+		},
+		"validator-custom-import-empty-string": {
+			input: GeneratorDynamicAttribute{
+				Validators: convert.NewValidators(convert.ValidatorTypeDynamic, specschema.CustomValidators{
+					&specschema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "",
+							},
+						},
+					},
+				})},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+			},
+		},
+		"validator-custom-import": {
+			input: GeneratorDynamicAttribute{
+				Validators: convert.NewValidators(convert.ValidatorTypeDynamic, specschema.CustomValidators{
+					&specschema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/myotherproject/myvalidators/validator",
+							},
+						},
+					},
+					&specschema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/myproject/myvalidators/validator",
+							},
+						},
+					},
+				})},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+				{
+					Path: generatorschema.ValidatorImport,
+				},
+				{
+					Path: "github.com/myotherproject/myvalidators/validator",
+				},
+				{
+					Path: "github.com/myproject/myvalidators/validator",
+				},
+			},
+		},
+		"associated-external-type": {
+			input: GeneratorDynamicAttribute{
+				AssociatedExternalType: &generatorschema.AssocExtType{
+					AssociatedExternalType: &specschema.AssociatedExternalType{
+						Type: "any",
+					},
+				},
+			},
+			expected: []code.Import{
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/types",
+				},
+				{
+					Path: "fmt",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/diag",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/attr",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-go/tftypes",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+				},
+				{
+					Path: "encoding/json",
+				},
+				{
+					Path: "math/big",
+				},
+			},
+		},
+		"associated-external-type-with-import": {
+			input: GeneratorDynamicAttribute{
+				AssociatedExternalType: &generatorschema.AssocExtType{
+					AssociatedExternalType: &specschema.AssociatedExternalType{
+						Import: &code.Import{
+							Path: "encoding/json",
+						},
+						Type: "json.RawMessage",
+					},
+				},
+			},
+			expected: []code.Import{
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/types",
+				},
+				{
+					Path: "fmt",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/diag",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/attr",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-go/tftypes",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+				},
+				{
+					Path: "encoding/json",
+				},
+				{
+					Path: "math/big",
+				},
+				{
+					Path: "bytes",
+				},
+			},
+		},
+		"associated-external-type-with-custom-type": {
+			input: GeneratorDynamicAttribute{
+				AssociatedExternalType: &generatorschema.AssocExtType{
+					AssociatedExternalType: &specschema.AssociatedExternalType{
+						Import: &code.Import{
+							Path: "encoding/json",
+						},
+						Type: "json.RawMessage",
+					},
+				},
+				CustomType: convert.NewCustomTypePrimitive(
+					&specschema.CustomType{
+						Import: &code.Import{
+							Path: "github.com/my_account/my_project/attribute",
+						},
+					},
+					nil,
+					"",
+				),
+			},
+			expected: []code.Import{
+				{
+					Path: "github.com/my_account/my_project/attribute",
+				},
+				{
+					Path: "fmt",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/diag",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/attr",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-go/tftypes",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+				},
+				{
+					Path: "encoding/json",
+				},
+				{
+					Path: "math/big",
+				},
+				{
+					Path: "bytes",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.Imports().All()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratorDynamicAttribute_Schema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         GeneratorDynamicAttribute
+		expected      string
+		expectedError error
+	}{
+		"custom-type": {
+			input: GeneratorDynamicAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					&specschema.CustomType{
+						Type: "my_custom_type",
+					},
+					nil,
+					"dynamic_attribute",
+				),
+			},
+			expected: `"dynamic_attribute": schema.DynamicAttribute{
+CustomType: my_custom_type,
+},`,
+		},
+
+		"associated-external-type": {
+			input: GeneratorDynamicAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					nil,
+					&specschema.AssociatedExternalType{
+						Type: "*api.ExtBool",
+					},
+					"dynamic_attribute",
+				),
+			},
+			expected: `"dynamic_attribute": schema.DynamicAttribute{
+CustomType: DynamicAttributeType{},
+},`,
+		},
+
+		"custom-type-overriding-associated-external-type": {
+			input: GeneratorDynamicAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					&specschema.CustomType{
+						Type: "my_custom_type",
+					},
+					&specschema.AssociatedExternalType{
+						Type: "*api.ExtBool",
+					},
+					"dynamic_attribute",
+				),
+			},
+			expected: `"dynamic_attribute": schema.DynamicAttribute{
+CustomType: my_custom_type,
+},`,
+		},
+
+		"required": {
+			input: GeneratorDynamicAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
+			},
+			expected: `"dynamic_attribute": schema.DynamicAttribute{
+Required: true,
+},`,
+		},
+
+		"optional": {
+			input: GeneratorDynamicAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+			},
+			expected: `"dynamic_attribute": schema.DynamicAttribute{
+Optional: true,
+},`,
+		},
+
+		"computed": {
+			input: GeneratorDynamicAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
+			},
+			expected: `"dynamic_attribute": schema.DynamicAttribute{
+Computed: true,
+},`,
+		},
+
+		"sensitive": {
+			input: GeneratorDynamicAttribute{
+				Sensitive: convert.NewSensitive(pointer(true)),
+			},
+			expected: `"dynamic_attribute": schema.DynamicAttribute{
+Sensitive: true,
+},`,
+		},
+
+		// TODO: Do we need separate description and markdown description?
+		"description": {
+			input: GeneratorDynamicAttribute{
+				Description: convert.NewDescription(pointer("description")),
+			},
+			expected: `"dynamic_attribute": schema.DynamicAttribute{
+Description: "description",
+MarkdownDescription: "description",
+},`,
+		},
+
+		"deprecation-message": {
+			input: GeneratorDynamicAttribute{
+				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecated")),
+			},
+			expected: `"dynamic_attribute": schema.DynamicAttribute{
+DeprecationMessage: "deprecated",
+},`,
+		},
+
+		"validators-empty": {
+			input: GeneratorDynamicAttribute{
+				Validators: convert.NewValidators(convert.ValidatorTypeDynamic, nil),
+			},
+			expected: `"dynamic_attribute": schema.DynamicAttribute{
+},`,
+		},
+		"validators": {
+			input: GeneratorDynamicAttribute{
+				Validators: convert.NewValidators(convert.ValidatorTypeDynamic, []*specschema.CustomValidator{
+					{
+						SchemaDefinition: "my_validator.Validate()",
+					},
+					{
+						SchemaDefinition: "my_other_validator.Validate()",
+					},
+				}),
+			},
+			expected: `"dynamic_attribute": schema.DynamicAttribute{
+Validators: []validator.Dynamic{
+my_validator.Validate(),
+my_other_validator.Validate(),
+},
+},`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.input.Schema("dynamic_attribute")
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratorDynamicAttribute_ModelField(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         GeneratorDynamicAttribute
+		expected      model.Field
+		expectedError error
+	}{
+		"default": {
+			expected: model.Field{
+				Name:      "DynamicAttribute",
+				ValueType: "types.Dynamic",
+				TfsdkName: "dynamic_attribute",
+			},
+		},
+		"custom-type": {
+			input: GeneratorDynamicAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					&specschema.CustomType{
+						ValueType: "my_custom_value_type",
+					},
+					nil,
+					"",
+				),
+			},
+			expected: model.Field{
+				Name:      "DynamicAttribute",
+				ValueType: "my_custom_value_type",
+				TfsdkName: "dynamic_attribute",
+			},
+		},
+		"associated-external-type": {
+			input: GeneratorDynamicAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					nil,
+					&specschema.AssociatedExternalType{
+						Type: "any",
+					},
+					"dynamic_attribute",
+				),
+			},
+			expected: model.Field{
+				Name:      "DynamicAttribute",
+				ValueType: "DynamicAttributeValue",
+				TfsdkName: "dynamic_attribute",
+			},
+		},
+		"custom-type-overriding-associated-external-type": {
+			input: GeneratorDynamicAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					&specschema.CustomType{
+						ValueType: "my_custom_value_type",
+					},
+					&specschema.AssociatedExternalType{
+						Type: "any",
+					},
+					"",
+				),
+			},
+			expected: model.Field{
+				Name:      "DynamicAttribute",
+				ValueType: "my_custom_value_type",
+				TfsdkName: "dynamic_attribute",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.input.ModelField("dynamic_attribute")
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
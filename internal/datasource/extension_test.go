@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasource
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+func TestApplySchemaExtension_Constraints(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ext            extension.Schema
+		expectedSchema string
+		expectedError  string
+	}{
+		"nested": {
+			ext: extension.Schema{
+				Attributes: extension.Attributes{
+					{
+						Name: "nested",
+						SingleNested: &extension.SingleNestedAttribute{
+							Attributes: extension.Attributes{
+								{
+									Name: "count",
+									Int64: &extension.AttributeProperties{
+										Constraints: &extension.Constraints{
+											Max:          pointer(json.Number("10")),
+											AlsoRequires: []string{"name"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedSchema: `Validators: []validator.Int64{
+int64validator.AtMost(10),
+int64validator.AlsoRequires(
+path.MatchRelative().AtParent().AtName("name"),
+),
+},
+`,
+		},
+		"not-sibling": {
+			ext: extension.Schema{
+				Attributes: extension.Attributes{
+					{
+						Name: "nested",
+						SingleNested: &extension.SingleNestedAttribute{
+							Attributes: extension.Attributes{
+								{
+									Name: "count",
+									Int64: &extension.AttributeProperties{
+										Constraints: &extension.Constraints{
+											ConflictsWith: []string{"nested"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: `/attributes/0/single_nested/attributes/0/int64/constraints/conflicts_with/0: data source "example" attribute "nested" attribute "count" constraint conflicts_with references "nested", which is not a sibling attribute or block`,
+		},
+		"not-defined": {
+			ext: extension.Schema{
+				Attributes: extension.Attributes{
+					{
+						Name: "missing",
+						String: &extension.AttributeProperties{
+							Constraints: &extension.Constraints{
+								MinLength: pointer(int64(1)),
+							},
+						},
+					},
+				},
+			},
+			expectedError: `/attributes/0: data source "example" attribute "missing" is not defined in the specification`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			attributes := generatorschema.GeneratorAttributes{
+				"nested": GeneratorSingleNestedAttribute{
+					Attributes: generatorschema.GeneratorAttributes{
+						"count": GeneratorInt64Attribute{
+							Validators: convert.NewValidators(convert.ValidatorTypeInt64, specschema.CustomValidators{}),
+						},
+						"name": GeneratorStringAttribute{},
+					},
+				},
+			}
+
+			err := applySchemaExtension(`data source "example"`, attributes, nil, testCase.ext)
+
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got %v", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			count := attributes["nested"].(GeneratorSingleNestedAttribute).Attributes["count"].(GeneratorInt64Attribute)
+
+			if diff := cmp.Diff(string(count.Validators.Schema()), testCase.expectedSchema); diff != "" {
+				t.Errorf("unexpected schema difference: %s", diff)
+			}
+		})
+	}
+}
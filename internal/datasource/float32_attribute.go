@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasource
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/datasource"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+type GeneratorFloat32Attribute struct {
+	AssociatedExternalType   *schema.AssocExtType
+	ComputedOptionalRequired convert.ComputedOptionalRequired
+	CustomType               convert.CustomTypePrimitive
+	DeprecationMessage       convert.DeprecationMessage
+	Description              convert.Description
+	Sensitive                convert.Sensitive
+	Validators               convert.Validators
+}
+
+func NewGeneratorFloat32Attribute(name string, a *datasource.Float64Attribute) (GeneratorFloat32Attribute, error) {
+	if a == nil {
+		return GeneratorFloat32Attribute{}, fmt.Errorf("*datasource.Float64Attribute is nil")
+	}
+
+	c := convert.NewComputedOptionalRequired(a.ComputedOptionalRequired)
+
+	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name)
+
+	d := convert.NewDescription(a.Description)
+
+	dm := convert.NewDeprecationMessage(a.DeprecationMessage)
+
+	s := convert.NewSensitive(a.Sensitive)
+
+	v := convert.NewValidators(convert.ValidatorTypeFloat32, a.Validators.CustomValidators())
+
+	return GeneratorFloat32Attribute{
+		AssociatedExternalType:   schema.NewAssocExtType(a.AssociatedExternalType),
+		ComputedOptionalRequired: c,
+		CustomType:               ctp,
+		DeprecationMessage:       dm,
+		Description:              d,
+		Sensitive:                s,
+		Validators:               v,
+	}, nil
+}
+
+func (g GeneratorFloat32Attribute) GeneratorSchemaType() schema.Type {
+	return schema.GeneratorFloat32Attribute
+}
+
+func (g GeneratorFloat32Attribute) Documentation() schema.Documentation {
+	return schema.Documentation{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		ConflictsWith:      g.Validators.ConflictsWith(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Example:            g.Validators.Example(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+	}
+}
+
+func (g GeneratorFloat32Attribute) Imports() *schema.Imports {
+	imports := schema.NewImports()
+
+	imports.Append(g.CustomType.Imports())
+
+	imports.Append(g.Validators.Imports())
+
+	if g.AssociatedExternalType != nil {
+		imports.Append(schema.AssociatedExternalTypeImports())
+	}
+
+	imports.Append(g.AssociatedExternalType.Imports())
+
+	return imports
+}
+
+func (g GeneratorFloat32Attribute) Equal(ga schema.GeneratorAttribute) bool {
+	h, ok := ga.(GeneratorFloat32Attribute)
+
+	if !ok {
+		return false
+	}
+
+	if !g.AssociatedExternalType.Equal(h.AssociatedExternalType) {
+		return false
+	}
+
+	if !g.ComputedOptionalRequired.Equal(h.ComputedOptionalRequired) {
+		return false
+	}
+
+	if !g.CustomType.Equal(h.CustomType) {
+		return false
+	}
+
+	if !g.DeprecationMessage.Equal(h.DeprecationMessage) {
+		return false
+	}
+
+	if !g.Description.Equal(h.Description) {
+		return false
+	}
+
+	if !g.Sensitive.Equal(h.Sensitive) {
+		return false
+	}
+
+	return g.Validators.Equal(h.Validators)
+}
+
+func (g GeneratorFloat32Attribute) Schema(name schema.FrameworkIdentifier) (string, error) {
+	var b bytes.Buffer
+
+	b.WriteString(fmt.Sprintf("%q: schema.Float32Attribute{\n", name))
+	b.Write(g.CustomType.Schema())
+	b.Write(g.ComputedOptionalRequired.Schema())
+	b.Write(g.Sensitive.Schema())
+	b.Write(g.Description.Schema())
+	b.Write(g.DeprecationMessage.Schema())
+	b.Write(g.Validators.Schema())
+	b.WriteString("},")
+
+	return b.String(), nil
+}
+
+func (g GeneratorFloat32Attribute) ModelField(name schema.FrameworkIdentifier) (model.Field, error) {
+	field := model.Field{
+		Name:      name.ToPascalCase(),
+		TfsdkName: name.ToString(),
+		ValueType: model.Float32ValueType,
+	}
+
+	customValueType := g.CustomType.ValueType()
+
+	if customValueType != "" {
+		field.ValueType = customValueType
+	}
+
+	return field, nil
+}
+
+func (g GeneratorFloat32Attribute) CustomTypeAndValue(name string, gen *schema.Generator) ([]byte, error) {
+	if g.AssociatedExternalType == nil {
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+
+	float32Type := schema.NewCustomFloat32Type(name, gen)
+
+	b, err := float32Type.Render()
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf.Write(b)
+
+	float32Value := schema.NewCustomFloat32Value(name, gen)
+
+	b, err = float32Value.Render()
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf.Write(b)
+
+	return buf.Bytes(), nil
+}
+
+func (g GeneratorFloat32Attribute) ToFromFunctions(name string, gen *schema.Generator) ([]byte, error) {
+	if g.AssociatedExternalType == nil {
+		return nil, nil
+	}
+
+	toFrom := schema.NewToFromFloat32(name, g.AssociatedExternalType, gen)
+
+	b, err := toFrom.Render()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// AttrType returns a string representation of a basetypes.Float32Typable type.
+func (g GeneratorFloat32Attribute) AttrType(name schema.FrameworkIdentifier) (string, error) {
+	if g.AssociatedExternalType != nil {
+		return fmt.Sprintf("%sType{}", name.ToPascalCase()), nil
+	}
+
+	return "basetypes.Float32Type{}", nil
+}
+
+// AttrValue returns a string representation of a basetypes.Float32Valuable type.
+func (g GeneratorFloat32Attribute) AttrValue(name schema.FrameworkIdentifier) string {
+	if g.AssociatedExternalType != nil {
+		return fmt.Sprintf("%sValue", name.ToPascalCase())
+	}
+
+	return "basetypes.Float32Value"
+}
+
+func (g GeneratorFloat32Attribute) To(gen *schema.Generator) (schema.ToFromConversion, error) {
+	if g.AssociatedExternalType != nil {
+		return schema.ToFromConversion{
+			AssocExtType: g.AssociatedExternalType,
+		}, nil
+	}
+
+	return schema.ToFromConversion{
+		Default: "ValueFloat32Pointer",
+	}, nil
+}
+
+func (g GeneratorFloat32Attribute) From(gen *schema.Generator) (schema.ToFromConversion, error) {
+	if g.AssociatedExternalType != nil {
+		return schema.ToFromConversion{
+			AssocExtType: g.AssociatedExternalType,
+		}, nil
+	}
+
+	return schema.ToFromConversion{
+		Default: "Float32PointerValue",
+	}, nil
+}
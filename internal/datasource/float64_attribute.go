@@ -56,6 +56,19 @@ func (g GeneratorFloat64Attribute) GeneratorSchemaType() schema.Type {
 	return schema.GeneratorFloat64Attribute
 }
 
+func (g GeneratorFloat64Attribute) Documentation() schema.Documentation {
+	return schema.Documentation{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		ConflictsWith:      g.Validators.ConflictsWith(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Example:            g.Validators.Example(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+	}
+}
+
 func (g GeneratorFloat64Attribute) Imports() *schema.Imports {
 	imports := schema.NewImports()
 
@@ -137,14 +150,14 @@ func (g GeneratorFloat64Attribute) ModelField(name schema.FrameworkIdentifier) (
 	return field, nil
 }
 
-func (g GeneratorFloat64Attribute) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorFloat64Attribute) CustomTypeAndValue(name string, gen *schema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	float64Type := schema.NewCustomFloat64Type(name)
+	float64Type := schema.NewCustomFloat64Type(name, gen)
 
 	b, err := float64Type.Render()
 
@@ -154,7 +167,7 @@ func (g GeneratorFloat64Attribute) CustomTypeAndValue(name string) ([]byte, erro
 
 	buf.Write(b)
 
-	float64Value := schema.NewCustomFloat64Value(name)
+	float64Value := schema.NewCustomFloat64Value(name, gen)
 
 	b, err = float64Value.Render()
 
@@ -167,12 +180,12 @@ func (g GeneratorFloat64Attribute) CustomTypeAndValue(name string) ([]byte, erro
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorFloat64Attribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorFloat64Attribute) ToFromFunctions(name string, gen *schema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	toFrom := schema.NewToFromFloat64(name, g.AssociatedExternalType)
+	toFrom := schema.NewToFromFloat64(name, g.AssociatedExternalType, gen)
 
 	b, err := toFrom.Render()
 
@@ -201,7 +214,7 @@ func (g GeneratorFloat64Attribute) AttrValue(name schema.FrameworkIdentifier) st
 	return "basetypes.Float64Value"
 }
 
-func (g GeneratorFloat64Attribute) To() (schema.ToFromConversion, error) {
+func (g GeneratorFloat64Attribute) To(gen *schema.Generator) (schema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return schema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
@@ -213,7 +226,7 @@ func (g GeneratorFloat64Attribute) To() (schema.ToFromConversion, error) {
 	}, nil
 }
 
-func (g GeneratorFloat64Attribute) From() (schema.ToFromConversion, error) {
+func (g GeneratorFloat64Attribute) From(gen *schema.Generator) (schema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return schema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
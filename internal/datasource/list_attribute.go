@@ -63,6 +63,19 @@ func (g GeneratorListAttribute) GeneratorSchemaType() generatorschema.Type {
 	return generatorschema.GeneratorListAttribute
 }
 
+func (g GeneratorListAttribute) Documentation() generatorschema.Documentation {
+	return generatorschema.Documentation{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		ConflictsWith:      g.Validators.ConflictsWith(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Example:            g.Validators.Example(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+	}
+}
+
 func (g GeneratorListAttribute) ElemType() specschema.ElementType {
 	return g.ElementType
 }
@@ -165,14 +178,14 @@ func (g GeneratorListAttribute) ModelField(name generatorschema.FrameworkIdentif
 	return field, nil
 }
 
-func (g GeneratorListAttribute) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorListAttribute) CustomTypeAndValue(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	listType := generatorschema.NewCustomListType(name)
+	listType := generatorschema.NewCustomListType(name, gen)
 
 	b, err := listType.Render()
 
@@ -184,7 +197,7 @@ func (g GeneratorListAttribute) CustomTypeAndValue(name string) ([]byte, error)
 
 	elemType := generatorschema.GetElementType(g.ElementType)
 
-	listValue := generatorschema.NewCustomListValue(name, elemType)
+	listValue := generatorschema.NewCustomListValue(name, elemType, gen)
 
 	b, err = listValue.Render()
 
@@ -197,7 +210,7 @@ func (g GeneratorListAttribute) CustomTypeAndValue(name string) ([]byte, error)
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorListAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorListAttribute) ToFromFunctions(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
@@ -205,13 +218,19 @@ func (g GeneratorListAttribute) ToFromFunctions(name string) ([]byte, error) {
 	elementTypeType := generatorschema.GetElementType(g.ElementType)
 	elementTypeValue := generatorschema.GetElementValueType(g.ElementType)
 
-	elementFrom, err := generatorschema.GetElementFromFunc(g.ElementType)
+	elementTo, err := generatorschema.GetElementToFunc(g.ElementType, gen)
+
+	if err != nil {
+		return nil, err
+	}
+
+	elementFrom, err := generatorschema.GetElementFromFunc(g.ElementType, gen)
 
 	if err != nil {
 		return nil, err
 	}
 
-	toFrom := generatorschema.NewToFromList(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementFrom)
+	toFrom := generatorschema.NewToFromList(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementTo, elementFrom, gen)
 
 	b, err := toFrom.Render()
 
@@ -246,14 +265,24 @@ func (g GeneratorListAttribute) AttrValue(name generatorschema.FrameworkIdentifi
 	return "basetypes.ListValue"
 }
 
-func (g GeneratorListAttribute) To() (generatorschema.ToFromConversion, error) {
+func (g GeneratorListAttribute) To(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
 		}, nil
 	}
 
-	elementGoType, err := generatorschema.ElementTypeGoType(g.ElementType)
+	elementGoType, err := generatorschema.ElementTypeGoType(g.ElementType, gen)
+
+	if err != nil {
+		return generatorschema.ToFromConversion{}, err
+	}
+
+	toFunc, err := generatorschema.GetCollectionToFunc(specschema.ElementType{
+		List: &specschema.ListType{
+			ElementType: g.ElementType,
+		},
+	}, gen)
 
 	if err != nil {
 		return generatorschema.ToFromConversion{}, err
@@ -262,11 +291,12 @@ func (g GeneratorListAttribute) To() (generatorschema.ToFromConversion, error) {
 	return generatorschema.ToFromConversion{
 		CollectionType: generatorschema.CollectionFields{
 			GoType: fmt.Sprintf("[]%s", elementGoType),
+			ToFunc: toFunc,
 		},
 	}, nil
 }
 
-func (g GeneratorListAttribute) From() (generatorschema.ToFromConversion, error) {
+func (g GeneratorListAttribute) From(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
@@ -279,10 +309,21 @@ func (g GeneratorListAttribute) From() (generatorschema.ToFromConversion, error)
 		return generatorschema.ToFromConversion{}, err
 	}
 
+	fromFunc, err := generatorschema.GetCollectionFromFunc(specschema.ElementType{
+		List: &specschema.ListType{
+			ElementType: g.ElementType,
+		},
+	}, gen)
+
+	if err != nil {
+		return generatorschema.ToFromConversion{}, err
+	}
+
 	return generatorschema.ToFromConversion{
 		CollectionType: generatorschema.CollectionFields{
 			ElementType:   elementType,
 			TypeValueFrom: "types.ListValueFrom",
+			FromFunc:      fromFunc,
 		},
 	}, nil
 }
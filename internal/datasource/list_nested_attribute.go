@@ -20,6 +20,7 @@ type GeneratorListNestedAttribute struct {
 	CustomType               convert.CustomTypeNestedCollection
 	DeprecationMessage       convert.DeprecationMessage
 	Description              convert.Description
+	Items                    convert.Items
 	NestedObject             GeneratorNestedAttributeObject
 	NestedAttributeObject    convert.NestedAttributeObject
 	Sensitive                convert.Sensitive
@@ -74,6 +75,19 @@ func (g GeneratorListNestedAttribute) GeneratorSchemaType() schema.Type {
 	return schema.GeneratorListNestedAttribute
 }
 
+func (g GeneratorListNestedAttribute) Documentation() schema.Documentation {
+	return schema.Documentation{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		MaxItems:           g.Items.MaxItems(),
+		MinItems:           g.Items.MinItems(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+	}
+}
+
 func (g GeneratorListNestedAttribute) Imports() *schema.Imports {
 	imports := schema.NewImports()
 
@@ -125,6 +139,10 @@ func (g GeneratorListNestedAttribute) Equal(ga schema.GeneratorAttribute) bool {
 		return false
 	}
 
+	if !g.Items.Equal(h.Items) {
+		return false
+	}
+
 	return g.Validators.Equal(h.Validators)
 }
 
@@ -170,7 +188,7 @@ func (g GeneratorListNestedAttribute) GetAttributes() schema.GeneratorAttributes
 	return g.NestedObject.Attributes
 }
 
-func (g GeneratorListNestedAttribute) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorListNestedAttribute) CustomTypeAndValue(name string, gen *schema.Generator) ([]byte, error) {
 	var buf bytes.Buffer
 
 	attributeAttrValues, err := g.NestedObject.Attributes.AttrValues()
@@ -179,7 +197,7 @@ func (g GeneratorListNestedAttribute) CustomTypeAndValue(name string) ([]byte, e
 		return nil, err
 	}
 
-	objectType := schema.NewCustomNestedObjectType(name, attributeAttrValues)
+	objectType := schema.NewCustomNestedObjectType(name, attributeAttrValues, gen)
 
 	b, err := objectType.Render()
 
@@ -207,7 +225,7 @@ func (g GeneratorListNestedAttribute) CustomTypeAndValue(name string) ([]byte, e
 		return nil, err
 	}
 
-	objectValue := schema.NewCustomNestedObjectValue(name, attributeTypes, attributeAttrTypes, attributeAttrValues, attributeCollectionTypes)
+	objectValue := schema.NewCustomNestedObjectValue(name, attributeTypes, attributeAttrTypes, attributeAttrValues, attributeCollectionTypes, gen)
 
 	b, err = objectValue.Render()
 
@@ -223,7 +241,7 @@ func (g GeneratorListNestedAttribute) CustomTypeAndValue(name string) ([]byte, e
 	// CustomTypeAndValue interface.
 	for _, k := range attributeKeys {
 		if c, ok := g.NestedObject.Attributes[k].(schema.CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := c.CustomTypeAndValue(k, gen)
 
 			if err != nil {
 				return nil, err
@@ -236,26 +254,30 @@ func (g GeneratorListNestedAttribute) CustomTypeAndValue(name string) ([]byte, e
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorListNestedAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorListNestedAttribute) AssocExtType() *schema.AssocExtType {
+	return g.NestedObject.AssociatedExternalType
+}
+
+func (g GeneratorListNestedAttribute) ToFromFunctions(name string, gen *schema.Generator) ([]byte, error) {
 	if g.NestedObject.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	toFuncs, err := g.NestedObject.Attributes.ToFuncs()
+	toFuncs, err := g.NestedObject.Attributes.ToFuncs(gen)
 
 	if err != nil {
 		return nil, err
 	}
 
-	fromFuncs, err := g.NestedObject.Attributes.FromFuncs()
+	fromFuncs, err := g.NestedObject.Attributes.FromFuncs(gen)
 
 	if err != nil {
 		return nil, err
 	}
 
-	toFrom := schema.NewToFromNestedObject(name, g.NestedObject.AssociatedExternalType, toFuncs, fromFuncs)
+	toFrom := schema.NewToFromNestedObject(name, g.NestedObject.AssociatedExternalType, toFuncs, fromFuncs, gen)
 
 	b, err := toFrom.Render()
 
@@ -271,7 +293,7 @@ func (g GeneratorListNestedAttribute) ToFromFunctions(name string) ([]byte, erro
 	// ToFrom interface.
 	for _, k := range attributeKeys {
 		if c, ok := g.NestedObject.Attributes[k].(schema.ToFrom); ok {
-			b, err := c.ToFromFunctions(k)
+			b, err := c.ToFromFunctions(k, gen)
 
 			if err != nil {
 				return nil, err
@@ -284,10 +306,10 @@ func (g GeneratorListNestedAttribute) ToFromFunctions(name string) ([]byte, erro
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorListNestedAttribute) To() (schema.ToFromConversion, error) {
+func (g GeneratorListNestedAttribute) To(gen *schema.Generator) (schema.ToFromConversion, error) {
 	return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("list nested type is not yet implemented"))
 }
 
-func (g GeneratorListNestedAttribute) From() (schema.ToFromConversion, error) {
+func (g GeneratorListNestedAttribute) From(gen *schema.Generator) (schema.ToFromConversion, error) {
 	return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("list nested type is not yet implemented"))
 }
@@ -20,6 +20,7 @@ type GeneratorListNestedBlock struct {
 	CustomType               convert.CustomTypeNestedCollection
 	DeprecationMessage       convert.DeprecationMessage
 	Description              convert.Description
+	Items                    convert.Items
 	NestedObject             GeneratorNestedBlockObject
 	NestedBlockObject        convert.NestedBlockObject
 	Sensitive                convert.Sensitive
@@ -81,6 +82,19 @@ func (g GeneratorListNestedBlock) GeneratorSchemaType() schema.Type {
 	return schema.GeneratorListNestedBlock
 }
 
+func (g GeneratorListNestedBlock) Documentation() schema.Documentation {
+	return schema.Documentation{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		MaxItems:           g.Items.MaxItems(),
+		MinItems:           g.Items.MinItems(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+	}
+}
+
 func (g GeneratorListNestedBlock) Imports() *schema.Imports {
 	imports := schema.NewImports()
 
@@ -132,6 +146,10 @@ func (g GeneratorListNestedBlock) Equal(ga schema.GeneratorBlock) bool {
 		return false
 	}
 
+	if !g.Items.Equal(h.Items) {
+		return false
+	}
+
 	return g.Validators.Equal(h.Validators)
 }
 
@@ -181,7 +199,7 @@ func (g GeneratorListNestedBlock) GetBlocks() schema.GeneratorBlocks {
 	return g.NestedObject.Blocks
 }
 
-func (g GeneratorListNestedBlock) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorListNestedBlock) CustomTypeAndValue(name string, gen *schema.Generator) ([]byte, error) {
 	var buf bytes.Buffer
 
 	attributeAttrValues, err := g.NestedObject.Attributes.AttrValues()
@@ -206,7 +224,7 @@ func (g GeneratorListNestedBlock) CustomTypeAndValue(name string) ([]byte, error
 		attributesBlocksAttrValues[k] = v
 	}
 
-	objectType := schema.NewCustomNestedObjectType(name, attributesBlocksAttrValues)
+	objectType := schema.NewCustomNestedObjectType(name, attributesBlocksAttrValues, gen)
 
 	b, err := objectType.Render()
 
@@ -267,7 +285,7 @@ func (g GeneratorListNestedBlock) CustomTypeAndValue(name string) ([]byte, error
 		return nil, err
 	}
 
-	objectValue := schema.NewCustomNestedObjectValue(name, attributesBlocksTypes, attributesBlocksAttrTypes, attributesBlocksAttrValues, attributeCollectionTypes)
+	objectValue := schema.NewCustomNestedObjectValue(name, attributesBlocksTypes, attributesBlocksAttrTypes, attributesBlocksAttrValues, attributeCollectionTypes, gen)
 
 	b, err = objectValue.Render()
 
@@ -285,7 +303,7 @@ func (g GeneratorListNestedBlock) CustomTypeAndValue(name string) ([]byte, error
 	// CustomTypeAndValue interface (i.e, nested attributes).
 	for _, k := range attributeKeys {
 		if c, ok := g.NestedObject.Attributes[k].(schema.CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := c.CustomTypeAndValue(k, gen)
 
 			if err != nil {
 				return nil, err
@@ -297,7 +315,7 @@ func (g GeneratorListNestedBlock) CustomTypeAndValue(name string) ([]byte, error
 
 	for _, k := range blockKeys {
 		if c, ok := g.NestedObject.Blocks[k].(schema.CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := c.CustomTypeAndValue(k, gen)
 
 			if err != nil {
 				return nil, err
@@ -310,26 +328,30 @@ func (g GeneratorListNestedBlock) CustomTypeAndValue(name string) ([]byte, error
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorListNestedBlock) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorListNestedBlock) AssocExtType() *schema.AssocExtType {
+	return g.NestedObject.AssociatedExternalType
+}
+
+func (g GeneratorListNestedBlock) ToFromFunctions(name string, gen *schema.Generator) ([]byte, error) {
 	if g.NestedObject.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	toFuncs, err := g.NestedObject.Attributes.ToFuncs()
+	toFuncs, err := g.NestedObject.Attributes.ToFuncs(gen)
 
 	if err != nil {
 		return nil, err
 	}
 
-	fromFuncs, err := g.NestedObject.Attributes.FromFuncs()
+	fromFuncs, err := g.NestedObject.Attributes.FromFuncs(gen)
 
 	if err != nil {
 		return nil, err
 	}
 
-	toFrom := schema.NewToFromNestedObject(name, g.NestedObject.AssociatedExternalType, toFuncs, fromFuncs)
+	toFrom := schema.NewToFromNestedObject(name, g.NestedObject.AssociatedExternalType, toFuncs, fromFuncs, gen)
 
 	b, err := toFrom.Render()
 
@@ -345,7 +367,7 @@ func (g GeneratorListNestedBlock) ToFromFunctions(name string) ([]byte, error) {
 	// ToFrom interface.
 	for _, k := range attributeKeys {
 		if c, ok := g.NestedObject.Attributes[k].(schema.ToFrom); ok {
-			b, err := c.ToFromFunctions(k)
+			b, err := c.ToFromFunctions(k, gen)
 
 			if err != nil {
 				return nil, err
@@ -358,10 +380,10 @@ func (g GeneratorListNestedBlock) ToFromFunctions(name string) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorListNestedBlock) To() (schema.ToFromConversion, error) {
+func (g GeneratorListNestedBlock) To(gen *schema.Generator) (schema.ToFromConversion, error) {
 	return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("list nested type is not yet implemented"))
 }
 
-func (g GeneratorListNestedBlock) From() (schema.ToFromConversion, error) {
+func (g GeneratorListNestedBlock) From(gen *schema.Generator) (schema.ToFromConversion, error) {
 	return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("list nested type is not yet implemented"))
 }
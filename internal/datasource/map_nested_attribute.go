@@ -74,6 +74,17 @@ func (g GeneratorMapNestedAttribute) GeneratorSchemaType() schema.Type {
 	return schema.GeneratorMapNestedAttribute
 }
 
+func (g GeneratorMapNestedAttribute) Documentation() schema.Documentation {
+	return schema.Documentation{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+	}
+}
+
 func (g GeneratorMapNestedAttribute) Imports() *schema.Imports {
 	imports := schema.NewImports()
 
@@ -170,7 +181,7 @@ func (g GeneratorMapNestedAttribute) GetAttributes() schema.GeneratorAttributes
 	return g.NestedObject.Attributes
 }
 
-func (g GeneratorMapNestedAttribute) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorMapNestedAttribute) CustomTypeAndValue(name string, gen *schema.Generator) ([]byte, error) {
 	var buf bytes.Buffer
 
 	attributeAttrValues, err := g.NestedObject.Attributes.AttrValues()
@@ -179,7 +190,7 @@ func (g GeneratorMapNestedAttribute) CustomTypeAndValue(name string) ([]byte, er
 		return nil, err
 	}
 
-	objectType := schema.NewCustomNestedObjectType(name, attributeAttrValues)
+	objectType := schema.NewCustomNestedObjectType(name, attributeAttrValues, gen)
 
 	b, err := objectType.Render()
 
@@ -207,7 +218,7 @@ func (g GeneratorMapNestedAttribute) CustomTypeAndValue(name string) ([]byte, er
 		return nil, err
 	}
 
-	objectValue := schema.NewCustomNestedObjectValue(name, attributeTypes, attributeAttrTypes, attributeAttrValues, attributeCollectionTypes)
+	objectValue := schema.NewCustomNestedObjectValue(name, attributeTypes, attributeAttrTypes, attributeAttrValues, attributeCollectionTypes, gen)
 
 	b, err = objectValue.Render()
 
@@ -223,7 +234,7 @@ func (g GeneratorMapNestedAttribute) CustomTypeAndValue(name string) ([]byte, er
 	// CustomTypeAndValue interface (i.e, nested attributes).
 	for _, k := range attributeKeys {
 		if c, ok := g.NestedObject.Attributes[k].(schema.CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := c.CustomTypeAndValue(k, gen)
 
 			if err != nil {
 				return nil, err
@@ -236,26 +247,30 @@ func (g GeneratorMapNestedAttribute) CustomTypeAndValue(name string) ([]byte, er
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorMapNestedAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorMapNestedAttribute) AssocExtType() *schema.AssocExtType {
+	return g.NestedObject.AssociatedExternalType
+}
+
+func (g GeneratorMapNestedAttribute) ToFromFunctions(name string, gen *schema.Generator) ([]byte, error) {
 	if g.NestedObject.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	toFuncs, err := g.NestedObject.Attributes.ToFuncs()
+	toFuncs, err := g.NestedObject.Attributes.ToFuncs(gen)
 
 	if err != nil {
 		return nil, err
 	}
 
-	fromFuncs, err := g.NestedObject.Attributes.FromFuncs()
+	fromFuncs, err := g.NestedObject.Attributes.FromFuncs(gen)
 
 	if err != nil {
 		return nil, err
 	}
 
-	toFrom := schema.NewToFromNestedObject(name, g.NestedObject.AssociatedExternalType, toFuncs, fromFuncs)
+	toFrom := schema.NewToFromNestedObject(name, g.NestedObject.AssociatedExternalType, toFuncs, fromFuncs, gen)
 
 	b, err := toFrom.Render()
 
@@ -271,7 +286,7 @@ func (g GeneratorMapNestedAttribute) ToFromFunctions(name string) ([]byte, error
 	// ToFrom interface.
 	for _, k := range attributeKeys {
 		if c, ok := g.NestedObject.Attributes[k].(schema.ToFrom); ok {
-			b, err := c.ToFromFunctions(k)
+			b, err := c.ToFromFunctions(k, gen)
 
 			if err != nil {
 				return nil, err
@@ -284,10 +299,10 @@ func (g GeneratorMapNestedAttribute) ToFromFunctions(name string) ([]byte, error
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorMapNestedAttribute) To() (schema.ToFromConversion, error) {
+func (g GeneratorMapNestedAttribute) To(gen *schema.Generator) (schema.ToFromConversion, error) {
 	return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("map nested type is not yet implemented"))
 }
 
-func (g GeneratorMapNestedAttribute) From() (schema.ToFromConversion, error) {
+func (g GeneratorMapNestedAttribute) From(gen *schema.Generator) (schema.ToFromConversion, error) {
 	return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("map nested type is not yet implemented"))
 }
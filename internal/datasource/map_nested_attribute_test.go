@@ -4,6 +4,7 @@
 package datasource
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -13,6 +14,7 @@ import (
 	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
 
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
 	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
@@ -44,9 +46,7 @@ func TestGeneratorMapNestedAttribute_New(t *testing.T) {
 					},
 				},
 			},
-			expectedError: fmt.Errorf("attribute type not defined: %+v", datasource.Attribute{
-				Name: "empty",
-			}),
+			expectedError: errors.Join(diagnostic.NewError(errors.New(`attribute "empty" type not defined`), "attributes", 0)),
 		},
 		"attributes-bool": {
 			input: &datasource.MapNestedAttribute{
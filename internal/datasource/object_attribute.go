@@ -64,6 +64,19 @@ func (g GeneratorObjectAttribute) GeneratorSchemaType() generatorschema.Type {
 	return generatorschema.GeneratorObjectAttribute
 }
 
+func (g GeneratorObjectAttribute) Documentation() generatorschema.Documentation {
+	return generatorschema.Documentation{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		ConflictsWith:      g.Validators.ConflictsWith(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Example:            g.Validators.Example(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+	}
+}
+
 func (g GeneratorObjectAttribute) AttrTypes() specschema.ObjectAttributeTypes {
 	return g.AttributeTypes
 }
@@ -172,14 +185,14 @@ func (g GeneratorObjectAttribute) ModelField(name generatorschema.FrameworkIdent
 	return field, nil
 }
 
-func (g GeneratorObjectAttribute) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorObjectAttribute) CustomTypeAndValue(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	objectType := generatorschema.NewCustomObjectType(name)
+	objectType := generatorschema.NewCustomObjectType(name, gen)
 
 	b, err := objectType.Render()
 
@@ -191,7 +204,7 @@ func (g GeneratorObjectAttribute) CustomTypeAndValue(name string) ([]byte, error
 
 	attrTypes := generatorschema.GetAttrTypes(g.AttrTypes())
 
-	objectValue := generatorschema.NewCustomObjectValue(name, attrTypes)
+	objectValue := generatorschema.NewCustomObjectValue(name, attrTypes, gen)
 
 	b, err = objectValue.Render()
 
@@ -204,24 +217,24 @@ func (g GeneratorObjectAttribute) CustomTypeAndValue(name string) ([]byte, error
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorObjectAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorObjectAttribute) ToFromFunctions(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	attrTypesToFuncs, err := generatorschema.GetAttrTypesToFuncs(g.AttributeTypes)
+	attrTypesToFuncs, err := generatorschema.GetAttrTypesToFuncs(g.AttributeTypes, gen)
 
 	if err != nil {
 		return nil, err
 	}
 
-	attrTypesFromFuncs, err := generatorschema.GetAttrTypesFromFuncs(g.AttributeTypes)
+	attrTypesFromFuncs, err := generatorschema.GetAttrTypesFromFuncs(g.AttributeTypes, gen)
 
 	if err != nil {
 		return nil, err
 	}
 
-	toFrom := generatorschema.NewToFromObject(name, g.AssociatedExternalType, attrTypesToFuncs, attrTypesFromFuncs)
+	toFrom := generatorschema.NewToFromObject(name, g.AssociatedExternalType, attrTypesToFuncs, attrTypesFromFuncs, gen)
 
 	b, err := toFrom.Render()
 
@@ -256,7 +269,7 @@ func (g GeneratorObjectAttribute) AttrValue(name generatorschema.FrameworkIdenti
 	return "basetypes.ObjectValue"
 }
 
-func (g GeneratorObjectAttribute) To() (generatorschema.ToFromConversion, error) {
+func (g GeneratorObjectAttribute) To(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
@@ -266,7 +279,7 @@ func (g GeneratorObjectAttribute) To() (generatorschema.ToFromConversion, error)
 	objectFields := make(map[generatorschema.FrameworkIdentifier]generatorschema.ObjectField, len(g.AttributeTypes))
 
 	for _, v := range g.AttributeTypes {
-		objField, err := generatorschema.ObjectFieldTo(v)
+		objField, err := generatorschema.ObjectFieldTo(v, gen)
 
 		if err != nil {
 			return generatorschema.ToFromConversion{}, err
@@ -280,7 +293,7 @@ func (g GeneratorObjectAttribute) To() (generatorschema.ToFromConversion, error)
 	}, nil
 }
 
-func (g GeneratorObjectAttribute) From() (generatorschema.ToFromConversion, error) {
+func (g GeneratorObjectAttribute) From(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
@@ -290,7 +303,7 @@ func (g GeneratorObjectAttribute) From() (generatorschema.ToFromConversion, erro
 	objectFields := make(map[generatorschema.FrameworkIdentifier]generatorschema.ObjectField, len(g.AttributeTypes))
 
 	for _, v := range g.AttributeTypes {
-		objField, err := generatorschema.ObjectFieldFrom(v)
+		objField, err := generatorschema.ObjectFieldFrom(v, gen)
 
 		if err != nil {
 			return generatorschema.ToFromConversion{}, err
@@ -263,7 +263,7 @@ func TestGeneratorDataSourceSchemas_ModelsBytes(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			g := schema.NewGeneratorSchemas(testCase.input)
+			g := schema.NewGeneratorSchemas(testCase.input, schema.GeneratorOptions{})
 			got, err := g.Models()
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
@@ -20,6 +20,7 @@ type GeneratorSetNestedAttribute struct {
 	CustomType               convert.CustomTypeNestedCollection
 	DeprecationMessage       convert.DeprecationMessage
 	Description              convert.Description
+	Items                    convert.Items
 	NestedObject             GeneratorNestedAttributeObject
 	NestedAttributeObject    convert.NestedAttributeObject
 	Sensitive                convert.Sensitive
@@ -74,6 +75,19 @@ func (g GeneratorSetNestedAttribute) GeneratorSchemaType() schema.Type {
 	return schema.GeneratorSetNestedAttribute
 }
 
+func (g GeneratorSetNestedAttribute) Documentation() schema.Documentation {
+	return schema.Documentation{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		MaxItems:           g.Items.MaxItems(),
+		MinItems:           g.Items.MinItems(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+	}
+}
+
 func (g GeneratorSetNestedAttribute) Imports() *schema.Imports {
 	imports := schema.NewImports()
 
@@ -125,6 +139,10 @@ func (g GeneratorSetNestedAttribute) Equal(ga schema.GeneratorAttribute) bool {
 		return false
 	}
 
+	if !g.Items.Equal(h.Items) {
+		return false
+	}
+
 	return g.Validators.Equal(h.Validators)
 }
 
@@ -170,7 +188,7 @@ func (g GeneratorSetNestedAttribute) GetAttributes() schema.GeneratorAttributes
 	return g.NestedObject.Attributes
 }
 
-func (g GeneratorSetNestedAttribute) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorSetNestedAttribute) CustomTypeAndValue(name string, gen *schema.Generator) ([]byte, error) {
 	var buf bytes.Buffer
 
 	attributeAttrValues, err := g.NestedObject.Attributes.AttrValues()
@@ -179,7 +197,7 @@ func (g GeneratorSetNestedAttribute) CustomTypeAndValue(name string) ([]byte, er
 		return nil, err
 	}
 
-	objectType := schema.NewCustomNestedObjectType(name, attributeAttrValues)
+	objectType := schema.NewCustomNestedObjectType(name, attributeAttrValues, gen)
 
 	b, err := objectType.Render()
 
@@ -207,7 +225,7 @@ func (g GeneratorSetNestedAttribute) CustomTypeAndValue(name string) ([]byte, er
 		return nil, err
 	}
 
-	objectValue := schema.NewCustomNestedObjectValue(name, attributeTypes, attributeAttrTypes, attributeAttrValues, attributeCollectionTypes)
+	objectValue := schema.NewCustomNestedObjectValue(name, attributeTypes, attributeAttrTypes, attributeAttrValues, attributeCollectionTypes, gen)
 
 	b, err = objectValue.Render()
 
@@ -223,7 +241,7 @@ func (g GeneratorSetNestedAttribute) CustomTypeAndValue(name string) ([]byte, er
 	// CustomTypeAndValue interface (i.e, nested attributes).
 	for _, k := range attributeKeys {
 		if c, ok := g.NestedObject.Attributes[k].(schema.CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := c.CustomTypeAndValue(k, gen)
 
 			if err != nil {
 				return nil, err
@@ -236,26 +254,30 @@ func (g GeneratorSetNestedAttribute) CustomTypeAndValue(name string) ([]byte, er
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorSetNestedAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorSetNestedAttribute) AssocExtType() *schema.AssocExtType {
+	return g.NestedObject.AssociatedExternalType
+}
+
+func (g GeneratorSetNestedAttribute) ToFromFunctions(name string, gen *schema.Generator) ([]byte, error) {
 	if g.NestedObject.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	toFuncs, err := g.NestedObject.Attributes.ToFuncs()
+	toFuncs, err := g.NestedObject.Attributes.ToFuncs(gen)
 
 	if err != nil {
 		return nil, err
 	}
 
-	fromFuncs, err := g.NestedObject.Attributes.FromFuncs()
+	fromFuncs, err := g.NestedObject.Attributes.FromFuncs(gen)
 
 	if err != nil {
 		return nil, err
 	}
 
-	toFrom := schema.NewToFromNestedObject(name, g.NestedObject.AssociatedExternalType, toFuncs, fromFuncs)
+	toFrom := schema.NewToFromNestedObject(name, g.NestedObject.AssociatedExternalType, toFuncs, fromFuncs, gen)
 
 	b, err := toFrom.Render()
 
@@ -271,7 +293,7 @@ func (g GeneratorSetNestedAttribute) ToFromFunctions(name string) ([]byte, error
 	// ToFrom interface.
 	for _, k := range attributeKeys {
 		if c, ok := g.NestedObject.Attributes[k].(schema.ToFrom); ok {
-			b, err := c.ToFromFunctions(k)
+			b, err := c.ToFromFunctions(k, gen)
 
 			if err != nil {
 				return nil, err
@@ -284,10 +306,10 @@ func (g GeneratorSetNestedAttribute) ToFromFunctions(name string) ([]byte, error
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorSetNestedAttribute) To() (schema.ToFromConversion, error) {
+func (g GeneratorSetNestedAttribute) To(gen *schema.Generator) (schema.ToFromConversion, error) {
 	return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("set nested type is not yet implemented"))
 }
 
-func (g GeneratorSetNestedAttribute) From() (schema.ToFromConversion, error) {
+func (g GeneratorSetNestedAttribute) From(gen *schema.Generator) (schema.ToFromConversion, error) {
 	return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("set nested type is not yet implemented"))
 }
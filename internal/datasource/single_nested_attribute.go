@@ -65,6 +65,17 @@ func (g GeneratorSingleNestedAttribute) GeneratorSchemaType() schema.Type {
 	return schema.GeneratorSingleNestedAttribute
 }
 
+func (g GeneratorSingleNestedAttribute) Documentation() schema.Documentation {
+	return schema.Documentation{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+	}
+}
+
 func (g GeneratorSingleNestedAttribute) Imports() *schema.Imports {
 	imports := schema.NewImports()
 
@@ -163,7 +174,7 @@ func (g GeneratorSingleNestedAttribute) GetAttributes() schema.GeneratorAttribut
 	return g.Attributes
 }
 
-func (g GeneratorSingleNestedAttribute) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorSingleNestedAttribute) CustomTypeAndValue(name string, gen *schema.Generator) ([]byte, error) {
 	var buf bytes.Buffer
 
 	attributeAttrValues, err := g.Attributes.AttrValues()
@@ -172,7 +183,7 @@ func (g GeneratorSingleNestedAttribute) CustomTypeAndValue(name string) ([]byte,
 		return nil, err
 	}
 
-	objectType := schema.NewCustomNestedObjectType(name, attributeAttrValues)
+	objectType := schema.NewCustomNestedObjectType(name, attributeAttrValues, gen)
 
 	b, err := objectType.Render()
 
@@ -200,7 +211,7 @@ func (g GeneratorSingleNestedAttribute) CustomTypeAndValue(name string) ([]byte,
 		return nil, err
 	}
 
-	objectValue := schema.NewCustomNestedObjectValue(name, attributeTypes, attributeAttrTypes, attributeAttrValues, attributeCollectionTypes)
+	objectValue := schema.NewCustomNestedObjectValue(name, attributeTypes, attributeAttrTypes, attributeAttrValues, attributeCollectionTypes, gen)
 
 	b, err = objectValue.Render()
 
@@ -216,7 +227,7 @@ func (g GeneratorSingleNestedAttribute) CustomTypeAndValue(name string) ([]byte,
 	// CustomTypeAndValue interface (i.e, nested attributes).
 	for _, k := range attributeKeys {
 		if c, ok := g.Attributes[k].(schema.CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := c.CustomTypeAndValue(k, gen)
 
 			if err != nil {
 				return nil, err
@@ -229,22 +240,26 @@ func (g GeneratorSingleNestedAttribute) CustomTypeAndValue(name string) ([]byte,
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorSingleNestedAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorSingleNestedAttribute) AssocExtType() *schema.AssocExtType {
+	return g.AssociatedExternalType
+}
+
+func (g GeneratorSingleNestedAttribute) ToFromFunctions(name string, gen *schema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	toFuncs, err := g.Attributes.ToFuncs()
+	toFuncs, err := g.Attributes.ToFuncs(gen)
 
 	if err != nil {
 		return nil, err
 	}
 
-	fromFuncs, _ := g.Attributes.FromFuncs()
+	fromFuncs, _ := g.Attributes.FromFuncs(gen)
 
-	toFrom := schema.NewToFromNestedObject(name, g.AssociatedExternalType, toFuncs, fromFuncs)
+	toFrom := schema.NewToFromNestedObject(name, g.AssociatedExternalType, toFuncs, fromFuncs, gen)
 
 	b, err := toFrom.Render()
 
@@ -260,7 +275,7 @@ func (g GeneratorSingleNestedAttribute) ToFromFunctions(name string) ([]byte, er
 	// ToFrom interface.
 	for _, k := range attributeKeys {
 		if c, ok := g.Attributes[k].(schema.ToFrom); ok {
-			b, err := c.ToFromFunctions(k)
+			b, err := c.ToFromFunctions(k, gen)
 
 			if err != nil {
 				return nil, err
@@ -273,10 +288,10 @@ func (g GeneratorSingleNestedAttribute) ToFromFunctions(name string) ([]byte, er
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorSingleNestedAttribute) To() (schema.ToFromConversion, error) {
+func (g GeneratorSingleNestedAttribute) To(gen *schema.Generator) (schema.ToFromConversion, error) {
 	return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("single nested type is not yet implemented"))
 }
 
-func (g GeneratorSingleNestedAttribute) From() (schema.ToFromConversion, error) {
+func (g GeneratorSingleNestedAttribute) From(gen *schema.Generator) (schema.ToFromConversion, error) {
 	return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("single nested type is not yet implemented"))
 }
@@ -73,6 +73,17 @@ func (g GeneratorSingleNestedBlock) GeneratorSchemaType() schema.Type {
 	return schema.GeneratorSingleNestedBlock
 }
 
+func (g GeneratorSingleNestedBlock) Documentation() schema.Documentation {
+	return schema.Documentation{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+	}
+}
+
 func (g GeneratorSingleNestedBlock) Imports() *schema.Imports {
 	imports := schema.NewImports()
 
@@ -194,7 +205,7 @@ func (g GeneratorSingleNestedBlock) GetBlocks() schema.GeneratorBlocks {
 	return g.Blocks
 }
 
-func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string, gen *schema.Generator) ([]byte, error) {
 	var buf bytes.Buffer
 
 	attributeAttrValues, err := g.Attributes.AttrValues()
@@ -219,7 +230,7 @@ func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string) ([]byte, err
 		attributesBlocksAttrValues[k] = v
 	}
 
-	objectType := schema.NewCustomNestedObjectType(name, attributesBlocksAttrValues)
+	objectType := schema.NewCustomNestedObjectType(name, attributesBlocksAttrValues, gen)
 
 	b, err := objectType.Render()
 
@@ -280,7 +291,7 @@ func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string) ([]byte, err
 		return nil, err
 	}
 
-	objectValue := schema.NewCustomNestedObjectValue(name, attributesBlocksTypes, attributesBlocksAttrTypes, attributesBlocksAttrValues, attributeCollectionTypes)
+	objectValue := schema.NewCustomNestedObjectValue(name, attributesBlocksTypes, attributesBlocksAttrTypes, attributesBlocksAttrValues, attributeCollectionTypes, gen)
 
 	b, err = objectValue.Render()
 
@@ -298,7 +309,7 @@ func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string) ([]byte, err
 	// CustomTypeAndValue interface (i.e, nested attributes).
 	for _, k := range attributeKeys {
 		if c, ok := g.Attributes[k].(schema.CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := c.CustomTypeAndValue(k, gen)
 
 			if err != nil {
 				return nil, err
@@ -310,7 +321,7 @@ func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string) ([]byte, err
 
 	for _, k := range blockKeys {
 		if c, ok := g.Blocks[k].(schema.CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := c.CustomTypeAndValue(k, gen)
 
 			if err != nil {
 				return nil, err
@@ -323,22 +334,26 @@ func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string) ([]byte, err
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorSingleNestedBlock) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorSingleNestedBlock) AssocExtType() *schema.AssocExtType {
+	return g.AssociatedExternalType
+}
+
+func (g GeneratorSingleNestedBlock) ToFromFunctions(name string, gen *schema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	toFuncs, err := g.Attributes.ToFuncs()
+	toFuncs, err := g.Attributes.ToFuncs(gen)
 
 	if err != nil {
 		return nil, err
 	}
 
-	fromFuncs, _ := g.Attributes.FromFuncs()
+	fromFuncs, _ := g.Attributes.FromFuncs(gen)
 
-	toFrom := schema.NewToFromNestedObject(name, g.AssociatedExternalType, toFuncs, fromFuncs)
+	toFrom := schema.NewToFromNestedObject(name, g.AssociatedExternalType, toFuncs, fromFuncs, gen)
 
 	b, err := toFrom.Render()
 
@@ -354,7 +369,7 @@ func (g GeneratorSingleNestedBlock) ToFromFunctions(name string) ([]byte, error)
 	// ToFrom interface.
 	for _, k := range attributeKeys {
 		if c, ok := g.Attributes[k].(schema.ToFrom); ok {
-			b, err := c.ToFromFunctions(k)
+			b, err := c.ToFromFunctions(k, gen)
 
 			if err != nil {
 				return nil, err
@@ -367,10 +382,10 @@ func (g GeneratorSingleNestedBlock) ToFromFunctions(name string) ([]byte, error)
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorSingleNestedBlock) To() (schema.ToFromConversion, error) {
+func (g GeneratorSingleNestedBlock) To(gen *schema.Generator) (schema.ToFromConversion, error) {
 	return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("single nested type is not yet implemented"))
 }
 
-func (g GeneratorSingleNestedBlock) From() (schema.ToFromConversion, error) {
+func (g GeneratorSingleNestedBlock) From(gen *schema.Generator) (schema.ToFromConversion, error) {
 	return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("single nested type is not yet implemented"))
 }
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diagnostic
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Error is used to indicate the location within the specification document
+// at which an error was found, as a JSON pointer. The line and column of the
+// location are populated by WithPositions, once the document is available.
+type Error struct {
+	err     error
+	pointer []string
+	offset  int64
+	line    int
+	column  int
+}
+
+// Error returns the underlying error string, prefixed with the JSON pointer
+// and, if known, the line and column.
+func (e *Error) Error() string {
+	var location []string
+
+	if len(e.pointer) > 0 {
+		location = append(location, e.Pointer())
+	}
+
+	if e.line > 0 {
+		location = append(location, fmt.Sprintf("line %d, column %d", e.line, e.column))
+	}
+
+	if len(location) == 0 {
+		return e.err.Error()
+	}
+
+	return fmt.Sprintf("%s: %s", strings.Join(location, ", "), e.err)
+}
+
+// Unwrap returns the underlying error.
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// Pointer returns the JSON pointer, such as /resources/4/schema, or an empty
+// string if the error applies to the whole document.
+func (e *Error) Pointer() string {
+	var sb strings.Builder
+
+	for _, token := range e.pointer {
+		sb.WriteString("/")
+		sb.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(token))
+	}
+
+	return sb.String()
+}
+
+// Position returns the line and column, which are zero if not known.
+func (e *Error) Position() (int, int) {
+	return e.line, e.column
+}
+
+// NewError returns an Error populated with the supplied error and the
+// reference tokens of the JSON pointer, which are strings or ints. If the
+// supplied error is itself an Error, the tokens are prepended to its JSON
+// pointer instead.
+func NewError(err error, tokens ...any) *Error {
+	pointer := make([]string, 0, len(tokens))
+
+	for _, t := range tokens {
+		switch v := t.(type) {
+		case int:
+			pointer = append(pointer, strconv.Itoa(v))
+		default:
+			pointer = append(pointer, fmt.Sprint(v))
+		}
+	}
+
+	if e, ok := err.(*Error); ok {
+		return &Error{
+			err:     e.err,
+			pointer: append(pointer, e.pointer...),
+			offset:  -1,
+		}
+	}
+
+	return &Error{
+		err:     err,
+		pointer: pointer,
+		offset:  -1,
+	}
+}
+
+// NewErrors returns Errors populated with the supplied error and reference
+// tokens. If the supplied error joins several errors, each is prefixed with
+// the tokens.
+func NewErrors(err error, tokens ...any) []error {
+	if e, ok := err.(interface{ Unwrap() []error }); ok {
+		var errs []error
+
+		for _, v := range e.Unwrap() {
+			errs = append(errs, NewErrors(v, tokens...)...)
+		}
+
+		return errs
+	}
+
+	return []error{NewError(err, tokens...)}
+}
+
+// WithPointer returns err with the reference tokens prepended to the JSON
+// pointer of each error it joins, or nil if err is nil.
+func WithPointer(err error, tokens ...any) error {
+	if err == nil {
+		return nil
+	}
+
+	errs := NewErrors(err, tokens...)
+
+	if len(errs) == 1 {
+		return errs[0]
+	}
+
+	return errors.Join(errs...)
+}
+
+// Wrapf returns err with the message of each error it joins wrapped, as with
+// fmt.Errorf, using the format and arguments followed by the underlying error,
+// which is referenced by the final %w verb of the format. The JSON pointer of
+// each Error is retained, rather than being included within the message.
+func Wrapf(err error, format string, args ...any) error {
+	if err == nil {
+		return nil
+	}
+
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var errs []error
+
+		for _, v := range joined.Unwrap() {
+			errs = append(errs, Wrapf(v, format, args...))
+		}
+
+		return errors.Join(errs...)
+	}
+
+	e, ok := err.(*Error)
+
+	if !ok {
+		return fmt.Errorf(format, append(args, err)...)
+	}
+
+	return &Error{
+		err:     fmt.Errorf(format, append(args, e.err)...),
+		pointer: e.pointer,
+		offset:  e.offset,
+		line:    e.line,
+		column:  e.column,
+	}
+}
+
+// NewOffsetError returns an Error populated with the supplied error and the
+// byte offset within the document, for errors such as invalid JSON syntax
+// which cannot be identified by a JSON pointer.
+func NewOffsetError(err error, offset int64) *Error {
+	return &Error{
+		err:    err,
+		offset: offset,
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diagnostic
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		tokens   []any
+		expected []string
+	}{
+		"error": {
+			err:    errors.New("attribute type not defined"),
+			tokens: []any{"attributes", 12},
+			expected: []string{
+				"/attributes/12: attribute type not defined",
+			},
+		},
+		"nested": {
+			err:    NewError(errors.New("attribute type not defined"), "attributes", 0),
+			tokens: []any{"resources", 4, "schema"},
+			expected: []string{
+				"/resources/4/schema/attributes/0: attribute type not defined",
+			},
+		},
+		"joined": {
+			err: errors.Join(
+				NewError(errors.New("attribute type not defined"), "attributes", 0),
+				NewError(errors.New("block type not defined"), "blocks", 1),
+			),
+			tokens: []any{"schema"},
+			expected: []string{
+				"/schema/attributes/0: attribute type not defined",
+				"/schema/blocks/1: block type not defined",
+			},
+		},
+		"escaped": {
+			err:    errors.New("invalid"),
+			tokens: []any{"a/b", "c~d"},
+			expected: []string{
+				"/a~1b/c~0d: invalid",
+			},
+		},
+		"no-tokens": {
+			err: errors.New("invalid"),
+			expected: []string{
+				"invalid",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+
+			for _, err := range NewErrors(testCase.err, testCase.tokens...) {
+				got = append(got, err.Error())
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWrapf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected string
+	}{
+		"error": {
+			err:      errors.New("must be a string"),
+			expected: `attribute "tags" must be a string`,
+		},
+		"pointer": {
+			err:      NewError(errors.New("must be a string"), "default", "static", 0),
+			expected: `/default/static/0: attribute "tags" must be a string`,
+		},
+		"joined": {
+			err: errors.Join(
+				NewError(errors.New("must be a string"), "default", "static", 0),
+				NewError(errors.New("must be a string"), "default", "static", 1),
+			),
+			expected: "/default/static/0: attribute \"tags\" must be a string\n" +
+				"/default/static/1: attribute \"tags\" must be a string",
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Wrapf(testCase.err, "attribute %q %w", "tags")
+
+			if diff := cmp.Diff(err.Error(), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diagnostic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// positions contains the byte offset of the value at each JSON pointer
+// within a document.
+type positions struct {
+	src     []byte
+	offsets map[string]int64
+}
+
+// newPositions scans the document, recording the offset of every value.
+// Scanning stops at the first syntax error, so that the values before it
+// can still be located.
+func newPositions(src []byte) positions {
+	p := positions{
+		src:     src,
+		offsets: make(map[string]int64),
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(src))
+
+	p.value(dec, "", 0)
+
+	return p
+}
+
+// value records the offset of the value which starts after prev, and of any
+// nested values, returning false if the document cannot be scanned further.
+func (p positions) value(dec *json.Decoder, pointer string, prev int64) bool {
+	tok, err := dec.Token()
+	if err != nil {
+		return false
+	}
+
+	p.offsets[pointer] = p.start(prev)
+
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return true
+	}
+
+	switch delim {
+	case '{':
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return false
+			}
+
+			k, _ := key.(string)
+
+			escaped := strings.NewReplacer("~", "~0", "/", "~1").Replace(k)
+
+			if !p.value(dec, pointer+"/"+escaped, dec.InputOffset()) {
+				return false
+			}
+		}
+	case '[':
+		for i := 0; dec.More(); i++ {
+			if !p.value(dec, pointer+"/"+strconv.Itoa(i), dec.InputOffset()) {
+				return false
+			}
+		}
+	}
+
+	// closing delimiter
+	_, err = dec.Token()
+
+	return err == nil
+}
+
+// start returns the offset of the first character of the value following
+// prev, skipping whitespace along with any separating colon or comma.
+func (p positions) start(prev int64) int64 {
+	for i := prev; i < int64(len(p.src)); i++ {
+		switch p.src[i] {
+		case ' ', '\t', '\r', '\n', ':', ',':
+			continue
+		}
+
+		return i
+	}
+
+	return prev
+}
+
+// lookup returns the line and column of the value at the JSON pointer, or of
+// its nearest ancestor which is present in the document, such as the parent
+// object of a missing property.
+func (p positions) lookup(pointer string) (int, int) {
+	for {
+		if offset, ok := p.offsets[pointer]; ok {
+			return p.lineColumn(offset)
+		}
+
+		i := strings.LastIndex(pointer, "/")
+
+		if i < 0 {
+			return 0, 0
+		}
+
+		pointer = pointer[:i]
+	}
+}
+
+// lineColumn returns the one-based line and column of the offset, in which
+// columns are counted in bytes.
+func (p positions) lineColumn(offset int64) (int, int) {
+	if offset > int64(len(p.src)) {
+		offset = int64(len(p.src))
+	}
+
+	line := 1 + bytes.Count(p.src[:offset], []byte("\n"))
+	column := int(offset) - bytes.LastIndexByte(p.src[:offset], '\n')
+
+	return line, column
+}
+
+type documentKey struct{}
+
+// SetDocumentInContext is used to supply the specification document, so that
+// the line and column of errors found after it has been parsed can be
+// populated with WithPositionsFromContext.
+func SetDocumentInContext(ctx context.Context, src []byte) context.Context {
+	return context.WithValue(ctx, documentKey{}, src)
+}
+
+// WithPositionsFromContext populates the line and column of each Error within
+// err from the document in the context, if one has been set, and returns err.
+func WithPositionsFromContext(ctx context.Context, err error) error {
+	src, ok := ctx.Value(documentKey{}).([]byte)
+	if !ok {
+		return err
+	}
+
+	return WithPositions(src, err)
+}
+
+// WithPositions populates the line and column of each Error within err from
+// the document, and returns err. As the message of an error is fixed once it
+// is wrapped, such as with fmt.Errorf, positions must be populated first.
+func WithPositions(src []byte, err error) error {
+	var p *positions
+
+	walk(err, func(e *Error) {
+		if p == nil {
+			v := newPositions(src)
+			p = &v
+		}
+
+		if e.offset >= 0 {
+			e.line, e.column = p.lineColumn(e.offset)
+
+			return
+		}
+
+		e.line, e.column = p.lookup(e.Pointer())
+	})
+
+	return err
+}
+
+// walk calls f with each Error within err, including those which are joined.
+func walk(err error, f func(*Error)) {
+	if err == nil {
+		return
+	}
+
+	if e, ok := err.(*Error); ok {
+		f(e)
+	}
+
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, v := range joined.Unwrap() {
+			walk(v, f)
+		}
+
+		return
+	}
+
+	walk(errors.Unwrap(err), f)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diagnostic
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWithPositions(t *testing.T) {
+	t.Parallel()
+
+	src := []byte(`{
+  "resources": [
+    {
+      "name": "example",
+      "schema": {
+        "attributes": [
+          {"name": "first"},
+          {
+            "name": "second",
+            "list": {
+              "element_type": {}
+            }
+          }
+        ]
+      }
+    }
+  ]
+}`)
+
+	testCases := map[string]struct {
+		err      error
+		expected string
+	}{
+		"object": {
+			err:      NewError(errors.New("invalid"), "resources", 0, "schema"),
+			expected: "/resources/0/schema, line 5, column 17: invalid",
+		},
+		"array-element": {
+			err:      NewError(errors.New("invalid"), "resources", 0, "schema", "attributes", 0),
+			expected: "/resources/0/schema/attributes/0, line 7, column 11: invalid",
+		},
+		"string": {
+			err:      NewError(errors.New("invalid"), "resources", 0, "schema", "attributes", 1, "name"),
+			expected: "/resources/0/schema/attributes/1/name, line 9, column 21: invalid",
+		},
+		"missing": {
+			err:      NewError(errors.New("invalid"), "resources", 0, "schema", "attributes", 1, "list", "element_type", "string"),
+			expected: "/resources/0/schema/attributes/1/list/element_type/string, line 11, column 31: invalid",
+		},
+		"offset": {
+			err:      NewOffsetError(errors.New("invalid"), 17),
+			expected: "line 2, column 16: invalid",
+		},
+		"joined": {
+			err: errors.Join(
+				NewError(errors.New("invalid"), "resources", 0, "name"),
+				NewError(errors.New("invalid"), "resources", 0, "schema", "attributes", 0, "name"),
+			),
+			expected: "/resources/0/name, line 4, column 15: invalid\n/resources/0/schema/attributes/0/name, line 7, column 20: invalid",
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := WithPositions(src, testCase.err)
+
+			if diff := cmp.Diff(err.Error(), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
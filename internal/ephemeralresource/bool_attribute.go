@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralresource
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/datasource"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+type GeneratorBoolAttribute struct {
+	AssociatedExternalType   *schema.AssocExtType
+	ComputedOptionalRequired convert.ComputedOptionalRequired
+	CustomType               convert.CustomTypePrimitive
+	DeprecationMessage       convert.DeprecationMessage
+	Description              convert.Description
+	Sensitive                convert.Sensitive
+	Validators               convert.Validators
+}
+
+func NewGeneratorBoolAttribute(name string, a *datasource.BoolAttribute) (GeneratorBoolAttribute, error) {
+	if a == nil {
+		return GeneratorBoolAttribute{}, fmt.Errorf("*datasource.BoolAttribute is nil")
+	}
+
+	c := convert.NewComputedOptionalRequired(a.ComputedOptionalRequired)
+
+	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name)
+
+	d := convert.NewDescription(a.Description)
+
+	dm := convert.NewDeprecationMessage(a.DeprecationMessage)
+
+	s := convert.NewSensitive(a.Sensitive)
+
+	v := convert.NewValidators(convert.ValidatorTypeBool, a.Validators.CustomValidators())
+
+	return GeneratorBoolAttribute{
+		AssociatedExternalType:   schema.NewAssocExtType(a.AssociatedExternalType),
+		ComputedOptionalRequired: c,
+		CustomType:               ctp,
+		DeprecationMessage:       dm,
+		Description:              d,
+		Sensitive:                s,
+		Validators:               v,
+	}, nil
+}
+
+func (g GeneratorBoolAttribute) GeneratorSchemaType() schema.Type {
+	return schema.GeneratorBoolAttribute
+}
+
+func (g GeneratorBoolAttribute) Imports() *schema.Imports {
+	imports := schema.NewImports()
+
+	imports.Append(g.CustomType.Imports())
+
+	imports.Append(g.Validators.Imports())
+
+	if g.AssociatedExternalType != nil {
+		imports.Append(schema.AssociatedExternalTypeImports())
+	}
+
+	imports.Append(g.AssociatedExternalType.Imports())
+
+	return imports
+}
+
+func (g GeneratorBoolAttribute) Equal(ga schema.GeneratorAttribute) bool {
+	h, ok := ga.(GeneratorBoolAttribute)
+
+	if !ok {
+		return false
+	}
+
+	if !g.AssociatedExternalType.Equal(h.AssociatedExternalType) {
+		return false
+	}
+
+	if !g.ComputedOptionalRequired.Equal(h.ComputedOptionalRequired) {
+		return false
+	}
+
+	if !g.CustomType.Equal(h.CustomType) {
+		return false
+	}
+
+	if !g.DeprecationMessage.Equal(h.DeprecationMessage) {
+		return false
+	}
+
+	if !g.Description.Equal(h.Description) {
+		return false
+	}
+
+	if !g.Sensitive.Equal(h.Sensitive) {
+		return false
+	}
+
+	return g.Validators.Equal(h.Validators)
+}
+
+func (g GeneratorBoolAttribute) Schema(name schema.FrameworkIdentifier) (string, error) {
+	var b bytes.Buffer
+
+	b.WriteString(fmt.Sprintf("%q: schema.BoolAttribute{\n", name))
+	b.Write(g.CustomType.Schema())
+	b.Write(g.ComputedOptionalRequired.Schema())
+	b.Write(g.Sensitive.Schema())
+	b.Write(g.Description.Schema())
+	b.Write(g.DeprecationMessage.Schema())
+	b.Write(g.Validators.Schema())
+	b.WriteString("},")
+
+	return b.String(), nil
+}
+
+func (g GeneratorBoolAttribute) ModelField(name schema.FrameworkIdentifier) (model.Field, error) {
+	field := model.Field{
+		Name:      name.ToPascalCase(),
+		TfsdkName: name.ToString(),
+		ValueType: model.BoolValueType,
+	}
+
+	customValueType := g.CustomType.ValueType()
+
+	if customValueType != "" {
+		field.ValueType = customValueType
+	}
+
+	return field, nil
+}
+
+func (g GeneratorBoolAttribute) CustomTypeAndValue(name string, gen *schema.Generator) ([]byte, error) {
+	if g.AssociatedExternalType == nil {
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+
+	boolType := schema.NewCustomBoolType(name, gen)
+
+	b, err := boolType.Render()
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf.Write(b)
+
+	boolValue := schema.NewCustomBoolValue(name, gen)
+
+	b, err = boolValue.Render()
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf.Write(b)
+
+	return buf.Bytes(), nil
+}
+
+func (g GeneratorBoolAttribute) ToFromFunctions(name string, gen *schema.Generator) ([]byte, error) {
+	if g.AssociatedExternalType == nil {
+		return nil, nil
+	}
+
+	toFrom := schema.NewToFromBool(name, g.AssociatedExternalType, gen)
+
+	b, err := toFrom.Render()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// AttrType returns a string representation of a basetypes.BoolTypable type.
+func (g GeneratorBoolAttribute) AttrType(name schema.FrameworkIdentifier) (string, error) {
+	if g.AssociatedExternalType != nil {
+		return fmt.Sprintf("%sType{}", name.ToPascalCase()), nil
+	}
+
+	return "basetypes.BoolType{}", nil
+}
+
+// AttrValue returns a string representation of a basetypes.BoolValuable type.
+func (g GeneratorBoolAttribute) AttrValue(name schema.FrameworkIdentifier) string {
+	if g.AssociatedExternalType != nil {
+		return fmt.Sprintf("%sValue", name.ToPascalCase())
+	}
+
+	return "basetypes.BoolValue"
+}
+
+func (g GeneratorBoolAttribute) To(gen *schema.Generator) (schema.ToFromConversion, error) {
+	if g.AssociatedExternalType != nil {
+		return schema.ToFromConversion{
+			AssocExtType: g.AssociatedExternalType,
+		}, nil
+	}
+
+	return schema.ToFromConversion{
+		Default: "ValueBoolPointer",
+	}, nil
+}
+
+func (g GeneratorBoolAttribute) From(gen *schema.Generator) (schema.ToFromConversion, error) {
+	if g.AssociatedExternalType != nil {
+		return schema.ToFromConversion{
+			AssocExtType: g.AssociatedExternalType,
+		}, nil
+	}
+
+	return schema.ToFromConversion{
+		Default: "BoolPointerValue",
+	}, nil
+}
@@ -0,0 +1,662 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralresource
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	"github.com/greatman/terraform-plugin-codegen-spec/datasource"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+func TestGeneratorBoolAttribute_New(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         *datasource.BoolAttribute
+		expected      GeneratorBoolAttribute
+		expectedError error
+	}{
+		"nil": {
+			expectedError: fmt.Errorf("*datasource.BoolAttribute is nil"),
+		},
+		"computed": {
+			input: &datasource.BoolAttribute{
+				ComputedOptionalRequired: "computed",
+			},
+			expected: GeneratorBoolAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
+			},
+		},
+		"computed_optional": {
+			input: &datasource.BoolAttribute{
+				ComputedOptionalRequired: "computed_optional",
+			},
+			expected: GeneratorBoolAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.ComputedOptional),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
+			},
+		},
+		"optional": {
+			input: &datasource.BoolAttribute{
+				ComputedOptionalRequired: "optional",
+			},
+			expected: GeneratorBoolAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
+			},
+		},
+		"required": {
+			input: &datasource.BoolAttribute{
+				ComputedOptionalRequired: "required",
+			},
+			expected: GeneratorBoolAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
+			},
+		},
+		"custom_type": {
+			input: &datasource.BoolAttribute{
+				CustomType: &specschema.CustomType{
+					Import: &code.Import{
+						Path: "github.com/",
+					},
+					Type:      "my_type",
+					ValueType: "myvalue_type",
+				},
+			},
+			expected: GeneratorBoolAttribute{
+				CustomType: convert.NewCustomTypePrimitive(&specschema.CustomType{
+					Import: &code.Import{
+						Path: "github.com/",
+					},
+					Type:      "my_type",
+					ValueType: "myvalue_type",
+				}, nil, "name"),
+				Validators: convert.NewValidators(convert.ValidatorTypeBool, nil),
+			},
+		},
+		"deprecation_message": {
+			input: &datasource.BoolAttribute{
+				DeprecationMessage: pointer("deprecation message"),
+			},
+			expected: GeneratorBoolAttribute{
+				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name"),
+				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
+				Validators:         convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
+			},
+		},
+		"description": {
+			input: &datasource.BoolAttribute{
+				Description: pointer("description"),
+			},
+			expected: GeneratorBoolAttribute{
+				CustomType:  convert.NewCustomTypePrimitive(nil, nil, "name"),
+				Description: convert.NewDescription(pointer("description")),
+				Validators:  convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
+			},
+		},
+		"sensitive": {
+			input: &datasource.BoolAttribute{
+				Sensitive: pointer(true),
+			},
+			expected: GeneratorBoolAttribute{
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				Sensitive:  convert.NewSensitive(pointer(true)),
+				Validators: convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
+			},
+		},
+		"validators": {
+			input: &datasource.BoolAttribute{
+				Validators: specschema.BoolValidators{
+					{
+						Custom: &specschema.CustomValidator{
+							Imports: []code.Import{
+								{
+									Path: "github.com/.../myvalidator",
+								},
+							},
+							SchemaDefinition: "myvalidator.Validate()",
+						},
+					},
+				},
+			},
+			expected: GeneratorBoolAttribute{
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				Validators: convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{
+					&specschema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/.../myvalidator",
+							},
+						},
+						SchemaDefinition: "myvalidator.Validate()",
+					},
+				}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewGeneratorBoolAttribute("name", testCase.input)
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratorBoolAttribute_Imports(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    GeneratorBoolAttribute
+		expected []code.Import
+	}{
+		"default": {
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+			},
+		},
+		"custom-type-without-import": {
+			input: GeneratorBoolAttribute{
+				CustomType: convert.NewCustomTypePrimitive(&specschema.CustomType{}, nil, ""),
+			},
+			expected: []code.Import{},
+		},
+		"custom-type-with-import-empty-string": {
+			input: GeneratorBoolAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					&specschema.CustomType{
+						Import: &code.Import{
+							Path: "",
+						},
+					},
+					nil,
+					"",
+				),
+			},
+			expected: []code.Import{},
+		},
+		"custom-type-with-import": {
+			input: GeneratorBoolAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					&specschema.CustomType{
+						Import: &code.Import{
+							Path: "github.com/my_account/my_project/attribute",
+						},
+					},
+					nil,
+					"",
+				),
+			},
+			expected: []code.Import{
+				{
+					Path: "github.com/my_account/my_project/attribute",
+				},
+			},
+		},
+		"validator-custom-nil": {
+			input: GeneratorBoolAttribute{
+				Validators: convert.NewValidators(convert.ValidatorTypeBool, nil),
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+			},
+		},
+		"validator-custom-import-nil": {
+			input: GeneratorBoolAttribute{
+				Validators: convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{
+					&specschema.CustomValidator{},
+				}),
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+			},
+		},
+		"validator-custom-import-empty-string": {
+			input: GeneratorBoolAttribute{
+				Validators: convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{
+					&specschema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "",
+							},
+						},
+					},
+				})},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+			},
+		},
+		"validator-custom-import": {
+			input: GeneratorBoolAttribute{
+				Validators: convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{
+					&specschema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/myotherproject/myvalidators/validator",
+							},
+						},
+					},
+					&specschema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/myproject/myvalidators/validator",
+							},
+						},
+					},
+				})},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+				{
+					Path: generatorschema.ValidatorImport,
+				},
+				{
+					Path: "github.com/myotherproject/myvalidators/validator",
+				},
+				{
+					Path: "github.com/myproject/myvalidators/validator",
+				},
+			},
+		},
+		"associated-external-type": {
+			input: GeneratorBoolAttribute{
+				AssociatedExternalType: &generatorschema.AssocExtType{
+					AssociatedExternalType: &specschema.AssociatedExternalType{
+						Type: "*api.BoolAttribute",
+					},
+				},
+			},
+			expected: []code.Import{
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/types",
+				},
+				{
+					Path: "fmt",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/diag",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/attr",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-go/tftypes",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+				},
+			},
+		},
+		"associated-external-type-with-import": {
+			input: GeneratorBoolAttribute{
+				AssociatedExternalType: &generatorschema.AssocExtType{
+					AssociatedExternalType: &specschema.AssociatedExternalType{
+						Import: &code.Import{
+							Path: "github.com/api",
+						},
+						Type: "*api.BoolAttribute",
+					},
+				},
+			},
+			expected: []code.Import{
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/types",
+				},
+				{
+					Path: "fmt",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/diag",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/attr",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-go/tftypes",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+				},
+				{
+					Path: "github.com/api",
+				},
+			},
+		},
+		"associated-external-type-with-custom-type": {
+			input: GeneratorBoolAttribute{
+				AssociatedExternalType: &generatorschema.AssocExtType{
+					AssociatedExternalType: &specschema.AssociatedExternalType{
+						Import: &code.Import{
+							Path: "github.com/api",
+						},
+						Type: "*api.BoolAttribute",
+					},
+				},
+				CustomType: convert.NewCustomTypePrimitive(
+					&specschema.CustomType{
+						Import: &code.Import{
+							Path: "github.com/my_account/my_project/attribute",
+						},
+					},
+					nil,
+					"",
+				),
+			},
+			expected: []code.Import{
+				{
+					Path: "github.com/my_account/my_project/attribute",
+				},
+				{
+					Path: "fmt",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/diag",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/attr",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-go/tftypes",
+				},
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/types/basetypes",
+				},
+				{
+					Path: "github.com/api",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.Imports().All()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratorBoolAttribute_Schema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         GeneratorBoolAttribute
+		expected      string
+		expectedError error
+	}{
+		"custom-type": {
+			input: GeneratorBoolAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					&specschema.CustomType{
+						Type: "my_custom_type",
+					},
+					nil,
+					"bool_attribute",
+				),
+			},
+			expected: `"bool_attribute": schema.BoolAttribute{
+CustomType: my_custom_type,
+},`,
+		},
+
+		"associated-external-type": {
+			input: GeneratorBoolAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					nil,
+					&specschema.AssociatedExternalType{
+						Type: "*api.ExtBool",
+					},
+					"bool_attribute",
+				),
+			},
+			expected: `"bool_attribute": schema.BoolAttribute{
+CustomType: BoolAttributeType{},
+},`,
+		},
+
+		"custom-type-overriding-associated-external-type": {
+			input: GeneratorBoolAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					&specschema.CustomType{
+						Type: "my_custom_type",
+					},
+					&specschema.AssociatedExternalType{
+						Type: "*api.ExtBool",
+					},
+					"bool_attribute",
+				),
+			},
+			expected: `"bool_attribute": schema.BoolAttribute{
+CustomType: my_custom_type,
+},`,
+		},
+
+		"required": {
+			input: GeneratorBoolAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
+			},
+			expected: `"bool_attribute": schema.BoolAttribute{
+Required: true,
+},`,
+		},
+
+		"optional": {
+			input: GeneratorBoolAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+			},
+			expected: `"bool_attribute": schema.BoolAttribute{
+Optional: true,
+},`,
+		},
+
+		"computed": {
+			input: GeneratorBoolAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
+			},
+			expected: `"bool_attribute": schema.BoolAttribute{
+Computed: true,
+},`,
+		},
+
+		"sensitive": {
+			input: GeneratorBoolAttribute{
+				Sensitive: convert.NewSensitive(pointer(true)),
+			},
+			expected: `"bool_attribute": schema.BoolAttribute{
+Sensitive: true,
+},`,
+		},
+
+		// TODO: Do we need separate description and markdown description?
+		"description": {
+			input: GeneratorBoolAttribute{
+				Description: convert.NewDescription(pointer("description")),
+			},
+			expected: `"bool_attribute": schema.BoolAttribute{
+Description: "description",
+MarkdownDescription: "description",
+},`,
+		},
+
+		"deprecation-message": {
+			input: GeneratorBoolAttribute{
+				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecated")),
+			},
+			expected: `"bool_attribute": schema.BoolAttribute{
+DeprecationMessage: "deprecated",
+},`,
+		},
+
+		"validators-empty": {
+			input: GeneratorBoolAttribute{
+				Validators: convert.NewValidators(convert.ValidatorTypeBool, nil),
+			},
+			expected: `"bool_attribute": schema.BoolAttribute{
+},`,
+		},
+		"validators": {
+			input: GeneratorBoolAttribute{
+				Validators: convert.NewValidators(convert.ValidatorTypeBool, []*specschema.CustomValidator{
+					{
+						SchemaDefinition: "my_validator.Validate()",
+					},
+					{
+						SchemaDefinition: "my_other_validator.Validate()",
+					},
+				}),
+			},
+			expected: `"bool_attribute": schema.BoolAttribute{
+Validators: []validator.Bool{
+my_validator.Validate(),
+my_other_validator.Validate(),
+},
+},`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.input.Schema("bool_attribute")
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratorBoolAttribute_ModelField(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         GeneratorBoolAttribute
+		expected      model.Field
+		expectedError error
+	}{
+		"default": {
+			expected: model.Field{
+				Name:      "BoolAttribute",
+				ValueType: "types.Bool",
+				TfsdkName: "bool_attribute",
+			},
+		},
+		"custom-type": {
+			input: GeneratorBoolAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					&specschema.CustomType{
+						ValueType: "my_custom_value_type",
+					},
+					nil,
+					"",
+				),
+			},
+			expected: model.Field{
+				Name:      "BoolAttribute",
+				ValueType: "my_custom_value_type",
+				TfsdkName: "bool_attribute",
+			},
+		},
+		"associated-external-type": {
+			input: GeneratorBoolAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					nil,
+					&specschema.AssociatedExternalType{
+						Type: "*api.BoolAttribute",
+					},
+					"bool_attribute",
+				),
+			},
+			expected: model.Field{
+				Name:      "BoolAttribute",
+				ValueType: "BoolAttributeValue",
+				TfsdkName: "bool_attribute",
+			},
+		},
+		"custom-type-overriding-associated-external-type": {
+			input: GeneratorBoolAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					&specschema.CustomType{
+						ValueType: "my_custom_value_type",
+					},
+					&specschema.AssociatedExternalType{
+						Type: "*api.BoolAttribute",
+					},
+					"",
+				),
+			},
+			expected: model.Field{
+				Name:      "BoolAttribute",
+				ValueType: "my_custom_value_type",
+				TfsdkName: "bool_attribute",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.input.ModelField("bool_attribute")
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func pointer[T any](in T) *T {
+	return &in
+}
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralresource
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/datasource"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+func NewSchemas(spec extension.Specification) (map[string]generatorschema.GeneratorSchema, error) {
+	ephemeralResourceSchemas := make(map[string]generatorschema.GeneratorSchema, len(spec.EphemeralResources))
+
+	var errs []error
+
+	for i, v := range spec.EphemeralResources {
+		s, err := NewSchema(v)
+		if err != nil {
+			errs = append(errs, diagnostic.NewErrors(err, "ephemeral_resources", i)...)
+
+			continue
+		}
+
+		ephemeralResourceSchemas[v.Name] = s
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return ephemeralResourceSchemas, nil
+}
+
+func NewSchema(d extension.EphemeralResource) (generatorschema.GeneratorSchema, error) {
+	var s generatorschema.GeneratorSchema
+
+	attributes := make(generatorschema.GeneratorAttributes, len(d.Schema.Attributes))
+	blocks := make(generatorschema.GeneratorBlocks, len(d.Schema.Blocks))
+
+	var errs []error
+
+	for i, v := range d.Schema.Attributes {
+		a, err := NewAttribute(v)
+
+		if err != nil {
+			errs = append(errs, diagnostic.NewErrors(err, "schema", "attributes", i)...)
+
+			continue
+		}
+
+		attributes[v.Name] = a
+	}
+
+	s.Attributes = attributes
+
+	for i, v := range d.Schema.Blocks {
+		b, err := NewBlock(v)
+
+		if err != nil {
+			errs = append(errs, diagnostic.NewErrors(err, "schema", "blocks", i)...)
+
+			continue
+		}
+
+		blocks[v.Name] = b
+	}
+
+	s.Blocks = blocks
+
+	if len(errs) > 0 {
+		return s, errors.Join(errs...)
+	}
+
+	s.Description = d.Schema.Description
+
+	s.MarkdownDescription = d.Schema.MarkdownDescription
+
+	s.DeprecationMessage = d.Schema.DeprecationMessage
+
+	return s, nil
+}
+
+func NewAttributes(a datasource.Attributes) (generatorschema.GeneratorAttributes, error) {
+	attributes := make(generatorschema.GeneratorAttributes, len(a))
+
+	var errs []error
+
+	for i, v := range a {
+		attribute, err := NewAttribute(v)
+
+		if err != nil {
+			errs = append(errs, diagnostic.NewErrors(err, "attributes", i)...)
+
+			continue
+		}
+
+		attributes[v.Name] = attribute
+	}
+
+	if len(errs) > 0 {
+		return generatorschema.GeneratorAttributes{}, errors.Join(errs...)
+	}
+
+	return attributes, nil
+}
+
+func NewAttribute(a datasource.Attribute) (generatorschema.GeneratorAttribute, error) {
+	switch {
+	case a.Bool != nil:
+		return NewGeneratorBoolAttribute(a.Name, a.Bool)
+	case a.Dynamic != nil:
+		return NewGeneratorDynamicAttribute(a.Name, a.Dynamic)
+	case a.Float64 != nil && extension.IsFloat32(a.Float64):
+		return NewGeneratorFloat32Attribute(a.Name, a.Float64)
+	case a.Float64 != nil:
+		return NewGeneratorFloat64Attribute(a.Name, a.Float64)
+	case a.Int32 != nil:
+		return NewGeneratorInt32Attribute(a.Name, a.Int32)
+	case a.Int64 != nil:
+		return NewGeneratorInt64Attribute(a.Name, a.Int64)
+	case a.List != nil:
+		return NewGeneratorListAttribute(a.Name, a.List)
+	case a.ListNested != nil:
+		attribute, err := NewGeneratorListNestedAttribute(a.Name, a.ListNested)
+
+		return attribute, diagnostic.WithPointer(err, "list_nested", "nested_object")
+	case a.Map != nil:
+		return NewGeneratorMapAttribute(a.Name, a.Map)
+	case a.MapNested != nil:
+		attribute, err := NewGeneratorMapNestedAttribute(a.Name, a.MapNested)
+
+		return attribute, diagnostic.WithPointer(err, "map_nested", "nested_object")
+	case a.Number != nil:
+		return NewGeneratorNumberAttribute(a.Name, a.Number)
+	case a.Object != nil:
+		return NewGeneratorObjectAttribute(a.Name, a.Object)
+	case a.Set != nil:
+		return NewGeneratorSetAttribute(a.Name, a.Set)
+	case a.SetNested != nil:
+		attribute, err := NewGeneratorSetNestedAttribute(a.Name, a.SetNested)
+
+		return attribute, diagnostic.WithPointer(err, "set_nested", "nested_object")
+	case a.SingleNested != nil:
+		attribute, err := NewGeneratorSingleNestedAttribute(a.Name, a.SingleNested)
+
+		return attribute, diagnostic.WithPointer(err, "single_nested")
+	case a.String != nil:
+		return NewGeneratorStringAttribute(a.Name, a.String)
+	}
+
+	return nil, fmt.Errorf("attribute %q type not defined", a.Name)
+}
+
+func NewBlocks(b datasource.Blocks) (generatorschema.GeneratorBlocks, error) {
+	blocks := make(generatorschema.GeneratorBlocks, len(b))
+
+	var errs []error
+
+	for i, v := range b {
+		block, err := NewBlock(v)
+
+		if err != nil {
+			errs = append(errs, diagnostic.NewErrors(err, "blocks", i)...)
+
+			continue
+		}
+
+		blocks[v.Name] = block
+	}
+
+	if len(errs) > 0 {
+		return generatorschema.GeneratorBlocks{}, errors.Join(errs...)
+	}
+
+	return blocks, nil
+}
+
+func NewBlock(b datasource.Block) (generatorschema.GeneratorBlock, error) {
+	switch {
+	case b.ListNested != nil:
+		block, err := NewGeneratorListNestedBlock(b.Name, b.ListNested)
+
+		return block, diagnostic.WithPointer(err, "list_nested", "nested_object")
+	case b.SetNested != nil:
+		block, err := NewGeneratorSetNestedBlock(b.Name, b.SetNested)
+
+		return block, diagnostic.WithPointer(err, "set_nested", "nested_object")
+	case b.SingleNested != nil:
+		block, err := NewGeneratorSingleNestedBlock(b.Name, b.SingleNested)
+
+		return block, diagnostic.WithPointer(err, "single_nested")
+	}
+
+	return nil, fmt.Errorf("block %q type not defined", b.Name)
+}
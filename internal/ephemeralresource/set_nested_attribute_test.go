@@ -0,0 +1,1725 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralresource
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	"github.com/greatman/terraform-plugin-codegen-spec/datasource"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+func TestGeneratorSetNestedAttribute_New(t *testing.T) {
+	t.Parallel()
+
+	attributes, err := NewAttributes(datasource.Attributes{})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	testCases := map[string]struct {
+		input         *datasource.SetNestedAttribute
+		expected      GeneratorSetNestedAttribute
+		expectedError error
+	}{
+		"nil": {
+			expectedError: fmt.Errorf("*datasource.SetNestedAttribute is nil"),
+		},
+		"attribute-nil": {
+			input: &datasource.SetNestedAttribute{
+				NestedObject: datasource.NestedAttributeObject{
+					Attributes: []datasource.Attribute{
+						{
+							Name: "empty",
+						},
+					},
+				},
+			},
+			expectedError: errors.Join(diagnostic.NewError(errors.New(`attribute "empty" type not defined`), "attributes", 0)),
+		},
+		"attributes-bool": {
+			input: &datasource.SetNestedAttribute{
+				NestedObject: datasource.NestedAttributeObject{
+					Attributes: []datasource.Attribute{
+						{
+							Name: "bool_attribute",
+							Bool: &datasource.BoolAttribute{
+								ComputedOptionalRequired: "optional",
+							},
+						},
+					},
+				},
+			},
+			expected: GeneratorSetNestedAttribute{
+				NestedObject: GeneratorNestedAttributeObject{
+					Attributes: generatorschema.GeneratorAttributes{
+						"bool_attribute": GeneratorBoolAttribute{
+							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+							Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
+						},
+					},
+				},
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{
+						"bool_attribute": GeneratorBoolAttribute{
+							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+							Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
+						},
+					},
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
+					"name",
+				),
+				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
+			},
+		},
+		"attributes-list-bool": {
+			input: &datasource.SetNestedAttribute{
+				NestedObject: datasource.NestedAttributeObject{
+					Attributes: []datasource.Attribute{
+						{
+							Name: "list_attribute",
+							List: &datasource.ListAttribute{
+								ComputedOptionalRequired: "optional",
+								ElementType: specschema.ElementType{
+									Bool: &specschema.BoolType{},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: GeneratorSetNestedAttribute{
+				NestedObject: GeneratorNestedAttributeObject{
+					Attributes: generatorschema.GeneratorAttributes{
+						"list_attribute": GeneratorListAttribute{
+							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+							CustomType: convert.NewCustomTypeCollection(
+								nil,
+								nil,
+								convert.CustomCollectionTypeList,
+								"types.BoolType",
+								"list_attribute",
+							),
+							ElementType: specschema.ElementType{
+								Bool: &specschema.BoolType{},
+							},
+							ElementTypeCollection: convert.NewElementType(specschema.ElementType{
+								Bool: &specschema.BoolType{},
+							}),
+							Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
+						},
+					},
+				},
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{
+						"list_attribute": GeneratorListAttribute{
+							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+							CustomType: convert.NewCustomTypeCollection(
+								nil,
+								nil,
+								convert.CustomCollectionTypeList,
+								"types.BoolType",
+								"list_attribute",
+							),
+							ElementType: specschema.ElementType{
+								Bool: &specschema.BoolType{},
+							},
+							ElementTypeCollection: convert.NewElementType(specschema.ElementType{
+								Bool: &specschema.BoolType{},
+							}),
+							Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
+						},
+					},
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
+					"name",
+				),
+				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
+			},
+		},
+		"attributes-set-nested-bool": {
+			input: &datasource.SetNestedAttribute{
+				NestedObject: datasource.NestedAttributeObject{
+					Attributes: []datasource.Attribute{
+						{
+							Name: "nested_attribute",
+							SetNested: &datasource.SetNestedAttribute{
+								NestedObject: datasource.NestedAttributeObject{
+									Attributes: []datasource.Attribute{
+										{
+											Name: "nested_bool",
+											Bool: &datasource.BoolAttribute{
+												ComputedOptionalRequired: "computed",
+											},
+										},
+									},
+								},
+								ComputedOptionalRequired: "optional",
+							},
+						},
+					},
+				},
+			},
+			expected: GeneratorSetNestedAttribute{
+				NestedObject: GeneratorNestedAttributeObject{
+					Attributes: generatorschema.GeneratorAttributes{
+						"nested_attribute": GeneratorSetNestedAttribute{
+							NestedObject: GeneratorNestedAttributeObject{
+								Attributes: generatorschema.GeneratorAttributes{
+									"nested_bool": GeneratorBoolAttribute{
+										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
+									},
+								},
+							},
+							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+							NestedAttributeObject: convert.NewNestedAttributeObject(
+								generatorschema.GeneratorAttributes{
+									"nested_bool": GeneratorBoolAttribute{
+										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
+									},
+								},
+								nil,
+								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
+								"nested_attribute",
+							),
+							Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
+						},
+					},
+				},
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{
+						"nested_attribute": GeneratorSetNestedAttribute{
+							NestedObject: GeneratorNestedAttributeObject{
+								Attributes: generatorschema.GeneratorAttributes{
+									"nested_bool": GeneratorBoolAttribute{
+										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
+									},
+								},
+							},
+							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+							NestedAttributeObject: convert.NewNestedAttributeObject(
+								generatorschema.GeneratorAttributes{
+									"nested_bool": GeneratorBoolAttribute{
+										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
+									},
+								},
+								nil,
+								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
+								"nested_attribute",
+							),
+							Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
+						},
+					},
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
+					"name",
+				),
+				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
+			},
+		},
+		"attributes-object-bool": {
+			input: &datasource.SetNestedAttribute{
+				NestedObject: datasource.NestedAttributeObject{
+					Attributes: []datasource.Attribute{
+						{
+							Name: "object_attribute",
+							Object: &datasource.ObjectAttribute{
+								AttributeTypes: specschema.ObjectAttributeTypes{
+									{
+										Name: "obj_bool",
+										Bool: &specschema.BoolType{},
+									},
+								},
+								ComputedOptionalRequired: "optional",
+							},
+						},
+					},
+				},
+			},
+			expected: GeneratorSetNestedAttribute{
+				NestedObject: GeneratorNestedAttributeObject{
+					Attributes: generatorschema.GeneratorAttributes{
+						"object_attribute": GeneratorObjectAttribute{
+							AttributeTypes: specschema.ObjectAttributeTypes{
+								{
+									Name: "obj_bool",
+									Bool: &specschema.BoolType{},
+								},
+							},
+							AttributeTypesObject: convert.NewObjectAttributeTypes(specschema.ObjectAttributeTypes{
+								{
+									Name: "obj_bool",
+									Bool: &specschema.BoolType{},
+								},
+							}),
+							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute"),
+							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
+						},
+					},
+				},
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{
+						"object_attribute": GeneratorObjectAttribute{
+							AttributeTypes: specschema.ObjectAttributeTypes{
+								{
+									Name: "obj_bool",
+									Bool: &specschema.BoolType{},
+								},
+							},
+							AttributeTypesObject: convert.NewObjectAttributeTypes(specschema.ObjectAttributeTypes{
+								{
+									Name: "obj_bool",
+									Bool: &specschema.BoolType{},
+								},
+							}),
+							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute"),
+							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
+						},
+					},
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
+					"name",
+				),
+				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
+			},
+		},
+		"attributes-single-nested-bool": {
+			input: &datasource.SetNestedAttribute{
+				NestedObject: datasource.NestedAttributeObject{
+					Attributes: []datasource.Attribute{
+						{
+							Name: "nested_attribute",
+							SingleNested: &datasource.SingleNestedAttribute{
+								Attributes: []datasource.Attribute{
+									{
+										Name: "nested_bool",
+										Bool: &datasource.BoolAttribute{
+											ComputedOptionalRequired: "computed",
+										},
+									},
+								},
+								ComputedOptionalRequired: "optional",
+							},
+						},
+					},
+				},
+			},
+			expected: GeneratorSetNestedAttribute{
+				NestedObject: GeneratorNestedAttributeObject{
+					Attributes: generatorschema.GeneratorAttributes{
+						"nested_attribute": GeneratorSingleNestedAttribute{
+							Attributes: generatorschema.GeneratorAttributes{
+								"nested_bool": GeneratorBoolAttribute{
+									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
+								},
+							},
+							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+							CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute"),
+							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
+						},
+					},
+				},
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{
+						"nested_attribute": GeneratorSingleNestedAttribute{
+							Attributes: generatorschema.GeneratorAttributes{
+								"nested_bool": GeneratorBoolAttribute{
+									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
+								},
+							},
+							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+							CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute"),
+							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
+						},
+					},
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
+					"name",
+				),
+				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
+			},
+		},
+		"computed": {
+			input: &datasource.SetNestedAttribute{
+				ComputedOptionalRequired: "computed",
+			},
+			expected: GeneratorSetNestedAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
+				NestedObject: GeneratorNestedAttributeObject{
+					Attributes: attributes,
+				},
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					attributes,
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
+					"name",
+				),
+				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
+			},
+		},
+		"computed_optional": {
+			input: &datasource.SetNestedAttribute{
+				ComputedOptionalRequired: "computed_optional",
+			},
+			expected: GeneratorSetNestedAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.ComputedOptional),
+				NestedObject: GeneratorNestedAttributeObject{
+					Attributes: attributes,
+				},
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					attributes,
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
+					"name",
+				),
+				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
+			},
+		},
+		"optional": {
+			input: &datasource.SetNestedAttribute{
+				ComputedOptionalRequired: "optional",
+			},
+			expected: GeneratorSetNestedAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+				NestedObject: GeneratorNestedAttributeObject{
+					Attributes: attributes,
+				},
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					attributes,
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
+					"name",
+				),
+				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{})},
+		},
+		"required": {
+			input: &datasource.SetNestedAttribute{
+				ComputedOptionalRequired: "required",
+			},
+			expected: GeneratorSetNestedAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
+				NestedObject: GeneratorNestedAttributeObject{
+					Attributes: attributes,
+				},
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					attributes,
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
+					"name",
+				),
+				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
+			},
+		},
+		"custom_type": {
+			input: &datasource.SetNestedAttribute{
+				CustomType: &specschema.CustomType{
+					Import: &code.Import{
+						Path: "github.com/",
+					},
+					Type:      "my_type",
+					ValueType: "myvalue_type",
+				},
+			},
+			expected: GeneratorSetNestedAttribute{
+				CustomType: convert.NewCustomTypeNestedCollection(&specschema.CustomType{
+					Import: &code.Import{
+						Path: "github.com/",
+					},
+					Type:      "my_type",
+					ValueType: "myvalue_type",
+				}),
+				NestedObject: GeneratorNestedAttributeObject{
+					Attributes: attributes,
+				},
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					attributes,
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					"name",
+				),
+				Validators: convert.NewValidators(convert.ValidatorTypeSet, nil),
+			},
+		},
+		"deprecation_message": {
+			input: &datasource.SetNestedAttribute{
+				DeprecationMessage: pointer("deprecation message"),
+			},
+			expected: GeneratorSetNestedAttribute{
+				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
+				NestedObject: GeneratorNestedAttributeObject{
+					Attributes: attributes,
+				},
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					attributes,
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					"name",
+				),
+				Validators: convert.NewValidators(convert.ValidatorTypeSet, nil),
+			},
+		},
+		"description": {
+			input: &datasource.SetNestedAttribute{
+				Description: pointer("description"),
+			},
+			expected: GeneratorSetNestedAttribute{
+				Description: convert.NewDescription(pointer("description")),
+				NestedObject: GeneratorNestedAttributeObject{
+					Attributes: attributes,
+				},
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					attributes,
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					"name",
+				),
+				Validators: convert.NewValidators(convert.ValidatorTypeSet, nil),
+			},
+		},
+		"sensitive": {
+			input: &datasource.SetNestedAttribute{
+				Sensitive: pointer(true),
+			},
+			expected: GeneratorSetNestedAttribute{
+				NestedObject: GeneratorNestedAttributeObject{
+					Attributes: attributes,
+				},
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					attributes,
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					"name",
+				),
+				Sensitive:  convert.NewSensitive(pointer(true)),
+				Validators: convert.NewValidators(convert.ValidatorTypeSet, nil),
+			},
+		},
+		"validators": {
+			input: &datasource.SetNestedAttribute{
+				Validators: specschema.SetValidators{
+					{
+						Custom: &specschema.CustomValidator{
+							Imports: []code.Import{
+								{
+									Path: "github.com/.../myvalidator",
+								},
+							},
+							SchemaDefinition: "myvalidator.Validate()",
+						},
+					},
+				},
+			},
+			expected: GeneratorSetNestedAttribute{
+				NestedObject: GeneratorNestedAttributeObject{
+					Attributes: attributes,
+				},
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					attributes,
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					"name",
+				),
+				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{
+					&specschema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/.../myvalidator",
+							},
+						},
+						SchemaDefinition: "myvalidator.Validate()",
+					},
+				}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewGeneratorSetNestedAttribute("name", testCase.input)
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    GeneratorSetNestedAttribute
+		expected []code.Import
+	}{
+		"default": {
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+				{
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"custom-type-without-import": {
+			input: GeneratorSetNestedAttribute{
+				CustomType: convert.NewCustomTypeNestedCollection(
+					&specschema.CustomType{},
+				)},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				}, {
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"nested-object-custom-type-without-import": {
+			input: GeneratorSetNestedAttribute{
+				NestedObject: GeneratorNestedAttributeObject{
+					CustomType: &specschema.CustomType{},
+				},
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+				{
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"custom-type-and-nested-object-custom-type-without-import": {
+			input: GeneratorSetNestedAttribute{
+				CustomType: convert.NewCustomTypeNestedCollection(
+					&specschema.CustomType{},
+				),
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					nil,
+					&specschema.CustomType{},
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					"",
+				),
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"custom-type-with-import-empty-string": {
+			input: GeneratorSetNestedAttribute{
+				CustomType: convert.NewCustomTypeNestedCollection(
+					&specschema.CustomType{
+						Import: &code.Import{
+							Path: "",
+						},
+					},
+				),
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				}, {
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"nested-object-custom-type-with-import-empty-string": {
+			input: GeneratorSetNestedAttribute{
+				NestedObject: GeneratorNestedAttributeObject{
+					CustomType: &specschema.CustomType{
+						Import: &code.Import{
+							Path: "",
+						},
+					},
+				},
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				}, {
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"custom-type-and-nested-object-custom-type-with-import-empty-string": {
+			input: GeneratorSetNestedAttribute{
+				CustomType: convert.NewCustomTypeNestedCollection(
+					&specschema.CustomType{
+						Import: &code.Import{
+							Path: "",
+						},
+					},
+				),
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					nil,
+					&specschema.CustomType{
+						Import: &code.Import{
+							Path: "",
+						},
+					},
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					"",
+				),
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"custom-type-with-import": {
+			input: GeneratorSetNestedAttribute{
+				CustomType: convert.NewCustomTypeNestedCollection(
+					&specschema.CustomType{
+						Import: &code.Import{
+							Path: "github.com/my_account/my_project/attribute",
+						},
+					},
+				),
+			},
+			expected: []code.Import{
+				{
+					Path: "github.com/my_account/my_project/attribute",
+				},
+				{
+					Path: generatorschema.TypesImport,
+				},
+				{
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"nested-object-custom-type-with-import": {
+			input: GeneratorSetNestedAttribute{
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					nil,
+					&specschema.CustomType{
+						Import: &code.Import{
+							Path: "github.com/my_account/my_project/attribute",
+						},
+					},
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					"",
+				),
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+				{
+					Path: "github.com/my_account/my_project/attribute",
+				},
+				{
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"custom-type-with-import-with-nested-object-custom-type-with-import": {
+			input: GeneratorSetNestedAttribute{
+				CustomType: convert.NewCustomTypeNestedCollection(
+					&specschema.CustomType{
+						Import: &code.Import{
+							Path: "github.com/my_account/my_project/attribute",
+						},
+					},
+				),
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					nil,
+					&specschema.CustomType{
+						Import: &code.Import{
+							Path: "github.com/my_account/my_project/nested_object",
+						},
+					},
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					"",
+				),
+			},
+			expected: []code.Import{
+				{
+					Path: "github.com/my_account/my_project/attribute",
+				},
+				{
+					Path: "github.com/my_account/my_project/nested_object",
+				}, {
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"nested-list": {
+			input: GeneratorSetNestedAttribute{
+				NestedObject: GeneratorNestedAttributeObject{
+					Attributes: generatorschema.GeneratorAttributes{
+						"list": GeneratorListAttribute{
+							ElementType: specschema.ElementType{
+								Bool: &specschema.BoolType{},
+							},
+						},
+					},
+				},
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				}, {
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"nested-list-with-custom-type": {
+			input: GeneratorSetNestedAttribute{
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{
+						"list": GeneratorListAttribute{
+							CustomType: convert.NewCustomTypeCollection(
+								&specschema.CustomType{
+									Import: &code.Import{
+										Path: "github.com/my_account/my_project/nested_list",
+									},
+								},
+								nil,
+								convert.CustomCollectionTypeList,
+								"",
+								"",
+							),
+						},
+					},
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					"",
+				),
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+				{
+					Path: "github.com/my_account/my_project/nested_list",
+				},
+				{
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"nested-list-with-custom-type-with-element-with-custom-type": {
+			input: GeneratorSetNestedAttribute{
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{
+						"list": GeneratorListAttribute{
+							CustomType: convert.NewCustomTypeCollection(
+								&specschema.CustomType{
+									Import: &code.Import{
+										Path: "github.com/my_account/my_project/nested_list",
+									},
+								},
+								nil,
+								convert.CustomCollectionTypeList,
+								"",
+								"",
+							),
+							ElementTypeCollection: convert.NewElementType(specschema.ElementType{
+								Bool: &specschema.BoolType{
+									CustomType: &specschema.CustomType{
+										Import: &code.Import{
+											Path: "github.com/my_account/my_project/bool",
+										},
+									},
+								},
+							}),
+						},
+					},
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					"",
+				),
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+				{
+					Path: "github.com/my_account/my_project/nested_list",
+				},
+				{
+					Path: "github.com/my_account/my_project/bool",
+				},
+				{
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"nested-object": {
+			input: GeneratorSetNestedAttribute{
+				NestedObject: GeneratorNestedAttributeObject{
+					Attributes: generatorschema.GeneratorAttributes{
+						"obj": GeneratorObjectAttribute{
+							AttributeTypes: specschema.ObjectAttributeTypes{
+								{
+									Name: "bool",
+									Bool: &specschema.BoolType{},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+				{
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"nested-object-with-custom-type": {
+			input: GeneratorSetNestedAttribute{
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{
+						"obj": GeneratorObjectAttribute{
+							CustomType: convert.NewCustomTypeObject(
+								&specschema.CustomType{
+									Import: &code.Import{
+										Path: "github.com/my_account/my_project/nested_object",
+									},
+								},
+								nil,
+								"",
+							),
+						},
+					},
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					"",
+				),
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+				{
+					Path: "github.com/my_account/my_project/nested_object",
+				},
+				{
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"nested-object-with-custom-type-with-attribute-with-custom-type": {
+			input: GeneratorSetNestedAttribute{
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{
+						"obj": GeneratorObjectAttribute{
+							AttributeTypesObject: convert.NewObjectAttributeTypes(specschema.ObjectAttributeTypes{
+								{
+									Name: "bool",
+									Bool: &specschema.BoolType{
+										CustomType: &specschema.CustomType{
+											Import: &code.Import{
+												Path: "github.com/my_account/my_project/bool",
+											},
+										},
+									},
+								},
+							}),
+							CustomType: convert.NewCustomTypeObject(
+								&specschema.CustomType{
+									Import: &code.Import{
+										Path: "github.com/my_account/my_project/nested_object",
+									},
+								},
+								nil,
+								"",
+							),
+						},
+					},
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					"",
+				),
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+				{
+					Path: "github.com/my_account/my_project/nested_object",
+				},
+				{
+					Path: "github.com/my_account/my_project/bool",
+				},
+				{
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"validator-custom-nil": {
+			input: GeneratorSetNestedAttribute{
+				Validators: convert.NewValidators(convert.ValidatorTypeSet, nil),
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+				{
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"validator-custom-import-nil": {
+			input: GeneratorSetNestedAttribute{
+				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{
+					&specschema.CustomValidator{},
+				}),
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+				{
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"validator-custom-import-empty-string": {
+			input: GeneratorSetNestedAttribute{
+				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{
+					&specschema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "",
+							},
+						},
+					},
+				}),
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+				{
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"validator-custom-import": {
+			input: GeneratorSetNestedAttribute{
+				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{
+					&specschema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/myotherproject/myvalidators/validator",
+							},
+						},
+					},
+					&specschema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/myproject/myvalidators/validator",
+							},
+						},
+					},
+				}),
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+				{
+					Path: generatorschema.ValidatorImport,
+				},
+				{
+					Path: "github.com/myotherproject/myvalidators/validator",
+				},
+				{
+					Path: "github.com/myproject/myvalidators/validator",
+				},
+				{
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"nested-object-validator-custom-nil": {
+			input: GeneratorSetNestedAttribute{
+				NestedObject: GeneratorNestedAttributeObject{
+					Validators: specschema.ObjectValidators{
+						{
+							Custom: nil,
+						},
+					},
+				},
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+				{
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"nested-object-validator-custom-import-nil": {
+			input: GeneratorSetNestedAttribute{
+				NestedObject: GeneratorNestedAttributeObject{
+					Validators: specschema.ObjectValidators{
+						{
+							Custom: &specschema.CustomValidator{},
+						},
+					},
+				},
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+				{
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"nested-object-validator-custom-import-empty-string": {
+			input: GeneratorSetNestedAttribute{
+				NestedObject: GeneratorNestedAttributeObject{
+					Validators: specschema.ObjectValidators{
+						{
+							Custom: &specschema.CustomValidator{
+								Imports: []code.Import{
+									{
+										Path: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+				{
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+		"nested-object-validator-custom-import": {
+			input: GeneratorSetNestedAttribute{
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					nil,
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{
+						&specschema.CustomValidator{
+							Imports: []code.Import{
+								{
+									Path: "github.com/myotherproject/myvalidators/validator",
+								},
+							},
+						},
+						&specschema.CustomValidator{
+							Imports: []code.Import{
+								{
+									Path: "github.com/myproject/myvalidators/validator",
+								},
+							},
+						},
+					}),
+					"",
+				),
+			},
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+				{
+					Path: generatorschema.ValidatorImport,
+				},
+				{
+					Path: "github.com/myotherproject/myvalidators/validator",
+				},
+				{
+					Path: "github.com/myproject/myvalidators/validator",
+				},
+				{
+					Path: generatorschema.AttrImport,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.Imports().All()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratorSetNestedAttribute_Schema(t *testing.T) {
+	t.Parallel()
+
+	attributeName := "set_nested_attribute"
+
+	testCases := map[string]struct {
+		input         GeneratorSetNestedAttribute
+		expected      string
+		expectedError error
+	}{
+		"attribute-bool": {
+			input: GeneratorSetNestedAttribute{
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{
+						"bool": GeneratorBoolAttribute{
+							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+						},
+					},
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					attributeName,
+				),
+			},
+			expected: `"set_nested_attribute": schema.SetNestedAttribute{
+NestedObject: schema.NestedAttributeObject{
+Attributes: map[string]schema.Attribute{
+"bool": schema.BoolAttribute{
+Optional: true,
+},
+},
+CustomType: SetNestedAttributeType{
+ObjectType: types.ObjectType{
+AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
+},
+},
+},
+},`,
+		},
+
+		"attribute-list": {
+			input: GeneratorSetNestedAttribute{
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{
+						"list": GeneratorListAttribute{
+							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+							ElementTypeCollection: convert.NewElementType(specschema.ElementType{
+								String: &specschema.StringType{},
+							}),
+						},
+					},
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					attributeName,
+				),
+			},
+			expected: `"set_nested_attribute": schema.SetNestedAttribute{
+NestedObject: schema.NestedAttributeObject{
+Attributes: map[string]schema.Attribute{
+"list": schema.ListAttribute{
+ElementType: types.StringType,
+Optional: true,
+},
+},
+CustomType: SetNestedAttributeType{
+ObjectType: types.ObjectType{
+AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
+},
+},
+},
+},`,
+		},
+
+		"attribute-set-nested": {
+			input: GeneratorSetNestedAttribute{
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{
+						"nested_set_nested": GeneratorSetNestedAttribute{
+							NestedAttributeObject: convert.NewNestedAttributeObject(
+								generatorschema.GeneratorAttributes{
+									"bool": GeneratorBoolAttribute{
+										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+									},
+								},
+								nil,
+								convert.NewValidators(convert.ValidatorTypeObject, nil),
+								"nested_set_nested",
+							),
+						},
+					},
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					attributeName,
+				),
+			},
+			expected: `"set_nested_attribute": schema.SetNestedAttribute{
+NestedObject: schema.NestedAttributeObject{
+Attributes: map[string]schema.Attribute{
+"nested_set_nested": schema.SetNestedAttribute{
+NestedObject: schema.NestedAttributeObject{
+Attributes: map[string]schema.Attribute{
+"bool": schema.BoolAttribute{
+Optional: true,
+},
+},
+CustomType: NestedSetNestedType{
+ObjectType: types.ObjectType{
+AttrTypes: NestedSetNestedValue{}.AttributeTypes(ctx),
+},
+},
+},
+},
+},
+CustomType: SetNestedAttributeType{
+ObjectType: types.ObjectType{
+AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
+},
+},
+},
+},`,
+		},
+
+		"attribute-object": {
+			input: GeneratorSetNestedAttribute{
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{
+						"object": GeneratorObjectAttribute{
+							AttributeTypesObject: convert.NewObjectAttributeTypes(specschema.ObjectAttributeTypes{
+								{
+									Name:   "str",
+									String: &specschema.StringType{},
+								},
+							}),
+							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+						},
+					},
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					attributeName,
+				),
+			},
+			expected: `"set_nested_attribute": schema.SetNestedAttribute{
+NestedObject: schema.NestedAttributeObject{
+Attributes: map[string]schema.Attribute{
+"object": schema.ObjectAttribute{
+AttributeTypes: map[string]attr.Type{
+"str": types.StringType,
+},
+Optional: true,
+},
+},
+CustomType: SetNestedAttributeType{
+ObjectType: types.ObjectType{
+AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
+},
+},
+},
+},`,
+		},
+
+		"attribute-single-nested-bool": {
+			input: GeneratorSetNestedAttribute{
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{
+						"nested_single_nested": GeneratorSingleNestedAttribute{
+							Attributes: generatorschema.GeneratorAttributes{
+								"bool": GeneratorBoolAttribute{
+									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+								},
+							},
+							CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested"),
+						},
+					},
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					attributeName,
+				),
+			},
+			expected: `"set_nested_attribute": schema.SetNestedAttribute{
+NestedObject: schema.NestedAttributeObject{
+Attributes: map[string]schema.Attribute{
+"nested_single_nested": schema.SingleNestedAttribute{
+Attributes: map[string]schema.Attribute{
+"bool": schema.BoolAttribute{
+Optional: true,
+},
+},
+CustomType: NestedSingleNestedType{
+ObjectType: types.ObjectType{
+AttrTypes: NestedSingleNestedValue{}.AttributeTypes(ctx),
+},
+},
+},
+},
+CustomType: SetNestedAttributeType{
+ObjectType: types.ObjectType{
+AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
+},
+},
+},
+},`,
+		},
+
+		"custom-type": {
+			input: GeneratorSetNestedAttribute{
+				CustomType: convert.NewCustomTypeNestedCollection(&specschema.CustomType{
+					Type: "my_custom_type",
+				}),
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{},
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					attributeName,
+				),
+			},
+			expected: `"set_nested_attribute": schema.SetNestedAttribute{
+NestedObject: schema.NestedAttributeObject{
+Attributes: map[string]schema.Attribute{
+},
+CustomType: SetNestedAttributeType{
+ObjectType: types.ObjectType{
+AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
+},
+},
+},
+CustomType: my_custom_type,
+},`,
+		},
+
+		"required": {
+			input: GeneratorSetNestedAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{},
+					nil,
+					convert.Validators{},
+					attributeName,
+				),
+			},
+			expected: `"set_nested_attribute": schema.SetNestedAttribute{
+NestedObject: schema.NestedAttributeObject{
+Attributes: map[string]schema.Attribute{
+},
+CustomType: SetNestedAttributeType{
+ObjectType: types.ObjectType{
+AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
+},
+},
+},
+Required: true,
+},`,
+		},
+
+		"optional": {
+			input: GeneratorSetNestedAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{},
+					nil,
+					convert.Validators{},
+					attributeName,
+				),
+			},
+			expected: `"set_nested_attribute": schema.SetNestedAttribute{
+NestedObject: schema.NestedAttributeObject{
+Attributes: map[string]schema.Attribute{
+},
+CustomType: SetNestedAttributeType{
+ObjectType: types.ObjectType{
+AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
+},
+},
+},
+Optional: true,
+},`,
+		},
+
+		"computed": {
+			input: GeneratorSetNestedAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{},
+					nil,
+					convert.Validators{},
+					attributeName,
+				),
+			},
+			expected: `"set_nested_attribute": schema.SetNestedAttribute{
+NestedObject: schema.NestedAttributeObject{
+Attributes: map[string]schema.Attribute{
+},
+CustomType: SetNestedAttributeType{
+ObjectType: types.ObjectType{
+AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
+},
+},
+},
+Computed: true,
+},`,
+		},
+
+		"sensitive": {
+			input: GeneratorSetNestedAttribute{
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{},
+					nil,
+					convert.Validators{},
+					attributeName,
+				),
+				Sensitive: convert.NewSensitive(pointer(true)),
+			},
+			expected: `"set_nested_attribute": schema.SetNestedAttribute{
+NestedObject: schema.NestedAttributeObject{
+Attributes: map[string]schema.Attribute{
+},
+CustomType: SetNestedAttributeType{
+ObjectType: types.ObjectType{
+AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
+},
+},
+},
+Sensitive: true,
+},`,
+		},
+
+		"description": {
+			input: GeneratorSetNestedAttribute{
+				Description: convert.NewDescription(pointer("description")),
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{},
+					nil,
+					convert.Validators{},
+					attributeName,
+				),
+			},
+			expected: `"set_nested_attribute": schema.SetNestedAttribute{
+NestedObject: schema.NestedAttributeObject{
+Attributes: map[string]schema.Attribute{
+},
+CustomType: SetNestedAttributeType{
+ObjectType: types.ObjectType{
+AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
+},
+},
+},
+Description: "description",
+MarkdownDescription: "description",
+},`,
+		},
+
+		"deprecation-message": {
+			input: GeneratorSetNestedAttribute{
+				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecated")),
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{},
+					nil,
+					convert.Validators{},
+					attributeName,
+				),
+			},
+			expected: `"set_nested_attribute": schema.SetNestedAttribute{
+NestedObject: schema.NestedAttributeObject{
+Attributes: map[string]schema.Attribute{
+},
+CustomType: SetNestedAttributeType{
+ObjectType: types.ObjectType{
+AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
+},
+},
+},
+DeprecationMessage: "deprecated",
+},`,
+		},
+
+		"validators": {
+			input: GeneratorSetNestedAttribute{
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{},
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					attributeName,
+				),
+				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{
+					&specschema.CustomValidator{
+						SchemaDefinition: "my_validator.Validate()",
+					},
+					&specschema.CustomValidator{
+						SchemaDefinition: "my_other_validator.Validate()",
+					},
+				}),
+			},
+			expected: `"set_nested_attribute": schema.SetNestedAttribute{
+NestedObject: schema.NestedAttributeObject{
+Attributes: map[string]schema.Attribute{
+},
+CustomType: SetNestedAttributeType{
+ObjectType: types.ObjectType{
+AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
+},
+},
+},
+Validators: []validator.Set{
+my_validator.Validate(),
+my_other_validator.Validate(),
+},
+},`,
+		},
+
+		"nested-object-custom-type": {
+			input: GeneratorSetNestedAttribute{
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{},
+					&specschema.CustomType{
+						Type: "my_custom_type",
+					},
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					attributeName,
+				),
+			},
+			expected: `"set_nested_attribute": schema.SetNestedAttribute{
+NestedObject: schema.NestedAttributeObject{
+Attributes: map[string]schema.Attribute{
+},
+CustomType: my_custom_type,
+},
+},`,
+		},
+
+		"nested-object-validators": {
+			input: GeneratorSetNestedAttribute{
+				NestedAttributeObject: convert.NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{},
+					nil,
+					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{
+						&specschema.CustomValidator{
+							SchemaDefinition: "my_validator.Validate()",
+						},
+						&specschema.CustomValidator{
+							SchemaDefinition: "my_other_validator.Validate()",
+						},
+					}),
+					attributeName,
+				),
+			},
+			expected: `"set_nested_attribute": schema.SetNestedAttribute{
+NestedObject: schema.NestedAttributeObject{
+Attributes: map[string]schema.Attribute{
+},
+CustomType: SetNestedAttributeType{
+ObjectType: types.ObjectType{
+AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
+},
+},
+Validators: []validator.Object{
+my_validator.Validate(),
+my_other_validator.Validate(),
+},
+},
+},`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.input.Schema("set_nested_attribute")
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratorSetNestedAttribute_ModelField(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         GeneratorSetNestedAttribute
+		expected      model.Field
+		expectedError error
+	}{
+		"default": {
+			expected: model.Field{
+				Name:      "SetNestedAttribute",
+				ValueType: "types.Set",
+				TfsdkName: "set_nested_attribute",
+			},
+		},
+		"custom-type": {
+			input: GeneratorSetNestedAttribute{
+				CustomType: convert.NewCustomTypeNestedCollection(
+					&specschema.CustomType{
+						ValueType: "my_custom_value_type",
+					},
+				),
+			},
+			expected: model.Field{
+				Name:      "SetNestedAttribute",
+				ValueType: "my_custom_value_type",
+				TfsdkName: "set_nested_attribute",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.input.ModelField("set_nested_attribute")
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
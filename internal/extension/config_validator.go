@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package extension
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+)
+
+// ConfigValidatorsValidateRequest defines the Path of the config validators
+// that are being validated.
+type ConfigValidatorsValidateRequest struct {
+	Path string
+}
+
+// ConfigValidators type defines ConfigValidator types.
+type ConfigValidators []ConfigValidator
+
+// Validate delegates to ConfigValidator.Validate for each config validator.
+// Errors are prefixed with the index of the config validator.
+func (cs ConfigValidators) Validate(ctx context.Context, req ConfigValidatorsValidateRequest) error {
+	var errs []error
+
+	for i, c := range cs {
+		err := c.Validate(ctx, ConfigValidatorValidateRequest{
+			Path: fmt.Sprintf("%s config validator %d", req.Path, i),
+		})
+
+		if err != nil {
+			errs = append(errs, diagnostic.NewErrors(err, i)...)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ConfigValidatorValidateRequest defines the Path of the config validator
+// that is being validated.
+type ConfigValidatorValidateRequest struct {
+	Path string
+}
+
+// ConfigValidator defines a validator of the configuration of a resource or
+// data source, which references attributes and blocks by path, such as
+// "block.attribute". Only one of the validators is expected to be set.
+type ConfigValidator struct {
+	AtLeastOneOf     []string `json:"at_least_one_of,omitempty"`
+	Conflicting      []string `json:"conflicting,omitempty"`
+	ExactlyOneOf     []string `json:"exactly_one_of,omitempty"`
+	RequiredTogether []string `json:"required_together,omitempty"`
+}
+
+// configValidatorType defines the name of the resourcevalidator and
+// datasourcevalidator function, the property name and the paths of one of
+// the validators.
+type configValidatorType struct {
+	function string
+	property string
+	paths    []string
+}
+
+// types returns each of the validators, whether or not it is set.
+func (c ConfigValidator) types() []configValidatorType {
+	return []configValidatorType{
+		{"AtLeastOneOf", "at_least_one_of", c.AtLeastOneOf},
+		{"Conflicting", "conflicting", c.Conflicting},
+		{"ExactlyOneOf", "exactly_one_of", c.ExactlyOneOf},
+		{"RequiredTogether", "required_together", c.RequiredTogether},
+	}
+}
+
+// Validator returns the name of the resourcevalidator and
+// datasourcevalidator function, and the paths, of whichever of the
+// validators is set.
+func (c ConfigValidator) Validator() (string, []string) {
+	for _, v := range c.types() {
+		if v.paths != nil {
+			return v.function, v.paths
+		}
+	}
+
+	return "", nil
+}
+
+// Property returns the property name of whichever of the validators is set,
+// such as exactly_one_of, which is the key of its paths within the document.
+func (c ConfigValidator) Property() string {
+	for _, v := range c.types() {
+		if v.paths != nil {
+			return v.property
+		}
+	}
+
+	return ""
+}
+
+// Validate checks that exactly one validator is set, and that it references
+// at least two distinct paths. Errors are prefixed with the JSON pointer of
+// the validator, or of the path, within the config validator.
+func (c ConfigValidator) Validate(ctx context.Context, req ConfigValidatorValidateRequest) error {
+	var count int
+
+	for _, v := range c.types() {
+		if v.paths != nil {
+			count++
+		}
+	}
+
+	if count != 1 {
+		return fmt.Errorf("%s must define exactly one of at_least_one_of, conflicting, exactly_one_of or required_together, got %d", req.Path, count)
+	}
+
+	_, paths := c.Validator()
+
+	name := c.Property()
+
+	var errs []error
+
+	if len(paths) < 2 {
+		errs = append(errs, diagnostic.NewError(fmt.Errorf("%s must reference at least two paths", req.Path), name))
+	}
+
+	for i, p := range paths {
+		if p == "" {
+			errs = append(errs, diagnostic.NewError(fmt.Errorf("%s path %d must not be empty", req.Path, i), name, i))
+		} else if slices.Contains(paths[:i], p) {
+			errs = append(errs, diagnostic.NewError(fmt.Errorf("%s path %q is duplicated", req.Path, p), name, i))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package extension
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+)
+
+// Constraints defines declarative validation of an attribute value, which is
+// generated as terraform-plugin-framework-validators validators, in place of
+// custom validators.
+type Constraints struct {
+	// MinLength and MaxLength define the length of string values.
+	MinLength *int64 `json:"min_length,omitempty"`
+	MaxLength *int64 `json:"max_length,omitempty"`
+
+	// Regex defines a regular expression which string values must match.
+	Regex *RegexConstraint `json:"regex,omitempty"`
+
+	// OneOf defines the allowed string or numeric values.
+	OneOf []json.RawMessage `json:"one_of,omitempty"`
+
+	// Min and Max define the inclusive range of numeric values.
+	Min *json.Number `json:"min,omitempty"`
+	Max *json.Number `json:"max,omitempty"`
+
+	// MinItems and MaxItems define the number of elements of list, map and
+	// set values.
+	MinItems *int64 `json:"min_items,omitempty"`
+	MaxItems *int64 `json:"max_items,omitempty"`
+
+	// ConflictsWith and AlsoRequires define the names of sibling attributes
+	// which must not, or must, be configured alongside the attribute.
+	ConflictsWith []string `json:"conflicts_with,omitempty"`
+	AlsoRequires  []string `json:"also_requires,omitempty"`
+}
+
+// RegexConstraint defines a regular expression and the message which is
+// returned if a value does not match.
+type RegexConstraint struct {
+	Pattern string `json:"pattern"`
+	Message string `json:"message,omitempty"`
+}
+
+// constraintTypes defines the constraints which are supported for each
+// attribute type.
+var constraintTypes = map[string][]string{
+	"bool":    {"conflicts_with", "also_requires"},
+	"float32": {"min", "max", "one_of", "conflicts_with", "also_requires"},
+	"float64": {"min", "max", "one_of", "conflicts_with", "also_requires"},
+	"int32":   {"min", "max", "one_of", "conflicts_with", "also_requires"},
+	"int64":   {"min", "max", "one_of", "conflicts_with", "also_requires"},
+	"list":    {"min_items", "max_items", "conflicts_with", "also_requires"},
+	"map":     {"min_items", "max_items", "conflicts_with", "also_requires"},
+	"number":  {"conflicts_with", "also_requires"},
+	"object":  {"conflicts_with", "also_requires"},
+	"set":     {"min_items", "max_items", "conflicts_with", "also_requires"},
+	"string":  {"min_length", "max_length", "regex", "one_of", "conflicts_with", "also_requires"},
+}
+
+// ConstraintsValidateRequest defines the Path, Name and AttributeType of the
+// attribute whose constraints are being validated.
+type ConstraintsValidateRequest struct {
+	Path          string
+	Name          string
+	AttributeType string
+}
+
+// Validate checks that each of the constraints is supported for the
+// attribute type, and that the values of the constraints are valid for the
+// attribute type. Errors are prefixed with the JSON pointer of the constraint.
+func (c Constraints) Validate(ctx context.Context, req ConstraintsValidateRequest) error {
+	var errs []error
+
+	for _, name := range c.names() {
+		if !slices.Contains(constraintTypes[req.AttributeType], name) {
+			errs = append(errs, diagnostic.NewError(fmt.Errorf("%s constraint %s is not supported for %s attributes", req.Path, name, req.AttributeType), name))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	errs = append(errs, validateConstraintRange(req.Path, "min_length", "max_length", c.MinLength, c.MaxLength)...)
+	errs = append(errs, validateConstraintRange(req.Path, "min_items", "max_items", c.MinItems, c.MaxItems)...)
+
+	if c.Regex != nil {
+		if c.Regex.Pattern == "" {
+			errs = append(errs, diagnostic.NewError(fmt.Errorf("%s constraint regex must define pattern", req.Path), "regex"))
+		} else if _, err := regexp.Compile(c.Regex.Pattern); err != nil {
+			errs = append(errs, diagnostic.NewError(fmt.Errorf("%s constraint regex is invalid: %w", req.Path, err), "regex", "pattern"))
+		}
+	}
+
+	for i, v := range c.OneOf {
+		if _, err := ConstraintValue(req.AttributeType, v); err != nil {
+			errs = append(errs, diagnostic.NewError(fmt.Errorf("%s constraint one_of[%d] %w", req.Path, i, err), "one_of", i))
+		}
+	}
+
+	var minimum, maximum *float64
+
+	for _, n := range []struct {
+		name   string
+		value  *json.Number
+		parsed **float64
+	}{
+		{"min", c.Min, &minimum},
+		{"max", c.Max, &maximum},
+	} {
+		if n.value == nil {
+			continue
+		}
+
+		if _, err := ConstraintValue(req.AttributeType, json.RawMessage(n.value.String())); err != nil {
+			errs = append(errs, diagnostic.NewError(fmt.Errorf("%s constraint %s %w", req.Path, n.name, err), n.name))
+
+			continue
+		}
+
+		f, _ := n.value.Float64()
+		*n.parsed = &f
+	}
+
+	if minimum != nil && maximum != nil && *minimum > *maximum {
+		errs = append(errs, diagnostic.NewError(fmt.Errorf("%s constraint min must not be greater than max", req.Path), "min"))
+	}
+
+	for _, paths := range []struct {
+		name  string
+		names []string
+	}{
+		{"conflicts_with", c.ConflictsWith},
+		{"also_requires", c.AlsoRequires},
+	} {
+		for i, name := range paths.names {
+			switch name {
+			case "":
+				errs = append(errs, diagnostic.NewError(fmt.Errorf("%s constraint %s must not contain an empty attribute name", req.Path, paths.name), paths.name, i))
+			case req.Name:
+				errs = append(errs, diagnostic.NewError(fmt.Errorf("%s constraint %s cannot reference the attribute itself", req.Path, paths.name), paths.name, i))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// names returns the names of the constraints which are set.
+func (c Constraints) names() []string {
+	var names []string
+
+	for _, v := range []struct {
+		name string
+		set  bool
+	}{
+		{"min_length", c.MinLength != nil},
+		{"max_length", c.MaxLength != nil},
+		{"regex", c.Regex != nil},
+		{"one_of", len(c.OneOf) > 0},
+		{"min", c.Min != nil},
+		{"max", c.Max != nil},
+		{"min_items", c.MinItems != nil},
+		{"max_items", c.MaxItems != nil},
+		{"conflicts_with", len(c.ConflictsWith) > 0},
+		{"also_requires", len(c.AlsoRequires) > 0},
+	} {
+		if v.set {
+			names = append(names, v.name)
+		}
+	}
+
+	return names
+}
+
+func validateConstraintRange(path, minName, maxName string, minimum, maximum *int64) []error {
+	var errs []error
+
+	if minimum != nil && *minimum < 0 {
+		errs = append(errs, diagnostic.NewError(fmt.Errorf("%s constraint %s must not be negative", path, minName), minName))
+	}
+
+	if maximum != nil && *maximum < 0 {
+		errs = append(errs, diagnostic.NewError(fmt.Errorf("%s constraint %s must not be negative", path, maxName), maxName))
+	}
+
+	if minimum != nil && maximum != nil && *minimum > *maximum {
+		errs = append(errs, diagnostic.NewError(fmt.Errorf("%s constraint %s must not be greater than %s", path, minName, maxName), minName))
+	}
+
+	return errs
+}
+
+// ConstraintValue returns the Go literal of the supplied JSON value, once it
+// has been checked against the attribute type.
+func ConstraintValue(attributeType string, v json.RawMessage) (string, error) {
+	switch attributeType {
+	case "string":
+		var s string
+
+		if err := json.Unmarshal(v, &s); err != nil {
+			return "", errors.New("must be a string")
+		}
+
+		return strconv.Quote(s), nil
+	case "float32", "float64", "int32", "int64":
+		var n json.Number
+
+		if err := json.Unmarshal(v, &n); err != nil || len(v) == 0 || v[0] == '"' {
+			return "", errors.New("must be a number")
+		}
+
+		var err error
+
+		switch attributeType {
+		case "float32":
+			_, err = strconv.ParseFloat(n.String(), 32)
+		case "float64":
+			_, err = strconv.ParseFloat(n.String(), 64)
+		case "int32":
+			_, err = strconv.ParseInt(n.String(), 10, 32)
+		case "int64":
+			_, err = strconv.ParseInt(n.String(), 10, 64)
+		}
+
+		if err != nil {
+			return "", fmt.Errorf("is not a valid %s: %w", attributeType, err)
+		}
+
+		return n.String(), nil
+	}
+
+	return "", fmt.Errorf("is not supported for %s attributes", attributeType)
+}
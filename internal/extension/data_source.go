@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package extension
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+)
+
+// DataSourcesValidateRequest defines the request sent during validation of DataSources.
+type DataSourcesValidateRequest struct{}
+
+// DataSources type defines DataSource types.
+type DataSources []DataSource
+
+// Get returns the DataSource with the given name, if present.
+func (ds DataSources) Get(name string) (DataSource, bool) {
+	for _, d := range ds {
+		if d.Name == name {
+			return d, true
+		}
+	}
+
+	return DataSource{}, false
+}
+
+// Validate checks for duplicated data source names and delegates to
+// DataSource.Validate for each data source. Errors are prefixed with the
+// JSON pointer of the data source.
+func (ds DataSources) Validate(ctx context.Context, req DataSourcesValidateRequest) error {
+	dataSourceNames := make(map[string]struct{}, len(ds))
+
+	var errs, nestedErrs []error
+
+	for i, d := range ds {
+		if _, ok := dataSourceNames[d.Name]; ok {
+			errs = append(errs, diagnostic.NewError(fmt.Errorf("data source %q is duplicated", d.Name), "datasources", i, "name"))
+		}
+
+		dataSourceNames[d.Name] = struct{}{}
+
+		validateRequest := DataSourceValidateRequest{
+			Path: fmt.Sprintf("data source %q", d.Name),
+		}
+
+		err := d.Validate(ctx, validateRequest)
+
+		if err != nil {
+			nestedErrs = append(nestedErrs, diagnostic.NewErrors(err, "datasources", i)...)
+		}
+	}
+
+	e := append(errs, nestedErrs...)
+
+	return errors.Join(e...)
+}
+
+// DataSourceValidateRequest defines the Path of the data source that is
+// being validated.
+type DataSourceValidateRequest struct {
+	Path string
+}
+
+// DataSource defines the additions to an individual data source.
+type DataSource struct {
+	// Name is the string identifier for the data source.
+	Name string `json:"name"`
+
+	// Schema defines the additions to the Attributes and Blocks for the data source.
+	Schema *Schema `json:"schema,omitempty"`
+
+	// ConfigValidators defines the validators of the configuration of the
+	// data source, which reference attributes and blocks by path.
+	ConfigValidators ConfigValidators `json:"config_validators,omitempty"`
+}
+
+// Validate delegates to Schema.Validate and ConfigValidators.Validate.
+func (d DataSource) Validate(ctx context.Context, req DataSourceValidateRequest) error {
+	var errs []error
+
+	if d.Schema != nil {
+		err := d.Schema.Validate(ctx, SchemaValidateRequest{
+			Path: req.Path,
+		})
+
+		if err != nil {
+			errs = append(errs, diagnostic.NewErrors(err, "schema")...)
+		}
+	}
+
+	if err := d.ConfigValidators.Validate(ctx, ConfigValidatorsValidateRequest(req)); err != nil {
+		errs = append(errs, diagnostic.NewErrors(err, "config_validators")...)
+	}
+
+	return errors.Join(errs...)
+}
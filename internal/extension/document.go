@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package extension
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+)
+
+// resourceAttributePropertyKeys are the additions to the properties of
+// resource attributes.
+var resourceAttributePropertyKeys = []string{
+	"requires_replace",
+	"requires_replace_if_configured",
+	"use_state_for_unknown",
+	"write_only",
+}
+
+// resourceNestedAttributePropertyKeys are the additions to the properties of
+// resource nested attributes.
+var resourceNestedAttributePropertyKeys = []string{
+	"requires_replace",
+	"requires_replace_if_configured",
+	"use_state_for_unknown",
+	"write_only",
+}
+
+// attributePropertyKeys are the additions to the properties of data source,
+// provider and resource attributes.
+var attributePropertyKeys = []string{
+	"constraints",
+}
+
+// SpecificationDocument returns the document with the additions to the
+// properties of attributes removed, including constraints and the static
+// defaults of collection and object attributes, the min and max items of list
+// and set nested attributes and blocks, along with the config validators of
+// data sources and resources, and float32 types replaced with float64 types,
+// so that the document can be validated against the specification JSON schema.
+// Additions which are not supported for a type of schema are left in place,
+// and are rejected by the specification JSON schema.
+func SpecificationDocument(document []byte) ([]byte, error) {
+	d, err := decodeDocument(document)
+
+	if err != nil {
+		return nil, err
+	}
+
+	replaceFloat32Types(d)
+
+	dataSources, _ := d["datasources"].([]any)
+
+	for _, ds := range dataSources {
+		dataSource, _ := ds.(map[string]any)
+
+		delete(dataSource, "config_validators")
+		removeSchemaProperties(dataSource["schema"], attributePropertyKeys)
+		removeItemsLimits(dataSource["schema"])
+	}
+
+	if provider, ok := d["provider"].(map[string]any); ok {
+		removeSchemaProperties(provider["schema"], attributePropertyKeys)
+		removeItemsLimits(provider["schema"])
+	}
+
+	resources, _ := d["resources"].([]any)
+
+	for _, r := range resources {
+		resource, _ := r.(map[string]any)
+
+		delete(resource, "config_validators")
+		removeSchemaProperties(resource["schema"], append(resourceAttributePropertyKeys, attributePropertyKeys...))
+		removeSchemaNestedAttributeProperties(resource["schema"], resourceNestedAttributePropertyKeys)
+		removeStaticDefaults(resource["schema"])
+		removeItemsLimits(resource["schema"])
+	}
+
+	return json.Marshal(d)
+}
+
+// decodeDocument decodes the document, preserving the precision of numbers.
+func decodeDocument(document []byte) (map[string]any, error) {
+	var d map[string]any
+
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&d); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// removeSchemaProperties removes the keys from the properties of every
+// attribute within the schema, including nested attributes and blocks.
+func removeSchemaProperties(schema any, keys []string) {
+	walkSchemaAttributeProperties(schema, func(_ string, properties map[string]any) {
+		for _, key := range keys {
+			delete(properties, key)
+		}
+	})
+}
+
+// removeSchemaNestedAttributeProperties removes the keys from the properties
+// of every nested attribute within the schema, including those which are
+// nested.
+func removeSchemaNestedAttributeProperties(schema any, keys []string) {
+	walkSchemaAttributes(schema, func(attributeType string, properties map[string]any) {
+		if !slices.Contains(nestedAttributeTypes, attributeType) {
+			return
+		}
+
+		for _, key := range keys {
+			delete(properties, key)
+		}
+	})
+}
+
+// staticDefaultAttributeTypes are the attribute types for which a static
+// default is an addition to the specification.
+var staticDefaultAttributeTypes = []string{
+	"list",
+	"map",
+	"object",
+	"set",
+}
+
+// removeStaticDefaults removes the static defaults of collection and object
+// attributes within the schema. The default is removed entirely if it does
+// not also define a custom default.
+func removeStaticDefaults(schema any) {
+	walkSchemaAttributeProperties(schema, func(attributeType string, properties map[string]any) {
+		if !slices.Contains(staticDefaultAttributeTypes, attributeType) {
+			return
+		}
+
+		d, ok := properties["default"].(map[string]any)
+
+		if !ok {
+			return
+		}
+
+		delete(d, "static")
+
+		if len(d) == 0 {
+			delete(properties, "default")
+		}
+	})
+}
+
+// itemsLimitsKeys are the additions to the properties of list and set nested
+// attributes and blocks.
+var itemsLimitsKeys = []string{
+	"max_items",
+	"min_items",
+}
+
+// removeItemsLimits removes the min and max items of list and set nested
+// attributes and blocks within the schema, including those which are nested.
+func removeItemsLimits(schema any) {
+	s, ok := schema.(map[string]any)
+
+	if !ok {
+		return
+	}
+
+	for _, key := range []string{"attributes", "blocks"} {
+		elements, _ := s[key].([]any)
+
+		for _, e := range elements {
+			element, _ := e.(map[string]any)
+
+			for k, v := range element {
+				properties, ok := v.(map[string]any)
+
+				if !ok {
+					continue
+				}
+
+				switch k {
+				case "list_nested", "set_nested":
+					for _, key := range itemsLimitsKeys {
+						delete(properties, key)
+					}
+
+					removeItemsLimits(properties["nested_object"])
+				case "map_nested":
+					removeItemsLimits(properties["nested_object"])
+				case "single_nested":
+					removeItemsLimits(properties)
+				}
+			}
+		}
+	}
+}
+
+// nestedAttributeTypes are the attribute types which contain nested
+// attributes.
+var nestedAttributeTypes = []string{
+	"list_nested",
+	"map_nested",
+	"set_nested",
+	"single_nested",
+}
+
+// walkSchemaAttributeProperties calls f with the type and properties of every
+// primitive, collection and object attribute within the schema, including
+// nested attributes and blocks.
+func walkSchemaAttributeProperties(schema any, f func(attributeType string, properties map[string]any)) {
+	walkSchemaAttributes(schema, func(attributeType string, properties map[string]any) {
+		if slices.Contains(nestedAttributeTypes, attributeType) {
+			return
+		}
+
+		f(attributeType, properties)
+	})
+}
+
+// walkSchemaAttributes calls f with the type and properties of every
+// attribute within the schema, including nested attributes and blocks.
+func walkSchemaAttributes(schema any, f func(attributeType string, properties map[string]any)) {
+	walkSchemaAttributeObjects(schema, func(attribute map[string]any) {
+		for k, v := range attribute {
+			properties, ok := v.(map[string]any)
+
+			if !ok {
+				continue
+			}
+
+			f(k, properties)
+		}
+	})
+}
+
+// walkSchemaAttributeObjects calls f with every attribute within the schema,
+// including nested attributes and blocks, before walking the attributes which
+// are nested within it.
+func walkSchemaAttributeObjects(schema any, f func(attribute map[string]any)) {
+	s, ok := schema.(map[string]any)
+
+	if !ok {
+		return
+	}
+
+	attributes, _ := s["attributes"].([]any)
+
+	for _, a := range attributes {
+		attribute, ok := a.(map[string]any)
+
+		if !ok {
+			continue
+		}
+
+		f(attribute)
+
+		for k, v := range attribute {
+			properties, ok := v.(map[string]any)
+
+			if !ok {
+				continue
+			}
+
+			switch k {
+			case "list_nested", "map_nested", "set_nested":
+				walkSchemaAttributeObjects(properties["nested_object"], f)
+			case "single_nested":
+				walkSchemaAttributeObjects(properties, f)
+			}
+		}
+	}
+
+	blocks, _ := s["blocks"].([]any)
+
+	for _, b := range blocks {
+		block, _ := b.(map[string]any)
+
+		for k, v := range block {
+			properties, ok := v.(map[string]any)
+
+			if !ok {
+				continue
+			}
+
+			switch k {
+			case "list_nested", "set_nested":
+				walkSchemaAttributeObjects(properties["nested_object"], f)
+			case "single_nested":
+				walkSchemaAttributeObjects(properties, f)
+			}
+		}
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package extension_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+)
+
+func TestSpecificationDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		document string
+		expected string
+	}{
+		"no-additions": {
+			document: `{"provider": {"name": "example"}, "version": "0.1"}`,
+			expected: `{"provider":{"name":"example"},"version":"0.1"}`,
+		},
+		"resource-write-only": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "password", "string": {"computed_optional_required": "required", "write_only": true}},
+								{
+									"name": "nested",
+									"list_nested": {
+										"computed_optional_required": "optional",
+										"nested_object": {
+											"attributes": [
+												{"name": "secret", "int64": {"computed_optional_required": "optional", "write_only": true}}
+											]
+										},
+										"write_only": true
+									}
+								}
+							],
+							"blocks": [
+								{
+									"name": "block",
+									"single_nested": {
+										"attributes": [
+											{"name": "token", "string": {"computed_optional_required": "optional", "write_only": true}}
+										]
+									}
+								}
+							]
+						}
+					}
+				]
+			}`,
+			expected: `{"resources":[{"name":"example","schema":{"attributes":[{"name":"password","string":{"computed_optional_required":"required"}},{"list_nested":{"computed_optional_required":"optional","nested_object":{"attributes":[{"int64":{"computed_optional_required":"optional"},"name":"secret"}]}},"name":"nested"}],"blocks":[{"name":"block","single_nested":{"attributes":[{"name":"token","string":{"computed_optional_required":"optional"}}]}}]}}]}`,
+		},
+		"resource-static-default": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "tags", "list": {"computed_optional_required": "computed_optional", "element_type": {"string": {}}, "default": {"static": ["a"]}}},
+								{"name": "labels", "map": {"computed_optional_required": "computed_optional", "element_type": {"string": {}}, "default": {"custom": {"schema_definition": "my_default.Default()"}}}},
+								{"name": "name", "string": {"computed_optional_required": "computed_optional", "default": {"static": "example"}}}
+							]
+						}
+					}
+				]
+			}`,
+			expected: `{"resources":[{"name":"example","schema":{"attributes":[{"list":{"computed_optional_required":"computed_optional","element_type":{"string":{}}},"name":"tags"},{"map":{"computed_optional_required":"computed_optional","default":{"custom":{"schema_definition":"my_default.Default()"}},"element_type":{"string":{}}},"name":"labels"},{"name":"name","string":{"computed_optional_required":"computed_optional","default":{"static":"example"}}}]}}]}`,
+		},
+		"datasource-write-only": {
+			document: `{"datasources": [{"name": "example", "schema": {"attributes": [{"name": "password", "string": {"computed_optional_required": "required", "write_only": true}}]}}]}`,
+			expected: `{"datasources":[{"name":"example","schema":{"attributes":[{"name":"password","string":{"computed_optional_required":"required","write_only":true}}]}}]}`,
+		},
+		"plan-modifier-shorthands": {
+			document: `{
+				"datasources": [{"name": "example", "schema": {"attributes": [{"name": "id", "string": {"computed_optional_required": "computed", "use_state_for_unknown": true}}]}}],
+				"resources": [{"name": "example", "schema": {"attributes": [{"name": "id", "string": {"computed_optional_required": "computed", "requires_replace": true, "use_state_for_unknown": true}}]}}]
+			}`,
+			expected: `{"datasources":[{"name":"example","schema":{"attributes":[{"name":"id","string":{"computed_optional_required":"computed","use_state_for_unknown":true}}]}}],"resources":[{"name":"example","schema":{"attributes":[{"name":"id","string":{"computed_optional_required":"computed"}}]}}]}`,
+		},
+		"nested-plan-modifier-shorthands": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{
+									"name": "nested",
+									"set_nested": {
+										"computed_optional_required": "computed_optional",
+										"nested_object": {
+											"attributes": [
+												{
+													"name": "object",
+													"single_nested": {
+														"attributes": [],
+														"computed_optional_required": "optional",
+														"requires_replace_if_configured": true
+													}
+												}
+											]
+										},
+										"requires_replace": true,
+										"use_state_for_unknown": true
+									}
+								}
+							]
+						}
+					}
+				]
+			}`,
+			expected: `{"resources":[{"name":"example","schema":{"attributes":[{"name":"nested","set_nested":{"computed_optional_required":"computed_optional","nested_object":{"attributes":[{"name":"object","single_nested":{"attributes":[],"computed_optional_required":"optional"}}]}}}]}}]}`,
+		},
+		"constraints": {
+			document: `{
+				"datasources": [{"name": "example", "schema": {"attributes": [{"name": "name", "string": {"computed_optional_required": "required", "constraints": {"min_length": 1}}}]}}],
+				"provider": {"name": "example", "schema": {"attributes": [{"name": "endpoint", "string": {"optional_required": "optional", "constraints": {"regex": {"pattern": "^https://"}}}}]}},
+				"resources": [{"name": "example", "schema": {"attributes": [{"name": "tags", "list": {"computed_optional_required": "optional", "element_type": {"string": {}}, "constraints": {"max_items": 1}}}]}}]
+			}`,
+			expected: `{"datasources":[{"name":"example","schema":{"attributes":[{"name":"name","string":{"computed_optional_required":"required"}}]}}],"provider":{"name":"example","schema":{"attributes":[{"name":"endpoint","string":{"optional_required":"optional"}}]}},"resources":[{"name":"example","schema":{"attributes":[{"list":{"computed_optional_required":"optional","element_type":{"string":{}}},"name":"tags"}]}}]}`,
+		},
+		"config-validators": {
+			document: `{
+				"datasources": [{"name": "example", "config_validators": [{"exactly_one_of": ["a", "b"]}]}],
+				"resources": [{"name": "example", "config_validators": [{"conflicting": ["a", "b"]}]}]
+			}`,
+			expected: `{"datasources":[{"name":"example"}],"resources":[{"name":"example"}]}`,
+		},
+		"items-limits": {
+			document: `{
+				"provider": {"name": "example", "schema": {"blocks": [{"name": "block", "list_nested": {"max_items": 1, "nested_object": {}}}]}},
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "nested", "set_nested": {"computed_optional_required": "optional", "min_items": 1, "nested_object": {"attributes": []}}},
+								{"name": "map", "map_nested": {"computed_optional_required": "optional", "min_items": 1, "nested_object": {"attributes": []}}}
+							]
+						}
+					}
+				]
+			}`,
+			expected: `{"provider":{"name":"example","schema":{"blocks":[{"list_nested":{"nested_object":{}},"name":"block"}]}},"resources":[{"name":"example","schema":{"attributes":[{"name":"nested","set_nested":{"computed_optional_required":"optional","nested_object":{"attributes":[]}}},{"map_nested":{"computed_optional_required":"optional","min_items":1,"nested_object":{"attributes":[]}},"name":"map"}]}}]}`,
+		},
+		"float32": {
+			document: `{
+				"datasources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "ratio", "float32": {"computed_optional_required": "required"}},
+								{"name": "ratios", "list": {"computed_optional_required": "optional", "element_type": {"map": {"element_type": {"float32": {}}}}}},
+								{"name": "point", "object": {"computed_optional_required": "optional", "attribute_types": [{"name": "x", "float32": {}}]}}
+							]
+						}
+					}
+				],
+				"provider": {
+					"name": "example",
+					"schema": {
+						"blocks": [
+							{
+								"name": "block",
+								"list_nested": {
+									"nested_object": {
+										"attributes": [
+											{"name": "ratio", "float32": {"computed_optional_required": "optional"}}
+										]
+									}
+								}
+							}
+						]
+					}
+				}
+			}`,
+			expected: `{"datasources":[{"name":"example","schema":{"attributes":[{"float64":{"computed_optional_required":"required"},"name":"ratio"},{"list":{"computed_optional_required":"optional","element_type":{"map":{"element_type":{"float64":{}}}}},"name":"ratios"},{"name":"point","object":{"attribute_types":[{"float64":{},"name":"x"}],"computed_optional_required":"optional"}}]}}],"provider":{"name":"example","schema":{"blocks":[{"list_nested":{"nested_object":{"attributes":[{"float64":{"computed_optional_required":"optional"},"name":"ratio"}]}},"name":"block"}]}}}`,
+		},
+		"float32-custom-type": {
+			document: `{"resources": [{"name": "example", "schema": {"attributes": [{"name": "ratio", "float32": {"computed_optional_required": "optional", "custom_type": {"type": "RatioType", "value_type": "RatioValue"}}}]}}]}`,
+			expected: `{"resources":[{"name":"example","schema":{"attributes":[{"float64":{"computed_optional_required":"optional","custom_type":{"type":"RatioType","value_type":"RatioValue"}},"name":"ratio"}]}}]}`,
+		},
+		"number-precision": {
+			document: `{"resources": [{"name": "example", "schema": {"attributes": [{"name": "number", "number": {"computed_optional_required": "computed_optional", "default": {"static": 1.23456789012345678901234567890}}}]}}]}`,
+			expected: `{"resources":[{"name":"example","schema":{"attributes":[{"name":"number","number":{"computed_optional_required":"computed_optional","default":{"static":1.23456789012345678901234567890}}}]}}]}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := extension.SpecificationDocument([]byte(testCase.document))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package extension
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/datasource"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+)
+
+// EphemeralResourcesValidateRequest defines the request sent during validation of EphemeralResources.
+type EphemeralResourcesValidateRequest struct{}
+
+// EphemeralResources type defines EphemeralResource types.
+type EphemeralResources []EphemeralResource
+
+// Validate checks for duplicated ephemeral resource names and delegates to
+// EphemeralResource.Validate for each ephemeral resource. Errors are prefixed
+// with the JSON pointer of the ephemeral resource.
+func (es EphemeralResources) Validate(ctx context.Context, req EphemeralResourcesValidateRequest) error {
+	ephemeralResourceNames := make(map[string]struct{}, len(es))
+
+	var errs, nestedErrs []error
+
+	for i, e := range es {
+		if _, ok := ephemeralResourceNames[e.Name]; ok {
+			errs = append(errs, diagnostic.NewError(fmt.Errorf("ephemeral resource %q is duplicated", e.Name), "ephemeral_resources", i, "name"))
+		}
+
+		ephemeralResourceNames[e.Name] = struct{}{}
+
+		validateRequest := datasource.ValidateRequest{
+			Path: fmt.Sprintf("ephemeral resource %q", e.Name),
+		}
+
+		err := e.Validate(ctx, validateRequest)
+
+		if err != nil {
+			nestedErrs = append(nestedErrs, diagnostic.NewErrors(err, "ephemeral_resources", i)...)
+		}
+	}
+
+	e := append(errs, nestedErrs...)
+
+	return errors.Join(e...)
+}
+
+// EphemeralResource defines an individual ephemeral resource.
+//
+// Ephemeral resource schemas support the same attributes and blocks as
+// data source schemas, so the data source Schema type is reused.
+type EphemeralResource struct {
+	// Name is the string identifier for the ephemeral resource.
+	Name string `json:"name"`
+
+	// Schema defines the Attributes and Blocks for the ephemeral resource,
+	// which are read with float32 types replaced, in the same way as the
+	// schemas of the specification.
+	Schema *datasource.Schema `json:"-"`
+
+	// Additions defines the additions to the attributes of the schema, such
+	// as constraints.
+	Additions *Schema `json:"schema,omitempty"`
+}
+
+// Validate delegates to the Validate method of the additions, which are read
+// from every attribute and block of the schema, so that duplicated names are
+// also reported.
+func (e EphemeralResource) Validate(ctx context.Context, req datasource.ValidateRequest) error {
+	if e.Additions == nil {
+		return nil
+	}
+
+	return diagnostic.WithPointer(e.Additions.Validate(ctx, SchemaValidateRequest{Path: req.Path}), "schema")
+}
+
+// unmarshalSchemas sets the schema of each ephemeral resource from the
+// document in which float32 types have been replaced, identifying them from
+// the original document. Values of the wrong type are reported when
+// validating the document against the specification JSON schema, so are not
+// reported again.
+func (es EphemeralResources) unmarshalSchemas(document, original []byte) error {
+	var d struct {
+		EphemeralResources []struct {
+			Schema *datasource.Schema `json:"schema,omitempty"`
+		} `json:"ephemeral_resources"`
+	}
+
+	err := unmarshalFloat32Document(document, original, &d)
+
+	var typeErr *json.UnmarshalTypeError
+
+	if err != nil && !errors.As(err, &typeErr) {
+		return err
+	}
+
+	for i := range es {
+		if i < len(d.EphemeralResources) {
+			es[i].Schema = d.EphemeralResources[i].Schema
+		}
+	}
+
+	return nil
+}
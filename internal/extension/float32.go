@@ -0,0 +1,300 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package extension
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/datasource"
+	"github.com/greatman/terraform-plugin-codegen-spec/provider"
+	"github.com/greatman/terraform-plugin-codegen-spec/resource"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+)
+
+// float64Type is the float64 type, attribute or function parameter of a
+// parsed specification which can be identified as a float32 type.
+type float64Type interface {
+	specschema.Float64Type |
+		datasource.Float64Attribute |
+		provider.Float64Attribute |
+		resource.Float64Attribute |
+		Float64Parameter
+}
+
+// float32Types holds the float64 types, attributes and function parameters of
+// parsed specifications which are defined as float32 types in the document,
+// as float32 is not yet part of the specification. Each is held by pointer,
+// so that the custom type of a float32 type is kept as it is written.
+var float32Types sync.Map
+
+// AsFloat32 identifies the float64 type, attribute or function parameter as a
+// float32 type, and returns it.
+func AsFloat32[T float64Type](t *T) *T {
+	if t != nil {
+		float32Types.Store(t, struct{}{})
+	}
+
+	return t
+}
+
+// IsFloat32 returns true if the float64 type, attribute or function parameter
+// is identified as a float32 type.
+func IsFloat32[T float64Type](t *T) bool {
+	if t == nil {
+		return false
+	}
+
+	_, ok := float32Types.Load(t)
+
+	return ok
+}
+
+// unmarshalFloat32Document unmarshals the document, in which float32 types
+// have been replaced with float64 types, into v, and identifies the float32
+// types of v which are defined by the original document.
+func unmarshalFloat32Document(document, original []byte, v any) error {
+	err := json.Unmarshal(document, v)
+
+	var d any
+
+	_ = json.Unmarshal(original, &d)
+
+	identifyFloat32Types(reflect.ValueOf(v), d)
+
+	return err
+}
+
+// identifyFloat32Types walks v, which has been unmarshalled from a document in
+// which float32 types are replaced with float64 types, along with d, the
+// original decoded document, and identifies each float64 type, attribute or
+// function parameter of v which d defines as a float32 type. Fields are
+// matched to the document by their JSON names.
+func identifyFloat32Types(v reflect.Value, d any) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return
+		}
+
+		identifyFloat32Types(v.Elem(), d)
+	case reflect.Slice:
+		elements, _ := d.([]any)
+
+		for i := 0; i < v.Len() && i < len(elements); i++ {
+			identifyFloat32Types(v.Index(i), elements[i])
+		}
+	case reflect.Struct:
+		m, ok := d.(map[string]any)
+
+		if !ok {
+			return
+		}
+
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+
+			if !field.IsExported() {
+				continue
+			}
+
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+			switch {
+			case field.Anonymous && name == "":
+				identifyFloat32Types(v.Field(i), m)
+			case name == "float64":
+				value, ok := m[name]
+
+				if float32Value, isFloat32 := m["float32"]; !ok && isFloat32 && v.Field(i).Kind() == reflect.Pointer && !v.Field(i).IsNil() {
+					float32Types.Store(v.Field(i).Interface(), struct{}{})
+
+					value = float32Value
+				}
+
+				identifyFloat32Types(v.Field(i), value)
+			case name != "" && name != "-":
+				identifyFloat32Types(v.Field(i), m[name])
+			}
+		}
+	}
+}
+
+// float32SchemaKeys are the keys of the document containing schemas, in
+// which float32 types are replaced. The float32 types of functions are
+// replaced separately.
+var float32SchemaKeys = []string{
+	"datasources",
+	"ephemeral_resources",
+	"resources",
+}
+
+// replaceFloat32Types replaces the float32 attributes, element types and
+// object attribute types within the schemas of the document, along with the
+// float32 parameters and returns of functions, with float64 types, as float32
+// is not yet part of the specification. The properties of float32 types,
+// including their custom types, are otherwise the same as those of float64
+// types. Once the document has been parsed, the float32 types are identified
+// by identifyFloat32Types.
+func replaceFloat32Types(d map[string]any) {
+	for _, key := range float32SchemaKeys {
+		entities, _ := d[key].([]any)
+
+		for _, e := range entities {
+			entity, _ := e.(map[string]any)
+
+			replaceSchemaFloat32Types(entity["schema"])
+		}
+	}
+
+	if provider, ok := d["provider"].(map[string]any); ok {
+		replaceSchemaFloat32Types(provider["schema"])
+	}
+
+	functions, _ := d["functions"].([]any)
+
+	for _, f := range functions {
+		function, _ := f.(map[string]any)
+		definition, _ := function["definition"].(map[string]any)
+
+		// Parameters and returns are defined in the same way as element
+		// types.
+		parameters, _ := definition["parameters"].([]any)
+
+		for _, parameter := range parameters {
+			replaceElementTypeFloat32Types(parameter)
+		}
+
+		replaceElementTypeFloat32Types(definition["variadic_parameter"])
+		replaceElementTypeFloat32Types(definition["return"])
+	}
+}
+
+// replaceSchemaFloat32Types replaces the float32 types within the
+// attributes of the schema, including nested attributes and blocks.
+func replaceSchemaFloat32Types(schema any) {
+	walkSchemaAttributeObjects(schema, func(attribute map[string]any) {
+		if replaceFloat32Type(attribute) {
+			return
+		}
+
+		for k, v := range attribute {
+			properties, ok := v.(map[string]any)
+
+			if !ok {
+				continue
+			}
+
+			switch k {
+			case "list", "map", "set":
+				replaceElementTypeFloat32Types(properties["element_type"])
+			case "object":
+				replaceObjectAttributeTypesFloat32Types(properties["attribute_types"])
+			}
+		}
+	})
+}
+
+// replaceElementTypeFloat32Types replaces float32 element types, including
+// those of nested collections and objects.
+func replaceElementTypeFloat32Types(elementType any) {
+	e, ok := elementType.(map[string]any)
+
+	if !ok {
+		return
+	}
+
+	if replaceFloat32Type(e) {
+		return
+	}
+
+	for k, v := range e {
+		properties, ok := v.(map[string]any)
+
+		if !ok {
+			continue
+		}
+
+		switch k {
+		case "list", "map", "set":
+			replaceElementTypeFloat32Types(properties["element_type"])
+		case "object":
+			replaceObjectAttributeTypesFloat32Types(properties["attribute_types"])
+		}
+	}
+}
+
+// replaceObjectAttributeTypesFloat32Types replaces float32 object attribute
+// types, which are defined in the same way as element types.
+func replaceObjectAttributeTypesFloat32Types(attributeTypes any) {
+	a, _ := attributeTypes.([]any)
+
+	for _, attributeType := range a {
+		replaceElementTypeFloat32Types(attributeType)
+	}
+}
+
+// replaceFloat32Type replaces the float32 type of the attribute, element type
+// or object attribute type with a float64 type, returning true if the type
+// was replaced.
+func replaceFloat32Type(t map[string]any) bool {
+	properties, ok := t["float32"]
+
+	if !ok {
+		return false
+	}
+
+	t["float64"] = properties
+	delete(t, "float32")
+
+	return true
+}
+
+// Float32Tokens returns a function which replaces float64 with float32 in the
+// reference tokens of JSON pointers of values within the document once its
+// float32 types have been replaced, wherever the original document defines a
+// float32 type, so that errors found by validating the specification
+// document locate the types as they are written. An original document which
+// cannot be unmarshalled leaves the tokens unchanged.
+func Float32Tokens(document []byte) func(tokens []string) []string {
+	var d any
+
+	_ = json.Unmarshal(document, &d)
+
+	return func(tokens []string) []string {
+		replaced := make([]string, len(tokens))
+		copy(replaced, tokens)
+
+		v := d
+
+		for i, token := range replaced {
+			switch value := v.(type) {
+			case map[string]any:
+				if _, ok := value[token]; !ok && token == "float64" {
+					if _, ok := value["float32"]; ok {
+						token = "float32"
+						replaced[i] = token
+					}
+				}
+
+				v = value[token]
+			case []any:
+				index, err := strconv.Atoi(token)
+
+				if err != nil || index < 0 || index >= len(value) {
+					return replaced
+				}
+
+				v = value[index]
+			default:
+				return replaced
+			}
+		}
+
+		return replaced
+	}
+}
@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package extension
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+)
+
+// FunctionsValidateRequest defines the request sent during validation of Functions.
+type FunctionsValidateRequest struct{}
+
+// Functions type defines Function types.
+type Functions []Function
+
+// Validate checks for duplicated function names and delegates to Function.Validate
+// for each function. Errors are prefixed with the JSON pointer of the function.
+func (fs Functions) Validate(ctx context.Context, req FunctionsValidateRequest) error {
+	functionNames := make(map[string]struct{}, len(fs))
+
+	var errs, nestedErrs []error
+
+	for i, f := range fs {
+		if _, ok := functionNames[f.Name]; ok {
+			errs = append(errs, diagnostic.NewError(fmt.Errorf("function %q is duplicated", f.Name), "functions", i, "name"))
+		}
+
+		functionNames[f.Name] = struct{}{}
+
+		validateRequest := FunctionValidateRequest{
+			Path: fmt.Sprintf("function %q", f.Name),
+		}
+
+		err := f.Validate(ctx, validateRequest)
+
+		if err != nil {
+			nestedErrs = append(nestedErrs, diagnostic.NewErrors(err, "functions", i)...)
+		}
+	}
+
+	e := append(errs, nestedErrs...)
+
+	return errors.Join(e...)
+}
+
+// unmarshalDefinitions sets the definition of each function from the document
+// in which float32 types have been replaced, identifying them from the
+// original document. Values of the wrong type are reported when validating
+// the document against the specification JSON schema, so are not reported
+// again.
+func (fs Functions) unmarshalDefinitions(document, original []byte) error {
+	var d struct {
+		Functions []struct {
+			Definition *FunctionDefinition `json:"definition,omitempty"`
+		} `json:"functions"`
+	}
+
+	err := unmarshalFloat32Document(document, original, &d)
+
+	var typeErr *json.UnmarshalTypeError
+
+	if err != nil && !errors.As(err, &typeErr) {
+		return err
+	}
+
+	for i := range fs {
+		if i < len(d.Functions) {
+			fs[i].Definition = d.Functions[i].Definition
+		}
+	}
+
+	return nil
+}
+
+// FunctionValidateRequest defines the Path of the function that is
+// being validated.
+type FunctionValidateRequest struct {
+	Path string
+}
+
+// Function defines an individual provider-defined function.
+type Function struct {
+	// Name is the string identifier for the function.
+	Name string `json:"name"`
+
+	// Definition defines the Parameters and Return for the function.
+	Definition *FunctionDefinition `json:"definition,omitempty"`
+}
+
+// Validate checks that the function has a definition, and delegates
+// to FunctionDefinition.Validate.
+func (f Function) Validate(ctx context.Context, req FunctionValidateRequest) error {
+	if f.Name == "" {
+		return diagnostic.NewError(errors.New("function name is required"), "name")
+	}
+
+	if f.Definition == nil {
+		return fmt.Errorf("%s definition is required", req.Path)
+	}
+
+	return diagnostic.WithPointer(f.Definition.Validate(ctx, req), "definition")
+}
+
+// FunctionDefinition defines the Parameters, VariadicParameter and Return
+// for a function.
+type FunctionDefinition struct {
+	Parameters        Parameters `json:"parameters,omitempty"`
+	VariadicParameter *Parameter `json:"variadic_parameter,omitempty"`
+	Return            Return     `json:"return"`
+
+	Summary             *string `json:"summary,omitempty"`
+	Description         *string `json:"description,omitempty"`
+	MarkdownDescription *string `json:"markdown_description,omitempty"`
+	DeprecationMessage  *string `json:"deprecation_message,omitempty"`
+}
+
+// Validate checks for duplicated parameter names, including the variadic
+// parameter, and that each parameter and the return define a single type.
+func (d FunctionDefinition) Validate(ctx context.Context, req FunctionValidateRequest) error {
+	type parameter struct {
+		Parameter
+
+		tokens []any
+	}
+
+	parameters := make([]parameter, 0, len(d.Parameters)+1)
+
+	for i, p := range d.Parameters {
+		parameters = append(parameters, parameter{p, []any{"parameters", i}})
+	}
+
+	if d.VariadicParameter != nil {
+		parameters = append(parameters, parameter{*d.VariadicParameter, []any{"variadic_parameter"}})
+	}
+
+	parameterNames := make(map[string]struct{}, len(parameters))
+
+	var errs []error
+
+	for _, p := range parameters {
+		if _, ok := parameterNames[p.Name]; ok {
+			errs = append(errs, diagnostic.NewError(fmt.Errorf("%s parameter %q is duplicated", req.Path, p.Name), append(p.tokens, "name")...))
+		}
+
+		parameterNames[p.Name] = struct{}{}
+
+		if n := p.typeCount(); n != 1 {
+			errs = append(errs, diagnostic.NewError(fmt.Errorf("%s parameter %q must define exactly one type, got %d", req.Path, p.Name, n), p.tokens...))
+		}
+	}
+
+	if n := d.Return.typeCount(); n != 1 {
+		errs = append(errs, diagnostic.NewError(fmt.Errorf("%s return must define exactly one type, got %d", req.Path, n), "return"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Parameters type defines Parameter types.
+type Parameters []Parameter
+
+// Parameter defines an individual function parameter. Float32 parameters are
+// read as float64 parameters, which are identified by IsFloat32.
+type Parameter struct {
+	Name string `json:"name"`
+
+	Bool    *BoolParameter    `json:"bool,omitempty"`
+	Dynamic *DynamicParameter `json:"dynamic,omitempty"`
+	Float64 *Float64Parameter `json:"float64,omitempty"`
+	Int32   *Int32Parameter   `json:"int32,omitempty"`
+	Int64   *Int64Parameter   `json:"int64,omitempty"`
+	List    *ListParameter    `json:"list,omitempty"`
+	Map     *MapParameter     `json:"map,omitempty"`
+	Number  *NumberParameter  `json:"number,omitempty"`
+	Object  *ObjectParameter  `json:"object,omitempty"`
+	Set     *SetParameter     `json:"set,omitempty"`
+	String  *StringParameter  `json:"string,omitempty"`
+}
+
+func (p Parameter) typeCount() int {
+	var n int
+
+	for _, isSet := range []bool{
+		p.Bool != nil,
+		p.Dynamic != nil,
+		p.Float64 != nil,
+		p.Int32 != nil,
+		p.Int64 != nil,
+		p.List != nil,
+		p.Map != nil,
+		p.Number != nil,
+		p.Object != nil,
+		p.Set != nil,
+		p.String != nil,
+	} {
+		if isSet {
+			n++
+		}
+	}
+
+	return n
+}
+
+type BoolParameter struct {
+	AllowNullValue     bool                      `json:"allow_null_value,omitempty"`
+	AllowUnknownValues bool                      `json:"allow_unknown_values,omitempty"`
+	CustomType         *specschema.CustomType    `json:"custom_type,omitempty"`
+	Description        *string                   `json:"description,omitempty"`
+	Validators         specschema.BoolValidators `json:"validators,omitempty"`
+}
+
+type DynamicParameter struct {
+	AllowNullValue     bool                         `json:"allow_null_value,omitempty"`
+	AllowUnknownValues bool                         `json:"allow_unknown_values,omitempty"`
+	CustomType         *specschema.CustomType       `json:"custom_type,omitempty"`
+	Description        *string                      `json:"description,omitempty"`
+	Validators         specschema.DynamicValidators `json:"validators,omitempty"`
+}
+
+type Float64Parameter struct {
+	AllowNullValue     bool                         `json:"allow_null_value,omitempty"`
+	AllowUnknownValues bool                         `json:"allow_unknown_values,omitempty"`
+	CustomType         *specschema.CustomType       `json:"custom_type,omitempty"`
+	Description        *string                      `json:"description,omitempty"`
+	Validators         specschema.Float64Validators `json:"validators,omitempty"`
+}
+
+type Int32Parameter struct {
+	AllowNullValue     bool                       `json:"allow_null_value,omitempty"`
+	AllowUnknownValues bool                       `json:"allow_unknown_values,omitempty"`
+	CustomType         *specschema.CustomType     `json:"custom_type,omitempty"`
+	Description        *string                    `json:"description,omitempty"`
+	Validators         specschema.Int32Validators `json:"validators,omitempty"`
+}
+
+type Int64Parameter struct {
+	AllowNullValue     bool                       `json:"allow_null_value,omitempty"`
+	AllowUnknownValues bool                       `json:"allow_unknown_values,omitempty"`
+	CustomType         *specschema.CustomType     `json:"custom_type,omitempty"`
+	Description        *string                    `json:"description,omitempty"`
+	Validators         specschema.Int64Validators `json:"validators,omitempty"`
+}
+
+type ListParameter struct {
+	AllowNullValue     bool                      `json:"allow_null_value,omitempty"`
+	AllowUnknownValues bool                      `json:"allow_unknown_values,omitempty"`
+	CustomType         *specschema.CustomType    `json:"custom_type,omitempty"`
+	Description        *string                   `json:"description,omitempty"`
+	ElementType        specschema.ElementType    `json:"element_type"`
+	Validators         specschema.ListValidators `json:"validators,omitempty"`
+}
+
+type MapParameter struct {
+	AllowNullValue     bool                     `json:"allow_null_value,omitempty"`
+	AllowUnknownValues bool                     `json:"allow_unknown_values,omitempty"`
+	CustomType         *specschema.CustomType   `json:"custom_type,omitempty"`
+	Description        *string                  `json:"description,omitempty"`
+	ElementType        specschema.ElementType   `json:"element_type"`
+	Validators         specschema.MapValidators `json:"validators,omitempty"`
+}
+
+type NumberParameter struct {
+	AllowNullValue     bool                        `json:"allow_null_value,omitempty"`
+	AllowUnknownValues bool                        `json:"allow_unknown_values,omitempty"`
+	CustomType         *specschema.CustomType      `json:"custom_type,omitempty"`
+	Description        *string                     `json:"description,omitempty"`
+	Validators         specschema.NumberValidators `json:"validators,omitempty"`
+}
+
+type ObjectParameter struct {
+	AllowNullValue     bool                            `json:"allow_null_value,omitempty"`
+	AllowUnknownValues bool                            `json:"allow_unknown_values,omitempty"`
+	AttributeTypes     specschema.ObjectAttributeTypes `json:"attribute_types"`
+	CustomType         *specschema.CustomType          `json:"custom_type,omitempty"`
+	Description        *string                         `json:"description,omitempty"`
+	Validators         specschema.ObjectValidators     `json:"validators,omitempty"`
+}
+
+type SetParameter struct {
+	AllowNullValue     bool                     `json:"allow_null_value,omitempty"`
+	AllowUnknownValues bool                     `json:"allow_unknown_values,omitempty"`
+	CustomType         *specschema.CustomType   `json:"custom_type,omitempty"`
+	Description        *string                  `json:"description,omitempty"`
+	ElementType        specschema.ElementType   `json:"element_type"`
+	Validators         specschema.SetValidators `json:"validators,omitempty"`
+}
+
+type StringParameter struct {
+	AllowNullValue     bool                        `json:"allow_null_value,omitempty"`
+	AllowUnknownValues bool                        `json:"allow_unknown_values,omitempty"`
+	CustomType         *specschema.CustomType      `json:"custom_type,omitempty"`
+	Description        *string                     `json:"description,omitempty"`
+	Validators         specschema.StringValidators `json:"validators,omitempty"`
+}
+
+// Return defines the type of the value returned by a function. The
+// upstream specification types are reused as a return has no properties
+// beyond its type, element type or attribute types and custom type. A float32
+// return is read as a float64 return, which is identified by IsFloat32.
+type Return struct {
+	Bool    *specschema.BoolType    `json:"bool,omitempty"`
+	Dynamic *specschema.DynamicType `json:"dynamic,omitempty"`
+	Float64 *specschema.Float64Type `json:"float64,omitempty"`
+	Int32   *specschema.Int32Type   `json:"int32,omitempty"`
+	Int64   *specschema.Int64Type   `json:"int64,omitempty"`
+	List    *specschema.ListType    `json:"list,omitempty"`
+	Map     *specschema.MapType     `json:"map,omitempty"`
+	Number  *specschema.NumberType  `json:"number,omitempty"`
+	Object  *specschema.ObjectType  `json:"object,omitempty"`
+	Set     *specschema.SetType     `json:"set,omitempty"`
+	String  *specschema.StringType  `json:"string,omitempty"`
+}
+
+func (r Return) typeCount() int {
+	var n int
+
+	for _, isSet := range []bool{
+		r.Bool != nil,
+		r.Dynamic != nil,
+		r.Float64 != nil,
+		r.Int32 != nil,
+		r.Int64 != nil,
+		r.List != nil,
+		r.Map != nil,
+		r.Number != nil,
+		r.Object != nil,
+		r.Set != nil,
+		r.String != nil,
+	} {
+		if isSet {
+			n++
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package extension
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+)
+
+// IdentityValidateRequest defines the Path of the identity that is
+// being validated.
+type IdentityValidateRequest struct {
+	Path string
+}
+
+// Identity defines the identity schema of a resource, which is used by
+// Terraform to uniquely identify the remote object for import and list
+// operations.
+type Identity struct {
+	Attributes IdentityAttributes `json:"attributes"`
+}
+
+// Validate checks that the identity defines at least one attribute, and
+// delegates to IdentityAttributes.Validate.
+func (i Identity) Validate(ctx context.Context, req IdentityValidateRequest) error {
+	if len(i.Attributes) == 0 {
+		return fmt.Errorf("%s must define at least one attribute", req.Path)
+	}
+
+	return i.Attributes.Validate(ctx, req)
+}
+
+// IdentityAttributes type defines IdentityAttribute types.
+type IdentityAttributes []IdentityAttribute
+
+// Validate checks for duplicated attribute names and delegates to
+// IdentityAttribute.Validate for each attribute. Errors are prefixed with
+// the JSON pointer of the attribute.
+func (as IdentityAttributes) Validate(ctx context.Context, req IdentityValidateRequest) error {
+	attributeNames := make(map[string]struct{}, len(as))
+
+	var errs []error
+
+	for i, a := range as {
+		if _, ok := attributeNames[a.Name]; ok {
+			errs = append(errs, diagnostic.NewError(fmt.Errorf("%s attribute %q is duplicated", req.Path, a.Name), "attributes", i, "name"))
+		}
+
+		attributeNames[a.Name] = struct{}{}
+
+		validateRequest := IdentityValidateRequest{
+			Path: fmt.Sprintf("%s attribute %q", req.Path, a.Name),
+		}
+
+		err := a.Validate(ctx, validateRequest)
+
+		if err != nil {
+			errs = append(errs, diagnostic.NewErrors(err, "attributes", i)...)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// IdentityAttribute defines an individual identity attribute. Identity
+// attributes are limited to primitive types, and lists of primitive types.
+type IdentityAttribute struct {
+	Name string `json:"name"`
+
+	Bool    *IdentityPrimitiveAttribute `json:"bool,omitempty"`
+	Float32 *IdentityPrimitiveAttribute `json:"float32,omitempty"`
+	Float64 *IdentityPrimitiveAttribute `json:"float64,omitempty"`
+	Int32   *IdentityPrimitiveAttribute `json:"int32,omitempty"`
+	Int64   *IdentityPrimitiveAttribute `json:"int64,omitempty"`
+	List    *IdentityListAttribute      `json:"list,omitempty"`
+	Number  *IdentityPrimitiveAttribute `json:"number,omitempty"`
+	String  *IdentityPrimitiveAttribute `json:"string,omitempty"`
+}
+
+// Validate checks that the attribute defines a single type, that the
+// attribute is either required or optional for import, and that the
+// element type of a list is a primitive type.
+func (a IdentityAttribute) Validate(ctx context.Context, req IdentityValidateRequest) error {
+	if a.Name == "" {
+		return diagnostic.NewError(fmt.Errorf("%s name is required", req.Path), "name")
+	}
+
+	var props []IdentityAttributeProperties
+
+	for _, p := range []*IdentityPrimitiveAttribute{
+		a.Bool,
+		a.Float32,
+		a.Float64,
+		a.Int32,
+		a.Int64,
+		a.Number,
+		a.String,
+	} {
+		if p != nil {
+			props = append(props, p.IdentityAttributeProperties)
+		}
+	}
+
+	if a.List != nil {
+		props = append(props, a.List.IdentityAttributeProperties)
+	}
+
+	if len(props) != 1 {
+		return fmt.Errorf("%s must define exactly one type, got %d", req.Path, len(props))
+	}
+
+	var errs []error
+
+	if props[0].OptionalForImport == props[0].RequiredForImport {
+		errs = append(errs, fmt.Errorf("%s must define exactly one of optional_for_import or required_for_import", req.Path))
+	}
+
+	if a.List != nil {
+		e := a.List.ElementType
+
+		isPrimitive := e.Bool != nil || e.Float64 != nil || e.Int32 != nil || e.Int64 != nil || e.Number != nil || e.String != nil
+
+		if !isPrimitive || e.List != nil || e.Map != nil || e.Object != nil || e.Set != nil {
+			errs = append(errs, diagnostic.NewError(fmt.Errorf("%s element type must be a primitive type", req.Path), "list", "element_type"))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// IdentityAttributeProperties defines the properties which are common to
+// all identity attributes.
+type IdentityAttributeProperties struct {
+	CustomType        *specschema.CustomType `json:"custom_type,omitempty"`
+	Description       *string                `json:"description,omitempty"`
+	OptionalForImport bool                   `json:"optional_for_import,omitempty"`
+	RequiredForImport bool                   `json:"required_for_import,omitempty"`
+}
+
+// IdentityPrimitiveAttribute defines a bool, float32, float64, int32, int64,
+// number or string identity attribute.
+type IdentityPrimitiveAttribute struct {
+	IdentityAttributeProperties
+}
+
+// IdentityListAttribute defines a list identity attribute.
+type IdentityListAttribute struct {
+	IdentityAttributeProperties
+
+	ElementType specschema.ElementType `json:"element_type"`
+}
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package extension
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+)
+
+// ProviderValidateRequest defines the request sent during validation of Provider.
+type ProviderValidateRequest struct{}
+
+// Provider defines the additions to the provider.
+type Provider struct {
+	// Name is the string identifier for the provider.
+	Name string `json:"name"`
+
+	// Schema defines the additions to the Attributes and Blocks for the provider.
+	Schema *Schema `json:"schema,omitempty"`
+}
+
+// Validate delegates to Schema.Validate. Errors are prefixed with the JSON
+// pointer of the provider.
+func (p Provider) Validate(ctx context.Context, req ProviderValidateRequest) error {
+	if p.Schema == nil {
+		return nil
+	}
+
+	err := p.Schema.Validate(ctx, SchemaValidateRequest{
+		Path: fmt.Sprintf("provider %q", p.Name),
+	})
+
+	return diagnostic.WithPointer(err, "provider", "schema")
+}
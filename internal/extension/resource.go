@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package extension
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+)
+
+// ResourcesValidateRequest defines the request sent during validation of Resources.
+type ResourcesValidateRequest struct{}
+
+// Resources type defines Resource types.
+type Resources []Resource
+
+// Get returns the Resource with the given name, if present.
+func (rs Resources) Get(name string) (Resource, bool) {
+	for _, r := range rs {
+		if r.Name == name {
+			return r, true
+		}
+	}
+
+	return Resource{}, false
+}
+
+// Validate checks for duplicated resource names and delegates to
+// Resource.Validate for each resource. Errors are prefixed with the JSON
+// pointer of the resource.
+func (rs Resources) Validate(ctx context.Context, req ResourcesValidateRequest) error {
+	resourceNames := make(map[string]struct{}, len(rs))
+
+	var errs, nestedErrs []error
+
+	for i, r := range rs {
+		if _, ok := resourceNames[r.Name]; ok {
+			errs = append(errs, diagnostic.NewError(fmt.Errorf("resource %q is duplicated", r.Name), "resources", i, "name"))
+		}
+
+		resourceNames[r.Name] = struct{}{}
+
+		validateRequest := ResourceValidateRequest{
+			Path: fmt.Sprintf("resource %q", r.Name),
+		}
+
+		err := r.Validate(ctx, validateRequest)
+
+		if err != nil {
+			nestedErrs = append(nestedErrs, diagnostic.NewErrors(err, "resources", i)...)
+		}
+	}
+
+	e := append(errs, nestedErrs...)
+
+	return errors.Join(e...)
+}
+
+// ResourceValidateRequest defines the Path of the resource that is
+// being validated.
+type ResourceValidateRequest struct {
+	Path string
+}
+
+// Resource defines the additions to an individual resource.
+type Resource struct {
+	// Name is the string identifier for the resource.
+	Name string `json:"name"`
+
+	// Schema defines the additions to the Attributes and Blocks for the resource.
+	Schema *Schema `json:"schema,omitempty"`
+
+	// ConfigValidators defines the validators of the configuration of the
+	// resource, which reference attributes and blocks by path.
+	ConfigValidators ConfigValidators `json:"config_validators,omitempty"`
+
+	// Identity defines the identity schema for the resource.
+	Identity *Identity `json:"identity,omitempty"`
+}
+
+// Validate delegates to Schema.Validate, ConfigValidators.Validate and
+// Identity.Validate.
+func (r Resource) Validate(ctx context.Context, req ResourceValidateRequest) error {
+	var errs []error
+
+	if r.Schema != nil {
+		err := r.Schema.Validate(ctx, SchemaValidateRequest{
+			Path: req.Path,
+		})
+
+		if err != nil {
+			errs = append(errs, diagnostic.NewErrors(err, "schema")...)
+		}
+	}
+
+	if err := r.ConfigValidators.Validate(ctx, ConfigValidatorsValidateRequest(req)); err != nil {
+		errs = append(errs, diagnostic.NewErrors(err, "config_validators")...)
+	}
+
+	if r.Identity != nil {
+		err := r.Identity.Validate(ctx, IdentityValidateRequest{
+			Path: fmt.Sprintf("%s identity", req.Path),
+		})
+
+		if err != nil {
+			errs = append(errs, diagnostic.NewErrors(err, "identity")...)
+		}
+	}
+
+	return errors.Join(errs...)
+}
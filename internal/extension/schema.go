@@ -0,0 +1,759 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package extension
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+)
+
+// SchemaValidateRequest defines the Path of the schema that is
+// being validated, and the restrictions on write-only attributes which
+// are inherited from the enclosing attribute or block.
+type SchemaValidateRequest struct {
+	Path string
+
+	// WriteOnlyRequired is set within a write-only nested attribute, all
+	// of the nested attributes of which must also be write-only.
+	WriteOnlyRequired bool
+
+	// WriteOnlyUnsupportedWithin is set to the description of the enclosing
+	// attribute or block, such as "set nested attributes", within which
+	// write-only attributes are not supported.
+	WriteOnlyUnsupportedWithin string
+}
+
+// Schema defines the additions to the Attributes and Blocks of a schema.
+type Schema struct {
+	Attributes Attributes `json:"attributes,omitempty"`
+	Blocks     Blocks     `json:"blocks,omitempty"`
+}
+
+// Validate delegates to Attributes.Validate and Blocks.Validate.
+func (s Schema) Validate(ctx context.Context, req SchemaValidateRequest) error {
+	var errs []error
+
+	err := s.Attributes.Validate(ctx, AttributesValidateRequest(req))
+
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	err = s.Blocks.Validate(ctx, BlocksValidateRequest(req))
+
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// AttributesValidateRequest defines the Path of the attributes that are
+// being validated, and the restrictions on write-only attributes.
+type AttributesValidateRequest struct {
+	Path                       string
+	WriteOnlyRequired          bool
+	WriteOnlyUnsupportedWithin string
+}
+
+// Attributes type defines Attribute types.
+type Attributes []Attribute
+
+// Get returns the Attribute with the given name, if present.
+func (as Attributes) Get(name string) (Attribute, bool) {
+	for _, a := range as {
+		if a.Name == name {
+			return a, true
+		}
+	}
+
+	return Attribute{}, false
+}
+
+// Validate checks for duplicated attribute names and delegates to
+// Attribute.Validate for each attribute. Errors are prefixed with the JSON
+// pointer of the attribute.
+func (as Attributes) Validate(ctx context.Context, req AttributesValidateRequest) error {
+	attributeNames := make(map[string]struct{}, len(as))
+
+	var errs, nestedErrs []error
+
+	for i, a := range as {
+		if _, ok := attributeNames[a.Name]; ok {
+			errs = append(errs, diagnostic.NewError(fmt.Errorf("%s attribute %q is duplicated", req.Path, a.Name), "attributes", i, "name"))
+		}
+
+		attributeNames[a.Name] = struct{}{}
+
+		validateRequest := AttributeValidateRequest{
+			Path:                       fmt.Sprintf("%s attribute %q", req.Path, a.Name),
+			AttributeType:              a.AttributeType(),
+			WriteOnlyRequired:          req.WriteOnlyRequired,
+			WriteOnlyUnsupportedWithin: req.WriteOnlyUnsupportedWithin,
+		}
+
+		err := a.Validate(ctx, validateRequest)
+
+		if err != nil {
+			nestedErrs = append(nestedErrs, diagnostic.NewErrors(err, "attributes", i)...)
+		}
+	}
+
+	e := append(errs, nestedErrs...)
+
+	return errors.Join(e...)
+}
+
+// AttributeValidateRequest defines the Path and AttributeType of the
+// attribute that is being validated, and the restrictions on write-only
+// attributes.
+type AttributeValidateRequest struct {
+	Path                       string
+	AttributeType              string
+	WriteOnlyRequired          bool
+	WriteOnlyUnsupportedWithin string
+}
+
+// Attribute defines the additions to an individual attribute. Only one of
+// the attribute types is expected to be set, mirroring the specification.
+type Attribute struct {
+	Name string `json:"name"`
+
+	Bool    *AttributeProperties `json:"bool,omitempty"`
+	Dynamic *AttributeProperties `json:"dynamic,omitempty"`
+	Float32 *AttributeProperties `json:"float32,omitempty"`
+	Float64 *AttributeProperties `json:"float64,omitempty"`
+	Int32   *AttributeProperties `json:"int32,omitempty"`
+	Int64   *AttributeProperties `json:"int64,omitempty"`
+	List    *AttributeProperties `json:"list,omitempty"`
+	Map     *AttributeProperties `json:"map,omitempty"`
+	Number  *AttributeProperties `json:"number,omitempty"`
+	Object  *AttributeProperties `json:"object,omitempty"`
+	Set     *AttributeProperties `json:"set,omitempty"`
+	String  *AttributeProperties `json:"string,omitempty"`
+
+	ListNested   *NestedAttribute       `json:"list_nested,omitempty"`
+	MapNested    *NestedAttribute       `json:"map_nested,omitempty"`
+	SetNested    *NestedAttribute       `json:"set_nested,omitempty"`
+	SingleNested *SingleNestedAttribute `json:"single_nested,omitempty"`
+}
+
+// Properties returns the AttributeProperties of whichever of the primitive,
+// collection or object attribute types is set.
+func (a Attribute) Properties() *AttributeProperties {
+	for _, p := range []*AttributeProperties{
+		a.Bool,
+		a.Dynamic,
+		a.Float32,
+		a.Float64,
+		a.Int32,
+		a.Int64,
+		a.List,
+		a.Map,
+		a.Number,
+		a.Object,
+		a.Set,
+		a.String,
+	} {
+		if p != nil {
+			return p
+		}
+	}
+
+	return nil
+}
+
+// AttributeType returns the name of whichever of the attribute types is set,
+// which is the key of its properties within the document.
+func (a Attribute) AttributeType() string {
+	switch {
+	case a.Bool != nil:
+		return "bool"
+	case a.Dynamic != nil:
+		return "dynamic"
+	case a.Float32 != nil:
+		return "float32"
+	case a.Float64 != nil:
+		return "float64"
+	case a.Int32 != nil:
+		return "int32"
+	case a.Int64 != nil:
+		return "int64"
+	case a.List != nil:
+		return "list"
+	case a.Map != nil:
+		return "map"
+	case a.Number != nil:
+		return "number"
+	case a.Object != nil:
+		return "object"
+	case a.Set != nil:
+		return "set"
+	case a.String != nil:
+		return "string"
+	case a.ListNested != nil:
+		return "list_nested"
+	case a.MapNested != nil:
+		return "map_nested"
+	case a.SetNested != nil:
+		return "set_nested"
+	case a.SingleNested != nil:
+		return "single_nested"
+	}
+
+	return ""
+}
+
+// Validate delegates to AttributeProperties.Validate and
+// Constraints.Validate, or to the nested attributes. Errors are prefixed with
+// the JSON pointer of the attribute type.
+func (a Attribute) Validate(ctx context.Context, req AttributeValidateRequest) error {
+	attributeType := a.AttributeType()
+
+	if p := a.Properties(); p != nil {
+		err := p.Validate(ctx, req)
+
+		if p.Constraints != nil {
+			err = errors.Join(err, diagnostic.WithPointer(p.Constraints.Validate(ctx, ConstraintsValidateRequest{
+				Path:          req.Path,
+				Name:          a.Name,
+				AttributeType: attributeType,
+			}), "constraints"))
+		}
+
+		return diagnostic.WithPointer(err, attributeType)
+	}
+
+	var attributes Attributes
+
+	var limits ItemsLimits
+
+	var properties NestedAttributeProperties
+
+	// The nested attributes of list, map and set nested attributes are
+	// defined within the nested object.
+	nestedTokens := []any{attributeType, "nested_object"}
+
+	nestedReq := AttributesValidateRequest{
+		Path:                       req.Path,
+		WriteOnlyUnsupportedWithin: req.WriteOnlyUnsupportedWithin,
+	}
+
+	switch {
+	case a.ListNested != nil:
+		attributes = a.ListNested.NestedObject.Attributes
+		limits = a.ListNested.ItemsLimits
+		properties = a.ListNested.NestedAttributeProperties
+	case a.MapNested != nil:
+		attributes = a.MapNested.NestedObject.Attributes
+		limits = a.MapNested.ItemsLimits
+		properties = a.MapNested.NestedAttributeProperties
+	case a.SetNested != nil:
+		attributes = a.SetNested.NestedObject.Attributes
+		limits = a.SetNested.ItemsLimits
+		properties = a.SetNested.NestedAttributeProperties
+
+		// The framework does not support write-only attributes within set
+		// nested attributes.
+		if nestedReq.WriteOnlyUnsupportedWithin == "" {
+			nestedReq.WriteOnlyUnsupportedWithin = "set nested attributes"
+		}
+	case a.SingleNested != nil:
+		attributes = a.SingleNested.Attributes
+		properties = a.SingleNested.NestedAttributeProperties
+		nestedTokens = []any{attributeType}
+	}
+
+	err := properties.Validate(ctx, req)
+
+	switch {
+	case nestedReq.WriteOnlyUnsupportedWithin != "":
+	case properties.WriteOnly:
+		nestedReq.WriteOnlyRequired = true
+	case properties.ComputedOptionalRequired == "computed", properties.ComputedOptionalRequired == "computed_optional":
+		nestedReq.WriteOnlyUnsupportedWithin = "computed nested attributes"
+	}
+
+	return errors.Join(
+		diagnostic.WithPointer(errors.Join(err, limits.Validate(ctx, req)), attributeType),
+		diagnostic.WithPointer(attributes.Validate(ctx, nestedReq), nestedTokens...),
+	)
+}
+
+// AttributeProperties defines the additions to the properties of a primitive,
+// collection or object attribute.
+type AttributeProperties struct {
+	// WriteOnly indicates that the attribute value is only available in
+	// configuration and is never persisted to plan or state. Only
+	// resource attributes can be write-only.
+	WriteOnly bool `json:"write_only,omitempty"`
+
+	PlanModifierShorthands
+
+	// ComputedOptionalRequired and Default are properties of the
+	// specification which are read to validate the additions. The static
+	// default of list, map, object and set attributes is an addition.
+	ComputedOptionalRequired string          `json:"computed_optional_required,omitempty"`
+	Default                  json.RawMessage `json:"default,omitempty"`
+
+	// ElementType and AttributeTypes are properties of the specification
+	// which are read to validate the names of object attribute types.
+	ElementType    *ElementType         `json:"element_type,omitempty"`
+	AttributeTypes ObjectAttributeTypes `json:"attribute_types,omitempty"`
+
+	// Constraints defines declarative validation of the attribute value.
+	Constraints *Constraints `json:"constraints,omitempty"`
+}
+
+// PlanModifierShorthands defines the shorthands for the framework plan
+// modifiers of the same name. Only resource attributes, including nested
+// attributes, can define plan modifier shorthands.
+type PlanModifierShorthands struct {
+	RequiresReplace             bool `json:"requires_replace,omitempty"`
+	RequiresReplaceIfConfigured bool `json:"requires_replace_if_configured,omitempty"`
+	UseStateForUnknown          bool `json:"use_state_for_unknown,omitempty"`
+}
+
+// validate checks that only one of the requires replace shorthands is
+// defined, and that use_state_for_unknown is only defined for computed
+// attributes.
+func (s PlanModifierShorthands) validate(req AttributeValidateRequest, computedOptionalRequired string) error {
+	var errs []error
+
+	if s.RequiresReplace && s.RequiresReplaceIfConfigured {
+		errs = append(errs, fmt.Errorf("%s cannot define both requires_replace and requires_replace_if_configured", req.Path))
+	}
+
+	if s.UseStateForUnknown {
+		switch computedOptionalRequired {
+		case "computed", "computed_optional":
+		default:
+			errs = append(errs, diagnostic.NewError(fmt.Errorf("%s must be computed to use use_state_for_unknown", req.Path), "use_state_for_unknown"))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// attributeDefault is the static or custom default of an attribute.
+type attributeDefault struct {
+	Custom json.RawMessage `json:"custom,omitempty"`
+	Static any             `json:"static,omitempty"`
+}
+
+// StaticDefault returns the static default, decoded with numbers as
+// json.Number, or nil if there is no static default.
+func (p AttributeProperties) StaticDefault() (any, error) {
+	d, err := p.attributeDefault()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return d.Static, nil
+}
+
+func (p AttributeProperties) attributeDefault() (attributeDefault, error) {
+	var d attributeDefault
+
+	if len(p.Default) == 0 {
+		return d, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(p.Default))
+	decoder.UseNumber()
+
+	err := decoder.Decode(&d)
+
+	return d, err
+}
+
+// Validate checks that the additions are compatible with the properties
+// defined by the specification.
+func (p AttributeProperties) Validate(ctx context.Context, req AttributeValidateRequest) error {
+	var errs []error
+
+	errs = append(errs, validateWriteOnly(req, p.WriteOnly, p.ComputedOptionalRequired))
+
+	if p.WriteOnly {
+		// The framework does not support write-only set attributes.
+		if req.AttributeType == "set" {
+			errs = append(errs, diagnostic.NewError(fmt.Errorf("%s is write-only, which is not supported for set attributes", req.Path), "write_only"))
+		}
+
+		if len(p.Default) > 0 {
+			errs = append(errs, diagnostic.NewError(fmt.Errorf("%s is write-only and cannot have a default", req.Path), "default"))
+		}
+	}
+
+	errs = append(errs, p.PlanModifierShorthands.validate(req, p.ComputedOptionalRequired))
+
+	errs = append(errs,
+		diagnostic.WithPointer(p.ElementType.Validate(ctx, req), "element_type"),
+		diagnostic.WithPointer(p.AttributeTypes.Validate(ctx, req), "attribute_types"),
+	)
+
+	d, err := p.attributeDefault()
+
+	if err != nil {
+		errs = append(errs, diagnostic.NewError(fmt.Errorf("%s default is invalid: %w", req.Path, err), "default"))
+	} else if d.Static != nil && len(d.Custom) > 0 {
+		errs = append(errs, diagnostic.NewError(fmt.Errorf("%s cannot have both a static and a custom default", req.Path), "default"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// ElementType defines the collection and object element types of a
+// collection attribute or element type, which are read to reach the object
+// attribute types within them.
+type ElementType struct {
+	List   *CollectionType `json:"list,omitempty"`
+	Map    *CollectionType `json:"map,omitempty"`
+	Object *ObjectType     `json:"object,omitempty"`
+	Set    *CollectionType `json:"set,omitempty"`
+}
+
+// Validate delegates to ObjectAttributeTypes.Validate for object element
+// types, including those of nested collections. Errors are prefixed with the
+// JSON pointer relative to the element type.
+func (e *ElementType) Validate(ctx context.Context, req AttributeValidateRequest) error {
+	if e == nil {
+		return nil
+	}
+
+	switch {
+	case e.List != nil:
+		return diagnostic.WithPointer(e.List.ElementType.Validate(ctx, req), "list", "element_type")
+	case e.Map != nil:
+		return diagnostic.WithPointer(e.Map.ElementType.Validate(ctx, req), "map", "element_type")
+	case e.Object != nil:
+		return diagnostic.WithPointer(e.Object.AttributeTypes.Validate(ctx, req), "object", "attribute_types")
+	case e.Set != nil:
+		return diagnostic.WithPointer(e.Set.ElementType.Validate(ctx, req), "set", "element_type")
+	}
+
+	return nil
+}
+
+// CollectionType defines the element type of a collection element type.
+type CollectionType struct {
+	ElementType *ElementType `json:"element_type,omitempty"`
+}
+
+// ObjectType defines the attribute types of an object element type.
+type ObjectType struct {
+	AttributeTypes ObjectAttributeTypes `json:"attribute_types,omitempty"`
+}
+
+// ObjectAttributeTypes type defines ObjectAttributeType types.
+type ObjectAttributeTypes []ObjectAttributeType
+
+// Validate checks for duplicated object attribute type names, including
+// those of nested collections and objects. Errors are prefixed with the JSON
+// pointer of the object attribute type.
+func (os ObjectAttributeTypes) Validate(ctx context.Context, req AttributeValidateRequest) error {
+	attributeTypeNames := make(map[string]struct{}, len(os))
+
+	var errs, nestedErrs []error
+
+	for i, o := range os {
+		if _, ok := attributeTypeNames[o.Name]; ok {
+			errs = append(errs, diagnostic.NewError(fmt.Errorf("%s object attribute type %q is duplicated", req.Path, o.Name), i, "name"))
+		}
+
+		attributeTypeNames[o.Name] = struct{}{}
+
+		nestedReq := req
+		nestedReq.Path = fmt.Sprintf("%s object attribute type %q", req.Path, o.Name)
+
+		err := o.ElementType.Validate(ctx, nestedReq)
+
+		if err != nil {
+			nestedErrs = append(nestedErrs, diagnostic.NewErrors(err, i)...)
+		}
+	}
+
+	e := append(errs, nestedErrs...)
+
+	return errors.Join(e...)
+}
+
+// ObjectAttributeType defines an individual object attribute type, which is
+// defined in the same way as an element type.
+type ObjectAttributeType struct {
+	Name string `json:"name"`
+
+	ElementType
+}
+
+// validateWriteOnly checks that a write-only attribute is not computed, and
+// that the attribute satisfies the restrictions on write-only attributes
+// inherited from the enclosing attribute or block.
+func validateWriteOnly(req AttributeValidateRequest, writeOnly bool, computedOptionalRequired string) error {
+	var errs []error
+
+	if writeOnly {
+		switch computedOptionalRequired {
+		case "computed", "computed_optional":
+			errs = append(errs, fmt.Errorf("%s is write-only and cannot be %s", req.Path, computedOptionalRequired))
+		}
+
+		if req.WriteOnlyUnsupportedWithin != "" {
+			errs = append(errs, fmt.Errorf("%s is write-only, which is not supported within %s", req.Path, req.WriteOnlyUnsupportedWithin))
+		}
+	}
+
+	if req.WriteOnlyRequired && !writeOnly {
+		errs = append(errs, fmt.Errorf("%s must be write-only, as the enclosing nested attribute is write-only", req.Path))
+	}
+
+	return errors.Join(errs...)
+}
+
+// NestedAttributeProperties defines the additions to the properties of a
+// nested attribute.
+type NestedAttributeProperties struct {
+	// WriteOnly indicates that the nested attribute value is only available
+	// in configuration, in which case every nested attribute must also be
+	// write-only. Only resource list, map and single nested attributes can
+	// be write-only.
+	WriteOnly bool `json:"write_only,omitempty"`
+
+	PlanModifierShorthands
+
+	// ComputedOptionalRequired is a property of the specification which is
+	// read to validate the additions.
+	ComputedOptionalRequired string `json:"computed_optional_required,omitempty"`
+}
+
+// Validate checks that the additions are compatible with the properties
+// defined by the specification.
+func (p NestedAttributeProperties) Validate(ctx context.Context, req AttributeValidateRequest) error {
+	err := validateWriteOnly(req, p.WriteOnly, p.ComputedOptionalRequired)
+
+	// The framework does not support write-only set nested attributes.
+	if p.WriteOnly && req.AttributeType == "set_nested" {
+		err = errors.Join(err, diagnostic.NewError(fmt.Errorf("%s is write-only, which is not supported for set nested attributes", req.Path), "write_only"))
+	}
+
+	return errors.Join(err, p.PlanModifierShorthands.validate(req, p.ComputedOptionalRequired))
+}
+
+// NestedAttribute defines the additions to the nested attributes of a list,
+// map or set nested attribute.
+type NestedAttribute struct {
+	ItemsLimits
+	NestedAttributeProperties
+
+	NestedObject NestedAttributeObject `json:"nested_object"`
+}
+
+// NestedAttributeObject defines the additions to the attributes of a
+// nested attribute object.
+type NestedAttributeObject struct {
+	Attributes Attributes `json:"attributes,omitempty"`
+}
+
+// SingleNestedAttribute defines the additions to the nested attributes of a
+// single nested attribute.
+type SingleNestedAttribute struct {
+	NestedAttributeProperties
+
+	Attributes Attributes `json:"attributes,omitempty"`
+}
+
+// BlocksValidateRequest defines the Path of the blocks that are
+// being validated, and the restrictions on write-only attributes.
+type BlocksValidateRequest struct {
+	Path                       string
+	WriteOnlyRequired          bool
+	WriteOnlyUnsupportedWithin string
+}
+
+// Blocks type defines Block types.
+type Blocks []Block
+
+// Get returns the Block with the given name, if present.
+func (bs Blocks) Get(name string) (Block, bool) {
+	for _, b := range bs {
+		if b.Name == name {
+			return b, true
+		}
+	}
+
+	return Block{}, false
+}
+
+// Validate checks for duplicated block names and delegates to the Validate
+// method of the nested attributes and blocks of each block. Errors are
+// prefixed with the JSON pointer of the block.
+func (bs Blocks) Validate(ctx context.Context, req BlocksValidateRequest) error {
+	blockNames := make(map[string]struct{}, len(bs))
+
+	var errs, nestedErrs []error
+
+	for i, b := range bs {
+		if _, ok := blockNames[b.Name]; ok {
+			errs = append(errs, diagnostic.NewError(fmt.Errorf("%s block %q is duplicated", req.Path, b.Name), "blocks", i, "name"))
+		}
+
+		blockNames[b.Name] = struct{}{}
+
+		path := fmt.Sprintf("%s block %q", req.Path, b.Name)
+
+		schemaReq := SchemaValidateRequest{
+			Path:                       path,
+			WriteOnlyUnsupportedWithin: req.WriteOnlyUnsupportedWithin,
+		}
+
+		// The framework does not support write-only attributes within set
+		// nested blocks, including those within nested blocks.
+		if b.SetNested != nil && schemaReq.WriteOnlyUnsupportedWithin == "" {
+			schemaReq.WriteOnlyUnsupportedWithin = "set nested blocks"
+		}
+
+		blockType := b.BlockType()
+
+		// The nested attributes and blocks of list and set nested blocks
+		// are defined within the nested object.
+		nestedTokens := []any{blockType, "nested_object"}
+
+		if b.SingleNested != nil {
+			nestedTokens = []any{blockType}
+		}
+
+		err := errors.Join(
+			diagnostic.WithPointer(b.ItemsLimits().Validate(ctx, AttributeValidateRequest{
+				Path: path,
+			}), blockType),
+			diagnostic.WithPointer(b.Schema().Validate(ctx, schemaReq), nestedTokens...),
+		)
+
+		if err != nil {
+			nestedErrs = append(nestedErrs, diagnostic.NewErrors(err, "blocks", i)...)
+		}
+	}
+
+	e := append(errs, nestedErrs...)
+
+	return errors.Join(e...)
+}
+
+// Block defines the additions to an individual block.
+type Block struct {
+	Name string `json:"name"`
+
+	ListNested   *NestedBlock       `json:"list_nested,omitempty"`
+	SetNested    *NestedBlock       `json:"set_nested,omitempty"`
+	SingleNested *SingleNestedBlock `json:"single_nested,omitempty"`
+}
+
+// BlockType returns the name of whichever of the block types is set, which
+// is the key of its properties within the document.
+func (b Block) BlockType() string {
+	switch {
+	case b.ListNested != nil:
+		return "list_nested"
+	case b.SetNested != nil:
+		return "set_nested"
+	case b.SingleNested != nil:
+		return "single_nested"
+	}
+
+	return ""
+}
+
+// ItemsLimits returns the ItemsLimits of a list or set nested block.
+func (b Block) ItemsLimits() ItemsLimits {
+	switch {
+	case b.ListNested != nil:
+		return b.ListNested.ItemsLimits
+	case b.SetNested != nil:
+		return b.SetNested.ItemsLimits
+	}
+
+	return ItemsLimits{}
+}
+
+// Schema returns the nested attributes and blocks of the block.
+func (b Block) Schema() Schema {
+	switch {
+	case b.ListNested != nil:
+		return Schema(b.ListNested.NestedObject)
+	case b.SetNested != nil:
+		return Schema(b.SetNested.NestedObject)
+	case b.SingleNested != nil:
+		return Schema(*b.SingleNested)
+	}
+
+	return Schema{}
+}
+
+// NestedBlock defines the additions to the nested attributes and blocks of
+// a list or set nested block.
+type NestedBlock struct {
+	ItemsLimits
+
+	NestedObject NestedBlockObject `json:"nested_object"`
+}
+
+// ItemsLimits defines the minimum and maximum number of elements of a list
+// or set nested attribute or block, which are generated as validators.
+type ItemsLimits struct {
+	MinItems *int64 `json:"min_items,omitempty"`
+	MaxItems *int64 `json:"max_items,omitempty"`
+}
+
+// Constraints returns the min_items and max_items constraints, or nil if
+// neither is defined.
+func (l ItemsLimits) Constraints() *Constraints {
+	if l.MinItems == nil && l.MaxItems == nil {
+		return nil
+	}
+
+	return &Constraints{
+		MinItems: l.MinItems,
+		MaxItems: l.MaxItems,
+	}
+}
+
+// Validate checks that the limits are not negative, and that the minimum is
+// not greater than the maximum.
+func (l ItemsLimits) Validate(ctx context.Context, req AttributeValidateRequest) error {
+	var errs []error
+
+	if l.MinItems != nil && *l.MinItems < 0 {
+		errs = append(errs, diagnostic.NewError(fmt.Errorf("%s min_items must not be negative", req.Path), "min_items"))
+	}
+
+	if l.MaxItems != nil && *l.MaxItems < 0 {
+		errs = append(errs, diagnostic.NewError(fmt.Errorf("%s max_items must not be negative", req.Path), "max_items"))
+	}
+
+	if l.MinItems != nil && l.MaxItems != nil && *l.MinItems > *l.MaxItems {
+		errs = append(errs, diagnostic.NewError(fmt.Errorf("%s min_items must not be greater than max_items", req.Path), "min_items"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// NestedBlockObject defines the additions to the attributes and blocks of a
+// nested block object.
+type NestedBlockObject struct {
+	Attributes Attributes `json:"attributes,omitempty"`
+	Blocks     Blocks     `json:"blocks,omitempty"`
+}
+
+// SingleNestedBlock defines the additions to the nested attributes and
+// blocks of a single nested block.
+type SingleNestedBlock struct {
+	Attributes Attributes `json:"attributes,omitempty"`
+	Blocks     Blocks     `json:"blocks,omitempty"`
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package extension
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/validate"
+)
+
+// Specification defines the additions to the Provider Code Specification
+// which are interpreted by the framework code generator, but are not yet
+// part of terraform-plugin-codegen-spec. The additions are read from the
+// same document as the specification.
+type Specification struct {
+	// DataSources defines the additions to each data source.
+	DataSources DataSources `json:"datasources,omitempty"`
+
+	// EphemeralResources defines a slice of EphemeralResource type.
+	EphemeralResources EphemeralResources `json:"ephemeral_resources,omitempty"`
+
+	// Functions defines a slice of Function type.
+	Functions Functions `json:"functions,omitempty"`
+
+	// Provider defines the additions to the provider.
+	Provider *Provider `json:"provider,omitempty"`
+
+	// Resources defines the additions to each resource.
+	Resources Resources `json:"resources,omitempty"`
+}
+
+// Parse unmarshals the additions to the Provider Code Specification from
+// the supplied document, and validates them along with the ephemeral
+// resources, which are not yet part of the specification. Every error is
+// returned, each as a diagnostic.Error locating it within the document.
+func Parse(ctx context.Context, document []byte) (Specification, error) {
+	var s Specification
+
+	var errs []error
+
+	// Unmarshal continues after a value of the wrong type, so that the
+	// remainder of the document can still be validated.
+	if err := json.Unmarshal(document, &s); err != nil {
+		var typeErr *json.UnmarshalTypeError
+
+		if !errors.As(err, &typeErr) {
+			return s, err
+		}
+
+		errs = append(errs, diagnostic.NewOffsetError(err, typeErr.Offset))
+	}
+
+	specDocument, err := SpecificationDocument(document)
+
+	if err != nil {
+		return s, err
+	}
+
+	errs = append(errs, validate.EphemeralResources(specDocument, Float32Tokens(document)))
+
+	// The schemas of ephemeral resources and the definitions of functions
+	// are read in the same way as the specification, with float32 types
+	// replaced and then identified.
+	if err := s.EphemeralResources.unmarshalSchemas(specDocument, document); err != nil {
+		return s, err
+	}
+
+	if err := s.Functions.unmarshalDefinitions(specDocument, document); err != nil {
+		return s, err
+	}
+
+	errs = append(errs, s.Validate(ctx))
+
+	return s, errors.Join(errs...)
+}
+
+// ParseSpecification unmarshals the Provider Code Specification from the
+// supplied document, with the additions removed and float32 types replaced
+// with float64 types, which are identified by IsFloat32. The document is
+// expected to have been validated against the specification JSON schema
+// beforehand, using SpecificationDocument.
+// Duplicated names are reported by Parse, along with their location within
+// the document.
+func ParseSpecification(ctx context.Context, document []byte) (spec.Specification, error) {
+	var s spec.Specification
+
+	specDocument, err := SpecificationDocument(document)
+
+	if err != nil {
+		return s, err
+	}
+
+	if err := unmarshalFloat32Document(specDocument, document, &s); err != nil {
+		return s, err
+	}
+
+	return s, nil
+}
+
+// Validate delegates to the Validate method of each of the
+// additions to the specification.
+func (s Specification) Validate(ctx context.Context) error {
+	var errs []error
+
+	err := s.DataSources.Validate(ctx, DataSourcesValidateRequest{})
+
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	err = s.EphemeralResources.Validate(ctx, EphemeralResourcesValidateRequest{})
+
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	err = s.Functions.Validate(ctx, FunctionsValidateRequest{})
+
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if s.Provider != nil {
+		err = s.Provider.Validate(ctx, ProviderValidateRequest{})
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	err = s.Resources.Validate(ctx, ResourcesValidateRequest{})
+
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
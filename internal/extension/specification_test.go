@@ -0,0 +1,1315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package extension_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	"github.com/greatman/terraform-plugin-codegen-spec/datasource"
+	"github.com/greatman/terraform-plugin-codegen-spec/resource"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+	"github.com/greatman/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		document      string
+		expected      extension.Specification
+		expectedError error
+	}{
+		"no-additions": {
+			document: `{"provider": {"name": "example"}, "version": "0.1"}`,
+			expected: extension.Specification{
+				Provider: &extension.Provider{
+					Name: "example",
+				},
+			},
+		},
+		"ephemeral-resources": {
+			document: `{
+				"ephemeral_resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "token", "string": {"computed_optional_required": "computed", "sensitive": true}}
+							]
+						}
+					}
+				],
+				"provider": {"name": "example"},
+				"version": "0.1"
+			}`,
+			expected: extension.Specification{
+				Provider: &extension.Provider{
+					Name: "example",
+				},
+				EphemeralResources: extension.EphemeralResources{
+					{
+						Name: "example",
+						Schema: &datasource.Schema{
+							Attributes: datasource.Attributes{
+								{
+									Name: "token",
+									String: &datasource.StringAttribute{
+										ComputedOptionalRequired: specschema.Computed,
+										Sensitive:                pointer(true),
+									},
+								},
+							},
+						},
+						Additions: &extension.Schema{
+							Attributes: extension.Attributes{
+								{
+									Name: "token",
+									String: &extension.AttributeProperties{
+										ComputedOptionalRequired: "computed",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"ephemeral-resource-duplicated": {
+			document: `{
+				"ephemeral_resources": [
+					{"name": "example", "schema": {"description": "example"}},
+					{"name": "example", "schema": {"description": "example"}}
+				],
+				"provider": {"name": "example"},
+				"version": "0.1"
+			}`,
+			expectedError: errors.New(`/ephemeral_resources/1/name: ephemeral resource "example" is duplicated`),
+		},
+		"ephemeral-resource-invalid": {
+			document: `{
+				"ephemeral_resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "token", "string": {"computed_optional_required": "computed", "write_only": true}}
+							]
+						}
+					}
+				],
+				"provider": {"name": "example"},
+				"version": "0.1"
+			}`,
+			expectedError: errors.New("/ephemeral_resources/0/schema/attributes/0: Must validate one and only one schema (oneOf)\n" +
+				"/ephemeral_resources/0/schema/attributes/0/string: Additional property write_only is not allowed\n" +
+				"/ephemeral_resources/0/schema/attributes/0/string: ephemeral resource \"example\" attribute \"token\" is write-only and cannot be computed"),
+		},
+		"ephemeral-resource-float32-invalid": {
+			document: `{
+				"ephemeral_resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "ratio", "float32": {"computed_optional_required": "maybe"}}
+							]
+						}
+					}
+				],
+				"provider": {"name": "example"},
+				"version": "0.1"
+			}`,
+			expectedError: errors.New("/ephemeral_resources/0/schema/attributes/0: Must validate one and only one schema (oneOf)\n" +
+				"/ephemeral_resources/0/schema/attributes/0/float32/computed_optional_required: ephemeral_resources.0.schema.attributes.0.float32.computed_optional_required must be one of the following: \"computed\", \"computed_optional\", \"optional\", \"required\""),
+		},
+		"functions": {
+			document: `{
+				"functions": [
+					{
+						"name": "example",
+						"definition": {
+							"parameters": [{"name": "input", "string": {}}],
+							"return": {"bool": {}}
+						}
+					}
+				]
+			}`,
+			expected: extension.Specification{
+				Functions: extension.Functions{
+					{
+						Name: "example",
+						Definition: &extension.FunctionDefinition{
+							Parameters: extension.Parameters{
+								{
+									Name:   "input",
+									String: &extension.StringParameter{},
+								},
+							},
+							Return: extension.Return{
+								Bool: &specschema.BoolType{},
+							},
+						},
+					},
+				},
+			},
+		},
+		"function-duplicated": {
+			document: `{
+				"functions": [
+					{"name": "example", "definition": {"return": {"bool": {}}}},
+					{"name": "example", "definition": {"return": {"bool": {}}}}
+				]
+			}`,
+			expectedError: errors.New(`/functions/1/name: function "example" is duplicated`),
+		},
+		"function-definition-missing": {
+			document:      `{"functions": [{"name": "example"}]}`,
+			expectedError: errors.New(`/functions/0: function "example" definition is required`),
+		},
+		"function-parameter-duplicated": {
+			document: `{
+				"functions": [
+					{
+						"name": "example",
+						"definition": {
+							"parameters": [{"name": "input", "string": {}}],
+							"variadic_parameter": {"name": "input", "string": {}},
+							"return": {"bool": {}}
+						}
+					}
+				]
+			}`,
+			expectedError: errors.New(`/functions/0/definition/variadic_parameter/name: function "example" parameter "input" is duplicated`),
+		},
+		"function-parameter-type-missing": {
+			document: `{
+				"functions": [
+					{
+						"name": "example",
+						"definition": {
+							"parameters": [{"name": "input"}],
+							"return": {"bool": {}}
+						}
+					}
+				]
+			}`,
+			expectedError: errors.New(`/functions/0/definition/parameters/0: function "example" parameter "input" must define exactly one type, got 0`),
+		},
+		"function-return-type-missing": {
+			document:      `{"functions": [{"name": "example", "definition": {"return": {}}}]}`,
+			expectedError: errors.New(`/functions/0/definition/return: function "example" return must define exactly one type, got 0`),
+		},
+		"resource-write-only": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "password", "string": {"computed_optional_required": "required", "write_only": true}},
+								{
+									"name": "nested",
+									"single_nested": {
+										"computed_optional_required": "optional",
+										"attributes": [
+											{"name": "secret", "list": {"computed_optional_required": "optional", "element_type": {"string": {}}, "write_only": true}}
+										]
+									}
+								}
+							]
+						}
+					}
+				]
+			}`,
+			expected: extension.Specification{
+				Resources: extension.Resources{
+					{
+						Name: "example",
+						Schema: &extension.Schema{
+							Attributes: extension.Attributes{
+								{
+									Name: "password",
+									String: &extension.AttributeProperties{
+										WriteOnly:                true,
+										ComputedOptionalRequired: "required",
+									},
+								},
+								{
+									Name: "nested",
+									SingleNested: &extension.SingleNestedAttribute{
+										NestedAttributeProperties: extension.NestedAttributeProperties{
+											ComputedOptionalRequired: "optional",
+										},
+										Attributes: extension.Attributes{
+											{
+												Name: "secret",
+												List: &extension.AttributeProperties{
+													WriteOnly:                true,
+													ComputedOptionalRequired: "optional",
+													ElementType:              &extension.ElementType{},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource-write-only-computed": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "password", "string": {"computed_optional_required": "computed_optional", "write_only": true}}
+							]
+						}
+					}
+				]
+			}`,
+			expectedError: errors.New(`/resources/0/schema/attributes/0/string: resource "example" attribute "password" is write-only and cannot be computed_optional`),
+		},
+		"resource-write-only-nested": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{
+									"name": "credentials",
+									"list_nested": {
+										"computed_optional_required": "optional",
+										"nested_object": {
+											"attributes": [
+												{"name": "password", "string": {"computed_optional_required": "required", "write_only": true}}
+											]
+										},
+										"write_only": true
+									}
+								}
+							]
+						}
+					}
+				]
+			}`,
+			expected: extension.Specification{
+				Resources: extension.Resources{
+					{
+						Name: "example",
+						Schema: &extension.Schema{
+							Attributes: extension.Attributes{
+								{
+									Name: "credentials",
+									ListNested: &extension.NestedAttribute{
+										NestedAttributeProperties: extension.NestedAttributeProperties{
+											WriteOnly:                true,
+											ComputedOptionalRequired: "optional",
+										},
+										NestedObject: extension.NestedAttributeObject{
+											Attributes: extension.Attributes{
+												{
+													Name: "password",
+													String: &extension.AttributeProperties{
+														WriteOnly:                true,
+														ComputedOptionalRequired: "required",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource-write-only-nested-child-not-write-only": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{
+									"name": "credentials",
+									"map_nested": {
+										"computed_optional_required": "optional",
+										"nested_object": {
+											"attributes": [
+												{"name": "username", "string": {"computed_optional_required": "required"}}
+											]
+										},
+										"write_only": true
+									}
+								}
+							]
+						}
+					}
+				]
+			}`,
+			expectedError: errors.New(`/resources/0/schema/attributes/0/map_nested/nested_object/attributes/0/string: resource "example" attribute "credentials" attribute "username" must be write-only, as the enclosing nested attribute is write-only`),
+		},
+		"resource-write-only-set-nested": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{
+									"name": "credentials",
+									"set_nested": {
+										"computed_optional_required": "optional",
+										"nested_object": {
+											"attributes": [
+												{"name": "password", "string": {"computed_optional_required": "required", "write_only": true}}
+											]
+										},
+										"write_only": true
+									}
+								}
+							]
+						}
+					}
+				]
+			}`,
+			expectedError: errors.Join(
+				errors.New(`/resources/0/schema/attributes/0/set_nested/write_only: resource "example" attribute "credentials" is write-only, which is not supported for set nested attributes`),
+				errors.New(`/resources/0/schema/attributes/0/set_nested/nested_object/attributes/0/string: resource "example" attribute "credentials" attribute "password" is write-only, which is not supported within set nested attributes`),
+			),
+		},
+		"resource-write-only-within-set-nested-block": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"blocks": [
+								{
+									"name": "credentials",
+									"set_nested": {
+										"nested_object": {
+											"blocks": [
+												{
+													"name": "inner",
+													"list_nested": {
+														"nested_object": {
+															"attributes": [
+																{"name": "password", "string": {"computed_optional_required": "optional", "write_only": true}}
+															]
+														}
+													}
+												}
+											]
+										}
+									}
+								}
+							]
+						}
+					}
+				]
+			}`,
+			expectedError: errors.New(`/resources/0/schema/blocks/0/set_nested/nested_object/blocks/0/list_nested/nested_object/attributes/0/string: resource "example" block "credentials" block "inner" attribute "password" is write-only, which is not supported within set nested blocks`),
+		},
+		"resource-write-only-within-computed-nested": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{
+									"name": "credentials",
+									"list_nested": {
+										"computed_optional_required": "computed_optional",
+										"nested_object": {
+											"attributes": [
+												{
+													"name": "inner",
+													"single_nested": {
+														"computed_optional_required": "optional",
+														"attributes": [
+															{"name": "password", "string": {"computed_optional_required": "optional", "write_only": true}}
+														]
+													}
+												}
+											]
+										}
+									}
+								}
+							]
+						}
+					}
+				]
+			}`,
+			expectedError: errors.New(`/resources/0/schema/attributes/0/list_nested/nested_object/attributes/0/single_nested/attributes/0/string: resource "example" attribute "credentials" attribute "inner" attribute "password" is write-only, which is not supported within computed nested attributes`),
+		},
+		"resource-write-only-set": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "secrets", "set": {"computed_optional_required": "optional", "element_type": {"string": {}}, "write_only": true}}
+							]
+						}
+					}
+				]
+			}`,
+			expectedError: errors.New(`/resources/0/schema/attributes/0/set/write_only: resource "example" attribute "secrets" is write-only, which is not supported for set attributes`),
+		},
+		"resource-float32-write-only": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "ratio", "float32": {"computed_optional_required": "optional", "write_only": true}}
+							]
+						}
+					}
+				]
+			}`,
+			expected: extension.Specification{
+				Resources: extension.Resources{
+					{
+						Name: "example",
+						Schema: &extension.Schema{
+							Attributes: extension.Attributes{
+								{
+									Name: "ratio",
+									Float32: &extension.AttributeProperties{
+										WriteOnly:                true,
+										ComputedOptionalRequired: "optional",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource-write-only-default": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"blocks": [
+								{
+									"name": "nested",
+									"list_nested": {
+										"nested_object": {
+											"attributes": [
+												{"name": "enabled", "bool": {"computed_optional_required": "optional", "default": {"static": true}, "write_only": true}}
+											]
+										}
+									}
+								}
+							]
+						}
+					}
+				]
+			}`,
+			expectedError: errors.New(`/resources/0/schema/blocks/0/list_nested/nested_object/attributes/0/bool/default: resource "example" block "nested" attribute "enabled" is write-only and cannot have a default`),
+		},
+		"resource-static-and-custom-default": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "tags", "list": {"computed_optional_required": "computed_optional", "element_type": {"string": {}}, "default": {"custom": {"schema_definition": "my_default.Default()"}, "static": ["a"]}}}
+							]
+						}
+					}
+				]
+			}`,
+			expectedError: errors.New(`/resources/0/schema/attributes/0/list/default: resource "example" attribute "tags" cannot have both a static and a custom default`),
+		},
+		"data-source-constraints": {
+			document: `{
+				"datasources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "name", "string": {"computed_optional_required": "required", "constraints": {"min_length": 1, "one_of": ["a", "b"], "conflicts_with": ["id"]}}},
+								{"name": "id", "string": {"computed_optional_required": "optional"}}
+							]
+						}
+					}
+				],
+				"provider": {"name": "example"}
+			}`,
+			expected: extension.Specification{
+				DataSources: extension.DataSources{
+					{
+						Name: "example",
+						Schema: &extension.Schema{
+							Attributes: extension.Attributes{
+								{
+									Name: "name",
+									String: &extension.AttributeProperties{
+										ComputedOptionalRequired: "required",
+										Constraints: &extension.Constraints{
+											MinLength:     pointer(int64(1)),
+											OneOf:         []json.RawMessage{json.RawMessage(`"a"`), json.RawMessage(`"b"`)},
+											ConflictsWith: []string{"id"},
+										},
+									},
+								},
+								{
+									Name: "id",
+									String: &extension.AttributeProperties{
+										ComputedOptionalRequired: "optional",
+									},
+								},
+							},
+						},
+					},
+				},
+				Provider: &extension.Provider{
+					Name: "example",
+				},
+			},
+		},
+		"data-source-constraint-type-mismatch": {
+			document: `{
+				"datasources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "name", "string": {"computed_optional_required": "required", "constraints": {"min": 1, "min_items": 1}}}
+							]
+						}
+					}
+				]
+			}`,
+			expectedError: errors.New("/datasources/0/schema/attributes/0/string/constraints/min: data source \"example\" attribute \"name\" constraint min is not supported for string attributes\n" +
+				"/datasources/0/schema/attributes/0/string/constraints/min_items: data source \"example\" attribute \"name\" constraint min_items is not supported for string attributes"),
+		},
+		"provider-constraint-values": {
+			document: `{
+				"provider": {
+					"name": "example",
+					"schema": {
+						"attributes": [
+							{"name": "endpoint", "string": {"optional_required": "optional", "constraints": {"min_length": 5, "max_length": 1, "regex": {"pattern": "("}, "one_of": [1]}}},
+							{"name": "retries", "int32": {"optional_required": "optional", "constraints": {"min": 2147483648, "one_of": [1.5], "also_requires": ["retries"]}}}
+						]
+					}
+				}
+			}`,
+			expectedError: errors.New("/provider/schema/attributes/0/string/constraints/min_length: provider \"example\" attribute \"endpoint\" constraint min_length must not be greater than max_length\n" +
+				"/provider/schema/attributes/0/string/constraints/regex/pattern: provider \"example\" attribute \"endpoint\" constraint regex is invalid: error parsing regexp: missing closing ): `(`\n" +
+				"/provider/schema/attributes/0/string/constraints/one_of/0: provider \"example\" attribute \"endpoint\" constraint one_of[0] must be a string\n" +
+				"/provider/schema/attributes/1/int32/constraints/one_of/0: provider \"example\" attribute \"retries\" constraint one_of[0] is not a valid int32: strconv.ParseInt: parsing \"1.5\": invalid syntax\n" +
+				"/provider/schema/attributes/1/int32/constraints/min: provider \"example\" attribute \"retries\" constraint min is not a valid int32: strconv.ParseInt: parsing \"2147483648\": value out of range\n" +
+				"/provider/schema/attributes/1/int32/constraints/also_requires/0: provider \"example\" attribute \"retries\" constraint also_requires cannot reference the attribute itself"),
+		},
+		"resource-float32-constraints": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "ratio", "float32": {"computed_optional_required": "optional", "constraints": {"min": 1, "max": 0.5, "min_length": 1}}}
+							]
+						}
+					}
+				]
+			}`,
+			expectedError: errors.New(`/resources/0/schema/attributes/0/float32/constraints/min_length: resource "example" attribute "ratio" constraint min_length is not supported for float32 attributes`),
+		},
+		"resource-constraint-range": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "ratio", "float64": {"computed_optional_required": "optional", "constraints": {"min": 1, "max": 0.5}}},
+								{"name": "tags", "set": {"computed_optional_required": "optional", "element_type": {"string": {}}, "constraints": {"min_items": -1}}}
+							]
+						}
+					}
+				]
+			}`,
+			expectedError: errors.New("/resources/0/schema/attributes/0/float64/constraints/min: resource \"example\" attribute \"ratio\" constraint min must not be greater than max\n" +
+				"/resources/0/schema/attributes/1/set/constraints/min_items: resource \"example\" attribute \"tags\" constraint min_items must not be negative"),
+		},
+		"resource-plan-modifier-shorthands": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "id", "string": {"computed_optional_required": "computed", "use_state_for_unknown": true, "requires_replace": true}}
+							]
+						}
+					}
+				]
+			}`,
+			expected: extension.Specification{
+				Resources: extension.Resources{
+					{
+						Name: "example",
+						Schema: &extension.Schema{
+							Attributes: extension.Attributes{
+								{
+									Name: "id",
+									String: &extension.AttributeProperties{
+										ComputedOptionalRequired: "computed",
+										PlanModifierShorthands: extension.PlanModifierShorthands{
+											RequiresReplace:    true,
+											UseStateForUnknown: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource-plan-modifier-shorthands-invalid": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "name", "string": {"computed_optional_required": "required", "use_state_for_unknown": true, "requires_replace": true, "requires_replace_if_configured": true}}
+							]
+						}
+					}
+				]
+			}`,
+			expectedError: errors.New("/resources/0/schema/attributes/0/string: resource \"example\" attribute \"name\" cannot define both requires_replace and requires_replace_if_configured\n" +
+				"/resources/0/schema/attributes/0/string/use_state_for_unknown: resource \"example\" attribute \"name\" must be computed to use use_state_for_unknown"),
+		},
+		"resource-nested-plan-modifier-shorthands": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "list", "list_nested": {"computed_optional_required": "computed", "nested_object": {}, "use_state_for_unknown": true}},
+								{"name": "single", "single_nested": {"computed_optional_required": "required", "requires_replace": true}}
+							]
+						}
+					}
+				]
+			}`,
+			expected: extension.Specification{
+				Resources: extension.Resources{
+					{
+						Name: "example",
+						Schema: &extension.Schema{
+							Attributes: extension.Attributes{
+								{
+									Name: "list",
+									ListNested: &extension.NestedAttribute{
+										NestedAttributeProperties: extension.NestedAttributeProperties{
+											ComputedOptionalRequired: "computed",
+											PlanModifierShorthands: extension.PlanModifierShorthands{
+												UseStateForUnknown: true,
+											},
+										},
+									},
+								},
+								{
+									Name: "single",
+									SingleNested: &extension.SingleNestedAttribute{
+										NestedAttributeProperties: extension.NestedAttributeProperties{
+											ComputedOptionalRequired: "required",
+											PlanModifierShorthands: extension.PlanModifierShorthands{
+												RequiresReplace: true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource-nested-plan-modifier-shorthands-invalid": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "map", "map_nested": {"computed_optional_required": "optional", "nested_object": {}, "use_state_for_unknown": true, "requires_replace": true, "requires_replace_if_configured": true}}
+							]
+						}
+					}
+				]
+			}`,
+			expectedError: errors.New("/resources/0/schema/attributes/0/map_nested: resource \"example\" attribute \"map\" cannot define both requires_replace and requires_replace_if_configured\n" +
+				"/resources/0/schema/attributes/0/map_nested/use_state_for_unknown: resource \"example\" attribute \"map\" must be computed to use use_state_for_unknown"),
+		},
+		"config-validators": {
+			document: `{
+				"datasources": [
+					{
+						"name": "example",
+						"config_validators": [
+							{"exactly_one_of": ["id", "name"]}
+						]
+					}
+				],
+				"resources": [
+					{
+						"name": "example",
+						"config_validators": [
+							{"required_together": ["block.id", "block.name"]}
+						]
+					}
+				]
+			}`,
+			expected: extension.Specification{
+				DataSources: extension.DataSources{
+					{
+						Name: "example",
+						ConfigValidators: extension.ConfigValidators{
+							{
+								ExactlyOneOf: []string{"id", "name"},
+							},
+						},
+					},
+				},
+				Resources: extension.Resources{
+					{
+						Name: "example",
+						ConfigValidators: extension.ConfigValidators{
+							{
+								RequiredTogether: []string{"block.id", "block.name"},
+							},
+						},
+					},
+				},
+			},
+		},
+		"config-validators-invalid": {
+			document: `{
+				"datasources": [
+					{
+						"name": "example",
+						"config_validators": [
+							{"at_least_one_of": ["id", "name"], "conflicting": ["id", "name"]}
+						]
+					}
+				],
+				"resources": [
+					{
+						"name": "example",
+						"config_validators": [
+							{"exactly_one_of": ["id"]},
+							{"conflicting": ["id", "", "id"]}
+						]
+					}
+				]
+			}`,
+			expectedError: errors.New("/datasources/0/config_validators/0: data source \"example\" config validator 0 must define exactly one of at_least_one_of, conflicting, exactly_one_of or required_together, got 2\n" +
+				"/resources/0/config_validators/0/exactly_one_of: resource \"example\" config validator 0 must reference at least two paths\n" +
+				"/resources/0/config_validators/1/conflicting/1: resource \"example\" config validator 1 path 1 must not be empty\n" +
+				"/resources/0/config_validators/1/conflicting/2: resource \"example\" config validator 1 path \"id\" is duplicated"),
+		},
+		"items-limits": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "nested", "list_nested": {"computed_optional_required": "optional", "min_items": 1, "nested_object": {}}}
+							],
+							"blocks": [
+								{"name": "block", "set_nested": {"max_items": 1, "nested_object": {}}}
+							]
+						}
+					}
+				]
+			}`,
+			expected: extension.Specification{
+				Resources: extension.Resources{
+					{
+						Name: "example",
+						Schema: &extension.Schema{
+							Attributes: extension.Attributes{
+								{
+									Name: "nested",
+									ListNested: &extension.NestedAttribute{
+										ItemsLimits: extension.ItemsLimits{
+											MinItems: pointer(int64(1)),
+										},
+										NestedAttributeProperties: extension.NestedAttributeProperties{
+											ComputedOptionalRequired: "optional",
+										},
+									},
+								},
+							},
+							Blocks: extension.Blocks{
+								{
+									Name: "block",
+									SetNested: &extension.NestedBlock{
+										ItemsLimits: extension.ItemsLimits{
+											MaxItems: pointer(int64(1)),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"items-limits-invalid": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "nested", "list_nested": {"computed_optional_required": "optional", "min_items": 2, "max_items": 1, "nested_object": {}}}
+							],
+							"blocks": [
+								{"name": "block", "set_nested": {"max_items": -1, "nested_object": {}}}
+							]
+						}
+					}
+				]
+			}`,
+			expectedError: errors.New("/resources/0/schema/attributes/0/list_nested/min_items: resource \"example\" attribute \"nested\" min_items must not be greater than max_items\n" +
+				"/resources/0/schema/blocks/0/set_nested/max_items: resource \"example\" block \"block\" max_items must not be negative"),
+		},
+		"resource-identity": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"identity": {
+							"attributes": [
+								{"name": "id", "string": {"required_for_import": true}},
+								{"name": "zones", "list": {"element_type": {"string": {}}, "optional_for_import": true}}
+							]
+						}
+					}
+				]
+			}`,
+			expected: extension.Specification{
+				Resources: extension.Resources{
+					{
+						Name: "example",
+						Identity: &extension.Identity{
+							Attributes: extension.IdentityAttributes{
+								{
+									Name: "id",
+									String: &extension.IdentityPrimitiveAttribute{
+										IdentityAttributeProperties: extension.IdentityAttributeProperties{
+											RequiredForImport: true,
+										},
+									},
+								},
+								{
+									Name: "zones",
+									List: &extension.IdentityListAttribute{
+										IdentityAttributeProperties: extension.IdentityAttributeProperties{
+											OptionalForImport: true,
+										},
+										ElementType: specschema.ElementType{
+											String: &specschema.StringType{},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"resource-identity-attributes-missing": {
+			document:      `{"resources": [{"name": "example", "identity": {"attributes": []}}]}`,
+			expectedError: errors.New(`/resources/0/identity: resource "example" identity must define at least one attribute`),
+		},
+		"resource-identity-attribute-duplicated": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"identity": {
+							"attributes": [
+								{"name": "id", "string": {"required_for_import": true}},
+								{"name": "id", "int64": {"required_for_import": true}}
+							]
+						}
+					}
+				]
+			}`,
+			expectedError: errors.New(`/resources/0/identity/attributes/1/name: resource "example" identity attribute "id" is duplicated`),
+		},
+		"resource-identity-attribute-import-requirement": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"identity": {
+							"attributes": [
+								{"name": "id", "string": {"optional_for_import": true, "required_for_import": true}}
+							]
+						}
+					}
+				]
+			}`,
+			expectedError: errors.New(`/resources/0/identity/attributes/0: resource "example" identity attribute "id" must define exactly one of optional_for_import or required_for_import`),
+		},
+		"resource-identity-attribute-element-type": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"identity": {
+							"attributes": [
+								{"name": "ids", "list": {"element_type": {"list": {"element_type": {"string": {}}}}, "required_for_import": true}}
+							]
+						}
+					}
+				]
+			}`,
+			expectedError: errors.New(`/resources/0/identity/attributes/0/list/element_type: resource "example" identity attribute "ids" element type must be a primitive type`),
+		},
+		"resource-identity-attribute-type-missing": {
+			document:      `{"resources": [{"name": "example", "identity": {"attributes": [{"name": "id"}]}}]}`,
+			expectedError: errors.New(`/resources/0/identity/attributes/0: resource "example" identity attribute "id" must define exactly one type, got 0`),
+		},
+		"duplicated": {
+			document: `{
+				"datasources": [
+					{"name": "example", "schema": {"attributes": [{"name": "id", "string": {"computed_optional_required": "computed"}}]}},
+					{"name": "example", "schema": {"attributes": [{"name": "id", "string": {"computed_optional_required": "computed"}}]}}
+				],
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "id", "string": {"computed_optional_required": "computed"}},
+								{"name": "id", "string": {"computed_optional_required": "computed"}},
+								{
+									"name": "point",
+									"object": {
+										"computed_optional_required": "optional",
+										"attribute_types": [
+											{"name": "x", "float64": {}},
+											{"name": "x", "float32": {}}
+										]
+									}
+								}
+							],
+							"blocks": [
+								{"name": "nested", "single_nested": {"attributes": [{"name": "enabled", "bool": {"computed_optional_required": "optional"}}]}},
+								{"name": "nested", "single_nested": {}}
+							]
+						}
+					}
+				]
+			}`,
+			expectedError: errors.New("/datasources/1/name: data source \"example\" is duplicated\n" +
+				"/resources/0/schema/attributes/1/name: resource \"example\" attribute \"id\" is duplicated\n" +
+				"/resources/0/schema/attributes/2/object/attribute_types/1/name: resource \"example\" attribute \"point\" object attribute type \"x\" is duplicated\n" +
+				"/resources/0/schema/blocks/1/name: resource \"example\" block \"nested\" is duplicated"),
+		},
+		"type-error": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "password", "string": {"computed_optional_required": "optional", "write_only": "yes"}},
+								{"name": "token", "string": {"computed_optional_required": "computed", "write_only": true}}
+							]
+						}
+					}
+				]
+			}`,
+			expectedError: errors.New("json: cannot unmarshal string into Go struct field Specification.resources.0.schema.attributes.0.string.write_only of type bool\n" +
+				"/resources/0/schema/attributes/1/string: resource \"example\" attribute \"token\" is write-only and cannot be computed"),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := extension.Parse(context.Background(), []byte(testCase.document))
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError.Error()); diff != "" {
+					t.Errorf("unexpected error: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != nil {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestParse_Float32(t *testing.T) {
+	t.Parallel()
+
+	document := `{
+		"ephemeral_resources": [
+			{
+				"name": "example",
+				"schema": {
+					"attributes": [
+						{"name": "ratio", "float32": {"computed_optional_required": "optional", "custom_type": {"type": "RatioType", "value_type": "RatioValue"}}}
+					]
+				}
+			}
+		],
+		"functions": [
+			{
+				"name": "example",
+				"definition": {
+					"parameters": [
+						{"name": "ratio", "float32": {"custom_type": {"type": "RatioType", "value_type": "RatioValue"}}},
+						{"name": "ratios", "list": {"element_type": {"float32": {}}}}
+					],
+					"variadic_parameter": {"name": "values", "float64": {}},
+					"return": {"float32": {}}
+				}
+			}
+		]
+	}`
+
+	got, err := extension.Parse(context.Background(), []byte(document))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ratio := got.EphemeralResources[0].Schema.Attributes[0].Float64
+
+	if !extension.IsFloat32(ratio) {
+		t.Errorf("expected ephemeral resource attribute to be float32")
+	}
+
+	if diff := cmp.Diff(ratio.CustomType, &specschema.CustomType{Type: "RatioType", ValueType: "RatioValue"}); diff != "" {
+		t.Errorf("unexpected custom type difference: %s", diff)
+	}
+
+	definition := got.Functions[0].Definition
+
+	if !extension.IsFloat32(definition.Parameters[0].Float64) {
+		t.Errorf("expected parameter to be float32")
+	}
+
+	if diff := cmp.Diff(definition.Parameters[0].Float64.CustomType, &specschema.CustomType{Type: "RatioType", ValueType: "RatioValue"}); diff != "" {
+		t.Errorf("unexpected custom type difference: %s", diff)
+	}
+
+	if !extension.IsFloat32(definition.Parameters[1].List.ElementType.Float64) {
+		t.Errorf("expected parameter element type to be float32")
+	}
+
+	if extension.IsFloat32(definition.VariadicParameter.Float64) {
+		t.Errorf("expected variadic parameter to be float64")
+	}
+
+	if !extension.IsFloat32(definition.Return.Float64) {
+		t.Errorf("expected return to be float32")
+	}
+}
+
+func TestParseSpecification(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		document string
+		expected spec.Specification
+	}{
+		"float32": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "ratio", "float32": {"computed_optional_required": "optional", "custom_type": {"type": "RatioType", "value_type": "RatioValue"}}},
+								{"name": "ratios", "list": {"computed_optional_required": "optional", "element_type": {"float32": {"custom_type": {"type": "RatioType", "value_type": "RatioValue"}}}}},
+								{"name": "point", "object": {"computed_optional_required": "optional", "attribute_types": [{"name": "x", "float32": {}}]}}
+							]
+						}
+					}
+				],
+				"version": "0.1"
+			}`,
+			expected: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Attributes: resource.Attributes{
+								{
+									Name: "ratio",
+									Float64: &resource.Float64Attribute{
+										ComputedOptionalRequired: specschema.Optional,
+										CustomType: &specschema.CustomType{
+											Type:      "RatioType",
+											ValueType: "RatioValue",
+										},
+									},
+								},
+								{
+									Name: "ratios",
+									List: &resource.ListAttribute{
+										ComputedOptionalRequired: specschema.Optional,
+										ElementType: specschema.ElementType{
+											Float64: &specschema.Float64Type{
+												CustomType: &specschema.CustomType{
+													Type:      "RatioType",
+													ValueType: "RatioValue",
+												},
+											},
+										},
+									},
+								},
+								{
+									Name: "point",
+									Object: &resource.ObjectAttribute{
+										ComputedOptionalRequired: specschema.Optional,
+										AttributeTypes: specschema.ObjectAttributeTypes{
+											{
+												Name:    "x",
+												Float64: &specschema.Float64Type{},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Version: "0.1",
+			},
+		},
+		"float64-custom-type": {
+			document: `{
+				"resources": [
+					{
+						"name": "example",
+						"schema": {
+							"attributes": [
+								{"name": "ratio", "float64": {"computed_optional_required": "optional", "custom_type": {"import": {"path": "github.com/hashicorp/terraform-plugin-framework/types"}, "type": "types.Float32Type", "value_type": "types.Float32"}}}
+							]
+						}
+					}
+				],
+				"version": "0.1"
+			}`,
+			expected: spec.Specification{
+				Resources: resource.Resources{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Attributes: resource.Attributes{
+								{
+									Name: "ratio",
+									Float64: &resource.Float64Attribute{
+										ComputedOptionalRequired: specschema.Optional,
+										CustomType: &specschema.CustomType{
+											Import: &code.Import{
+												Path: "github.com/hashicorp/terraform-plugin-framework/types",
+											},
+											Type:      "types.Float32Type",
+											ValueType: "types.Float32",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Version: "0.1",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := extension.ParseSpecification(context.Background(), []byte(testCase.document))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			for _, a := range got.Resources[0].Schema.Attributes {
+				var isFloat32 bool
+
+				switch {
+				case a.Float64 != nil:
+					isFloat32 = extension.IsFloat32(a.Float64)
+				case a.List != nil:
+					isFloat32 = extension.IsFloat32(a.List.ElementType.Float64)
+				case a.Object != nil:
+					isFloat32 = extension.IsFloat32(a.Object.AttributeTypes[0].Float64)
+				}
+
+				if expected := name == "float32"; isFloat32 != expected {
+					t.Errorf("attribute %q: expected IsFloat32 to return %t, got %t", a.Name, expected, isFloat32)
+				}
+			}
+		})
+	}
+}
+
+func pointer[T any](in T) *T {
+	return &in
+}
@@ -4,23 +4,44 @@
 package format
 
 import (
+	"errors"
+	"fmt"
 	"go/format"
 	"regexp"
+	"sort"
 	"strings"
 )
 
+// Format formats the Go code of each schema. Errors are collected across
+// all schemas, and are prefixed with the schema name.
 func Format(schemas map[string][]byte) (map[string][]byte, error) {
 	formattedSchemas := make(map[string][]byte, len(schemas))
 
-	for k, v := range schemas {
-		formattedSchema, err := format.Source(v)
+	keys := make([]string, 0, len(schemas))
+
+	for k := range schemas {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	var errs []error
+
+	for _, k := range keys {
+		formattedSchema, err := format.Source(schemas[k])
 		if err != nil {
-			return nil, err
+			errs = append(errs, fmt.Errorf("%s: %w", k, err))
+
+			continue
 		}
 
 		formattedSchemas[k] = formattedSchema
 	}
 
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
 	return formattedSchemas, nil
 }
 
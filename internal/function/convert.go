@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+)
+
+func NewFunctions(spec extension.Specification) (map[string]GeneratorFunction, error) {
+	functions := make(map[string]GeneratorFunction, len(spec.Functions))
+
+	var errs []error
+
+	for i, v := range spec.Functions {
+		f, err := NewFunction(v)
+		if err != nil {
+			errs = append(errs, diagnostic.NewErrors(diagnostic.Wrapf(err, "function %q %w", v.Name), "functions", i)...)
+
+			continue
+		}
+
+		functions[v.Name] = f
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return functions, nil
+}
+
+func NewFunction(f extension.Function) (GeneratorFunction, error) {
+	if f.Definition == nil {
+		return GeneratorFunction{}, fmt.Errorf("definition is nil")
+	}
+
+	var parameters GeneratorParameters
+
+	var errs []error
+
+	for i, v := range f.Definition.Parameters {
+		p, err := NewParameter(v)
+		if err != nil {
+			errs = append(errs, diagnostic.NewError(err, "definition", "parameters", i))
+
+			continue
+		}
+
+		parameters = append(parameters, GeneratorNamedParameter{
+			Name:      v.Name,
+			Parameter: p,
+		})
+	}
+
+	var variadicParameter *GeneratorNamedParameter
+
+	if f.Definition.VariadicParameter != nil {
+		p, err := NewParameter(*f.Definition.VariadicParameter)
+		if err != nil {
+			errs = append(errs, diagnostic.NewError(err, "definition", "variadic_parameter"))
+		} else {
+			variadicParameter = &GeneratorNamedParameter{
+				Name:      f.Definition.VariadicParameter.Name,
+				Parameter: p,
+			}
+		}
+	}
+
+	r, err := NewGeneratorReturn(f.Definition.Return)
+	if err != nil {
+		errs = append(errs, diagnostic.NewError(err, "definition", "return"))
+	}
+
+	if len(errs) > 0 {
+		return GeneratorFunction{}, errors.Join(errs...)
+	}
+
+	return GeneratorFunction{
+		Parameters:          parameters,
+		VariadicParameter:   variadicParameter,
+		Return:              r,
+		Summary:             f.Definition.Summary,
+		Description:         f.Definition.Description,
+		MarkdownDescription: f.Definition.MarkdownDescription,
+		DeprecationMessage:  f.Definition.DeprecationMessage,
+	}, nil
+}
+
+func NewParameter(p extension.Parameter) (GeneratorParameter, error) {
+	switch {
+	case p.Bool != nil:
+		return NewGeneratorBoolParameter(p.Name, p.Bool)
+	case p.Dynamic != nil:
+		return NewGeneratorDynamicParameter(p.Name, p.Dynamic)
+	case p.Float64 != nil && extension.IsFloat32(p.Float64):
+		return NewGeneratorFloat32Parameter(p.Name, p.Float64)
+	case p.Float64 != nil:
+		return NewGeneratorFloat64Parameter(p.Name, p.Float64)
+	case p.Int32 != nil:
+		return NewGeneratorInt32Parameter(p.Name, p.Int32)
+	case p.Int64 != nil:
+		return NewGeneratorInt64Parameter(p.Name, p.Int64)
+	case p.List != nil:
+		return NewGeneratorListParameter(p.Name, p.List)
+	case p.Map != nil:
+		return NewGeneratorMapParameter(p.Name, p.Map)
+	case p.Number != nil:
+		return NewGeneratorNumberParameter(p.Name, p.Number)
+	case p.Object != nil:
+		return NewGeneratorObjectParameter(p.Name, p.Object)
+	case p.Set != nil:
+		return NewGeneratorSetParameter(p.Name, p.Set)
+	case p.String != nil:
+		return NewGeneratorStringParameter(p.Name, p.String)
+	}
+
+	return nil, fmt.Errorf("parameter %q type not defined", p.Name)
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+)
+
+func Test_NewFunctions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		spec          extension.Specification
+		expected      map[string]GeneratorFunction
+		expectedError error
+	}{
+		"success": {
+			spec: extension.Specification{
+				Functions: extension.Functions{
+					{
+						Name: "example",
+						Definition: &extension.FunctionDefinition{
+							Parameters: extension.Parameters{
+								{
+									Name: "bool_parameter",
+									Bool: &extension.BoolParameter{
+										AllowNullValue: true,
+									},
+								},
+							},
+							VariadicParameter: &extension.Parameter{
+								Name: "set_parameter",
+								Set: &extension.SetParameter{
+									ElementType: specschema.ElementType{
+										String: &specschema.StringType{},
+									},
+								},
+							},
+							Return: extension.Return{
+								Int64: &specschema.Int64Type{},
+							},
+							Summary: pointer("summary"),
+						},
+					},
+				},
+			},
+			expected: map[string]GeneratorFunction{
+				"example": {
+					Parameters: GeneratorParameters{
+						{
+							Name: "bool_parameter",
+							Parameter: GeneratorBoolParameter{
+								AllowNullValue: convert.NewAllowNullValue(true),
+								CustomType:     convert.NewCustomTypePrimitive(nil, nil, "bool_parameter"),
+								Validators:     convert.NewParameterValidators(convert.ValidatorTypeBool, nil),
+							},
+						},
+					},
+					VariadicParameter: &GeneratorNamedParameter{
+						Name: "set_parameter",
+						Parameter: GeneratorSetParameter{
+							CustomType: convert.NewCustomTypeCollection(nil, nil, convert.CustomCollectionTypeSet, "types.StringType", "set_parameter"),
+							ElementType: specschema.ElementType{
+								String: &specschema.StringType{},
+							},
+							ElementTypeCollection: convert.NewElementType(specschema.ElementType{
+								String: &specschema.StringType{},
+							}),
+							Validators: convert.NewParameterValidators(convert.ValidatorTypeSet, nil),
+						},
+					},
+					Return: GeneratorReturn{
+						ReturnType: "Int64",
+					},
+					Summary: pointer("summary"),
+				},
+			},
+		},
+		"float32": {
+			spec: extension.Specification{
+				Functions: extension.Functions{
+					{
+						Name: "example",
+						Definition: &extension.FunctionDefinition{
+							Parameters: extension.Parameters{
+								{
+									Name:    "float32_parameter",
+									Float64: extension.AsFloat32(&extension.Float64Parameter{}),
+								},
+							},
+							Return: extension.Return{
+								Float64: extension.AsFloat32(&specschema.Float64Type{}),
+							},
+						},
+					},
+				},
+			},
+			expected: map[string]GeneratorFunction{
+				"example": {
+					Parameters: GeneratorParameters{
+						{
+							Name: "float32_parameter",
+							Parameter: GeneratorFloat32Parameter{
+								CustomType: convert.NewCustomTypePrimitive(nil, nil, "float32_parameter"),
+								Validators: convert.NewParameterValidators(convert.ValidatorTypeFloat32, nil),
+							},
+						},
+					},
+					Return: GeneratorReturn{
+						ReturnType: "Float32",
+					},
+				},
+			},
+		},
+		"parameter-type-not-defined": {
+			spec: extension.Specification{
+				Functions: extension.Functions{
+					{
+						Name: "example",
+						Definition: &extension.FunctionDefinition{
+							Parameters: extension.Parameters{
+								{
+									Name: "parameter",
+								},
+							},
+							Return: extension.Return{
+								Int64: &specschema.Int64Type{},
+							},
+						},
+					},
+				},
+			},
+			expectedError: errors.Join(
+				diagnostic.NewError(errors.New(`function "example" parameter "parameter" type not defined`), "functions", 0, "definition", "parameters", 0),
+			),
+		},
+		"multiple-errors": {
+			spec: extension.Specification{
+				Functions: extension.Functions{
+					{
+						Name: "example",
+						Definition: &extension.FunctionDefinition{
+							Parameters: extension.Parameters{
+								{
+									Name: "parameter",
+								},
+							},
+							VariadicParameter: &extension.Parameter{
+								Name: "variadic",
+							},
+						},
+					},
+					{
+						Name: "other",
+					},
+				},
+			},
+			expectedError: errors.Join(
+				diagnostic.NewError(errors.New(`function "example" parameter "parameter" type not defined`), "functions", 0, "definition", "parameters", 0),
+				diagnostic.NewError(errors.New(`function "example" parameter "variadic" type not defined`), "functions", 0, "definition", "variadic_parameter"),
+				diagnostic.NewError(errors.New(`function "example" return type not defined`), "functions", 0, "definition", "return"),
+				diagnostic.NewError(errors.New(`function "other" definition is nil`), "functions", 1),
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewFunctions(testCase.spec)
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	_ "embed"
+)
+
+//go:embed templates/function.gotmpl
+var FunctionGoTemplate string
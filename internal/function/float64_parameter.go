@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+type GeneratorFloat64Parameter struct {
+	AllowNullValue     convert.AllowNullValue
+	AllowUnknownValues convert.AllowUnknownValues
+	CustomType         convert.CustomTypePrimitive
+	Description        convert.Description
+	Validators         convert.ParameterValidators
+}
+
+func NewGeneratorFloat64Parameter(name string, p *extension.Float64Parameter) (GeneratorFloat64Parameter, error) {
+	if p == nil {
+		return GeneratorFloat64Parameter{}, fmt.Errorf("*extension.Float64Parameter is nil")
+	}
+
+	anv := convert.NewAllowNullValue(p.AllowNullValue)
+
+	auv := convert.NewAllowUnknownValues(p.AllowUnknownValues)
+
+	ctp := convert.NewCustomTypePrimitive(p.CustomType, nil, name)
+
+	d := convert.NewDescription(p.Description)
+
+	v := convert.NewParameterValidators(convert.ValidatorTypeFloat64, p.Validators.CustomValidators())
+
+	return GeneratorFloat64Parameter{
+		AllowNullValue:     anv,
+		AllowUnknownValues: auv,
+		CustomType:         ctp,
+		Description:        d,
+		Validators:         v,
+	}, nil
+}
+
+func (g GeneratorFloat64Parameter) Imports() *generatorschema.Imports {
+	imports := generatorschema.NewImports()
+
+	imports.Append(g.CustomType.Imports())
+
+	imports.Append(g.Validators.Imports())
+
+	return imports
+}
+
+func (g GeneratorFloat64Parameter) Equal(gp GeneratorParameter) bool {
+	h, ok := gp.(GeneratorFloat64Parameter)
+
+	if !ok {
+		return false
+	}
+
+	if !g.AllowNullValue.Equal(h.AllowNullValue) {
+		return false
+	}
+
+	if !g.AllowUnknownValues.Equal(h.AllowUnknownValues) {
+		return false
+	}
+
+	if !g.CustomType.Equal(h.CustomType) {
+		return false
+	}
+
+	if !g.Description.Equal(h.Description) {
+		return false
+	}
+
+	return g.Validators.Equal(h.Validators)
+}
+
+func (g GeneratorFloat64Parameter) Definition(name generatorschema.FrameworkIdentifier) (string, error) {
+	var b bytes.Buffer
+
+	b.WriteString("function.Float64Parameter{\n")
+	b.WriteString(fmt.Sprintf("Name: %q,\n", name))
+	b.Write(g.CustomType.Schema())
+	b.Write(g.AllowNullValue.Schema())
+	b.Write(g.AllowUnknownValues.Schema())
+	b.Write(g.Description.Schema())
+	b.Write(g.Validators.Schema())
+	b.WriteString("},")
+
+	return b.String(), nil
+}
+
+func (g GeneratorFloat64Parameter) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
+	field := model.Field{
+		Name:      name.ToPascalCase(),
+		TfsdkName: name.ToString(),
+		ValueType: model.Float64ValueType,
+	}
+
+	customValueType := g.CustomType.ValueType()
+
+	if customValueType != "" {
+		field.ValueType = customValueType
+	}
+
+	return field, nil
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// GeneratorFunction holds the parameters, variadic parameter, return and
+// descriptions of a provider-defined function.
+type GeneratorFunction struct {
+	Parameters          GeneratorParameters
+	VariadicParameter   *GeneratorNamedParameter
+	Return              GeneratorReturn
+	Summary             *string
+	Description         *string
+	MarkdownDescription *string
+	DeprecationMessage  *string
+}
+
+func (g GeneratorFunction) Equal(other GeneratorFunction) bool {
+	if !g.Parameters.Equal(other.Parameters) {
+		return false
+	}
+
+	if (g.VariadicParameter == nil) != (other.VariadicParameter == nil) {
+		return false
+	}
+
+	if g.VariadicParameter != nil && !g.VariadicParameter.Equal(*other.VariadicParameter) {
+		return false
+	}
+
+	if !g.Return.Equal(other.Return) {
+		return false
+	}
+
+	if !stringPointerEqual(g.Summary, other.Summary) {
+		return false
+	}
+
+	if !stringPointerEqual(g.Description, other.Description) {
+		return false
+	}
+
+	if !stringPointerEqual(g.MarkdownDescription, other.MarkdownDescription) {
+		return false
+	}
+
+	return stringPointerEqual(g.DeprecationMessage, other.DeprecationMessage)
+}
+
+func (g GeneratorFunction) Imports() (string, error) {
+	imports := generatorschema.NewImports()
+
+	imports.Add(
+		code.Import{
+			Path: generatorschema.ContextImport,
+		},
+	)
+
+	imports.Append(g.Parameters.Imports())
+
+	if g.VariadicParameter != nil && g.VariadicParameter.Parameter != nil {
+		imports.Append(g.VariadicParameter.Parameter.Imports())
+	}
+
+	imports.Append(g.Return.Imports())
+
+	var sb strings.Builder
+
+	for _, i := range imports.All() {
+		var alias string
+
+		if i.Alias != nil {
+			alias = *i.Alias + " "
+		}
+
+		sb.WriteString(fmt.Sprintf("%s%q\n", alias, i.Path))
+	}
+
+	return sb.String(), nil
+}
+
+// ArgumentFields returns the fields of the struct into which the function
+// arguments are read. The variadic parameter, if defined, is the final field
+// and holds a slice of the parameter type.
+func (g GeneratorFunction) ArgumentFields() ([]model.Field, error) {
+	fields, err := g.Parameters.ModelFields()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if g.VariadicParameter != nil && g.VariadicParameter.Parameter != nil {
+		field, err := g.VariadicParameter.Parameter.ModelField(generatorschema.FrameworkIdentifier(g.VariadicParameter.Name))
+
+		if err != nil {
+			return nil, err
+		}
+
+		field.ValueType = "[]" + field.ValueType
+
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+// Definition renders the function definition, together with the struct into
+// which the function arguments are read.
+func (g GeneratorFunction) Definition(name, packageName string) ([]byte, error) {
+	parameters, err := g.Parameters.Definition()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var variadicParameter string
+
+	if g.VariadicParameter != nil && g.VariadicParameter.Parameter != nil {
+		variadicParameter, err = g.VariadicParameter.Parameter.Definition(generatorschema.FrameworkIdentifier(g.VariadicParameter.Name))
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	r, err := g.Return.Definition()
+
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := g.ArgumentFields()
+
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err := g.Imports()
+
+	if err != nil {
+		return nil, err
+	}
+
+	templateData := struct {
+		Name                string
+		PackageName         string
+		Imports             string
+		Parameters          string
+		VariadicParameter   string
+		Return              string
+		Fields              []model.Field
+		Summary             string
+		Description         string
+		MarkdownDescription string
+		DeprecationMessage  string
+	}{
+		Name:                generatorschema.FrameworkIdentifier(name).ToPascalCase(),
+		PackageName:         packageName,
+		Imports:             imports,
+		Parameters:          parameters,
+		VariadicParameter:   variadicParameter,
+		Return:              r,
+		Fields:              fields,
+		Summary:             stringValue(g.Summary),
+		Description:         stringValue(g.Description),
+		MarkdownDescription: stringValue(g.MarkdownDescription),
+		DeprecationMessage:  stringValue(g.DeprecationMessage),
+	}
+
+	t, err := template.New("function").Parse(FunctionGoTemplate)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	err = t.Execute(&buf, templateData)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func stringPointerEqual(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+)
+
+func TestGeneratorFunction_ArgumentFields(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         GeneratorFunction
+		expected      []model.Field
+		expectedError error
+	}{
+		"no-parameters": {},
+		"parameters": {
+			input: GeneratorFunction{
+				Parameters: GeneratorParameters{
+					{
+						Name: "input",
+						Parameter: GeneratorStringParameter{
+							CustomType: convert.NewCustomTypePrimitive(nil, nil, "input"),
+						},
+					},
+					{
+						Name: "count",
+						Parameter: GeneratorInt64Parameter{
+							CustomType: convert.NewCustomTypePrimitive(nil, nil, "count"),
+						},
+					},
+				},
+			},
+			expected: []model.Field{
+				{
+					Name:      "Input",
+					TfsdkName: "input",
+					ValueType: "types.String",
+				},
+				{
+					Name:      "Count",
+					TfsdkName: "count",
+					ValueType: "types.Int64",
+				},
+			},
+		},
+		"variadic-parameter": {
+			input: GeneratorFunction{
+				Parameters: GeneratorParameters{
+					{
+						Name: "input",
+						Parameter: GeneratorStringParameter{
+							CustomType: convert.NewCustomTypePrimitive(nil, nil, "input"),
+						},
+					},
+				},
+				VariadicParameter: &GeneratorNamedParameter{
+					Name: "tags",
+					Parameter: GeneratorStringParameter{
+						CustomType: convert.NewCustomTypePrimitive(
+							&specschema.CustomType{
+								ValueType: "my_custom_value_type",
+							},
+							nil,
+							"tags",
+						),
+					},
+				},
+			},
+			expected: []model.Field{
+				{
+					Name:      "Input",
+					TfsdkName: "input",
+					ValueType: "types.String",
+				},
+				{
+					Name:      "Tags",
+					TfsdkName: "tags",
+					ValueType: "[]my_custom_value_type",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.input.ArgumentFields()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratorFunction_Definition(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         GeneratorFunction
+		expected      string
+		expectedError error
+	}{
+		"no-parameters": {
+			input: GeneratorFunction{
+				Return: GeneratorReturn{
+					ReturnType: "Bool",
+				},
+				Summary: pointer("summary"),
+			},
+			expected: `// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package example
+
+import (
+"context"
+
+"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+func ExampleFunctionDefinition(ctx context.Context) function.Definition {
+return function.Definition{
+	Return: function.BoolReturn{
+},
+	Summary: "summary",
+    }
+}
+`,
+		},
+		"parameters": {
+			input: GeneratorFunction{
+				Parameters: GeneratorParameters{
+					{
+						Name: "input",
+						Parameter: GeneratorStringParameter{
+							CustomType: convert.NewCustomTypePrimitive(nil, nil, "input"),
+						},
+					},
+				},
+				VariadicParameter: &GeneratorNamedParameter{
+					Name: "values",
+					Parameter: GeneratorStringParameter{
+						CustomType: convert.NewCustomTypePrimitive(
+							&specschema.CustomType{
+								Import: &code.Import{
+									Path: "github.com/my_account/my_project/values",
+								},
+								Type:      "values.ValueType{}",
+								ValueType: "values.Value",
+							},
+							nil,
+							"values",
+						),
+					},
+				},
+				Return: GeneratorReturn{
+					ReturnType: "String",
+				},
+			},
+			expected: `// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package example
+
+import (
+"context"
+"github.com/hashicorp/terraform-plugin-framework/types"
+"github.com/my_account/my_project/values"
+
+"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+func ExampleFunctionDefinition(ctx context.Context) function.Definition {
+return function.Definition{
+    Parameters: []function.Parameter{
+function.StringParameter{
+Name: "input",
+},
+	},
+	VariadicParameter: function.StringParameter{
+Name: "values",
+CustomType: values.ValueType{},
+},
+	Return: function.StringReturn{
+},
+    }
+}
+
+type ExampleFunctionArguments struct {
+Input types.String
+Values []values.Value
+}
+
+func (a *ExampleFunctionArguments) Get(ctx context.Context, args function.ArgumentsData) *function.FuncError {
+return args.Get(ctx, &a.Input, &a.Values)
+}
+`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.input.Definition("example", "example")
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+)
+
+type GeneratorFunctions struct {
+	functions map[string]GeneratorFunction
+}
+
+func NewGeneratorFunctions(functions map[string]GeneratorFunction) GeneratorFunctions {
+	return GeneratorFunctions{
+		functions: functions,
+	}
+}
+
+// Definitions renders the function definition and arguments struct for each
+// function. If packageName is an empty string, a package per function is used.
+func (g GeneratorFunctions) Definitions(packageName string) (map[string][]byte, error) {
+	definitionsBytes := make(map[string][]byte, len(g.functions))
+
+	for k, f := range g.functions {
+		pkgName := packageName
+		if pkgName == "" {
+			pkgName = fmt.Sprintf("function_%s", k)
+		}
+
+		b, err := f.Definition(k, pkgName)
+
+		if err != nil {
+			return nil, fmt.Errorf("function %q: %w", k, err)
+		}
+
+		definitionsBytes[k] = b
+	}
+
+	return definitionsBytes, nil
+}
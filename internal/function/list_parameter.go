@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"fmt"
+
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+type GeneratorListParameter struct {
+	AllowNullValue        convert.AllowNullValue
+	AllowUnknownValues    convert.AllowUnknownValues
+	CustomType            convert.CustomTypeCollection
+	Description           convert.Description
+	ElementType           specschema.ElementType
+	ElementTypeCollection convert.ElementType
+	Validators            convert.ParameterValidators
+}
+
+func NewGeneratorListParameter(name string, p *extension.ListParameter) (GeneratorListParameter, error) {
+	if p == nil {
+		return GeneratorListParameter{}, fmt.Errorf("*extension.ListParameter is nil")
+	}
+
+	et := convert.NewElementType(p.ElementType)
+
+	anv := convert.NewAllowNullValue(p.AllowNullValue)
+
+	auv := convert.NewAllowUnknownValues(p.AllowUnknownValues)
+
+	ctc := convert.NewCustomTypeCollection(p.CustomType, nil, convert.CustomCollectionTypeList, string(et.ElementType()), name)
+
+	d := convert.NewDescription(p.Description)
+
+	v := convert.NewParameterValidators(convert.ValidatorTypeList, p.Validators.CustomValidators())
+
+	return GeneratorListParameter{
+		AllowNullValue:        anv,
+		AllowUnknownValues:    auv,
+		CustomType:            ctc,
+		Description:           d,
+		ElementType:           p.ElementType,
+		ElementTypeCollection: et,
+		Validators:            v,
+	}, nil
+}
+
+func (g GeneratorListParameter) Imports() *generatorschema.Imports {
+	imports := generatorschema.NewImports()
+
+	imports.Append(g.CustomType.Imports())
+
+	imports.Append(g.ElementTypeCollection.Imports())
+
+	imports.Append(g.Validators.Imports())
+
+	return imports
+}
+
+func (g GeneratorListParameter) Equal(gp GeneratorParameter) bool {
+	h, ok := gp.(GeneratorListParameter)
+
+	if !ok {
+		return false
+	}
+
+	if !g.AllowNullValue.Equal(h.AllowNullValue) {
+		return false
+	}
+
+	if !g.AllowUnknownValues.Equal(h.AllowUnknownValues) {
+		return false
+	}
+
+	if !g.CustomType.Equal(h.CustomType) {
+		return false
+	}
+
+	if !g.Description.Equal(h.Description) {
+		return false
+	}
+
+	if !g.ElementType.Equal(h.ElementType) {
+		return false
+	}
+
+	if !g.ElementTypeCollection.Equal(h.ElementTypeCollection) {
+		return false
+	}
+
+	return g.Validators.Equal(h.Validators)
+}
+
+func (g GeneratorListParameter) Definition(name generatorschema.FrameworkIdentifier) (string, error) {
+	var b bytes.Buffer
+
+	customTypeSchema := g.CustomType.Schema()
+
+	b.WriteString("function.ListParameter{\n")
+	b.WriteString(fmt.Sprintf("Name: %q,\n", name))
+	b.Write(customTypeSchema)
+	if len(customTypeSchema) == 0 {
+		b.Write(g.ElementTypeCollection.Schema())
+	}
+	b.Write(g.AllowNullValue.Schema())
+	b.Write(g.AllowUnknownValues.Schema())
+	b.Write(g.Description.Schema())
+	b.Write(g.Validators.Schema())
+	b.WriteString("},")
+
+	return b.String(), nil
+}
+
+func (g GeneratorListParameter) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
+	field := model.Field{
+		Name:      name.ToPascalCase(),
+		TfsdkName: name.ToString(),
+		ValueType: model.ListValueType,
+	}
+
+	customValueType := g.CustomType.ValueType()
+
+	if customValueType != "" {
+		field.ValueType = customValueType
+	}
+
+	return field, nil
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"fmt"
+
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+type GeneratorObjectParameter struct {
+	AllowNullValue       convert.AllowNullValue
+	AllowUnknownValues   convert.AllowUnknownValues
+	AttributeTypes       specschema.ObjectAttributeTypes
+	AttributeTypesObject convert.ObjectAttributeTypes
+	CustomType           convert.CustomTypeObject
+	Description          convert.Description
+	Validators           convert.ParameterValidators
+}
+
+func NewGeneratorObjectParameter(name string, p *extension.ObjectParameter) (GeneratorObjectParameter, error) {
+	if p == nil {
+		return GeneratorObjectParameter{}, fmt.Errorf("*extension.ObjectParameter is nil")
+	}
+
+	anv := convert.NewAllowNullValue(p.AllowNullValue)
+
+	auv := convert.NewAllowUnknownValues(p.AllowUnknownValues)
+
+	ot := convert.NewObjectAttributeTypes(p.AttributeTypes)
+
+	cto := convert.NewCustomTypeObject(p.CustomType, nil, name)
+
+	d := convert.NewDescription(p.Description)
+
+	v := convert.NewParameterValidators(convert.ValidatorTypeObject, p.Validators.CustomValidators())
+
+	return GeneratorObjectParameter{
+		AllowNullValue:       anv,
+		AllowUnknownValues:   auv,
+		AttributeTypes:       p.AttributeTypes,
+		AttributeTypesObject: ot,
+		CustomType:           cto,
+		Description:          d,
+		Validators:           v,
+	}, nil
+}
+
+func (g GeneratorObjectParameter) Imports() *generatorschema.Imports {
+	imports := generatorschema.NewImports()
+
+	imports.Append(g.CustomType.Imports())
+
+	imports.Append(g.AttributeTypesObject.Imports())
+
+	imports.Append(g.Validators.Imports())
+
+	return imports
+}
+
+func (g GeneratorObjectParameter) Equal(gp GeneratorParameter) bool {
+	h, ok := gp.(GeneratorObjectParameter)
+
+	if !ok {
+		return false
+	}
+
+	if !g.AllowNullValue.Equal(h.AllowNullValue) {
+		return false
+	}
+
+	if !g.AllowUnknownValues.Equal(h.AllowUnknownValues) {
+		return false
+	}
+
+	if !g.AttributeTypes.Equal(h.AttributeTypes) {
+		return false
+	}
+
+	if !g.AttributeTypesObject.Equal(h.AttributeTypesObject) {
+		return false
+	}
+
+	if !g.CustomType.Equal(h.CustomType) {
+		return false
+	}
+
+	if !g.Description.Equal(h.Description) {
+		return false
+	}
+
+	return g.Validators.Equal(h.Validators)
+}
+
+func (g GeneratorObjectParameter) Definition(name generatorschema.FrameworkIdentifier) (string, error) {
+	var b bytes.Buffer
+
+	customTypeSchema := g.CustomType.Schema()
+
+	b.WriteString("function.ObjectParameter{\n")
+	b.WriteString(fmt.Sprintf("Name: %q,\n", name))
+	b.Write(customTypeSchema)
+	if len(customTypeSchema) == 0 {
+		b.Write(g.AttributeTypesObject.Schema())
+	}
+	b.Write(g.AllowNullValue.Schema())
+	b.Write(g.AllowUnknownValues.Schema())
+	b.Write(g.Description.Schema())
+	b.Write(g.Validators.Schema())
+	b.WriteString("},")
+
+	return b.String(), nil
+}
+
+func (g GeneratorObjectParameter) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
+	field := model.Field{
+		Name:      name.ToPascalCase(),
+		TfsdkName: name.ToString(),
+		ValueType: model.ObjectValueType,
+	}
+
+	customValueType := g.CustomType.ValueType()
+
+	if customValueType != "" {
+		field.ValueType = customValueType
+	}
+
+	return field, nil
+}
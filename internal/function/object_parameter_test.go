@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+)
+
+func TestGeneratorObjectParameter_Definition(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         GeneratorObjectParameter
+		expected      string
+		expectedError error
+	}{
+		"attribute-types": {
+			input: GeneratorObjectParameter{
+				AttributeTypesObject: convert.NewObjectAttributeTypes(specschema.ObjectAttributeTypes{
+					{
+						Name: "bool",
+						Bool: &specschema.BoolType{},
+					},
+					{
+						Name: "list",
+						List: &specschema.ListType{
+							ElementType: specschema.ElementType{
+								String: &specschema.StringType{},
+							},
+						},
+					},
+				}),
+			},
+			expected: `function.ObjectParameter{
+Name: "object_parameter",
+AttributeTypes: map[string]attr.Type{
+"bool": types.BoolType,
+"list": types.ListType{
+ElemType: types.StringType,
+},
+},
+},`,
+		},
+
+		"custom-type": {
+			input: GeneratorObjectParameter{
+				AttributeTypesObject: convert.NewObjectAttributeTypes(specschema.ObjectAttributeTypes{
+					{
+						Name: "bool",
+						Bool: &specschema.BoolType{},
+					},
+				}),
+				CustomType: convert.NewCustomTypeObject(
+					&specschema.CustomType{
+						Type: "my_custom_type",
+					},
+					nil,
+					"object_parameter",
+				),
+			},
+			expected: `function.ObjectParameter{
+Name: "object_parameter",
+CustomType: my_custom_type,
+},`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.input.Definition("object_parameter")
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
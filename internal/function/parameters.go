@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// GeneratorNamedParameter associates a name with a GeneratorParameter. Function
+// parameters are positional, so they are held in a slice rather than a map to
+// retain the order declared in the specification.
+type GeneratorNamedParameter struct {
+	Name      string
+	Parameter GeneratorParameter
+}
+
+func (g GeneratorNamedParameter) Equal(other GeneratorNamedParameter) bool {
+	if g.Name != other.Name {
+		return false
+	}
+
+	if g.Parameter == nil || other.Parameter == nil {
+		return g.Parameter == nil && other.Parameter == nil
+	}
+
+	return g.Parameter.Equal(other.Parameter)
+}
+
+type GeneratorParameters []GeneratorNamedParameter
+
+func (g GeneratorParameters) Equal(other GeneratorParameters) bool {
+	if len(g) != len(other) {
+		return false
+	}
+
+	for k := range g {
+		if !g[k].Equal(other[k]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Definition returns the function.Parameter definitions in the order in which
+// they were declared.
+func (g GeneratorParameters) Definition() (string, error) {
+	var s strings.Builder
+
+	for _, p := range g {
+		if p.Parameter == nil {
+			continue
+		}
+
+		str, err := p.Parameter.Definition(generatorschema.FrameworkIdentifier(p.Name))
+
+		if err != nil {
+			return "", err
+		}
+
+		s.WriteString("\n" + str)
+	}
+
+	return s.String(), nil
+}
+
+func (g GeneratorParameters) Imports() *generatorschema.Imports {
+	imports := generatorschema.NewImports()
+
+	for _, p := range g {
+		if p.Parameter == nil {
+			continue
+		}
+
+		imports.Append(p.Parameter.Imports())
+	}
+
+	return imports
+}
+
+// ModelFields returns the fields of the arguments struct in the order in which
+// the parameters were declared.
+func (g GeneratorParameters) ModelFields() ([]model.Field, error) {
+	var fields []model.Field
+
+	for _, p := range g {
+		if p.Parameter == nil {
+			continue
+		}
+
+		field, err := p.Parameter.ModelField(generatorschema.FrameworkIdentifier(p.Name))
+
+		if err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
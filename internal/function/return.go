@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// GeneratorReturn holds the type of the value returned by a function, which is
+// rendered as the corresponding function.<Type>Return.
+type GeneratorReturn struct {
+	// ReturnType is the name of the framework return type without the
+	// Return suffix (e.g., String for function.StringReturn).
+	ReturnType string
+
+	CustomType     *specschema.CustomType
+	ElementType    *convert.ElementType
+	AttributeTypes *convert.ObjectAttributeTypes
+}
+
+func NewGeneratorReturn(r extension.Return) (GeneratorReturn, error) {
+	switch {
+	case r.Bool != nil:
+		return GeneratorReturn{ReturnType: "Bool", CustomType: r.Bool.CustomType}, nil
+	case r.Dynamic != nil:
+		return GeneratorReturn{ReturnType: "Dynamic", CustomType: r.Dynamic.CustomType}, nil
+	case r.Float64 != nil && extension.IsFloat32(r.Float64):
+		return GeneratorReturn{ReturnType: "Float32", CustomType: r.Float64.CustomType}, nil
+	case r.Float64 != nil:
+		return GeneratorReturn{ReturnType: "Float64", CustomType: r.Float64.CustomType}, nil
+	case r.Int32 != nil:
+		return GeneratorReturn{ReturnType: "Int32", CustomType: r.Int32.CustomType}, nil
+	case r.Int64 != nil:
+		return GeneratorReturn{ReturnType: "Int64", CustomType: r.Int64.CustomType}, nil
+	case r.List != nil:
+		et := convert.NewElementType(r.List.ElementType)
+
+		return GeneratorReturn{ReturnType: "List", CustomType: r.List.CustomType, ElementType: &et}, nil
+	case r.Map != nil:
+		et := convert.NewElementType(r.Map.ElementType)
+
+		return GeneratorReturn{ReturnType: "Map", CustomType: r.Map.CustomType, ElementType: &et}, nil
+	case r.Number != nil:
+		return GeneratorReturn{ReturnType: "Number", CustomType: r.Number.CustomType}, nil
+	case r.Object != nil:
+		ot := convert.NewObjectAttributeTypes(r.Object.AttributeTypes)
+
+		return GeneratorReturn{ReturnType: "Object", CustomType: r.Object.CustomType, AttributeTypes: &ot}, nil
+	case r.Set != nil:
+		et := convert.NewElementType(r.Set.ElementType)
+
+		return GeneratorReturn{ReturnType: "Set", CustomType: r.Set.CustomType, ElementType: &et}, nil
+	case r.String != nil:
+		return GeneratorReturn{ReturnType: "String", CustomType: r.String.CustomType}, nil
+	}
+
+	return GeneratorReturn{}, errors.New("return type not defined")
+}
+
+func (g GeneratorReturn) Equal(other GeneratorReturn) bool {
+	if g.ReturnType != other.ReturnType {
+		return false
+	}
+
+	if !g.CustomType.Equal(other.CustomType) {
+		return false
+	}
+
+	if (g.ElementType == nil) != (other.ElementType == nil) {
+		return false
+	}
+
+	if g.ElementType != nil && !g.ElementType.Equal(*other.ElementType) {
+		return false
+	}
+
+	if (g.AttributeTypes == nil) != (other.AttributeTypes == nil) {
+		return false
+	}
+
+	return g.AttributeTypes == nil || g.AttributeTypes.Equal(*other.AttributeTypes)
+}
+
+func (g GeneratorReturn) Imports() *generatorschema.Imports {
+	imports := generatorschema.NewImports()
+
+	if g.CustomType != nil {
+		if g.CustomType.HasImport() {
+			imports.Add(*g.CustomType.Import)
+		}
+
+		return imports
+	}
+
+	if g.ElementType != nil {
+		imports.Append(g.ElementType.Imports())
+	}
+
+	if g.AttributeTypes != nil {
+		imports.Add(code.Import{
+			Path: generatorschema.AttrImport,
+		})
+
+		imports.Append(g.AttributeTypes.Imports())
+	}
+
+	return imports
+}
+
+func (g GeneratorReturn) Definition() (string, error) {
+	if g.ReturnType == "" {
+		return "", errors.New("return type not defined")
+	}
+
+	var b bytes.Buffer
+
+	b.WriteString(fmt.Sprintf("function.%sReturn{\n", g.ReturnType))
+
+	switch {
+	case g.CustomType != nil:
+		b.WriteString(fmt.Sprintf("CustomType: %s,\n", g.CustomType.Type))
+	case g.ElementType != nil:
+		b.Write(g.ElementType.Schema())
+	case g.AttributeTypes != nil:
+		b.Write(g.AttributeTypes.Schema())
+	}
+
+	b.WriteString("}")
+
+	return b.String(), nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+)
+
+func TestGeneratorReturn_Definition(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         extension.Return
+		expected      string
+		expectedError error
+	}{
+		"undefined": {
+			expectedError: errors.New("return type not defined"),
+		},
+		"string": {
+			input: extension.Return{
+				String: &specschema.StringType{},
+			},
+			expected: `function.StringReturn{
+}`,
+		},
+		"string-custom-type": {
+			input: extension.Return{
+				String: &specschema.StringType{
+					CustomType: &specschema.CustomType{
+						Type: "my_custom_type",
+					},
+				},
+			},
+			expected: `function.StringReturn{
+CustomType: my_custom_type,
+}`,
+		},
+		"float32": {
+			input: extension.Return{
+				Float64: extension.AsFloat32(&specschema.Float64Type{}),
+			},
+			expected: `function.Float32Return{
+}`,
+		},
+		"float32-custom-type": {
+			input: extension.Return{
+				Float64: extension.AsFloat32(&specschema.Float64Type{
+					CustomType: &specschema.CustomType{
+						Type: "my_custom_type",
+					},
+				}),
+			},
+			expected: `function.Float32Return{
+CustomType: my_custom_type,
+}`,
+		},
+		"list-float32": {
+			input: extension.Return{
+				List: &specschema.ListType{
+					ElementType: specschema.ElementType{
+						Float64: extension.AsFloat32(&specschema.Float64Type{}),
+					},
+				},
+			},
+			expected: `function.ListReturn{
+ElementType: types.Float32Type,
+}`,
+		},
+		"map": {
+			input: extension.Return{
+				Map: &specschema.MapType{
+					ElementType: specschema.ElementType{
+						Int64: &specschema.Int64Type{},
+					},
+				},
+			},
+			expected: `function.MapReturn{
+ElementType: types.Int64Type,
+}`,
+		},
+		"object": {
+			input: extension.Return{
+				Object: &specschema.ObjectType{
+					AttributeTypes: specschema.ObjectAttributeTypes{
+						{
+							Name:   "number",
+							Number: &specschema.NumberType{},
+						},
+					},
+				},
+			},
+			expected: `function.ObjectReturn{
+AttributeTypes: map[string]attr.Type{
+"number": types.NumberType,
+},
+}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := NewGeneratorReturn(testCase.input)
+
+			if err != nil {
+				if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+					t.Errorf("unexpected error: %s", diff)
+				}
+
+				return
+			}
+
+			got, err := r.Definition()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
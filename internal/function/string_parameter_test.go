@@ -0,0 +1,360 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+)
+
+func TestGeneratorStringParameter_New(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         *extension.StringParameter
+		expected      GeneratorStringParameter
+		expectedError error
+	}{
+		"nil": {
+			expectedError: fmt.Errorf("*extension.StringParameter is nil"),
+		},
+		"allow_null_value": {
+			input: &extension.StringParameter{
+				AllowNullValue: true,
+			},
+			expected: GeneratorStringParameter{
+				AllowNullValue: convert.NewAllowNullValue(true),
+				CustomType:     convert.NewCustomTypePrimitive(nil, nil, "name"),
+				Validators:     convert.NewParameterValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
+			},
+		},
+		"allow_unknown_values": {
+			input: &extension.StringParameter{
+				AllowUnknownValues: true,
+			},
+			expected: GeneratorStringParameter{
+				AllowUnknownValues: convert.NewAllowUnknownValues(true),
+				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name"),
+				Validators:         convert.NewParameterValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
+			},
+		},
+		"custom_type": {
+			input: &extension.StringParameter{
+				CustomType: &specschema.CustomType{
+					Import: &code.Import{
+						Path: "github.com/",
+					},
+					Type:      "my_type",
+					ValueType: "myvalue_type",
+				},
+			},
+			expected: GeneratorStringParameter{
+				CustomType: convert.NewCustomTypePrimitive(&specschema.CustomType{
+					Import: &code.Import{
+						Path: "github.com/",
+					},
+					Type:      "my_type",
+					ValueType: "myvalue_type",
+				}, nil, "name"),
+				Validators: convert.NewParameterValidators(convert.ValidatorTypeString, nil),
+			},
+		},
+		"description": {
+			input: &extension.StringParameter{
+				Description: pointer("description"),
+			},
+			expected: GeneratorStringParameter{
+				CustomType:  convert.NewCustomTypePrimitive(nil, nil, "name"),
+				Description: convert.NewDescription(pointer("description")),
+				Validators:  convert.NewParameterValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
+			},
+		},
+		"validators": {
+			input: &extension.StringParameter{
+				Validators: specschema.StringValidators{
+					{
+						Custom: &specschema.CustomValidator{
+							Imports: []code.Import{
+								{
+									Path: "github.com/.../myvalidator",
+								},
+							},
+							SchemaDefinition: "myvalidator.Validate()",
+						},
+					},
+				},
+			},
+			expected: GeneratorStringParameter{
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				Validators: convert.NewParameterValidators(convert.ValidatorTypeString, specschema.CustomValidators{
+					&specschema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/.../myvalidator",
+							},
+						},
+						SchemaDefinition: "myvalidator.Validate()",
+					},
+				}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewGeneratorStringParameter("name", testCase.input)
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratorStringParameter_Imports(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    GeneratorStringParameter
+		expected []code.Import
+	}{
+		"default": {
+			expected: []code.Import{
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/types",
+				},
+			},
+		},
+		"custom-type-with-import": {
+			input: GeneratorStringParameter{
+				CustomType: convert.NewCustomTypePrimitive(
+					&specschema.CustomType{
+						Import: &code.Import{
+							Path: "github.com/my_account/my_project/parameter",
+						},
+					},
+					nil,
+					"",
+				),
+			},
+			expected: []code.Import{
+				{
+					Path: "github.com/my_account/my_project/parameter",
+				},
+			},
+		},
+		"validator-custom-import": {
+			input: GeneratorStringParameter{
+				Validators: convert.NewParameterValidators(convert.ValidatorTypeString, specschema.CustomValidators{
+					&specschema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/myotherproject/myvalidators/validator",
+							},
+						},
+					},
+				}),
+			},
+			expected: []code.Import{
+				{
+					Path: "github.com/hashicorp/terraform-plugin-framework/types",
+				},
+				{
+					Path: "github.com/myotherproject/myvalidators/validator",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.Imports().All()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratorStringParameter_Definition(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         GeneratorStringParameter
+		expected      string
+		expectedError error
+	}{
+		"default": {
+			expected: `function.StringParameter{
+Name: "string_parameter",
+},`,
+		},
+
+		"custom-type": {
+			input: GeneratorStringParameter{
+				CustomType: convert.NewCustomTypePrimitive(
+					&specschema.CustomType{
+						Type: "my_custom_type",
+					},
+					nil,
+					"string_parameter",
+				),
+			},
+			expected: `function.StringParameter{
+Name: "string_parameter",
+CustomType: my_custom_type,
+},`,
+		},
+
+		"allow-null-value": {
+			input: GeneratorStringParameter{
+				AllowNullValue: convert.NewAllowNullValue(true),
+			},
+			expected: `function.StringParameter{
+Name: "string_parameter",
+AllowNullValue: true,
+},`,
+		},
+
+		"allow-unknown-values": {
+			input: GeneratorStringParameter{
+				AllowUnknownValues: convert.NewAllowUnknownValues(true),
+			},
+			expected: `function.StringParameter{
+Name: "string_parameter",
+AllowUnknownValues: true,
+},`,
+		},
+
+		"description": {
+			input: GeneratorStringParameter{
+				Description: convert.NewDescription(pointer("description")),
+			},
+			expected: `function.StringParameter{
+Name: "string_parameter",
+Description: "description",
+MarkdownDescription: "description",
+},`,
+		},
+
+		"validators": {
+			input: GeneratorStringParameter{
+				Validators: convert.NewParameterValidators(convert.ValidatorTypeString, specschema.CustomValidators{
+					&specschema.CustomValidator{
+						SchemaDefinition: "my_validator.Validate()",
+					},
+					&specschema.CustomValidator{
+						SchemaDefinition: "my_other_validator.Validate()",
+					},
+				}),
+			},
+			expected: `function.StringParameter{
+Name: "string_parameter",
+Validators: []function.StringParameterValidator{
+my_validator.Validate(),
+my_other_validator.Validate(),
+},
+},`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.input.Definition("string_parameter")
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratorStringParameter_ModelField(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         GeneratorStringParameter
+		expected      model.Field
+		expectedError error
+	}{
+		"default": {
+			expected: model.Field{
+				Name:      "StringParameter",
+				ValueType: "types.String",
+				TfsdkName: "string_parameter",
+			},
+		},
+		"custom-type": {
+			input: GeneratorStringParameter{
+				CustomType: convert.NewCustomTypePrimitive(
+					&specschema.CustomType{
+						ValueType: "my_custom_value_type",
+					},
+					nil,
+					"",
+				),
+			},
+			expected: model.Field{
+				Name:      "StringParameter",
+				ValueType: "my_custom_value_type",
+				TfsdkName: "string_parameter",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.input.ModelField("string_parameter")
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func pointer[T any](in T) *T {
+	return &in
+}
+
+var equateErrorMessage = cmp.Comparer(func(x, y error) bool {
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+
+	return x.Error() == y.Error()
+})
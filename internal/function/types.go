@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+type GeneratorParameter interface {
+	Definition(generatorschema.FrameworkIdentifier) (string, error)
+	Equal(GeneratorParameter) bool
+	Imports() *generatorschema.Imports
+	ModelField(generatorschema.FrameworkIdentifier) (model.Field, error)
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+type GeneratorBoolAttribute struct {
+	CustomType        convert.CustomTypePrimitive
+	Description       convert.Description
+	ImportRequirement convert.ImportRequirement
+}
+
+func NewGeneratorBoolAttribute(name string, a *extension.IdentityPrimitiveAttribute) (GeneratorBoolAttribute, error) {
+	if a == nil {
+		return GeneratorBoolAttribute{}, fmt.Errorf("*extension.IdentityPrimitiveAttribute is nil")
+	}
+
+	ctp := convert.NewCustomTypePrimitive(a.CustomType, nil, name)
+
+	d := convert.NewDescription(a.Description)
+
+	ir := convert.NewImportRequirement(a.OptionalForImport, a.RequiredForImport)
+
+	return GeneratorBoolAttribute{
+		CustomType:        ctp,
+		Description:       d,
+		ImportRequirement: ir,
+	}, nil
+}
+
+func (g GeneratorBoolAttribute) GeneratorSchemaType() generatorschema.Type {
+	return generatorschema.GeneratorBoolAttribute
+}
+
+func (g GeneratorBoolAttribute) Imports() *generatorschema.Imports {
+	imports := generatorschema.NewImports()
+
+	imports.Append(g.CustomType.Imports())
+
+	return imports
+}
+
+func (g GeneratorBoolAttribute) Equal(ga generatorschema.GeneratorAttribute) bool {
+	h, ok := ga.(GeneratorBoolAttribute)
+
+	if !ok {
+		return false
+	}
+
+	if !g.CustomType.Equal(h.CustomType) {
+		return false
+	}
+
+	if !g.Description.Equal(h.Description) {
+		return false
+	}
+
+	return g.ImportRequirement.Equal(h.ImportRequirement)
+}
+
+func (g GeneratorBoolAttribute) Schema(name generatorschema.FrameworkIdentifier) (string, error) {
+	var b bytes.Buffer
+
+	b.WriteString(fmt.Sprintf("%q: identityschema.BoolAttribute{\n", name))
+	b.Write(g.CustomType.Schema())
+	b.Write(g.ImportRequirement.Schema())
+	b.Write(g.Description.SchemaDescription())
+	b.WriteString("},")
+
+	return b.String(), nil
+}
+
+func (g GeneratorBoolAttribute) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
+	field := model.Field{
+		Name:      name.ToPascalCase(),
+		TfsdkName: name.ToString(),
+		ValueType: model.BoolValueType,
+	}
+
+	customValueType := g.CustomType.ValueType()
+
+	if customValueType != "" {
+		field.ValueType = customValueType
+	}
+
+	return field, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// NewAttributes converts the identity attributes of a resource into generator
+// attributes, which render as identityschema attributes.
+func NewAttributes(a extension.IdentityAttributes) (generatorschema.GeneratorAttributes, error) {
+	attributes := make(generatorschema.GeneratorAttributes, len(a))
+
+	var errs []error
+
+	for i, v := range a {
+		attribute, err := NewAttribute(v)
+
+		if err != nil {
+			errs = append(errs, diagnostic.NewErrors(err, "attributes", i)...)
+
+			continue
+		}
+
+		attributes[v.Name] = attribute
+	}
+
+	if len(errs) > 0 {
+		return generatorschema.GeneratorAttributes{}, errors.Join(errs...)
+	}
+
+	return attributes, nil
+}
+
+func NewAttribute(a extension.IdentityAttribute) (generatorschema.GeneratorAttribute, error) {
+	switch {
+	case a.Bool != nil:
+		return NewGeneratorBoolAttribute(a.Name, a.Bool)
+	case a.Float32 != nil:
+		return NewGeneratorFloat32Attribute(a.Name, a.Float32)
+	case a.Float64 != nil:
+		return NewGeneratorFloat64Attribute(a.Name, a.Float64)
+	case a.Int32 != nil:
+		return NewGeneratorInt32Attribute(a.Name, a.Int32)
+	case a.Int64 != nil:
+		return NewGeneratorInt64Attribute(a.Name, a.Int64)
+	case a.List != nil:
+		return NewGeneratorListAttribute(a.Name, a.List)
+	case a.Number != nil:
+		return NewGeneratorNumberAttribute(a.Name, a.Number)
+	case a.String != nil:
+		return NewGeneratorStringAttribute(a.Name, a.String)
+	}
+
+	return nil, fmt.Errorf("identity attribute %q type not defined", a.Name)
+}
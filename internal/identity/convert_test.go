@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+func TestNewAttributes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         extension.IdentityAttributes
+		expected      generatorschema.GeneratorAttributes
+		expectedError error
+	}{
+		"success": {
+			input: extension.IdentityAttributes{
+				{
+					Name: "id",
+					String: &extension.IdentityPrimitiveAttribute{
+						IdentityAttributeProperties: extension.IdentityAttributeProperties{
+							RequiredForImport: true,
+						},
+					},
+				},
+				{
+					Name: "weight",
+					Float32: &extension.IdentityPrimitiveAttribute{
+						IdentityAttributeProperties: extension.IdentityAttributeProperties{
+							OptionalForImport: true,
+						},
+					},
+				},
+				{
+					Name: "zones",
+					List: &extension.IdentityListAttribute{
+						IdentityAttributeProperties: extension.IdentityAttributeProperties{
+							OptionalForImport: true,
+						},
+						ElementType: specschema.ElementType{
+							String: &specschema.StringType{},
+						},
+					},
+				},
+			},
+			expected: generatorschema.GeneratorAttributes{
+				"id": GeneratorStringAttribute{
+					CustomType:        convert.NewCustomTypePrimitive(nil, nil, "id"),
+					ImportRequirement: convert.NewImportRequirement(false, true),
+				},
+				"weight": GeneratorFloat32Attribute{
+					CustomType:        convert.NewCustomTypePrimitive(nil, nil, "weight"),
+					ImportRequirement: convert.NewImportRequirement(true, false),
+				},
+				"zones": GeneratorListAttribute{
+					CustomType: convert.NewCustomTypeCollection(nil, nil, convert.CustomCollectionTypeList, "types.StringType", "zones"),
+					ElementType: specschema.ElementType{
+						String: &specschema.StringType{},
+					},
+					ElementTypeCollection: convert.NewElementType(specschema.ElementType{
+						String: &specschema.StringType{},
+					}),
+					ImportRequirement: convert.NewImportRequirement(true, false),
+				},
+			},
+		},
+		"type-not-defined": {
+			input: extension.IdentityAttributes{
+				{
+					Name: "id",
+				},
+			},
+			expected:      generatorschema.GeneratorAttributes{},
+			expectedError: errors.Join(diagnostic.NewError(errors.New(`identity attribute "id" type not defined`), "attributes", 0)),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewAttributes(testCase.input)
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+type GeneratorInt32Attribute struct {
+	CustomType        convert.CustomTypePrimitive
+	Description       convert.Description
+	ImportRequirement convert.ImportRequirement
+}
+
+func NewGeneratorInt32Attribute(name string, a *extension.IdentityPrimitiveAttribute) (GeneratorInt32Attribute, error) {
+	if a == nil {
+		return GeneratorInt32Attribute{}, fmt.Errorf("*extension.IdentityPrimitiveAttribute is nil")
+	}
+
+	ctp := convert.NewCustomTypePrimitive(a.CustomType, nil, name)
+
+	d := convert.NewDescription(a.Description)
+
+	ir := convert.NewImportRequirement(a.OptionalForImport, a.RequiredForImport)
+
+	return GeneratorInt32Attribute{
+		CustomType:        ctp,
+		Description:       d,
+		ImportRequirement: ir,
+	}, nil
+}
+
+func (g GeneratorInt32Attribute) GeneratorSchemaType() generatorschema.Type {
+	return generatorschema.GeneratorInt32Attribute
+}
+
+func (g GeneratorInt32Attribute) Imports() *generatorschema.Imports {
+	imports := generatorschema.NewImports()
+
+	imports.Append(g.CustomType.Imports())
+
+	return imports
+}
+
+func (g GeneratorInt32Attribute) Equal(ga generatorschema.GeneratorAttribute) bool {
+	h, ok := ga.(GeneratorInt32Attribute)
+
+	if !ok {
+		return false
+	}
+
+	if !g.CustomType.Equal(h.CustomType) {
+		return false
+	}
+
+	if !g.Description.Equal(h.Description) {
+		return false
+	}
+
+	return g.ImportRequirement.Equal(h.ImportRequirement)
+}
+
+func (g GeneratorInt32Attribute) Schema(name generatorschema.FrameworkIdentifier) (string, error) {
+	var b bytes.Buffer
+
+	b.WriteString(fmt.Sprintf("%q: identityschema.Int32Attribute{\n", name))
+	b.Write(g.CustomType.Schema())
+	b.Write(g.ImportRequirement.Schema())
+	b.Write(g.Description.SchemaDescription())
+	b.WriteString("},")
+
+	return b.String(), nil
+}
+
+func (g GeneratorInt32Attribute) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
+	field := model.Field{
+		Name:      name.ToPascalCase(),
+		TfsdkName: name.ToString(),
+		ValueType: model.Int32ValueType,
+	}
+
+	customValueType := g.CustomType.ValueType()
+
+	if customValueType != "" {
+		field.ValueType = customValueType
+	}
+
+	return field, nil
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"bytes"
+	"fmt"
+
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+type GeneratorListAttribute struct {
+	CustomType            convert.CustomTypeCollection
+	Description           convert.Description
+	ElementType           specschema.ElementType
+	ElementTypeCollection convert.ElementType
+	ImportRequirement     convert.ImportRequirement
+}
+
+func NewGeneratorListAttribute(name string, a *extension.IdentityListAttribute) (GeneratorListAttribute, error) {
+	if a == nil {
+		return GeneratorListAttribute{}, fmt.Errorf("*extension.IdentityListAttribute is nil")
+	}
+
+	et := convert.NewElementType(a.ElementType)
+
+	ctc := convert.NewCustomTypeCollection(a.CustomType, nil, convert.CustomCollectionTypeList, string(et.ElementType()), name)
+
+	d := convert.NewDescription(a.Description)
+
+	ir := convert.NewImportRequirement(a.OptionalForImport, a.RequiredForImport)
+
+	return GeneratorListAttribute{
+		CustomType:            ctc,
+		Description:           d,
+		ElementType:           a.ElementType,
+		ElementTypeCollection: et,
+		ImportRequirement:     ir,
+	}, nil
+}
+
+func (g GeneratorListAttribute) GeneratorSchemaType() generatorschema.Type {
+	return generatorschema.GeneratorListAttribute
+}
+
+func (g GeneratorListAttribute) Imports() *generatorschema.Imports {
+	imports := generatorschema.NewImports()
+
+	imports.Append(g.CustomType.Imports())
+
+	imports.Append(g.ElementTypeCollection.Imports())
+
+	return imports
+}
+
+// Equal does not delegate to g.ElementType.Equal(h.ElementType) as the
+// call returns false when the ElementType is nil.
+func (g GeneratorListAttribute) Equal(ga generatorschema.GeneratorAttribute) bool {
+	h, ok := ga.(GeneratorListAttribute)
+
+	if !ok {
+		return false
+	}
+
+	if !g.CustomType.Equal(h.CustomType) {
+		return false
+	}
+
+	if !g.Description.Equal(h.Description) {
+		return false
+	}
+
+	if !g.ElementTypeCollection.Equal(h.ElementTypeCollection) {
+		return false
+	}
+
+	return g.ImportRequirement.Equal(h.ImportRequirement)
+}
+
+func (g GeneratorListAttribute) Schema(name generatorschema.FrameworkIdentifier) (string, error) {
+	var b bytes.Buffer
+
+	customTypeSchema := g.CustomType.Schema()
+
+	b.WriteString(fmt.Sprintf("%q: identityschema.ListAttribute{\n", name))
+	b.Write(customTypeSchema)
+	if len(customTypeSchema) == 0 {
+		b.Write(g.ElementTypeCollection.Schema())
+	}
+	b.Write(g.ImportRequirement.Schema())
+	b.Write(g.Description.SchemaDescription())
+	b.WriteString("},")
+
+	return b.String(), nil
+}
+
+func (g GeneratorListAttribute) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
+	field := model.Field{
+		Name:      name.ToPascalCase(),
+		TfsdkName: name.ToString(),
+		ValueType: model.ListValueType,
+	}
+
+	customValueType := g.CustomType.ValueType()
+
+	if customValueType != "" {
+		field.ValueType = customValueType
+	}
+
+	return field, nil
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+)
+
+func TestGeneratorListAttribute_New(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         *extension.IdentityListAttribute
+		expected      GeneratorListAttribute
+		expectedError error
+	}{
+		"nil": {
+			expectedError: fmt.Errorf("*extension.IdentityListAttribute is nil"),
+		},
+		"element-type": {
+			input: &extension.IdentityListAttribute{
+				IdentityAttributeProperties: extension.IdentityAttributeProperties{
+					OptionalForImport: true,
+				},
+				ElementType: specschema.ElementType{
+					String: &specschema.StringType{},
+				},
+			},
+			expected: GeneratorListAttribute{
+				CustomType: convert.NewCustomTypeCollection(nil, nil, convert.CustomCollectionTypeList, "types.StringType", "name"),
+				ElementType: specschema.ElementType{
+					String: &specschema.StringType{},
+				},
+				ElementTypeCollection: convert.NewElementType(specschema.ElementType{
+					String: &specschema.StringType{},
+				}),
+				ImportRequirement: convert.NewImportRequirement(true, false),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewGeneratorListAttribute("name", testCase.input)
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratorListAttribute_Schema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         GeneratorListAttribute
+		expected      string
+		expectedError error
+	}{
+		"custom-type": {
+			input: GeneratorListAttribute{
+				CustomType: convert.NewCustomTypeCollection(
+					&specschema.CustomType{
+						Type: "my_custom_type",
+					},
+					nil,
+					convert.CustomCollectionTypeList,
+					"",
+					"",
+				),
+				ElementTypeCollection: convert.NewElementType(specschema.ElementType{
+					String: &specschema.StringType{},
+				}),
+				ImportRequirement: convert.NewImportRequirement(false, true),
+			},
+			expected: `"list_attribute": identityschema.ListAttribute{
+CustomType: my_custom_type,
+RequiredForImport: true,
+},`,
+		},
+		"element-type": {
+			input: GeneratorListAttribute{
+				ElementTypeCollection: convert.NewElementType(specschema.ElementType{
+					Int64: &specschema.Int64Type{},
+				}),
+				ImportRequirement: convert.NewImportRequirement(true, false),
+			},
+			expected: `"list_attribute": identityschema.ListAttribute{
+ElementType: types.Int64Type,
+OptionalForImport: true,
+},`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.input.Schema("list_attribute")
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratorListAttribute_ModelField(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         GeneratorListAttribute
+		expected      model.Field
+		expectedError error
+	}{
+		"default": {
+			expected: model.Field{
+				Name:      "ListAttribute",
+				ValueType: model.ListValueType,
+				TfsdkName: "list_attribute",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.input.ModelField("list_attribute")
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+func TestGeneratorStringAttribute_New(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         *extension.IdentityPrimitiveAttribute
+		expected      GeneratorStringAttribute
+		expectedError error
+	}{
+		"nil": {
+			expectedError: fmt.Errorf("*extension.IdentityPrimitiveAttribute is nil"),
+		},
+		"required-for-import": {
+			input: &extension.IdentityPrimitiveAttribute{
+				IdentityAttributeProperties: extension.IdentityAttributeProperties{
+					RequiredForImport: true,
+				},
+			},
+			expected: GeneratorStringAttribute{
+				CustomType:        convert.NewCustomTypePrimitive(nil, nil, "name"),
+				ImportRequirement: convert.NewImportRequirement(false, true),
+			},
+		},
+		"custom-type": {
+			input: &extension.IdentityPrimitiveAttribute{
+				IdentityAttributeProperties: extension.IdentityAttributeProperties{
+					CustomType: &specschema.CustomType{
+						Type:      "my_custom_type",
+						ValueType: "my_custom_value_type",
+					},
+					OptionalForImport: true,
+				},
+			},
+			expected: GeneratorStringAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					&specschema.CustomType{
+						Type:      "my_custom_type",
+						ValueType: "my_custom_value_type",
+					},
+					nil,
+					"name",
+				),
+				ImportRequirement: convert.NewImportRequirement(true, false),
+			},
+		},
+		"description": {
+			input: &extension.IdentityPrimitiveAttribute{
+				IdentityAttributeProperties: extension.IdentityAttributeProperties{
+					Description:       pointer("description"),
+					RequiredForImport: true,
+				},
+			},
+			expected: GeneratorStringAttribute{
+				CustomType:        convert.NewCustomTypePrimitive(nil, nil, "name"),
+				Description:       convert.NewDescription(pointer("description")),
+				ImportRequirement: convert.NewImportRequirement(false, true),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewGeneratorStringAttribute("name", testCase.input)
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratorStringAttribute_Imports(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    GeneratorStringAttribute
+		expected []code.Import
+	}{
+		"default": {
+			expected: []code.Import{
+				{
+					Path: generatorschema.TypesImport,
+				},
+			},
+		},
+		"custom-type-with-import": {
+			input: GeneratorStringAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					&specschema.CustomType{
+						Import: &code.Import{
+							Path: "github.com/my_account/my_project/attribute",
+						},
+					},
+					nil,
+					"name",
+				),
+			},
+			expected: []code.Import{
+				{
+					Path: "github.com/my_account/my_project/attribute",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.Imports().All()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratorStringAttribute_Schema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         GeneratorStringAttribute
+		expected      string
+		expectedError error
+	}{
+		"custom-type": {
+			input: GeneratorStringAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					&specschema.CustomType{
+						Type: "my_custom_type",
+					},
+					nil,
+					"string_attribute",
+				),
+			},
+			expected: `"string_attribute": identityschema.StringAttribute{
+CustomType: my_custom_type,
+},`,
+		},
+		"optional-for-import": {
+			input: GeneratorStringAttribute{
+				ImportRequirement: convert.NewImportRequirement(true, false),
+			},
+			expected: `"string_attribute": identityschema.StringAttribute{
+OptionalForImport: true,
+},`,
+		},
+		"required-for-import": {
+			input: GeneratorStringAttribute{
+				ImportRequirement: convert.NewImportRequirement(false, true),
+			},
+			expected: `"string_attribute": identityschema.StringAttribute{
+RequiredForImport: true,
+},`,
+		},
+		"description": {
+			input: GeneratorStringAttribute{
+				Description:       convert.NewDescription(pointer("description")),
+				ImportRequirement: convert.NewImportRequirement(false, true),
+			},
+			expected: `"string_attribute": identityschema.StringAttribute{
+RequiredForImport: true,
+Description: "description",
+},`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.input.Schema("string_attribute")
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratorStringAttribute_ModelField(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         GeneratorStringAttribute
+		expected      model.Field
+		expectedError error
+	}{
+		"default": {
+			expected: model.Field{
+				Name:      "StringAttribute",
+				ValueType: model.StringValueType,
+				TfsdkName: "string_attribute",
+			},
+		},
+		"custom-type": {
+			input: GeneratorStringAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					&specschema.CustomType{
+						ValueType: "my_custom_value_type",
+					},
+					nil,
+					"string_attribute",
+				),
+			},
+			expected: model.Field{
+				Name:      "StringAttribute",
+				ValueType: "my_custom_value_type",
+				TfsdkName: "string_attribute",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.input.ModelField("string_attribute")
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func pointer[T any](in T) *T {
+	return &in
+}
+
+var equateErrorMessage = cmp.Comparer(func(x, y error) bool {
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+
+	return x.Error() == y.Error()
+})
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// NewLogger returns a logger which writes records of at least the supplied
+// level, which is one of debug, info, warn or error, to w in the supplied
+// format, which is one of json or text. Each record includes the path from
+// the context, if one is set and the record does not define its own path.
+func NewLogger(w io.Writer, level, format string) (*slog.Logger, error) {
+	var l slog.Level
+
+	err := l.UnmarshalText([]byte(level))
+	if err != nil {
+		return nil, fmt.Errorf("invalid log level %q, must be one of debug, info, warn, error", level)
+	}
+
+	opts := &slog.HandlerOptions{
+		Level: l,
+	}
+
+	var handler slog.Handler
+
+	switch strings.ToLower(format) {
+	case FormatJSON:
+		handler = slog.NewJSONHandler(w, opts)
+	case FormatText:
+		handler = slog.NewTextHandler(w, opts)
+	default:
+		return nil, fmt.Errorf("invalid log format %q, must be one of json, text", format)
+	}
+
+	return slog.New(pathHandler{handler}), nil
+}
+
+// pathHandler adds the path from the context to each record.
+type pathHandler struct {
+	slog.Handler
+}
+
+func (h pathHandler) Handle(ctx context.Context, r slog.Record) error {
+	p := GetPathFromContext(ctx)
+
+	if p == "" {
+		return h.Handler.Handle(ctx, r)
+	}
+
+	hasPath := false
+
+	r.Attrs(func(a slog.Attr) bool {
+		if a.Key == string(path) {
+			hasPath = true
+
+			return false
+		}
+
+		return true
+	})
+
+	if !hasPath {
+		r.AddAttrs(slog.String(string(path), p))
+	}
+
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h pathHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return pathHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h pathHandler) WithGroup(name string) slog.Handler {
+	return pathHandler{h.Handler.WithGroup(name)}
+}
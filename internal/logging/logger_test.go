@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/logging"
+)
+
+func TestNewLogger(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		level         string
+		format        string
+		expected      []map[string]any
+		expectedError string
+	}{
+		"warn": {
+			level:  "warn",
+			format: logging.FormatJSON,
+			expected: []map[string]any{
+				{"level": "WARN", "msg": "warning", "path": "resource.example"},
+				{"level": "ERROR", "msg": "error", "path": "resource.example.attr"},
+			},
+		},
+		"error": {
+			level:  "error",
+			format: logging.FormatJSON,
+			expected: []map[string]any{
+				{"level": "ERROR", "msg": "error", "path": "resource.example.attr"},
+			},
+		},
+		"invalid-level": {
+			level:         "loud",
+			format:        logging.FormatJSON,
+			expectedError: `invalid log level "loud", must be one of debug, info, warn, error`,
+		},
+		"invalid-format": {
+			level:         "warn",
+			format:        "xml",
+			expectedError: `invalid log format "xml", must be one of json, text`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			logger, err := logging.NewLogger(&buf, testCase.level, testCase.format)
+
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got %v", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			ctx := logging.SetPathInContext(context.Background(), "resource")
+			ctx = logging.SetPathInContext(ctx, "example")
+
+			logger.InfoContext(ctx, "info")
+			logger.WarnContext(ctx, "warning")
+			logger.ErrorContext(ctx, "error", "path", "resource.example.attr")
+
+			var got []map[string]any
+
+			dec := json.NewDecoder(&buf)
+
+			for dec.More() {
+				var record map[string]any
+
+				err = dec.Decode(&record)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				delete(record, "time")
+
+				got = append(got, record)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
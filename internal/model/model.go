@@ -10,6 +10,8 @@ import (
 
 const (
 	BoolValueType    = "types.Bool"
+	DynamicValueType = "types.Dynamic"
+	Float32ValueType = "types.Float32"
 	Float64ValueType = "types.Float64"
 	Int32ValueType   = "types.Int32"
 	Int64ValueType   = "types.Int64"
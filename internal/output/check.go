@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package output
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+type DifferenceType string
+
+const (
+	// DifferenceModified indicates that the content of the file in the output
+	// directory differs from the generated content.
+	DifferenceModified DifferenceType = "modified"
+
+	// DifferenceMissing indicates that a generated file does not exist in the
+	// output directory.
+	DifferenceMissing DifferenceType = "missing"
+
+	// DifferenceExtra indicates that a file in the output directory is no
+	// longer generated from the specification.
+	DifferenceExtra DifferenceType = "extra"
+)
+
+// Difference describes a file in the output directory which is not
+// up-to-date with the generated code.
+type Difference struct {
+	// Path is relative to the output directory.
+	Path string
+	Type DifferenceType
+
+	// Diff is a unified diff of the content of the file in the output
+	// directory and the generated content. Diff is only set for a
+	// DifferenceModified.
+	Diff []byte
+}
+
+// Check compares the generated files held by w with the files in outputDir.
+// Existing files are considered to be extra if they match the naming of any
+// of the supplied kinds but have not been generated. The returned differences
+// are sorted by path.
+func Check(w *MemoryWriter, outputDir string, kinds ...Kind) ([]Difference, error) {
+	var differences []Difference
+
+	for _, path := range w.Paths() {
+		rel, err := relativePath(outputDir, path)
+		if err != nil {
+			return nil, err
+		}
+
+		existing, err := os.ReadFile(path)
+
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			differences = append(differences, Difference{
+				Path: rel,
+				Type: DifferenceMissing,
+			})
+
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		generated := w.Files()[path]
+
+		if bytes.Equal(existing, generated) {
+			continue
+		}
+
+		differences = append(differences, Difference{
+			Path: rel,
+			Type: DifferenceModified,
+			Diff: Diff("a/"+rel, existing, "b/"+rel, generated),
+		})
+	}
+
+	for _, k := range kinds {
+		paths, err := k.Glob(outputDir)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, path := range paths {
+			if _, ok := w.Files()[path]; ok {
+				continue
+			}
+
+			rel, err := relativePath(outputDir, path)
+			if err != nil {
+				return nil, err
+			}
+
+			differences = append(differences, Difference{
+				Path: rel,
+				Type: DifferenceExtra,
+			})
+		}
+	}
+
+	sort.Slice(differences, func(i, j int) bool {
+		return differences[i].Path < differences[j].Path
+	})
+
+	return differences, nil
+}
+
+func relativePath(outputDir, path string) (string, error) {
+	rel, err := filepath.Rel(outputDir, path)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.ToSlash(rel), nil
+}
@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	// diffContext is the number of unchanged lines shown around each change.
+	diffContext = 3
+
+	// diffMaxEditDistance limits the work done to find the smallest set of
+	// changes. Beyond the limit, the differing lines are reported as having
+	// been entirely replaced.
+	diffMaxEditDistance = 2000
+)
+
+type diffOp byte
+
+const (
+	diffEqual  diffOp = ' '
+	diffDelete diffOp = '-'
+	diffInsert diffOp = '+'
+)
+
+type diffEdit struct {
+	op   diffOp
+	line string
+}
+
+// Diff returns a unified diff of the lines of oldContent and newContent, or
+// nil if the contents are equal.
+func Diff(oldName string, oldContent []byte, newName string, newContent []byte) []byte {
+	if bytes.Equal(oldContent, newContent) {
+		return nil
+	}
+
+	edits := diffLines(splitLines(oldContent), splitLines(newContent))
+
+	var buf bytes.Buffer
+
+	buf.WriteString(fmt.Sprintf("--- %s\n", oldName))
+	buf.WriteString(fmt.Sprintf("+++ %s\n", newName))
+
+	writeHunks(&buf, edits)
+
+	return buf.Bytes()
+}
+
+func splitLines(content []byte) []string {
+	if len(content) == 0 {
+		return nil
+	}
+
+	lines := strings.SplitAfter(string(content), "\n")
+
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// diffLines removes the common prefix and suffix of a and b, and uses the
+// Myers algorithm to find the changes to the remaining lines.
+func diffLines(a, b []string) []diffEdit {
+	var prefix, suffix int
+
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var edits []diffEdit
+
+	for _, l := range a[:prefix] {
+		edits = append(edits, diffEdit{op: diffEqual, line: l})
+	}
+
+	edits = append(edits, myers(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+
+	for _, l := range a[len(a)-suffix:] {
+		edits = append(edits, diffEdit{op: diffEqual, line: l})
+	}
+
+	return edits
+}
+
+func myers(a, b []string) []diffEdit {
+	n, m := len(a), len(b)
+	maxD := n + m
+	offset := maxD
+
+	v := make([]int, 2*maxD+2)
+
+	var trace [][]int
+
+	for d := 0; d <= maxD && d <= diffMaxEditDistance; d++ {
+		snapshot := make([]int, 2*d+1)
+		copy(snapshot, v[offset-d:offset+d+1])
+		trace = append(trace, snapshot)
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+
+			y := x - k
+
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				return backtrack(trace, a, b)
+			}
+		}
+	}
+
+	var edits []diffEdit
+
+	for _, l := range a {
+		edits = append(edits, diffEdit{op: diffDelete, line: l})
+	}
+
+	for _, l := range b {
+		edits = append(edits, diffEdit{op: diffInsert, line: l})
+	}
+
+	return edits
+}
+
+func backtrack(trace [][]int, a, b []string) []diffEdit {
+	x, y := len(a), len(b)
+
+	var reversed []diffEdit
+
+	for d := len(trace) - 1; d > 0; d-- {
+		t := trace[d]
+		k := x - y
+
+		var prevK int
+
+		if k == -d || (k != d && t[k-1+d] < t[k+1+d]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+
+		prevX := t[prevK+d]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			reversed = append(reversed, diffEdit{op: diffEqual, line: a[x-1]})
+			x--
+			y--
+		}
+
+		if x == prevX {
+			reversed = append(reversed, diffEdit{op: diffInsert, line: b[y-1]})
+		} else {
+			reversed = append(reversed, diffEdit{op: diffDelete, line: a[x-1]})
+		}
+
+		x, y = prevX, prevY
+	}
+
+	for x > 0 && y > 0 {
+		reversed = append(reversed, diffEdit{op: diffEqual, line: a[x-1]})
+		x--
+		y--
+	}
+
+	edits := make([]diffEdit, 0, len(reversed))
+
+	for i := len(reversed) - 1; i >= 0; i-- {
+		edits = append(edits, reversed[i])
+	}
+
+	return edits
+}
+
+// writeHunks writes the changes, surrounded by unchanged lines, as hunks.
+// Hunks which are separated by few unchanged lines are merged.
+func writeHunks(buf *bytes.Buffer, edits []diffEdit) {
+	// aPos and bPos are the number of lines of each side preceding an edit.
+	aPos := make([]int, len(edits)+1)
+	bPos := make([]int, len(edits)+1)
+
+	for i, e := range edits {
+		aPos[i+1], bPos[i+1] = aPos[i], bPos[i]
+
+		if e.op != diffInsert {
+			aPos[i+1]++
+		}
+
+		if e.op != diffDelete {
+			bPos[i+1]++
+		}
+	}
+
+	i := 0
+
+	for i < len(edits) {
+		for i < len(edits) && edits[i].op == diffEqual {
+			i++
+		}
+
+		if i == len(edits) {
+			return
+		}
+
+		start := max(i-diffContext, 0)
+		end := i
+
+		for {
+			for end < len(edits) && edits[end].op != diffEqual {
+				end++
+			}
+
+			next := end
+
+			for next < len(edits) && edits[next].op == diffEqual {
+				next++
+			}
+
+			if next < len(edits) && next-end <= 2*diffContext {
+				end = next
+
+				continue
+			}
+
+			end = min(end+diffContext, len(edits))
+
+			break
+		}
+
+		aStart, aCount := aPos[start], aPos[end]-aPos[start]
+		bStart, bCount := bPos[start], bPos[end]-bPos[start]
+
+		if aCount > 0 {
+			aStart++
+		}
+
+		if bCount > 0 {
+			bStart++
+		}
+
+		buf.WriteString(fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", aStart, aCount, bStart, bCount))
+
+		for _, e := range edits[start:end] {
+			buf.WriteByte(byte(e.op))
+			buf.WriteString(e.line)
+
+			if !strings.HasSuffix(e.line, "\n") {
+				buf.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+
+		i = end
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package output_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/output"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		old      string
+		new      string
+		expected string
+	}{
+		"equal": {
+			old: "a\nb\n",
+			new: "a\nb\n",
+		},
+		"modified": {
+			old: "1\n2\n3\n4\n5\n6\n7\n8\n9\n",
+			new: "1\n2\n3\n4\nfive\n6\n7\n8\n9\n",
+			expected: `--- a/file.go
++++ b/file.go
+@@ -2,7 +2,7 @@
+ 2
+ 3
+ 4
+-5
++five
+ 6
+ 7
+ 8
+`,
+		},
+		"inserted-deleted": {
+			old: "a\nb\nc\n",
+			new: "b\nc\nd\n",
+			expected: `--- a/file.go
++++ b/file.go
+@@ -1,3 +1,3 @@
+-a
+ b
+ c
++d
+`,
+		},
+		"separate-hunks": {
+			old: "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			new: "one\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\ntwelve\n",
+			expected: `--- a/file.go
++++ b/file.go
+@@ -1,4 +1,4 @@
+-1
++one
+ 2
+ 3
+ 4
+@@ -9,4 +9,4 @@
+ 9
+ 10
+ 11
+-12
++twelve
+`,
+		},
+		"missing-newline": {
+			old: "a\nb",
+			new: "a\nb\n",
+			expected: `--- a/file.go
++++ b/file.go
+@@ -1,2 +1,2 @@
+ a
+-b
+\ No newline at end of file
++b
+`,
+		},
+		"empty": {
+			old: "",
+			new: "a\n",
+			expected: `--- a/file.go
++++ b/file.go
+@@ -0,0 +1,1 @@
++a
+`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := output.Diff("a/file.go", []byte(testCase.old), "b/file.go", []byte(testCase.new))
+
+			if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package output
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Kind defines the directory prefix and file suffix of the generated files
+// for a type of entity, such as a resource or data source.
+type Kind struct {
+	DirPrefix  string
+	FileSuffix string
+}
+
+var (
+	DataSourceKind = Kind{
+		DirPrefix:  "datasource_",
+		FileSuffix: "_data_source_gen.go",
+	}
+	EphemeralResourceKind = Kind{
+		DirPrefix:  "ephemeralresource_",
+		FileSuffix: "_ephemeral_resource_gen.go",
+	}
+	FunctionKind = Kind{
+		DirPrefix:  "function_",
+		FileSuffix: "_function_gen.go",
+	}
+	ProviderKind = Kind{
+		DirPrefix:  "provider_",
+		FileSuffix: "_provider_gen.go",
+	}
+	ResourceKind = Kind{
+		DirPrefix:  "resource_",
+		FileSuffix: "_resource_gen.go",
+	}
+)
+
+// ProviderRegistryFile is the name of the generated file which lists the
+// constructors of the data sources and resources.
+const ProviderRegistryFile = "provider_registry_gen.go"
+
+// kinds contains every Kind, and is used to determine which Kind a file
+// belongs to, as the file suffix of one Kind can be the suffix of another.
+var kinds = []Kind{
+	DataSourceKind,
+	EphemeralResourceKind,
+	FunctionKind,
+	ProviderKind,
+	ResourceKind,
+}
+
+// Path returns the path of the generated file for the named entity, relative
+// to the output directory. If packageName is an empty string, the file is
+// placed into a directory per entity.
+func (k Kind) Path(name, packageName string) string {
+	filename := fmt.Sprintf("%s%s", name, k.FileSuffix)
+
+	if packageName == "" {
+		return filepath.Join(fmt.Sprintf("%s%s", k.DirPrefix, name), filename)
+	}
+
+	return filename
+}
+
+// Glob returns the existing generated files of the Kind within the output
+// directory, both with and without a directory per entity.
+func (k Kind) Glob(outputDir string) ([]string, error) {
+	var paths []string
+
+	for _, pattern := range []string{
+		filepath.Join(outputDir, "*"+k.FileSuffix),
+		filepath.Join(outputDir, k.DirPrefix+"*", "*"+k.FileSuffix),
+	} {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, m := range matches {
+			if k.owns(m) {
+				paths = append(paths, filepath.Clean(m))
+			}
+		}
+	}
+
+	return paths, nil
+}
+
+// owns returns false if the file has the longer file suffix of another Kind,
+// for instance an ephemeral resource file is not a resource file.
+func (k Kind) owns(path string) bool {
+	for _, other := range kinds {
+		if len(other.FileSuffix) > len(k.FileSuffix) && strings.HasSuffix(path, other.FileSuffix) {
+			return false
+		}
+	}
+
+	return true
+}
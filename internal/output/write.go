@@ -4,6 +4,7 @@
 package output
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io/fs"
@@ -15,42 +16,16 @@ import (
 // If packageName is an empty string, this indicates that the flag was not set, and the default behaviour is
 // then to create a package and directory per data source. If packageName is set then all generated code is
 // placed into the same directory and package.
-func WriteDataSources(dataSourcesSchema, dataSourcesModels, customTypeValue, dataSourcesToFrom map[string][]byte, outputDir, packageName string) error {
+func WriteDataSources(w Writer, dataSourcesSchema, dataSourcesModels, customTypeValue, dataSourcesToFrom map[string][]byte, outputDir, packageName string) error {
 	for k, v := range dataSourcesSchema {
-		dirName := ""
+		var buf bytes.Buffer
 
-		if packageName == "" {
-			dirName = fmt.Sprintf("datasource_%s", k)
+		buf.Write(v)
+		buf.Write(dataSourcesModels[k])
+		buf.Write(customTypeValue[k])
+		buf.Write(dataSourcesToFrom[k])
 
-			err := os.MkdirAll(filepath.Join(outputDir, dirName), os.ModePerm)
-			if err != nil {
-				return err
-			}
-		}
-
-		filename := fmt.Sprintf("%s_data_source_gen.go", k)
-
-		f, err := os.Create(filepath.Join(outputDir, dirName, filename))
-		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(v)
-		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(dataSourcesModels[k])
-		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(customTypeValue[k])
-		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(dataSourcesToFrom[k])
+		err := w.Write(filepath.Join(outputDir, DataSourceKind.Path(k, packageName)), buf.Bytes())
 		if err != nil {
 			return err
 		}
@@ -63,42 +38,38 @@ func WriteDataSources(dataSourcesSchema, dataSourcesModels, customTypeValue, dat
 // If packageName is an empty string, this indicates that the flag was not set, and the default behaviour is
 // then to create a package and directory per resource. If packageName is set then all generated code is
 // placed into the same directory and package.
-func WriteResources(resourcesSchema, resourcesModels, customTypeValue, resourcesToFrom map[string][]byte, outputDir, packageName string) error {
+func WriteResources(w Writer, resourcesSchema, resourcesModels, customTypeValue, resourcesToFrom map[string][]byte, outputDir, packageName string) error {
 	for k, v := range resourcesSchema {
-		dirName := ""
-
-		if packageName == "" {
-			dirName = fmt.Sprintf("resource_%s", k)
-
-			err := os.MkdirAll(filepath.Join(outputDir, dirName), os.ModePerm)
-			if err != nil {
-				return err
-			}
-		}
+		var buf bytes.Buffer
 
-		filename := fmt.Sprintf("%s_resource_gen.go", k)
+		buf.Write(v)
+		buf.Write(resourcesModels[k])
+		buf.Write(customTypeValue[k])
+		buf.Write(resourcesToFrom[k])
 
-		f, err := os.Create(filepath.Join(outputDir, dirName, filename))
+		err := w.Write(filepath.Join(outputDir, ResourceKind.Path(k, packageName)), buf.Bytes())
 		if err != nil {
 			return err
 		}
+	}
 
-		_, err = f.Write(v)
-		if err != nil {
-			return err
-		}
+	return nil
+}
 
-		_, err = f.Write(resourcesModels[k])
-		if err != nil {
-			return err
-		}
+// WriteEphemeralResources uses the packageName to determine whether to create a directory and package per ephemeral resource.
+// If packageName is an empty string, this indicates that the flag was not set, and the default behaviour is
+// then to create a package and directory per ephemeral resource. If packageName is set then all generated code is
+// placed into the same directory and package.
+func WriteEphemeralResources(w Writer, ephemeralResourcesSchema, ephemeralResourcesModels, customTypeValue, ephemeralResourcesToFrom map[string][]byte, outputDir, packageName string) error {
+	for k, v := range ephemeralResourcesSchema {
+		var buf bytes.Buffer
 
-		_, err = f.Write(customTypeValue[k])
-		if err != nil {
-			return err
-		}
+		buf.Write(v)
+		buf.Write(ephemeralResourcesModels[k])
+		buf.Write(customTypeValue[k])
+		buf.Write(ephemeralResourcesToFrom[k])
 
-		_, err = f.Write(resourcesToFrom[k])
+		err := w.Write(filepath.Join(outputDir, EphemeralResourceKind.Path(k, packageName)), buf.Bytes())
 		if err != nil {
 			return err
 		}
@@ -111,42 +82,62 @@ func WriteResources(resourcesSchema, resourcesModels, customTypeValue, resources
 // If packageName is an empty string, this indicates that the flag was not set, and the default behaviour is
 // then to create a package and directory for the provider. If packageName is set then all generated code is
 // placed into the same directory and package.
-func WriteProviders(providersSchema, providerModels, customTypeValue, providerToFrom map[string][]byte, outputDir, packageName string) error {
+func WriteProviders(w Writer, providersSchema, providerModels, customTypeValue, providerToFrom map[string][]byte, outputDir, packageName string) error {
 	for k, v := range providersSchema {
-		dirName := ""
+		var buf bytes.Buffer
 
-		if packageName == "" {
-			dirName = fmt.Sprintf("provider_%s", k)
+		buf.Write(v)
+		buf.Write(providerModels[k])
+		buf.Write(customTypeValue[k])
+		buf.Write(providerToFrom[k])
 
-			err := os.MkdirAll(filepath.Join(outputDir, dirName), os.ModePerm)
-			if err != nil {
-				return err
-			}
-		}
-
-		filename := fmt.Sprintf("%s_provider_gen.go", k)
-
-		f, err := os.Create(filepath.Join(outputDir, dirName, filename))
+		err := w.Write(filepath.Join(outputDir, ProviderKind.Path(k, packageName)), buf.Bytes())
 		if err != nil {
 			return err
 		}
+	}
 
-		_, err = f.Write(v)
-		if err != nil {
-			return err
-		}
+	return nil
+}
 
-		_, err = f.Write(providerModels[k])
+// WriteFunctions uses the packageName to determine whether to create a directory and package per function.
+// If packageName is an empty string, this indicates that the flag was not set, and the default behaviour is
+// then to create a package and directory per function. If packageName is set then all generated code is
+// placed into the same directory and package.
+func WriteFunctions(w Writer, functionsDefinition map[string][]byte, outputDir, packageName string) error {
+	for k, v := range functionsDefinition {
+		err := w.Write(filepath.Join(outputDir, FunctionKind.Path(k, packageName)), v)
 		if err != nil {
 			return err
 		}
+	}
+
+	return nil
+}
+
+// WriteProviderRegistry writes the provider registry file into the output directory. The registry is placed
+// into the output directory itself, rather than a directory per entity, as it belongs to the same package as the
+// provider, data source and resource implementations.
+func WriteProviderRegistry(w Writer, providerRegistry []byte, outputDir string) error {
+	return w.Write(filepath.Join(outputDir, ProviderRegistryFile), providerRegistry)
+}
 
-		_, err = f.Write(customTypeValue[k])
+// WriteDocs writes a Markdown file for each of the documentation pages into the output directory.
+func WriteDocs(w Writer, docs map[string][]byte, outputDir string) error {
+	for k, v := range docs {
+		err := w.Write(filepath.Join(outputDir, k+".md"), v)
 		if err != nil {
 			return err
 		}
+	}
+
+	return nil
+}
 
-		_, err = f.Write(providerToFrom[k])
+// WriteExamples writes an example configuration file with the given filename into a directory per entity.
+func WriteExamples(w Writer, examples map[string][]byte, outputDir, filename string) error {
+	for k, v := range examples {
+		err := w.Write(filepath.Join(outputDir, k, filename), v)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package output
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Writer is the destination for generated files.
+type Writer interface {
+	Write(path string, content []byte) error
+}
+
+// FileWriter writes generated files to the filesystem, creating any
+// directories which do not exist.
+type FileWriter struct{}
+
+func NewFileWriter() FileWriter {
+	return FileWriter{}
+}
+
+func (w FileWriter) Write(path string, content []byte) error {
+	err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0644)
+}
+
+// MemoryWriter holds generated files in memory, so that they can be
+// compared with the files in the output directory.
+type MemoryWriter struct {
+	files map[string][]byte
+}
+
+func NewMemoryWriter() *MemoryWriter {
+	return &MemoryWriter{
+		files: make(map[string][]byte),
+	}
+}
+
+func (w *MemoryWriter) Write(path string, content []byte) error {
+	w.files[filepath.Clean(path)] = content
+
+	return nil
+}
+
+// Files returns the generated files, keyed by path.
+func (w *MemoryWriter) Files() map[string][]byte {
+	return w.files
+}
+
+// Paths returns the paths of the generated files in sorted order.
+func (w *MemoryWriter) Paths() []string {
+	paths := make([]string, 0, len(w.files))
+
+	for k := range w.files {
+		paths = append(paths, k)
+	}
+
+	sort.Strings(paths)
+
+	return paths
+}
@@ -56,6 +56,16 @@ func (g GeneratorBoolAttribute) GeneratorSchemaType() schema.Type {
 	return schema.GeneratorBoolAttribute
 }
 
+func (g GeneratorBoolAttribute) Documentation() schema.Documentation {
+	return schema.Documentation{
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Optional:           g.OptionalRequired.IsOptional(),
+		Required:           g.OptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+	}
+}
+
 func (g GeneratorBoolAttribute) Imports() *schema.Imports {
 	imports := schema.NewImports()
 
@@ -137,14 +147,14 @@ func (g GeneratorBoolAttribute) ModelField(name schema.FrameworkIdentifier) (mod
 	return field, nil
 }
 
-func (g GeneratorBoolAttribute) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorBoolAttribute) CustomTypeAndValue(name string, gen *schema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	boolType := schema.NewCustomBoolType(name)
+	boolType := schema.NewCustomBoolType(name, gen)
 
 	b, err := boolType.Render()
 
@@ -154,7 +164,7 @@ func (g GeneratorBoolAttribute) CustomTypeAndValue(name string) ([]byte, error)
 
 	buf.Write(b)
 
-	boolValue := schema.NewCustomBoolValue(name)
+	boolValue := schema.NewCustomBoolValue(name, gen)
 
 	b, err = boolValue.Render()
 
@@ -167,12 +177,12 @@ func (g GeneratorBoolAttribute) CustomTypeAndValue(name string) ([]byte, error)
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorBoolAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorBoolAttribute) ToFromFunctions(name string, gen *schema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	toFrom := schema.NewToFromBool(name, g.AssociatedExternalType)
+	toFrom := schema.NewToFromBool(name, g.AssociatedExternalType, gen)
 
 	b, err := toFrom.Render()
 
@@ -201,7 +211,7 @@ func (g GeneratorBoolAttribute) AttrValue(name schema.FrameworkIdentifier) strin
 	return "basetypes.BoolValue"
 }
 
-func (g GeneratorBoolAttribute) To() (schema.ToFromConversion, error) {
+func (g GeneratorBoolAttribute) To(gen *schema.Generator) (schema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return schema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
@@ -213,7 +223,7 @@ func (g GeneratorBoolAttribute) To() (schema.ToFromConversion, error) {
 	}, nil
 }
 
-func (g GeneratorBoolAttribute) From() (schema.ToFromConversion, error) {
+func (g GeneratorBoolAttribute) From(gen *schema.Generator) (schema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return schema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
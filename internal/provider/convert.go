@@ -4,21 +4,32 @@
 package provider
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/greatman/terraform-plugin-codegen-spec/provider"
 	"github.com/greatman/terraform-plugin-codegen-spec/spec"
 
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
 	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
 
-func NewSchemas(spec spec.Specification) (map[string]generatorschema.GeneratorSchema, error) {
+func NewSchemas(spec spec.Specification, ext extension.Specification) (map[string]generatorschema.GeneratorSchema, error) {
 	providerSchemas := make(map[string]generatorschema.GeneratorSchema, 1)
 
 	providerSchema, err := NewSchema(spec.Provider)
 
 	if err != nil {
-		return nil, err
+		return nil, diagnostic.WithPointer(err, "provider")
+	}
+
+	if ext.Provider != nil && ext.Provider.Schema != nil {
+		err = applySchemaExtension(fmt.Sprintf("provider %q", spec.Provider.Name), providerSchema.Attributes, providerSchema.Blocks, *ext.Provider.Schema)
+
+		if err != nil {
+			return nil, diagnostic.WithPointer(err, "provider", "schema")
+		}
 	}
 
 	providerSchemas[spec.Provider.Name] = providerSchema
@@ -36,11 +47,15 @@ func NewSchema(p *provider.Provider) (generatorschema.GeneratorSchema, error) {
 	attributes := make(generatorschema.GeneratorAttributes, len(p.Schema.Attributes))
 	blocks := make(generatorschema.GeneratorBlocks, len(p.Schema.Blocks))
 
-	for _, v := range p.Schema.Attributes {
+	var errs []error
+
+	for i, v := range p.Schema.Attributes {
 		a, err := NewAttribute(v)
 
 		if err != nil {
-			return s, err
+			errs = append(errs, diagnostic.NewErrors(err, "schema", "attributes", i)...)
+
+			continue
 		}
 
 		attributes[v.Name] = a
@@ -48,11 +63,13 @@ func NewSchema(p *provider.Provider) (generatorschema.GeneratorSchema, error) {
 
 	s.Attributes = attributes
 
-	for _, v := range p.Schema.Blocks {
+	for i, v := range p.Schema.Blocks {
 		b, err := NewBlock(v)
 
 		if err != nil {
-			return s, err
+			errs = append(errs, diagnostic.NewErrors(err, "schema", "blocks", i)...)
+
+			continue
 		}
 
 		blocks[v.Name] = b
@@ -60,6 +77,10 @@ func NewSchema(p *provider.Provider) (generatorschema.GeneratorSchema, error) {
 
 	s.Blocks = blocks
 
+	if len(errs) > 0 {
+		return s, errors.Join(errs...)
+	}
+
 	s.Description = p.Schema.Description
 
 	s.MarkdownDescription = p.Schema.MarkdownDescription
@@ -72,16 +93,24 @@ func NewSchema(p *provider.Provider) (generatorschema.GeneratorSchema, error) {
 func NewAttributes(a provider.Attributes) (generatorschema.GeneratorAttributes, error) {
 	attributes := make(generatorschema.GeneratorAttributes, len(a))
 
-	for _, v := range a {
+	var errs []error
+
+	for i, v := range a {
 		attribute, err := NewAttribute(v)
 
 		if err != nil {
-			return generatorschema.GeneratorAttributes{}, err
+			errs = append(errs, diagnostic.NewErrors(err, "attributes", i)...)
+
+			continue
 		}
 
 		attributes[v.Name] = attribute
 	}
 
+	if len(errs) > 0 {
+		return generatorschema.GeneratorAttributes{}, errors.Join(errs...)
+	}
+
 	return attributes, nil
 }
 
@@ -89,18 +118,28 @@ func NewAttribute(a provider.Attribute) (generatorschema.GeneratorAttribute, err
 	switch {
 	case a.Bool != nil:
 		return NewGeneratorBoolAttribute(a.Name, a.Bool)
+	case a.Dynamic != nil:
+		return NewGeneratorDynamicAttribute(a.Name, a.Dynamic)
+	case a.Float64 != nil && extension.IsFloat32(a.Float64):
+		return NewGeneratorFloat32Attribute(a.Name, a.Float64)
 	case a.Float64 != nil:
 		return NewGeneratorFloat64Attribute(a.Name, a.Float64)
+	case a.Int32 != nil:
+		return NewGeneratorInt32Attribute(a.Name, a.Int32)
 	case a.Int64 != nil:
 		return NewGeneratorInt64Attribute(a.Name, a.Int64)
 	case a.List != nil:
 		return NewGeneratorListAttribute(a.Name, a.List)
 	case a.ListNested != nil:
-		return NewGeneratorListNestedAttribute(a.Name, a.ListNested)
+		attribute, err := NewGeneratorListNestedAttribute(a.Name, a.ListNested)
+
+		return attribute, diagnostic.WithPointer(err, "list_nested", "nested_object")
 	case a.Map != nil:
 		return NewGeneratorMapAttribute(a.Name, a.Map)
 	case a.MapNested != nil:
-		return NewGeneratorMapNestedAttribute(a.Name, a.MapNested)
+		attribute, err := NewGeneratorMapNestedAttribute(a.Name, a.MapNested)
+
+		return attribute, diagnostic.WithPointer(err, "map_nested", "nested_object")
 	case a.Number != nil:
 		return NewGeneratorNumberAttribute(a.Name, a.Number)
 	case a.Object != nil:
@@ -108,41 +147,59 @@ func NewAttribute(a provider.Attribute) (generatorschema.GeneratorAttribute, err
 	case a.Set != nil:
 		return NewGeneratorSetAttribute(a.Name, a.Set)
 	case a.SetNested != nil:
-		return NewGeneratorSetNestedAttribute(a.Name, a.SetNested)
+		attribute, err := NewGeneratorSetNestedAttribute(a.Name, a.SetNested)
+
+		return attribute, diagnostic.WithPointer(err, "set_nested", "nested_object")
 	case a.SingleNested != nil:
-		return NewGeneratorSingleNestedAttribute(a.Name, a.SingleNested)
+		attribute, err := NewGeneratorSingleNestedAttribute(a.Name, a.SingleNested)
+
+		return attribute, diagnostic.WithPointer(err, "single_nested")
 	case a.String != nil:
 		return NewGeneratorStringAttribute(a.Name, a.String)
 	}
 
-	return nil, fmt.Errorf("attribute type not defined: %+v", a)
+	return nil, fmt.Errorf("attribute %q type not defined", a.Name)
 }
 
 func NewBlocks(b provider.Blocks) (generatorschema.GeneratorBlocks, error) {
 	blocks := make(generatorschema.GeneratorBlocks, len(b))
 
-	for _, v := range b {
+	var errs []error
+
+	for i, v := range b {
 		block, err := NewBlock(v)
 
 		if err != nil {
-			return generatorschema.GeneratorBlocks{}, err
+			errs = append(errs, diagnostic.NewErrors(err, "blocks", i)...)
+
+			continue
 		}
 
 		blocks[v.Name] = block
 	}
 
+	if len(errs) > 0 {
+		return generatorschema.GeneratorBlocks{}, errors.Join(errs...)
+	}
+
 	return blocks, nil
 }
 
 func NewBlock(b provider.Block) (generatorschema.GeneratorBlock, error) {
 	switch {
 	case b.ListNested != nil:
-		return NewGeneratorListNestedBlock(b.Name, b.ListNested)
+		block, err := NewGeneratorListNestedBlock(b.Name, b.ListNested)
+
+		return block, diagnostic.WithPointer(err, "list_nested", "nested_object")
 	case b.SetNested != nil:
-		return NewGeneratorSetNestedBlock(b.Name, b.SetNested)
+		block, err := NewGeneratorSetNestedBlock(b.Name, b.SetNested)
+
+		return block, diagnostic.WithPointer(err, "set_nested", "nested_object")
 	case b.SingleNested != nil:
-		return NewGeneratorSingleNestedBlock(b.Name, b.SingleNested)
+		block, err := NewGeneratorSingleNestedBlock(b.Name, b.SingleNested)
+
+		return block, diagnostic.WithPointer(err, "single_nested")
 	}
 
-	return nil, fmt.Errorf("block type not defined: %+v", b)
+	return nil, fmt.Errorf("block %q type not defined", b.Name)
 }
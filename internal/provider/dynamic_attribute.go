@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/provider"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+type GeneratorDynamicAttribute struct {
+	AssociatedExternalType *schema.AssocExtType
+	OptionalRequired       convert.OptionalRequired
+	CustomType             convert.CustomTypePrimitive
+	DeprecationMessage     convert.DeprecationMessage
+	Description            convert.Description
+	Sensitive              convert.Sensitive
+	Validators             convert.Validators
+}
+
+func NewGeneratorDynamicAttribute(name string, a *provider.DynamicAttribute) (GeneratorDynamicAttribute, error) {
+	if a == nil {
+		return GeneratorDynamicAttribute{}, fmt.Errorf("*provider.DynamicAttribute is nil")
+	}
+
+	c := convert.NewOptionalRequired(a.OptionalRequired)
+
+	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name)
+
+	d := convert.NewDescription(a.Description)
+
+	dm := convert.NewDeprecationMessage(a.DeprecationMessage)
+
+	s := convert.NewSensitive(a.Sensitive)
+
+	v := convert.NewValidators(convert.ValidatorTypeDynamic, a.Validators.CustomValidators())
+
+	return GeneratorDynamicAttribute{
+		AssociatedExternalType: schema.NewAssocExtType(a.AssociatedExternalType),
+		OptionalRequired:       c,
+		CustomType:             ctp,
+		DeprecationMessage:     dm,
+		Description:            d,
+		Sensitive:              s,
+		Validators:             v,
+	}, nil
+}
+
+func (g GeneratorDynamicAttribute) GeneratorSchemaType() schema.Type {
+	return schema.GeneratorDynamicAttribute
+}
+
+func (g GeneratorDynamicAttribute) Documentation() schema.Documentation {
+	return schema.Documentation{
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Optional:           g.OptionalRequired.IsOptional(),
+		Required:           g.OptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+	}
+}
+
+func (g GeneratorDynamicAttribute) Imports() *schema.Imports {
+	imports := schema.NewImports()
+
+	imports.Append(g.CustomType.Imports())
+
+	imports.Append(g.Validators.Imports())
+
+	if g.AssociatedExternalType != nil {
+		imports.Append(schema.AssociatedExternalTypeImports())
+
+		imports.Append(schema.DynamicAssociatedExternalTypeImports(g.AssociatedExternalType))
+	}
+
+	imports.Append(g.AssociatedExternalType.Imports())
+
+	return imports
+}
+
+func (g GeneratorDynamicAttribute) Equal(ga schema.GeneratorAttribute) bool {
+	h, ok := ga.(GeneratorDynamicAttribute)
+
+	if !ok {
+		return false
+	}
+
+	if !g.AssociatedExternalType.Equal(h.AssociatedExternalType) {
+		return false
+	}
+
+	if !g.OptionalRequired.Equal(h.OptionalRequired) {
+		return false
+	}
+
+	if !g.CustomType.Equal(h.CustomType) {
+		return false
+	}
+
+	if !g.DeprecationMessage.Equal(h.DeprecationMessage) {
+		return false
+	}
+
+	if !g.Description.Equal(h.Description) {
+		return false
+	}
+
+	if !g.Sensitive.Equal(h.Sensitive) {
+		return false
+	}
+
+	return g.Validators.Equal(h.Validators)
+}
+
+func (g GeneratorDynamicAttribute) Schema(name schema.FrameworkIdentifier) (string, error) {
+	var b bytes.Buffer
+
+	b.WriteString(fmt.Sprintf("%q: schema.DynamicAttribute{\n", name))
+	b.Write(g.CustomType.Schema())
+	b.Write(g.OptionalRequired.Schema())
+	b.Write(g.Sensitive.Schema())
+	b.Write(g.Description.Schema())
+	b.Write(g.DeprecationMessage.Schema())
+	b.Write(g.Validators.Schema())
+	b.WriteString("},")
+
+	return b.String(), nil
+}
+
+func (g GeneratorDynamicAttribute) ModelField(name schema.FrameworkIdentifier) (model.Field, error) {
+	field := model.Field{
+		Name:      name.ToPascalCase(),
+		TfsdkName: name.ToString(),
+		ValueType: model.DynamicValueType,
+	}
+
+	customValueType := g.CustomType.ValueType()
+
+	if customValueType != "" {
+		field.ValueType = customValueType
+	}
+
+	return field, nil
+}
+
+func (g GeneratorDynamicAttribute) CustomTypeAndValue(name string, gen *schema.Generator) ([]byte, error) {
+	if g.AssociatedExternalType == nil {
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+
+	dynamicType := schema.NewCustomDynamicType(name, gen)
+
+	b, err := dynamicType.Render()
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf.Write(b)
+
+	dynamicValue := schema.NewCustomDynamicValue(name, gen)
+
+	b, err = dynamicValue.Render()
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf.Write(b)
+
+	return buf.Bytes(), nil
+}
+
+func (g GeneratorDynamicAttribute) ToFromFunctions(name string, gen *schema.Generator) ([]byte, error) {
+	if g.AssociatedExternalType == nil {
+		return nil, nil
+	}
+
+	toFrom := schema.NewToFromDynamic(name, g.AssociatedExternalType, gen)
+
+	b, err := toFrom.Render()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// AttrType returns a string representation of a basetypes.DynamicTypable type.
+func (g GeneratorDynamicAttribute) AttrType(name schema.FrameworkIdentifier) (string, error) {
+	if g.AssociatedExternalType != nil {
+		return fmt.Sprintf("%sType{}", name.ToPascalCase()), nil
+	}
+
+	return "basetypes.DynamicType{}", nil
+}
+
+// AttrValue returns a string representation of a basetypes.DynamicValuable type.
+func (g GeneratorDynamicAttribute) AttrValue(name schema.FrameworkIdentifier) string {
+	if g.AssociatedExternalType != nil {
+		return fmt.Sprintf("%sValue", name.ToPascalCase())
+	}
+
+	return "basetypes.DynamicValue"
+}
+
+func (g GeneratorDynamicAttribute) To(gen *schema.Generator) (schema.ToFromConversion, error) {
+	if g.AssociatedExternalType != nil {
+		return schema.ToFromConversion{
+			AssocExtType: g.AssociatedExternalType,
+		}, nil
+	}
+
+	return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("dynamic attribute without an associated external type is not yet implemented"))
+}
+
+func (g GeneratorDynamicAttribute) From(gen *schema.Generator) (schema.ToFromConversion, error) {
+	if g.AssociatedExternalType != nil {
+		return schema.ToFromConversion{
+			AssocExtType: g.AssociatedExternalType,
+		}, nil
+	}
+
+	return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("dynamic attribute without an associated external type is not yet implemented"))
+}
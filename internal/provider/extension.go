@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// applySchemaExtension sets the properties defined in the additions to the
+// specification, such as constraints and the min and max items of list and
+// set nested attributes and blocks, on the corresponding attributes and
+// blocks, including those which are nested. Generator attributes and blocks
+// are updated in place.
+func applySchemaExtension(path string, attributes generatorschema.GeneratorAttributes, blocks generatorschema.GeneratorBlocks, s extension.Schema) error {
+	var errs []error
+
+	for i, a := range s.Attributes {
+		err := applyAttributeExtension(fmt.Sprintf("%s attribute %q", path, a.Name), attributes, blocks, a)
+
+		if err != nil {
+			errs = append(errs, diagnostic.NewErrors(err, "attributes", i)...)
+		}
+	}
+
+	for i, b := range s.Blocks {
+		err := applyBlockExtension(fmt.Sprintf("%s block %q", path, b.Name), blocks, b)
+
+		if err != nil {
+			errs = append(errs, diagnostic.NewErrors(err, "blocks", i)...)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func applyAttributeExtension(path string, attributes generatorschema.GeneratorAttributes, blocks generatorschema.GeneratorBlocks, a extension.Attribute) error {
+	attribute, ok := attributes[a.Name]
+
+	if !ok {
+		return fmt.Errorf("%s is not defined in the specification", path)
+	}
+
+	var properties extension.AttributeProperties
+
+	if p := a.Properties(); p != nil {
+		properties = *p
+	}
+
+	constraints := properties.Constraints
+
+	withConstraints := func(v convert.Validators) (convert.Validators, error) {
+		return v.WithConstraints(constraints, func(name string) bool {
+			_, attributeOk := attributes[name]
+			_, blockOk := blocks[name]
+
+			return attributeOk || blockOk
+		})
+	}
+
+	var err error
+
+	switch t := attribute.(type) {
+	case GeneratorBoolAttribute:
+		t.Validators, err = withConstraints(t.Validators)
+		attributes[a.Name] = t
+	case GeneratorFloat32Attribute:
+		t.Validators, err = withConstraints(t.Validators)
+		attributes[a.Name] = t
+	case GeneratorFloat64Attribute:
+		t.Validators, err = withConstraints(t.Validators)
+		attributes[a.Name] = t
+	case GeneratorInt32Attribute:
+		t.Validators, err = withConstraints(t.Validators)
+		attributes[a.Name] = t
+	case GeneratorInt64Attribute:
+		t.Validators, err = withConstraints(t.Validators)
+		attributes[a.Name] = t
+	case GeneratorListAttribute:
+		t.Validators, err = withConstraints(t.Validators)
+		attributes[a.Name] = t
+	case GeneratorMapAttribute:
+		t.Validators, err = withConstraints(t.Validators)
+		attributes[a.Name] = t
+	case GeneratorNumberAttribute:
+		t.Validators, err = withConstraints(t.Validators)
+		attributes[a.Name] = t
+	case GeneratorObjectAttribute:
+		t.Validators, err = withConstraints(t.Validators)
+		attributes[a.Name] = t
+	case GeneratorSetAttribute:
+		t.Validators, err = withConstraints(t.Validators)
+		attributes[a.Name] = t
+	case GeneratorStringAttribute:
+		t.Validators, err = withConstraints(t.Validators)
+		attributes[a.Name] = t
+	case GeneratorListNestedAttribute:
+		if a.ListNested != nil {
+			var constraintsErr error
+
+			t.Validators, err = t.Validators.WithConstraints(a.ListNested.Constraints(), nil)
+
+			if err != nil {
+				constraintsErr = diagnostic.WithPointer(diagnostic.Wrapf(err, "%s %w", path), "list_nested")
+			}
+
+			t.Items = convert.NewItems(a.ListNested.MinItems, a.ListNested.MaxItems)
+			attributes[a.Name] = t
+
+			err = applySchemaExtension(path, t.NestedObject.Attributes, nil, extension.Schema{
+				Attributes: a.ListNested.NestedObject.Attributes,
+			})
+
+			return errors.Join(constraintsErr, diagnostic.WithPointer(err, "list_nested", "nested_object"))
+		}
+	case GeneratorMapNestedAttribute:
+		if a.MapNested != nil {
+			err = applySchemaExtension(path, t.NestedObject.Attributes, nil, extension.Schema{
+				Attributes: a.MapNested.NestedObject.Attributes,
+			})
+
+			return diagnostic.WithPointer(err, "map_nested", "nested_object")
+		}
+	case GeneratorSetNestedAttribute:
+		if a.SetNested != nil {
+			var constraintsErr error
+
+			t.Validators, err = t.Validators.WithConstraints(a.SetNested.Constraints(), nil)
+
+			if err != nil {
+				constraintsErr = diagnostic.WithPointer(diagnostic.Wrapf(err, "%s %w", path), "set_nested")
+			}
+
+			t.Items = convert.NewItems(a.SetNested.MinItems, a.SetNested.MaxItems)
+			attributes[a.Name] = t
+
+			err = applySchemaExtension(path, t.NestedObject.Attributes, nil, extension.Schema{
+				Attributes: a.SetNested.NestedObject.Attributes,
+			})
+
+			return errors.Join(constraintsErr, diagnostic.WithPointer(err, "set_nested", "nested_object"))
+		}
+	case GeneratorSingleNestedAttribute:
+		if a.SingleNested != nil {
+			err = applySchemaExtension(path, t.Attributes, nil, extension.Schema{
+				Attributes: a.SingleNested.Attributes,
+			})
+
+			return diagnostic.WithPointer(err, "single_nested")
+		}
+	}
+
+	if err != nil {
+		return diagnostic.WithPointer(diagnostic.Wrapf(err, "%s %w", path), a.AttributeType(), "constraints")
+	}
+
+	return nil
+}
+
+func applyBlockExtension(path string, blocks generatorschema.GeneratorBlocks, b extension.Block) error {
+	block, ok := blocks[b.Name]
+
+	if !ok {
+		return fmt.Errorf("%s is not defined in the specification", path)
+	}
+
+	switch t := block.(type) {
+	case GeneratorListNestedBlock:
+		var constraintsErr error
+
+		if b.ListNested != nil {
+			var err error
+
+			t.Validators, err = t.Validators.WithConstraints(b.ListNested.Constraints(), nil)
+
+			if err != nil {
+				constraintsErr = diagnostic.WithPointer(diagnostic.Wrapf(err, "%s %w", path), "list_nested")
+			}
+
+			t.Items = convert.NewItems(b.ListNested.MinItems, b.ListNested.MaxItems)
+			blocks[b.Name] = t
+		}
+
+		err := applySchemaExtension(path, t.NestedObject.Attributes, t.NestedObject.Blocks, b.Schema())
+
+		return errors.Join(constraintsErr, diagnostic.WithPointer(err, "list_nested", "nested_object"))
+	case GeneratorSetNestedBlock:
+		var constraintsErr error
+
+		if b.SetNested != nil {
+			var err error
+
+			t.Validators, err = t.Validators.WithConstraints(b.SetNested.Constraints(), nil)
+
+			if err != nil {
+				constraintsErr = diagnostic.WithPointer(diagnostic.Wrapf(err, "%s %w", path), "set_nested")
+			}
+
+			t.Items = convert.NewItems(b.SetNested.MinItems, b.SetNested.MaxItems)
+			blocks[b.Name] = t
+		}
+
+		err := applySchemaExtension(path, t.NestedObject.Attributes, t.NestedObject.Blocks, b.Schema())
+
+		return errors.Join(constraintsErr, diagnostic.WithPointer(err, "set_nested", "nested_object"))
+	case GeneratorSingleNestedBlock:
+		err := applySchemaExtension(path, t.Attributes, t.Blocks, b.Schema())
+
+		return diagnostic.WithPointer(err, "single_nested")
+	}
+
+	return nil
+}
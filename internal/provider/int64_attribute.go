@@ -56,6 +56,16 @@ func (g GeneratorInt64Attribute) GeneratorSchemaType() schema.Type {
 	return schema.GeneratorInt64Attribute
 }
 
+func (g GeneratorInt64Attribute) Documentation() schema.Documentation {
+	return schema.Documentation{
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Optional:           g.OptionalRequired.IsOptional(),
+		Required:           g.OptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+	}
+}
+
 func (g GeneratorInt64Attribute) Imports() *schema.Imports {
 	imports := schema.NewImports()
 
@@ -137,14 +147,14 @@ func (g GeneratorInt64Attribute) ModelField(name schema.FrameworkIdentifier) (mo
 	return field, nil
 }
 
-func (g GeneratorInt64Attribute) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorInt64Attribute) CustomTypeAndValue(name string, gen *schema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	int64Type := schema.NewCustomInt64Type(name)
+	int64Type := schema.NewCustomInt64Type(name, gen)
 
 	b, err := int64Type.Render()
 
@@ -154,7 +164,7 @@ func (g GeneratorInt64Attribute) CustomTypeAndValue(name string) ([]byte, error)
 
 	buf.Write(b)
 
-	int64Value := schema.NewCustomInt64Value(name)
+	int64Value := schema.NewCustomInt64Value(name, gen)
 
 	b, err = int64Value.Render()
 
@@ -167,12 +177,12 @@ func (g GeneratorInt64Attribute) CustomTypeAndValue(name string) ([]byte, error)
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorInt64Attribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorInt64Attribute) ToFromFunctions(name string, gen *schema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	toFrom := schema.NewToFromInt64(name, g.AssociatedExternalType)
+	toFrom := schema.NewToFromInt64(name, g.AssociatedExternalType, gen)
 
 	b, err := toFrom.Render()
 
@@ -201,7 +211,7 @@ func (g GeneratorInt64Attribute) AttrValue(name schema.FrameworkIdentifier) stri
 	return "basetypes.Int64Value"
 }
 
-func (g GeneratorInt64Attribute) To() (schema.ToFromConversion, error) {
+func (g GeneratorInt64Attribute) To(gen *schema.Generator) (schema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return schema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
@@ -213,7 +223,7 @@ func (g GeneratorInt64Attribute) To() (schema.ToFromConversion, error) {
 	}, nil
 }
 
-func (g GeneratorInt64Attribute) From() (schema.ToFromConversion, error) {
+func (g GeneratorInt64Attribute) From(gen *schema.Generator) (schema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return schema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
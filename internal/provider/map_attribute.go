@@ -63,6 +63,16 @@ func (g GeneratorMapAttribute) GeneratorSchemaType() generatorschema.Type {
 	return generatorschema.GeneratorMapAttribute
 }
 
+func (g GeneratorMapAttribute) Documentation() generatorschema.Documentation {
+	return generatorschema.Documentation{
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Optional:           g.OptionalRequired.IsOptional(),
+		Required:           g.OptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+	}
+}
+
 func (g GeneratorMapAttribute) ElemType() specschema.ElementType {
 	return g.ElementType
 }
@@ -165,14 +175,14 @@ func (g GeneratorMapAttribute) ModelField(name generatorschema.FrameworkIdentifi
 	return field, nil
 }
 
-func (g GeneratorMapAttribute) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorMapAttribute) CustomTypeAndValue(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	listType := generatorschema.NewCustomMapType(name)
+	listType := generatorschema.NewCustomMapType(name, gen)
 
 	b, err := listType.Render()
 
@@ -184,7 +194,7 @@ func (g GeneratorMapAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 
 	elemType := generatorschema.GetElementType(g.ElementType)
 
-	listValue := generatorschema.NewCustomMapValue(name, elemType)
+	listValue := generatorschema.NewCustomMapValue(name, elemType, gen)
 
 	b, err = listValue.Render()
 
@@ -197,7 +207,7 @@ func (g GeneratorMapAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorMapAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorMapAttribute) ToFromFunctions(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
@@ -205,13 +215,19 @@ func (g GeneratorMapAttribute) ToFromFunctions(name string) ([]byte, error) {
 	elementTypeType := generatorschema.GetElementType(g.ElementType)
 	elementTypeValue := generatorschema.GetElementValueType(g.ElementType)
 
-	elementFrom, err := generatorschema.GetElementFromFunc(g.ElementType)
+	elementTo, err := generatorschema.GetElementToFunc(g.ElementType, gen)
+
+	if err != nil {
+		return nil, err
+	}
+
+	elementFrom, err := generatorschema.GetElementFromFunc(g.ElementType, gen)
 
 	if err != nil {
 		return nil, err
 	}
 
-	toFrom := generatorschema.NewToFromMap(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementFrom)
+	toFrom := generatorschema.NewToFromMap(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementTo, elementFrom, gen)
 
 	b, err := toFrom.Render()
 
@@ -246,14 +262,24 @@ func (g GeneratorMapAttribute) AttrValue(name generatorschema.FrameworkIdentifie
 	return "basetypes.MapValue"
 }
 
-func (g GeneratorMapAttribute) To() (generatorschema.ToFromConversion, error) {
+func (g GeneratorMapAttribute) To(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
 		}, nil
 	}
 
-	elementGoType, err := generatorschema.ElementTypeGoType(g.ElementType)
+	elementGoType, err := generatorschema.ElementTypeGoType(g.ElementType, gen)
+
+	if err != nil {
+		return generatorschema.ToFromConversion{}, err
+	}
+
+	toFunc, err := generatorschema.GetCollectionToFunc(specschema.ElementType{
+		Map: &specschema.MapType{
+			ElementType: g.ElementType,
+		},
+	}, gen)
 
 	if err != nil {
 		return generatorschema.ToFromConversion{}, err
@@ -262,11 +288,12 @@ func (g GeneratorMapAttribute) To() (generatorschema.ToFromConversion, error) {
 	return generatorschema.ToFromConversion{
 		CollectionType: generatorschema.CollectionFields{
 			GoType: fmt.Sprintf("map[string]%s", elementGoType),
+			ToFunc: toFunc,
 		},
 	}, nil
 }
 
-func (g GeneratorMapAttribute) From() (generatorschema.ToFromConversion, error) {
+func (g GeneratorMapAttribute) From(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
@@ -279,10 +306,21 @@ func (g GeneratorMapAttribute) From() (generatorschema.ToFromConversion, error)
 		return generatorschema.ToFromConversion{}, err
 	}
 
+	fromFunc, err := generatorschema.GetCollectionFromFunc(specschema.ElementType{
+		Map: &specschema.MapType{
+			ElementType: g.ElementType,
+		},
+	}, gen)
+
+	if err != nil {
+		return generatorschema.ToFromConversion{}, err
+	}
+
 	return generatorschema.ToFromConversion{
 		CollectionType: generatorschema.CollectionFields{
 			ElementType:   elementType,
 			TypeValueFrom: "types.MapValueFrom",
+			FromFunc:      fromFunc,
 		},
 	}, nil
 }
@@ -56,6 +56,16 @@ func (g GeneratorNumberAttribute) GeneratorSchemaType() schema.Type {
 	return schema.GeneratorNumberAttribute
 }
 
+func (g GeneratorNumberAttribute) Documentation() schema.Documentation {
+	return schema.Documentation{
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Optional:           g.OptionalRequired.IsOptional(),
+		Required:           g.OptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+	}
+}
+
 func (g GeneratorNumberAttribute) Imports() *schema.Imports {
 	imports := schema.NewImports()
 
@@ -137,14 +147,14 @@ func (g GeneratorNumberAttribute) ModelField(name schema.FrameworkIdentifier) (m
 	return field, nil
 }
 
-func (g GeneratorNumberAttribute) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorNumberAttribute) CustomTypeAndValue(name string, gen *schema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	numberType := schema.NewCustomNumberType(name)
+	numberType := schema.NewCustomNumberType(name, gen)
 
 	b, err := numberType.Render()
 
@@ -154,7 +164,7 @@ func (g GeneratorNumberAttribute) CustomTypeAndValue(name string) ([]byte, error
 
 	buf.Write(b)
 
-	numberValue := schema.NewCustomNumberValue(name)
+	numberValue := schema.NewCustomNumberValue(name, gen)
 
 	b, err = numberValue.Render()
 
@@ -167,12 +177,12 @@ func (g GeneratorNumberAttribute) CustomTypeAndValue(name string) ([]byte, error
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorNumberAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorNumberAttribute) ToFromFunctions(name string, gen *schema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	toFrom := schema.NewToFromNumber(name, g.AssociatedExternalType)
+	toFrom := schema.NewToFromNumber(name, g.AssociatedExternalType, gen)
 
 	b, err := toFrom.Render()
 
@@ -201,7 +211,7 @@ func (g GeneratorNumberAttribute) AttrValue(name schema.FrameworkIdentifier) str
 	return "basetypes.NumberValue"
 }
 
-func (g GeneratorNumberAttribute) To() (schema.ToFromConversion, error) {
+func (g GeneratorNumberAttribute) To(gen *schema.Generator) (schema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return schema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
@@ -213,7 +223,7 @@ func (g GeneratorNumberAttribute) To() (schema.ToFromConversion, error) {
 	}, nil
 }
 
-func (g GeneratorNumberAttribute) From() (schema.ToFromConversion, error) {
+func (g GeneratorNumberAttribute) From(gen *schema.Generator) (schema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return schema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
@@ -63,6 +63,16 @@ func (g GeneratorSetAttribute) GeneratorSchemaType() generatorschema.Type {
 	return generatorschema.GeneratorSetAttribute
 }
 
+func (g GeneratorSetAttribute) Documentation() generatorschema.Documentation {
+	return generatorschema.Documentation{
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Optional:           g.OptionalRequired.IsOptional(),
+		Required:           g.OptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+	}
+}
+
 func (g GeneratorSetAttribute) ElemType() specschema.ElementType {
 	return g.ElementType
 }
@@ -165,14 +175,14 @@ func (g GeneratorSetAttribute) ModelField(name generatorschema.FrameworkIdentifi
 	return field, nil
 }
 
-func (g GeneratorSetAttribute) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorSetAttribute) CustomTypeAndValue(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	listType := generatorschema.NewCustomSetType(name)
+	listType := generatorschema.NewCustomSetType(name, gen)
 
 	b, err := listType.Render()
 
@@ -184,7 +194,7 @@ func (g GeneratorSetAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 
 	elemType := generatorschema.GetElementType(g.ElementType)
 
-	listValue := generatorschema.NewCustomSetValue(name, elemType)
+	listValue := generatorschema.NewCustomSetValue(name, elemType, gen)
 
 	b, err = listValue.Render()
 
@@ -197,7 +207,7 @@ func (g GeneratorSetAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorSetAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorSetAttribute) ToFromFunctions(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
@@ -205,13 +215,19 @@ func (g GeneratorSetAttribute) ToFromFunctions(name string) ([]byte, error) {
 	elementTypeType := generatorschema.GetElementType(g.ElementType)
 	elementTypeValue := generatorschema.GetElementValueType(g.ElementType)
 
-	elementFrom, err := generatorschema.GetElementFromFunc(g.ElementType)
+	elementTo, err := generatorschema.GetElementToFunc(g.ElementType, gen)
+
+	if err != nil {
+		return nil, err
+	}
+
+	elementFrom, err := generatorschema.GetElementFromFunc(g.ElementType, gen)
 
 	if err != nil {
 		return nil, err
 	}
 
-	toFrom := generatorschema.NewToFromSet(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementFrom)
+	toFrom := generatorschema.NewToFromSet(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementTo, elementFrom, gen)
 
 	b, err := toFrom.Render()
 
@@ -246,14 +262,24 @@ func (g GeneratorSetAttribute) AttrValue(name generatorschema.FrameworkIdentifie
 	return "basetypes.SetValue"
 }
 
-func (g GeneratorSetAttribute) To() (generatorschema.ToFromConversion, error) {
+func (g GeneratorSetAttribute) To(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
 		}, nil
 	}
 
-	elementGoType, err := generatorschema.ElementTypeGoType(g.ElementType)
+	elementGoType, err := generatorschema.ElementTypeGoType(g.ElementType, gen)
+
+	if err != nil {
+		return generatorschema.ToFromConversion{}, err
+	}
+
+	toFunc, err := generatorschema.GetCollectionToFunc(specschema.ElementType{
+		Set: &specschema.SetType{
+			ElementType: g.ElementType,
+		},
+	}, gen)
 
 	if err != nil {
 		return generatorschema.ToFromConversion{}, err
@@ -262,11 +288,12 @@ func (g GeneratorSetAttribute) To() (generatorschema.ToFromConversion, error) {
 	return generatorschema.ToFromConversion{
 		CollectionType: generatorschema.CollectionFields{
 			GoType: fmt.Sprintf("[]%s", elementGoType),
+			ToFunc: toFunc,
 		},
 	}, nil
 }
 
-func (g GeneratorSetAttribute) From() (generatorschema.ToFromConversion, error) {
+func (g GeneratorSetAttribute) From(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
@@ -279,10 +306,21 @@ func (g GeneratorSetAttribute) From() (generatorschema.ToFromConversion, error)
 		return generatorschema.ToFromConversion{}, err
 	}
 
+	fromFunc, err := generatorschema.GetCollectionFromFunc(specschema.ElementType{
+		Set: &specschema.SetType{
+			ElementType: g.ElementType,
+		},
+	}, gen)
+
+	if err != nil {
+		return generatorschema.ToFromConversion{}, err
+	}
+
 	return generatorschema.ToFromConversion{
 		CollectionType: generatorschema.CollectionFields{
 			ElementType:   elementType,
 			TypeValueFrom: "types.SetValueFrom",
+			FromFunc:      fromFunc,
 		},
 	}, nil
 }
@@ -56,6 +56,16 @@ func (g GeneratorStringAttribute) GeneratorSchemaType() schema.Type {
 	return schema.GeneratorStringAttribute
 }
 
+func (g GeneratorStringAttribute) Documentation() schema.Documentation {
+	return schema.Documentation{
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Optional:           g.OptionalRequired.IsOptional(),
+		Required:           g.OptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+	}
+}
+
 func (g GeneratorStringAttribute) Imports() *schema.Imports {
 	imports := schema.NewImports()
 
@@ -137,14 +147,14 @@ func (g GeneratorStringAttribute) ModelField(name schema.FrameworkIdentifier) (m
 	return field, nil
 }
 
-func (g GeneratorStringAttribute) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorStringAttribute) CustomTypeAndValue(name string, gen *schema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	stringType := schema.NewCustomStringType(name)
+	stringType := schema.NewCustomStringType(name, gen)
 
 	b, err := stringType.Render()
 
@@ -154,7 +164,7 @@ func (g GeneratorStringAttribute) CustomTypeAndValue(name string) ([]byte, error
 
 	buf.Write(b)
 
-	stringValue := schema.NewCustomStringValue(name)
+	stringValue := schema.NewCustomStringValue(name, gen)
 
 	b, err = stringValue.Render()
 
@@ -167,12 +177,12 @@ func (g GeneratorStringAttribute) CustomTypeAndValue(name string) ([]byte, error
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorStringAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorStringAttribute) ToFromFunctions(name string, gen *schema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	toFrom := schema.NewToFromString(name, g.AssociatedExternalType)
+	toFrom := schema.NewToFromString(name, g.AssociatedExternalType, gen)
 
 	b, err := toFrom.Render()
 
@@ -201,7 +211,7 @@ func (g GeneratorStringAttribute) AttrValue(name schema.FrameworkIdentifier) str
 	return "basetypes.StringValue"
 }
 
-func (g GeneratorStringAttribute) To() (schema.ToFromConversion, error) {
+func (g GeneratorStringAttribute) To(gen *schema.Generator) (schema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return schema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
@@ -213,7 +223,7 @@ func (g GeneratorStringAttribute) To() (schema.ToFromConversion, error) {
 	}, nil
 }
 
-func (g GeneratorStringAttribute) From() (schema.ToFromConversion, error) {
+func (g GeneratorStringAttribute) From(gen *schema.Generator) (schema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return schema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/output"
+)
+
+type reportKey struct{}
+
+// Report is a machine-readable record of a code generation run. All methods
+// can be called on a nil Report, in which case nothing is recorded, so that
+// code generation does not need to check whether a report was requested.
+type Report struct {
+	// Schemas contains each resource, data source, ephemeral resource and
+	// provider which was processed.
+	Schemas []Schema `json:"schemas"`
+
+	// Files contains the path of each file which was written.
+	Files []string `json:"files"`
+
+	// Skipped contains each path for which to/from functions were not
+	// generated, as they are not yet implemented.
+	Skipped []Skipped `json:"skipped"`
+
+	// Phases contains the duration of each phase of code generation.
+	Phases []Phase `json:"phases"`
+}
+
+// Schema is a resource, data source, ephemeral resource or provider which
+// was processed, along with the Go identifiers of the generated code.
+type Schema struct {
+	// Type is one of data_source, ephemeral_resource, provider or resource.
+	Type string `json:"type"`
+
+	Name string `json:"name"`
+
+	// File is the path of the file containing the generated code.
+	File string `json:"file"`
+
+	// CustomTypes contains the names of the custom Type and Value types.
+	CustomTypes []string `json:"custom_types"`
+
+	// ToFromFunctions contains the to/from methods for associated external
+	// types, in the form Receiver.Method.
+	ToFromFunctions []string `json:"to_from_functions"`
+}
+
+// Skipped is a path for which to/from functions were not generated.
+type Skipped struct {
+	// Path is the dot-separated path, including the schema being processed.
+	Path string `json:"path"`
+
+	// UnimplementedPath is the value of UnimplementedError.Path().
+	UnimplementedPath string `json:"unimplemented_path"`
+
+	Error string `json:"error"`
+}
+
+// Phase is the duration of one phase of generating code for a type, such
+// as the models of resources.
+type Phase struct {
+	// Type is one of data_source, ephemeral_resource, provider or resource.
+	Type string `json:"type"`
+
+	// Phase is one of schema, models, custom_types, to_from, format or write.
+	Phase string `json:"phase"`
+
+	DurationMs float64 `json:"duration_ms"`
+}
+
+func New() *Report {
+	return &Report{
+		Schemas: []Schema{},
+		Files:   []string{},
+		Skipped: []Skipped{},
+		Phases:  []Phase{},
+	}
+}
+
+// SetReportInContext is used to supply the Report to record code generation
+// to.
+func SetReportInContext(ctx context.Context, r *Report) context.Context {
+	return context.WithValue(ctx, reportKey{}, r)
+}
+
+// GetReportFromContext returns the Report, or nil if none has been set.
+func GetReportFromContext(ctx context.Context) *Report {
+	if v, ok := ctx.Value(reportKey{}).(*Report); ok {
+		return v
+	}
+
+	return nil
+}
+
+// AddSchema records a schema, determining the Go identifiers of the custom
+// types and to/from functions from the generated code.
+func (r *Report) AddSchema(typ, name, file string, customTypeValue, toFromFunctions []byte) error {
+	if r == nil {
+		return nil
+	}
+
+	customTypes, _, err := declarations(customTypeValue)
+	if err != nil {
+		return err
+	}
+
+	_, methods, err := declarations(toFromFunctions)
+	if err != nil {
+		return err
+	}
+
+	r.Schemas = append(r.Schemas, Schema{
+		Type:            typ,
+		Name:            name,
+		File:            file,
+		CustomTypes:     customTypes,
+		ToFromFunctions: methods,
+	})
+
+	return nil
+}
+
+// AddSkipped records a path for which to/from functions were not generated.
+func (r *Report) AddSkipped(path, unimplementedPath string, err error) {
+	if r == nil {
+		return
+	}
+
+	r.Skipped = append(r.Skipped, Skipped{
+		Path:              path,
+		UnimplementedPath: unimplementedPath,
+		Error:             err.Error(),
+	})
+}
+
+// Writer returns an output.Writer which records the path of each file which
+// is written to w.
+func (r *Report) Writer(w output.Writer) output.Writer {
+	if r == nil {
+		return w
+	}
+
+	return writer{
+		report: r,
+		writer: w,
+	}
+}
+
+// Timer returns a Timer which records the phases of generating code for the
+// type, starting from now.
+func (r *Report) Timer(typ string) *Timer {
+	return &Timer{
+		report: r,
+		typ:    typ,
+		start:  time.Now(),
+	}
+}
+
+// Write writes the report as JSON to path. Schemas and files are sorted, so
+// that reports of the same specification can be compared.
+func (r *Report) Write(path string) error {
+	if r == nil {
+		return nil
+	}
+
+	sort.SliceStable(r.Schemas, func(i, j int) bool {
+		if r.Schemas[i].Type != r.Schemas[j].Type {
+			return r.Schemas[i].Type < r.Schemas[j].Type
+		}
+
+		return r.Schemas[i].Name < r.Schemas[j].Name
+	})
+
+	sort.Strings(r.Files)
+
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(b, '\n'), 0644)
+}
+
+// Timer records the duration of consecutive phases.
+type Timer struct {
+	report *Report
+	typ    string
+	start  time.Time
+}
+
+// Phase records the time since the previous phase, or since the Timer was
+// created, as the duration of the named phase.
+func (t *Timer) Phase(phase string) {
+	now := time.Now()
+
+	if t.report != nil {
+		t.report.Phases = append(t.report.Phases, Phase{
+			Type:       t.typ,
+			Phase:      phase,
+			DurationMs: float64(now.Sub(t.start).Microseconds()) / 1000,
+		})
+	}
+
+	t.start = now
+}
+
+type writer struct {
+	report *Report
+	writer output.Writer
+}
+
+func (w writer) Write(path string, content []byte) error {
+	err := w.writer.Write(path, content)
+	if err != nil {
+		return err
+	}
+
+	w.report.Files = append(w.report.Files, filepath.Clean(path))
+
+	return nil
+}
+
+// declarations returns the names of the types, and of the methods in the form
+// Receiver.Method, declared in generated code, which does not include a
+// package clause.
+func declarations(src []byte) ([]string, []string, error) {
+	types := []string{}
+	methods := []string{}
+
+	if len(src) == 0 {
+		return types, methods, nil
+	}
+
+	f, err := parser.ParseFile(token.NewFileSet(), "", append([]byte("package generated\n"), src...), parser.SkipObjectResolution)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				if s, ok := spec.(*ast.TypeSpec); ok {
+					types = append(types, s.Name.Name)
+				}
+			}
+		case *ast.FuncDecl:
+			if d.Recv == nil || len(d.Recv.List) == 0 {
+				continue
+			}
+
+			recv := d.Recv.List[0].Type
+
+			if s, ok := recv.(*ast.StarExpr); ok {
+				recv = s.X
+			}
+
+			if i, ok := recv.(*ast.Ident); ok {
+				methods = append(methods, i.Name+"."+d.Name.Name)
+			}
+		}
+	}
+
+	return types, methods, nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReport_AddSchema(t *testing.T) {
+	t.Parallel()
+
+	customTypeValue := []byte(`
+type ExampleType struct {
+	basetypes.ObjectType
+}
+
+func (t ExampleType) String() string {
+	return "ExampleType"
+}
+
+type ExampleValue struct {
+	state attr.ValueState
+}
+`)
+
+	toFromFunctions := []byte(`
+func (v ExampleValue) ToApisdkType(ctx context.Context) (*apisdk.Type, diag.Diagnostics) {
+	return nil, nil
+}
+
+func (v *ExampleValue) FromApisdkType(ctx context.Context, apiObject *apisdk.Type) (ExampleValue, diag.Diagnostics) {
+	return ExampleValue{}, nil
+}
+`)
+
+	r := New()
+
+	err := r.AddSchema("resource", "example", "example_resource_gen.go", customTypeValue, toFromFunctions)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []Schema{
+		{
+			Type:            "resource",
+			Name:            "example",
+			File:            "example_resource_gen.go",
+			CustomTypes:     []string{"ExampleType", "ExampleValue"},
+			ToFromFunctions: []string{"ExampleValue.ToApisdkType", "ExampleValue.FromApisdkType"},
+		},
+	}
+
+	if diff := cmp.Diff(r.Schemas, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestReport_Nil(t *testing.T) {
+	t.Parallel()
+
+	var r *Report
+
+	err := r.AddSchema("resource", "example", "example_resource_gen.go", []byte("invalid"), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r.AddSkipped("resource.example", "", nil)
+	r.Timer("resource").Phase("schema")
+
+	err = r.Write("report.json")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
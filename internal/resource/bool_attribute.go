@@ -24,6 +24,7 @@ type GeneratorBoolAttribute struct {
 	PlanModifiers            convert.PlanModifiers
 	Sensitive                convert.Sensitive
 	Validators               convert.Validators
+	WriteOnly                convert.WriteOnly
 }
 
 func NewGeneratorBoolAttribute(name string, a *resource.BoolAttribute) (GeneratorBoolAttribute, error) {
@@ -64,6 +65,21 @@ func (g GeneratorBoolAttribute) GeneratorSchemaType() generatorschema.Type {
 	return generatorschema.GeneratorBoolAttribute
 }
 
+func (g GeneratorBoolAttribute) Documentation() generatorschema.Documentation {
+	return generatorschema.Documentation{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		ConflictsWith:      g.Validators.ConflictsWith(),
+		Default:            g.Default.StaticValue(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Example:            g.Validators.Example(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		WriteOnly:          g.WriteOnly.IsWriteOnly(),
+	}
+}
+
 func (g GeneratorBoolAttribute) Imports() *generatorschema.Imports {
 	imports := generatorschema.NewImports()
 
@@ -123,7 +139,11 @@ func (g GeneratorBoolAttribute) Equal(ga generatorschema.GeneratorAttribute) boo
 		return false
 	}
 
-	return g.Validators.Equal(h.Validators)
+	if !g.Validators.Equal(h.Validators) {
+		return false
+	}
+
+	return g.WriteOnly.Equal(h.WriteOnly)
 }
 
 func (g GeneratorBoolAttribute) Schema(name generatorschema.FrameworkIdentifier) (string, error) {
@@ -133,6 +153,7 @@ func (g GeneratorBoolAttribute) Schema(name generatorschema.FrameworkIdentifier)
 	b.Write(g.CustomType.Schema())
 	b.Write(g.ComputedOptionalRequired.Schema())
 	b.Write(g.Sensitive.Schema())
+	b.Write(g.WriteOnly.Schema())
 	b.Write(g.Description.Schema())
 	b.Write(g.DeprecationMessage.Schema())
 	b.Write(g.PlanModifiers.Schema())
@@ -159,14 +180,14 @@ func (g GeneratorBoolAttribute) ModelField(name generatorschema.FrameworkIdentif
 	return field, nil
 }
 
-func (g GeneratorBoolAttribute) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorBoolAttribute) CustomTypeAndValue(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	boolType := generatorschema.NewCustomBoolType(name)
+	boolType := generatorschema.NewCustomBoolType(name, gen)
 
 	b, err := boolType.Render()
 
@@ -176,7 +197,7 @@ func (g GeneratorBoolAttribute) CustomTypeAndValue(name string) ([]byte, error)
 
 	buf.Write(b)
 
-	boolValue := generatorschema.NewCustomBoolValue(name)
+	boolValue := generatorschema.NewCustomBoolValue(name, gen)
 
 	b, err = boolValue.Render()
 
@@ -189,12 +210,12 @@ func (g GeneratorBoolAttribute) CustomTypeAndValue(name string) ([]byte, error)
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorBoolAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorBoolAttribute) ToFromFunctions(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	toFrom := generatorschema.NewToFromBool(name, g.AssociatedExternalType)
+	toFrom := generatorschema.NewToFromBool(name, g.AssociatedExternalType, gen)
 
 	b, err := toFrom.Render()
 
@@ -223,7 +244,7 @@ func (g GeneratorBoolAttribute) AttrValue(name generatorschema.FrameworkIdentifi
 	return "basetypes.BoolValue"
 }
 
-func (g GeneratorBoolAttribute) To() (generatorschema.ToFromConversion, error) {
+func (g GeneratorBoolAttribute) To(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
@@ -235,14 +256,16 @@ func (g GeneratorBoolAttribute) To() (generatorschema.ToFromConversion, error) {
 	}, nil
 }
 
-func (g GeneratorBoolAttribute) From() (generatorschema.ToFromConversion, error) {
+func (g GeneratorBoolAttribute) From(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
+			ConfigOnly:   g.WriteOnly.IsWriteOnly(),
 		}, nil
 	}
 
 	return generatorschema.ToFromConversion{
-		Default: "BoolPointerValue",
+		Default:    "BoolPointerValue",
+		ConfigOnly: g.WriteOnly.IsWriteOnly(),
 	}, nil
 }
@@ -734,6 +734,14 @@ Computed: true,
 			},
 			expected: `"bool_attribute": schema.BoolAttribute{
 Sensitive: true,
+},`,
+		},
+		"write-only": {
+			input: GeneratorBoolAttribute{
+				WriteOnly: convert.NewWriteOnly(true),
+			},
+			expected: `"bool_attribute": schema.BoolAttribute{
+WriteOnly: true,
 },`,
 		},
 
@@ -4,26 +4,72 @@
 package resource
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/greatman/terraform-plugin-codegen-spec/resource"
 	"github.com/greatman/terraform-plugin-codegen-spec/spec"
 
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/identity"
 	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
 
-func NewSchemas(spec spec.Specification) (map[string]generatorschema.GeneratorSchema, error) {
+func NewSchemas(spec spec.Specification, ext extension.Specification) (map[string]generatorschema.GeneratorSchema, error) {
 	resourceSchemas := make(map[string]generatorschema.GeneratorSchema, len(spec.Resources))
 
-	for _, v := range spec.Resources {
+	var errs []error
+
+	for i, v := range spec.Resources {
 		s, err := NewSchema(v)
 		if err != nil {
-			return nil, err
+			errs = append(errs, diagnostic.NewErrors(err, "resources", i)...)
+
+			continue
+		}
+
+		r, _ := ext.Resources.Get(v.Name)
+
+		// the errors of the additions, identity and config validators are
+		// all reported, rather than only those of the first to fail
+		var resourceErrs []error
+
+		if r.Schema != nil {
+			err = applySchemaExtension(fmt.Sprintf("resource %q", v.Name), s.Attributes, s.Blocks, *r.Schema)
+
+			if err != nil {
+				resourceErrs = append(resourceErrs, diagnostic.NewErrors(err, "resources", i, "schema")...)
+			}
+		}
+
+		if r.Identity != nil {
+			s.Identity, err = identity.NewAttributes(r.Identity.Attributes)
+
+			if err != nil {
+				resourceErrs = append(resourceErrs, diagnostic.NewErrors(err, "resources", i, "identity")...)
+			}
+		}
+
+		s.ConfigValidators, err = generatorschema.NewConfigValidators("resource", s, r.ConfigValidators)
+
+		if err != nil {
+			resourceErrs = append(resourceErrs, diagnostic.NewErrors(diagnostic.Wrapf(err, "resource %q %w", v.Name), "resources", i, "config_validators")...)
+		}
+
+		if len(resourceErrs) > 0 {
+			errs = append(errs, resourceErrs...)
+
+			continue
 		}
 
 		resourceSchemas[v.Name] = s
 	}
 
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
 	return resourceSchemas, nil
 }
 
@@ -33,11 +79,15 @@ func NewSchema(d resource.Resource) (generatorschema.GeneratorSchema, error) {
 	attributes := make(generatorschema.GeneratorAttributes, len(d.Schema.Attributes))
 	blocks := make(generatorschema.GeneratorBlocks, len(d.Schema.Blocks))
 
-	for _, v := range d.Schema.Attributes {
+	var errs []error
+
+	for i, v := range d.Schema.Attributes {
 		a, err := NewAttribute(v)
 
 		if err != nil {
-			return s, err
+			errs = append(errs, diagnostic.NewErrors(err, "schema", "attributes", i)...)
+
+			continue
 		}
 
 		attributes[v.Name] = a
@@ -45,11 +95,13 @@ func NewSchema(d resource.Resource) (generatorschema.GeneratorSchema, error) {
 
 	s.Attributes = attributes
 
-	for _, v := range d.Schema.Blocks {
+	for i, v := range d.Schema.Blocks {
 		b, err := NewBlock(v)
 
 		if err != nil {
-			return s, err
+			errs = append(errs, diagnostic.NewErrors(err, "schema", "blocks", i)...)
+
+			continue
 		}
 
 		blocks[v.Name] = b
@@ -57,6 +109,10 @@ func NewSchema(d resource.Resource) (generatorschema.GeneratorSchema, error) {
 
 	s.Blocks = blocks
 
+	if len(errs) > 0 {
+		return s, errors.Join(errs...)
+	}
+
 	s.Description = d.Schema.Description
 
 	s.MarkdownDescription = d.Schema.MarkdownDescription
@@ -69,16 +125,24 @@ func NewSchema(d resource.Resource) (generatorschema.GeneratorSchema, error) {
 func NewAttributes(a resource.Attributes) (generatorschema.GeneratorAttributes, error) {
 	attributes := make(generatorschema.GeneratorAttributes, len(a))
 
-	for _, v := range a {
+	var errs []error
+
+	for i, v := range a {
 		attribute, err := NewAttribute(v)
 
 		if err != nil {
-			return generatorschema.GeneratorAttributes{}, err
+			errs = append(errs, diagnostic.NewErrors(err, "attributes", i)...)
+
+			continue
 		}
 
 		attributes[v.Name] = attribute
 	}
 
+	if len(errs) > 0 {
+		return generatorschema.GeneratorAttributes{}, errors.Join(errs...)
+	}
+
 	return attributes, nil
 }
 
@@ -86,6 +150,10 @@ func NewAttribute(a resource.Attribute) (generatorschema.GeneratorAttribute, err
 	switch {
 	case a.Bool != nil:
 		return NewGeneratorBoolAttribute(a.Name, a.Bool)
+	case a.Dynamic != nil:
+		return NewGeneratorDynamicAttribute(a.Name, a.Dynamic)
+	case a.Float64 != nil && extension.IsFloat32(a.Float64):
+		return NewGeneratorFloat32Attribute(a.Name, a.Float64)
 	case a.Float64 != nil:
 		return NewGeneratorFloat64Attribute(a.Name, a.Float64)
 	case a.Int64 != nil:
@@ -95,11 +163,15 @@ func NewAttribute(a resource.Attribute) (generatorschema.GeneratorAttribute, err
 	case a.List != nil:
 		return NewGeneratorListAttribute(a.Name, a.List)
 	case a.ListNested != nil:
-		return NewGeneratorListNestedAttribute(a.Name, a.ListNested)
+		attribute, err := NewGeneratorListNestedAttribute(a.Name, a.ListNested)
+
+		return attribute, diagnostic.WithPointer(err, "list_nested", "nested_object")
 	case a.Map != nil:
 		return NewGeneratorMapAttribute(a.Name, a.Map)
 	case a.MapNested != nil:
-		return NewGeneratorMapNestedAttribute(a.Name, a.MapNested)
+		attribute, err := NewGeneratorMapNestedAttribute(a.Name, a.MapNested)
+
+		return attribute, diagnostic.WithPointer(err, "map_nested", "nested_object")
 	case a.Number != nil:
 		return NewGeneratorNumberAttribute(a.Name, a.Number)
 	case a.Object != nil:
@@ -107,41 +179,59 @@ func NewAttribute(a resource.Attribute) (generatorschema.GeneratorAttribute, err
 	case a.Set != nil:
 		return NewGeneratorSetAttribute(a.Name, a.Set)
 	case a.SetNested != nil:
-		return NewGeneratorSetNestedAttribute(a.Name, a.SetNested)
+		attribute, err := NewGeneratorSetNestedAttribute(a.Name, a.SetNested)
+
+		return attribute, diagnostic.WithPointer(err, "set_nested", "nested_object")
 	case a.SingleNested != nil:
-		return NewGeneratorSingleNestedAttribute(a.Name, a.SingleNested)
+		attribute, err := NewGeneratorSingleNestedAttribute(a.Name, a.SingleNested)
+
+		return attribute, diagnostic.WithPointer(err, "single_nested")
 	case a.String != nil:
 		return NewGeneratorStringAttribute(a.Name, a.String)
 	}
 
-	return nil, fmt.Errorf("attribute type not defined: %+v", a)
+	return nil, fmt.Errorf("attribute %q type not defined", a.Name)
 }
 
 func NewBlocks(b resource.Blocks) (generatorschema.GeneratorBlocks, error) {
 	blocks := make(generatorschema.GeneratorBlocks, len(b))
 
-	for _, v := range b {
+	var errs []error
+
+	for i, v := range b {
 		block, err := NewBlock(v)
 
 		if err != nil {
-			return generatorschema.GeneratorBlocks{}, err
+			errs = append(errs, diagnostic.NewErrors(err, "blocks", i)...)
+
+			continue
 		}
 
 		blocks[v.Name] = block
 	}
 
+	if len(errs) > 0 {
+		return generatorschema.GeneratorBlocks{}, errors.Join(errs...)
+	}
+
 	return blocks, nil
 }
 
 func NewBlock(b resource.Block) (generatorschema.GeneratorBlock, error) {
 	switch {
 	case b.ListNested != nil:
-		return NewGeneratorListNestedBlock(b.Name, b.ListNested)
+		block, err := NewGeneratorListNestedBlock(b.Name, b.ListNested)
+
+		return block, diagnostic.WithPointer(err, "list_nested", "nested_object")
 	case b.SetNested != nil:
-		return NewGeneratorSetNestedBlock(b.Name, b.SetNested)
+		block, err := NewGeneratorSetNestedBlock(b.Name, b.SetNested)
+
+		return block, diagnostic.WithPointer(err, "set_nested", "nested_object")
 	case b.SingleNested != nil:
-		return NewGeneratorSingleNestedBlock(b.Name, b.SingleNested)
+		block, err := NewGeneratorSingleNestedBlock(b.Name, b.SingleNested)
+
+		return block, diagnostic.WithPointer(err, "single_nested")
 	}
 
-	return nil, fmt.Errorf("block type not defined: %+v", b)
+	return nil, fmt.Errorf("block %q type not defined", b.Name)
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/greatman/terraform-plugin-codegen-spec/spec"
 
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
 	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
 
@@ -20,6 +21,7 @@ func Test_NewSchemas(t *testing.T) {
 
 	testCases := map[string]struct {
 		spec           spec.Specification
+		ext            extension.Specification
 		expectedSchema map[string]generatorschema.GeneratorSchema
 	}{
 		"success": {
@@ -445,6 +447,102 @@ func Test_NewSchemas(t *testing.T) {
 				},
 			},
 		},
+		"write-only": {
+			spec: spec.Specification{
+				Resources: []resource.Resource{
+					{
+						Name: "example",
+						Schema: &resource.Schema{
+							Attributes: []resource.Attribute{
+								{
+									Name: "string_attribute",
+									String: &resource.StringAttribute{
+										ComputedOptionalRequired: "optional",
+									},
+								},
+								{
+									Name: "single_nested_attribute",
+									SingleNested: &resource.SingleNestedAttribute{
+										Attributes: []resource.Attribute{
+											{
+												Name: "nested_string_attribute",
+												String: &resource.StringAttribute{
+													ComputedOptionalRequired: "required",
+												},
+											},
+										},
+										ComputedOptionalRequired: "optional",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			ext: extension.Specification{
+				Resources: extension.Resources{
+					{
+						Name: "example",
+						Schema: &extension.Schema{
+							Attributes: extension.Attributes{
+								{
+									Name: "string_attribute",
+									String: &extension.AttributeProperties{
+										WriteOnly: true,
+									},
+								},
+								{
+									Name: "single_nested_attribute",
+									SingleNested: &extension.SingleNestedAttribute{
+										NestedAttributeProperties: extension.NestedAttributeProperties{
+											WriteOnly: true,
+										},
+										Attributes: extension.Attributes{
+											{
+												Name: "nested_string_attribute",
+												String: &extension.AttributeProperties{
+													WriteOnly: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedSchema: map[string]generatorschema.GeneratorSchema{
+				"example": {
+					Attributes: generatorschema.GeneratorAttributes{
+						"string_attribute": GeneratorStringAttribute{
+							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "string_attribute"),
+							PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeString, specschema.CustomPlanModifiers{}),
+							Validators:               convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
+							WriteOnly:                convert.NewWriteOnly(true),
+						},
+						"single_nested_attribute": GeneratorSingleNestedAttribute{
+							Attributes: generatorschema.GeneratorAttributes{
+								"nested_string_attribute": GeneratorStringAttribute{
+									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_string_attribute"),
+									PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeString, specschema.CustomPlanModifiers{}),
+									Validators:               convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
+									WriteOnly:                convert.NewWriteOnly(true),
+								},
+							},
+							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+							CustomType:               convert.NewCustomTypeNestedObject(nil, "single_nested_attribute"),
+							PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
+							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
+							WriteOnly:                convert.NewWriteOnly(true),
+						},
+					},
+					Blocks: generatorschema.GeneratorBlocks{},
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -452,7 +550,7 @@ func Test_NewSchemas(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewSchemas(testCase.spec)
+			got, err := NewSchemas(testCase.spec, testCase.ext)
 
 			if err != nil {
 				t.Error(err)
@@ -464,3 +562,173 @@ func Test_NewSchemas(t *testing.T) {
 		})
 	}
 }
+
+func Test_NewSchemas_ConfigValidators(t *testing.T) {
+	t.Parallel()
+
+	s := spec.Specification{
+		Resources: []resource.Resource{
+			{
+				Name: "example",
+				Schema: &resource.Schema{
+					Attributes: []resource.Attribute{
+						{
+							Name: "string_attribute",
+							String: &resource.StringAttribute{
+								ComputedOptionalRequired: "optional",
+							},
+						},
+						{
+							Name: "map_nested_attribute",
+							MapNested: &resource.MapNestedAttribute{
+								ComputedOptionalRequired: "optional",
+								NestedObject: resource.NestedAttributeObject{
+									Attributes: []resource.Attribute{
+										{
+											Name: "string_attribute",
+											String: &resource.StringAttribute{
+												ComputedOptionalRequired: "optional",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					Blocks: []resource.Block{
+						{
+							Name: "list_nested_block",
+							ListNested: &resource.ListNestedBlock{
+								NestedObject: resource.NestedBlockObject{
+									Blocks: []resource.Block{
+										{
+											Name: "single_nested_block",
+											SingleNested: &resource.SingleNestedBlock{
+												Attributes: []resource.Attribute{
+													{
+														Name: "string_attribute",
+														String: &resource.StringAttribute{
+															ComputedOptionalRequired: "optional",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		configValidators extension.ConfigValidators
+		expected         *generatorschema.ConfigValidators
+		expectedError    string
+	}{
+		"none": {},
+		"paths": {
+			configValidators: extension.ConfigValidators{
+				{
+					ExactlyOneOf: []string{
+						"string_attribute",
+						"map_nested_attribute.string_attribute",
+						"list_nested_block.single_nested_block.string_attribute",
+					},
+				},
+				{
+					RequiredTogether: []string{
+						"string_attribute",
+						"list_nested_block",
+					},
+				},
+			},
+			expected: &generatorschema.ConfigValidators{
+				Package: "resource",
+				Validators: []generatorschema.ConfigValidator{
+					{
+						Name: "ExactlyOneOf",
+						PathExpressions: []string{
+							`path.MatchRoot("string_attribute")`,
+							`path.MatchRoot("map_nested_attribute").AtAnyMapKey().AtName("string_attribute")`,
+							`path.MatchRoot("list_nested_block").AtAnyListIndex().AtName("single_nested_block").AtName("string_attribute")`,
+						},
+						Paths: []string{
+							"string_attribute",
+							"map_nested_attribute.string_attribute",
+							"list_nested_block.single_nested_block.string_attribute",
+						},
+					},
+					{
+						Name: "RequiredTogether",
+						PathExpressions: []string{
+							`path.MatchRoot("string_attribute")`,
+							`path.MatchRoot("list_nested_block")`,
+						},
+						Paths: []string{
+							"string_attribute",
+							"list_nested_block",
+						},
+					},
+				},
+			},
+		},
+		"path-not-defined": {
+			configValidators: extension.ConfigValidators{
+				{
+					Conflicting: []string{
+						"string_attribute",
+						"list_nested_block.string_attribute",
+					},
+				},
+			},
+			expectedError: `/resources/0/config_validators/0/conflicting/1: resource "example" config validator 0: path "list_nested_block.string_attribute" is not defined in the schema`,
+		},
+		"path-not-nested": {
+			configValidators: extension.ConfigValidators{
+				{
+					AtLeastOneOf: []string{
+						"string_attribute.nested",
+						"list_nested_block",
+					},
+				},
+			},
+			expectedError: `/resources/0/config_validators/0/at_least_one_of/0: resource "example" config validator 0: path "string_attribute.nested" is not defined in the schema, as "string_attribute" is not a nested attribute or block`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewSchemas(s, extension.Specification{
+				Resources: extension.Resources{
+					{
+						Name:             "example",
+						ConfigValidators: testCase.configValidators,
+					},
+				},
+			})
+
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got %v", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got["example"].ConfigValidators, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/resource"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+type GeneratorDynamicAttribute struct {
+	AssociatedExternalType   *generatorschema.AssocExtType
+	ComputedOptionalRequired convert.ComputedOptionalRequired
+	CustomType               convert.CustomTypePrimitive
+	Default                  convert.DefaultCustom
+	DeprecationMessage       convert.DeprecationMessage
+	Description              convert.Description
+	PlanModifiers            convert.PlanModifiers
+	Sensitive                convert.Sensitive
+	Validators               convert.Validators
+	WriteOnly                convert.WriteOnly
+}
+
+func NewGeneratorDynamicAttribute(name string, a *resource.DynamicAttribute) (GeneratorDynamicAttribute, error) {
+	if a == nil {
+		return GeneratorDynamicAttribute{}, fmt.Errorf("*resource.DynamicAttribute is nil")
+	}
+
+	c := convert.NewComputedOptionalRequired(a.ComputedOptionalRequired)
+
+	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name)
+
+	var cd *specschema.CustomDefault
+
+	if a.Default != nil {
+		cd = a.Default.Custom
+	}
+
+	dc := convert.NewDefaultCustom(cd)
+
+	dm := convert.NewDeprecationMessage(a.DeprecationMessage)
+
+	d := convert.NewDescription(a.Description)
+
+	pm := convert.NewPlanModifiers(convert.PlanModifierTypeDynamic, a.PlanModifiers.CustomPlanModifiers())
+
+	s := convert.NewSensitive(a.Sensitive)
+
+	v := convert.NewValidators(convert.ValidatorTypeDynamic, a.Validators.CustomValidators())
+
+	return GeneratorDynamicAttribute{
+		AssociatedExternalType:   generatorschema.NewAssocExtType(a.AssociatedExternalType),
+		ComputedOptionalRequired: c,
+		CustomType:               ctp,
+		Default:                  dc,
+		DeprecationMessage:       dm,
+		Description:              d,
+		PlanModifiers:            pm,
+		Sensitive:                s,
+		Validators:               v,
+	}, nil
+}
+
+func (g GeneratorDynamicAttribute) GeneratorSchemaType() generatorschema.Type {
+	return generatorschema.GeneratorDynamicAttribute
+}
+
+func (g GeneratorDynamicAttribute) Documentation() generatorschema.Documentation {
+	return generatorschema.Documentation{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		WriteOnly:          g.WriteOnly.IsWriteOnly(),
+	}
+}
+
+func (g GeneratorDynamicAttribute) Imports() *generatorschema.Imports {
+	imports := generatorschema.NewImports()
+
+	imports.Append(g.CustomType.Imports())
+
+	imports.Append(g.Default.Imports())
+
+	imports.Append(g.PlanModifiers.Imports())
+
+	imports.Append(g.Validators.Imports())
+
+	if g.AssociatedExternalType != nil {
+		imports.Append(generatorschema.AssociatedExternalTypeImports())
+
+		imports.Append(generatorschema.DynamicAssociatedExternalTypeImports(g.AssociatedExternalType))
+	}
+
+	imports.Append(g.AssociatedExternalType.Imports())
+
+	return imports
+}
+
+func (g GeneratorDynamicAttribute) Equal(ga generatorschema.GeneratorAttribute) bool {
+	h, ok := ga.(GeneratorDynamicAttribute)
+
+	if !ok {
+		return false
+	}
+
+	if !g.AssociatedExternalType.Equal(h.AssociatedExternalType) {
+		return false
+	}
+
+	if !g.ComputedOptionalRequired.Equal(h.ComputedOptionalRequired) {
+		return false
+	}
+
+	if !g.CustomType.Equal(h.CustomType) {
+		return false
+	}
+
+	if !g.Default.Equal(h.Default) {
+		return false
+	}
+
+	if !g.DeprecationMessage.Equal(h.DeprecationMessage) {
+		return false
+	}
+
+	if !g.Description.Equal(h.Description) {
+		return false
+	}
+
+	if !g.PlanModifiers.Equal(h.PlanModifiers) {
+		return false
+	}
+
+	if !g.Sensitive.Equal(h.Sensitive) {
+		return false
+	}
+
+	if !g.Validators.Equal(h.Validators) {
+		return false
+	}
+
+	return g.WriteOnly.Equal(h.WriteOnly)
+}
+
+func (g GeneratorDynamicAttribute) Schema(name generatorschema.FrameworkIdentifier) (string, error) {
+	var b bytes.Buffer
+
+	b.WriteString(fmt.Sprintf("%q: schema.DynamicAttribute{\n", name))
+	b.Write(g.CustomType.Schema())
+	b.Write(g.ComputedOptionalRequired.Schema())
+	b.Write(g.Sensitive.Schema())
+	b.Write(g.WriteOnly.Schema())
+	b.Write(g.Description.Schema())
+	b.Write(g.DeprecationMessage.Schema())
+	b.Write(g.PlanModifiers.Schema())
+	b.Write(g.Validators.Schema())
+	b.Write(g.Default.Schema())
+	b.WriteString("},")
+
+	return b.String(), nil
+}
+
+func (g GeneratorDynamicAttribute) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
+	field := model.Field{
+		Name:      name.ToPascalCase(),
+		TfsdkName: name.ToString(),
+		ValueType: model.DynamicValueType,
+	}
+
+	customValueType := g.CustomType.ValueType()
+
+	if customValueType != "" {
+		field.ValueType = customValueType
+	}
+
+	return field, nil
+}
+
+func (g GeneratorDynamicAttribute) CustomTypeAndValue(name string, gen *generatorschema.Generator) ([]byte, error) {
+	if g.AssociatedExternalType == nil {
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+
+	dynamicType := generatorschema.NewCustomDynamicType(name, gen)
+
+	b, err := dynamicType.Render()
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf.Write(b)
+
+	dynamicValue := generatorschema.NewCustomDynamicValue(name, gen)
+
+	b, err = dynamicValue.Render()
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf.Write(b)
+
+	return buf.Bytes(), nil
+}
+
+func (g GeneratorDynamicAttribute) ToFromFunctions(name string, gen *generatorschema.Generator) ([]byte, error) {
+	if g.AssociatedExternalType == nil {
+		return nil, nil
+	}
+
+	toFrom := generatorschema.NewToFromDynamic(name, g.AssociatedExternalType, gen)
+
+	b, err := toFrom.Render()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// AttrType returns a string representation of a basetypes.DynamicTypable type.
+func (g GeneratorDynamicAttribute) AttrType(name generatorschema.FrameworkIdentifier) (string, error) {
+	if g.AssociatedExternalType != nil {
+		return fmt.Sprintf("%sType{}", name.ToPascalCase()), nil
+	}
+
+	return "basetypes.DynamicType{}", nil
+}
+
+// AttrValue returns a string representation of a basetypes.DynamicValuable type.
+func (g GeneratorDynamicAttribute) AttrValue(name generatorschema.FrameworkIdentifier) string {
+	if g.AssociatedExternalType != nil {
+		return fmt.Sprintf("%sValue", name.ToPascalCase())
+	}
+
+	return "basetypes.DynamicValue"
+}
+
+func (g GeneratorDynamicAttribute) To(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
+	if g.AssociatedExternalType != nil {
+		return generatorschema.ToFromConversion{
+			AssocExtType: g.AssociatedExternalType,
+		}, nil
+	}
+
+	return generatorschema.ToFromConversion{}, generatorschema.NewUnimplementedError(errors.New("dynamic attribute without an associated external type is not yet implemented"))
+}
+
+func (g GeneratorDynamicAttribute) From(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
+	if g.AssociatedExternalType != nil {
+		return generatorschema.ToFromConversion{
+			AssocExtType: g.AssociatedExternalType,
+			ConfigOnly:   g.WriteOnly.IsWriteOnly(),
+		}, nil
+	}
+
+	return generatorschema.ToFromConversion{}, generatorschema.NewUnimplementedError(errors.New("dynamic attribute without an associated external type is not yet implemented"))
+}
@@ -0,0 +1,749 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+func TestApplySchemaExtension_StaticDefault(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute       generatorschema.GeneratorAttribute
+		ext             extension.Attribute
+		expectedSchema  string
+		expectedDocs    string
+		expectedImports []string
+		expectedError   string
+	}{
+		"list": {
+			attribute: GeneratorListAttribute{
+				ElementType: specschema.ElementType{
+					String: &specschema.StringType{},
+				},
+			},
+			ext: extension.Attribute{
+				List: &extension.AttributeProperties{
+					Default: json.RawMessage(`{"static": ["a", "${b}"]}`),
+				},
+			},
+			expectedSchema: `Default: listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{
+types.StringValue("a"),
+types.StringValue("${b}"),
+})),
+`,
+			expectedDocs: `["a", "$${b}"]`,
+			expectedImports: []string{
+				generatorschema.AttrImport,
+				"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault",
+				generatorschema.TypesImport,
+			},
+		},
+		"map": {
+			attribute: GeneratorMapAttribute{
+				ElementType: specschema.ElementType{
+					Number: &specschema.NumberType{},
+				},
+			},
+			ext: extension.Attribute{
+				Map: &extension.AttributeProperties{
+					Default: json.RawMessage(`{"static": {"b": 1.5, "a": null}}`),
+				},
+			},
+			expectedSchema: `Default: mapdefault.StaticValue(types.MapValueMust(types.NumberType, map[string]attr.Value{
+"a": types.NumberNull(),
+"b": types.NumberValue(big.NewFloat(1.5)),
+})),
+`,
+			expectedDocs: `{ "a" = null, "b" = 1.5 }`,
+			expectedImports: []string{
+				generatorschema.AttrImport,
+				"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault",
+				generatorschema.TypesImport,
+				generatorschema.MathBigImport,
+			},
+		},
+		"set-list": {
+			attribute: GeneratorSetAttribute{
+				ElementType: specschema.ElementType{
+					List: &specschema.ListType{
+						ElementType: specschema.ElementType{
+							Int64: &specschema.Int64Type{},
+						},
+					},
+				},
+			},
+			ext: extension.Attribute{
+				Set: &extension.AttributeProperties{
+					Default: json.RawMessage(`{"static": [[1, 2], null]}`),
+				},
+			},
+			expectedSchema: `Default: setdefault.StaticValue(types.SetValueMust(types.ListType{
+ElemType: types.Int64Type,
+}, []attr.Value{
+types.ListValueMust(types.Int64Type, []attr.Value{
+types.Int64Value(1),
+types.Int64Value(2),
+}),
+types.ListNull(types.Int64Type),
+})),
+`,
+			expectedDocs: `[[1, 2], null]`,
+			expectedImports: []string{
+				generatorschema.AttrImport,
+				"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault",
+				generatorschema.TypesImport,
+			},
+		},
+		"object": {
+			attribute: GeneratorObjectAttribute{
+				AttributeTypes: specschema.ObjectAttributeTypes{
+					{
+						Name:   "name",
+						String: &specschema.StringType{},
+					},
+					{
+						Name: "enabled",
+						Bool: &specschema.BoolType{},
+					},
+				},
+			},
+			ext: extension.Attribute{
+				Object: &extension.AttributeProperties{
+					Default: json.RawMessage(`{"static": {"name": "example"}}`),
+				},
+			},
+			expectedSchema: `Default: objectdefault.StaticValue(types.ObjectValueMust(map[string]attr.Type{
+"name": types.StringType,
+"enabled": types.BoolType,
+}, map[string]attr.Value{
+"name": types.StringValue("example"),
+"enabled": types.BoolNull(),
+})),
+`,
+			expectedDocs: `{ name = "example" }`,
+			expectedImports: []string{
+				generatorschema.AttrImport,
+				"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault",
+				generatorschema.TypesImport,
+			},
+		},
+		"element-type-mismatch": {
+			attribute: GeneratorListAttribute{
+				ElementType: specschema.ElementType{
+					Int64: &specschema.Int64Type{},
+				},
+			},
+			ext: extension.Attribute{
+				List: &extension.AttributeProperties{
+					Default: json.RawMessage(`{"static": [1, "2"]}`),
+				},
+			},
+			expectedError: `/attributes/0/list/default/static/1: resource "example" attribute "attribute" has an invalid static default: value[1] must be a number`,
+		},
+		"element-type-out-of-range": {
+			attribute: GeneratorListAttribute{
+				ElementType: specschema.ElementType{
+					Int32: &specschema.Int32Type{},
+				},
+			},
+			ext: extension.Attribute{
+				List: &extension.AttributeProperties{
+					Default: json.RawMessage(`{"static": [2147483648]}`),
+				},
+			},
+			expectedError: `/attributes/0/list/default/static/0: resource "example" attribute "attribute" has an invalid static default: value[0] is not a valid number: strconv.ParseInt: parsing "2147483648": value out of range`,
+		},
+		"element-type-custom-type": {
+			attribute: GeneratorSetAttribute{
+				ElementType: specschema.ElementType{
+					String: &specschema.StringType{
+						CustomType: &specschema.CustomType{
+							Type:      "my_custom_type",
+							ValueType: "my_custom_value_type",
+						},
+					},
+				},
+			},
+			ext: extension.Attribute{
+				Set: &extension.AttributeProperties{
+					Default: json.RawMessage(`{"static": ["a"]}`),
+				},
+			},
+			expectedError: `/attributes/0/set/default/static/0: resource "example" attribute "attribute" has an invalid static default: value[0] has a custom type, which is not supported`,
+		},
+		"not-collection": {
+			attribute: GeneratorMapAttribute{
+				ElementType: specschema.ElementType{
+					String: &specschema.StringType{},
+				},
+			},
+			ext: extension.Attribute{
+				Map: &extension.AttributeProperties{
+					Default: json.RawMessage(`{"static": ["a"]}`),
+				},
+			},
+			expectedError: `/attributes/0/map/default/static: resource "example" attribute "attribute" has an invalid static default: value must be an object`,
+		},
+		"object-unknown-attribute": {
+			attribute: GeneratorObjectAttribute{
+				AttributeTypes: specschema.ObjectAttributeTypes{
+					{
+						Name:   "name",
+						String: &specschema.StringType{},
+					},
+				},
+			},
+			ext: extension.Attribute{
+				Object: &extension.AttributeProperties{
+					Default: json.RawMessage(`{"static": {"names": "example"}}`),
+				},
+			},
+			expectedError: `/attributes/0/object/default/static/names: resource "example" attribute "attribute" has an invalid static default: value has no attribute type "names"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			attributes := generatorschema.GeneratorAttributes{
+				"attribute": testCase.attribute,
+			}
+
+			testCase.ext.Name = "attribute"
+
+			err := applySchemaExtension(`resource "example"`, attributes, nil, extension.Schema{
+				Attributes: extension.Attributes{
+					testCase.ext,
+				},
+			})
+
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got %v", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var schema []byte
+
+			switch a := attributes["attribute"].(type) {
+			case GeneratorListAttribute:
+				schema = a.Default.Schema()
+			case GeneratorMapAttribute:
+				schema = a.Default.Schema()
+			case GeneratorObjectAttribute:
+				schema = a.Default.Schema()
+			case GeneratorSetAttribute:
+				schema = a.Default.Schema()
+			}
+
+			if diff := cmp.Diff(string(schema), testCase.expectedSchema); diff != "" {
+				t.Errorf("unexpected schema difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(attributes["attribute"].(generatorschema.Documented).Documentation().Default, testCase.expectedDocs); diff != "" {
+				t.Errorf("unexpected docs difference: %s", diff)
+			}
+
+			var imports []string
+
+			for _, i := range attributes["attribute"].Imports().All() {
+				imports = append(imports, i.Path)
+			}
+
+			sort.Strings(imports)
+
+			if diff := cmp.Diff(imports, testCase.expectedImports); diff != "" {
+				t.Errorf("unexpected imports difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestApplySchemaExtension_Constraints(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributes      generatorschema.GeneratorAttributes
+		ext             extension.Attribute
+		expectedSchema  string
+		expectedImports []string
+		expectedError   string
+	}{
+		"string": {
+			attributes: generatorschema.GeneratorAttributes{
+				"attribute": GeneratorStringAttribute{
+					Validators: convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{
+						{
+							SchemaDefinition: "my_validator.Validate()",
+						},
+					}),
+				},
+				"other": GeneratorStringAttribute{},
+			},
+			ext: extension.Attribute{
+				String: &extension.AttributeProperties{
+					Constraints: &extension.Constraints{
+						MinLength: pointer(int64(1)),
+						MaxLength: pointer(int64(10)),
+						Regex: &extension.RegexConstraint{
+							Pattern: "^[a-z]+$",
+							Message: "must be lowercase",
+						},
+						OneOf:         []json.RawMessage{json.RawMessage(`"a"`), json.RawMessage(`"b"`)},
+						ConflictsWith: []string{"other"},
+					},
+				},
+			},
+			expectedSchema: `Validators: []validator.String{
+stringvalidator.LengthBetween(1, 10),
+stringvalidator.RegexMatches(regexp.MustCompile("^[a-z]+$"), "must be lowercase"),
+stringvalidator.OneOf("a", "b"),
+stringvalidator.ConflictsWith(
+path.MatchRelative().AtParent().AtName("other"),
+),
+my_validator.Validate(),
+},
+`,
+			expectedImports: []string{
+				"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+				generatorschema.PathImport,
+				generatorschema.ValidatorImport,
+				generatorschema.TypesImport,
+				generatorschema.RegexpImport,
+			},
+		},
+		"int64": {
+			attributes: generatorschema.GeneratorAttributes{
+				"attribute": GeneratorInt64Attribute{
+					Validators: convert.NewValidators(convert.ValidatorTypeInt64, nil),
+				},
+			},
+			ext: extension.Attribute{
+				Int64: &extension.AttributeProperties{
+					Constraints: &extension.Constraints{
+						Min:   pointer(json.Number("1")),
+						OneOf: []json.RawMessage{json.RawMessage(`1`), json.RawMessage(`5`)},
+					},
+				},
+			},
+			expectedSchema: `Validators: []validator.Int64{
+int64validator.OneOf(1, 5),
+int64validator.AtLeast(1),
+},
+`,
+			expectedImports: []string{
+				"github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+				generatorschema.ValidatorImport,
+				generatorschema.TypesImport,
+			},
+		},
+		"list": {
+			attributes: generatorschema.GeneratorAttributes{
+				"attribute": GeneratorListAttribute{
+					ElementType: specschema.ElementType{
+						String: &specschema.StringType{},
+					},
+					Validators: convert.NewValidators(convert.ValidatorTypeList, nil),
+				},
+			},
+			ext: extension.Attribute{
+				List: &extension.AttributeProperties{
+					Constraints: &extension.Constraints{
+						MaxItems: pointer(int64(1)),
+					},
+				},
+			},
+			expectedSchema: `Validators: []validator.List{
+listvalidator.SizeAtMost(1),
+},
+`,
+			expectedImports: []string{
+				"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator",
+				generatorschema.ValidatorImport,
+				generatorschema.TypesImport,
+			},
+		},
+		"sibling-missing": {
+			attributes: generatorschema.GeneratorAttributes{
+				"attribute": GeneratorBoolAttribute{
+					Validators: convert.NewValidators(convert.ValidatorTypeBool, nil),
+				},
+			},
+			ext: extension.Attribute{
+				Bool: &extension.AttributeProperties{
+					Constraints: &extension.Constraints{
+						AlsoRequires: []string{"other"},
+					},
+				},
+			},
+			expectedError: `/attributes/0/bool/constraints/also_requires/0: resource "example" attribute "attribute" constraint also_requires references "other", which is not a sibling attribute or block`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testCase.ext.Name = "attribute"
+
+			err := applySchemaExtension(`resource "example"`, testCase.attributes, nil, extension.Schema{
+				Attributes: extension.Attributes{
+					testCase.ext,
+				},
+			})
+
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got %v", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var validators convert.Validators
+
+			switch a := testCase.attributes["attribute"].(type) {
+			case GeneratorInt64Attribute:
+				validators = a.Validators
+			case GeneratorListAttribute:
+				validators = a.Validators
+			case GeneratorStringAttribute:
+				validators = a.Validators
+			}
+
+			if diff := cmp.Diff(string(validators.Schema()), testCase.expectedSchema); diff != "" {
+				t.Errorf("unexpected schema difference: %s", diff)
+			}
+
+			var imports []string
+
+			for _, i := range testCase.attributes["attribute"].Imports().All() {
+				imports = append(imports, i.Path)
+			}
+
+			sort.Strings(imports)
+
+			if diff := cmp.Diff(imports, testCase.expectedImports); diff != "" {
+				t.Errorf("unexpected imports difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestApplySchemaExtension_PlanModifierShorthands(t *testing.T) {
+	t.Parallel()
+
+	attributes := generatorschema.GeneratorAttributes{
+		"id": GeneratorStringAttribute{
+			PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeString, specschema.CustomPlanModifiers{
+				{
+					SchemaDefinition: "my_plan_modifier.Modify()",
+				},
+			}),
+		},
+		"tags": GeneratorSetAttribute{
+			ElementType: specschema.ElementType{
+				String: &specschema.StringType{},
+			},
+			PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, nil),
+		},
+	}
+
+	err := applySchemaExtension(`resource "example"`, attributes, nil, extension.Schema{
+		Attributes: extension.Attributes{
+			{
+				Name: "id",
+				String: &extension.AttributeProperties{
+					PlanModifierShorthands: extension.PlanModifierShorthands{
+						RequiresReplace:    true,
+						UseStateForUnknown: true,
+					},
+				},
+			},
+			{
+				Name: "tags",
+				Set: &extension.AttributeProperties{
+					PlanModifierShorthands: extension.PlanModifierShorthands{
+						RequiresReplaceIfConfigured: true,
+					},
+				},
+			},
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedID := `PlanModifiers: []planmodifier.String{
+stringplanmodifier.UseStateForUnknown(),
+stringplanmodifier.RequiresReplace(),
+my_plan_modifier.Modify(),
+},
+`
+
+	if diff := cmp.Diff(string(attributes["id"].(GeneratorStringAttribute).PlanModifiers.Schema()), expectedID); diff != "" {
+		t.Errorf("unexpected schema difference: %s", diff)
+	}
+
+	expectedTags := `PlanModifiers: []planmodifier.Set{
+setplanmodifier.RequiresReplaceIfConfigured(),
+},
+`
+
+	if diff := cmp.Diff(string(attributes["tags"].(GeneratorSetAttribute).PlanModifiers.Schema()), expectedTags); diff != "" {
+		t.Errorf("unexpected schema difference: %s", diff)
+	}
+
+	var imports []string
+
+	for _, i := range attributes["tags"].Imports().All() {
+		imports = append(imports, i.Path)
+	}
+
+	sort.Strings(imports)
+
+	expectedImports := []string{
+		generatorschema.PlanModifierImport,
+		"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier",
+		generatorschema.TypesImport,
+	}
+
+	if diff := cmp.Diff(imports, expectedImports); diff != "" {
+		t.Errorf("unexpected imports difference: %s", diff)
+	}
+}
+
+func TestApplySchemaExtension_NestedAttributePlanModifierShorthands(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute generatorschema.GeneratorAttribute
+		extension extension.Attribute
+		expected  string
+	}{
+		"list-nested": {
+			attribute: GeneratorListNestedAttribute{
+				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeList, nil),
+			},
+			extension: extension.Attribute{
+				ListNested: &extension.NestedAttribute{
+					NestedAttributeProperties: extension.NestedAttributeProperties{
+						PlanModifierShorthands: extension.PlanModifierShorthands{
+							RequiresReplace: true,
+						},
+					},
+				},
+			},
+			expected: `PlanModifiers: []planmodifier.List{
+listplanmodifier.RequiresReplace(),
+},
+`,
+		},
+		"map-nested": {
+			attribute: GeneratorMapNestedAttribute{
+				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeMap, nil),
+			},
+			extension: extension.Attribute{
+				MapNested: &extension.NestedAttribute{
+					NestedAttributeProperties: extension.NestedAttributeProperties{
+						PlanModifierShorthands: extension.PlanModifierShorthands{
+							RequiresReplaceIfConfigured: true,
+						},
+					},
+				},
+			},
+			expected: `PlanModifiers: []planmodifier.Map{
+mapplanmodifier.RequiresReplaceIfConfigured(),
+},
+`,
+		},
+		"set-nested": {
+			attribute: GeneratorSetNestedAttribute{
+				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, nil),
+			},
+			extension: extension.Attribute{
+				SetNested: &extension.NestedAttribute{
+					NestedAttributeProperties: extension.NestedAttributeProperties{
+						PlanModifierShorthands: extension.PlanModifierShorthands{
+							UseStateForUnknown: true,
+						},
+					},
+				},
+			},
+			expected: `PlanModifiers: []planmodifier.Set{
+setplanmodifier.UseStateForUnknown(),
+},
+`,
+		},
+		"single-nested": {
+			attribute: GeneratorSingleNestedAttribute{
+				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
+			},
+			extension: extension.Attribute{
+				SingleNested: &extension.SingleNestedAttribute{
+					NestedAttributeProperties: extension.NestedAttributeProperties{
+						PlanModifierShorthands: extension.PlanModifierShorthands{
+							RequiresReplace:    true,
+							UseStateForUnknown: true,
+						},
+					},
+				},
+			},
+			expected: `PlanModifiers: []planmodifier.Object{
+objectplanmodifier.UseStateForUnknown(),
+objectplanmodifier.RequiresReplace(),
+},
+`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			attributes := generatorschema.GeneratorAttributes{
+				"nested": testCase.attribute,
+			}
+
+			testCase.extension.Name = "nested"
+
+			err := applySchemaExtension(`resource "example"`, attributes, nil, extension.Schema{
+				Attributes: extension.Attributes{testCase.extension},
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var planModifiers convert.PlanModifiers
+
+			switch a := attributes["nested"].(type) {
+			case GeneratorListNestedAttribute:
+				planModifiers = a.PlanModifiers
+			case GeneratorMapNestedAttribute:
+				planModifiers = a.PlanModifiers
+			case GeneratorSetNestedAttribute:
+				planModifiers = a.PlanModifiers
+			case GeneratorSingleNestedAttribute:
+				planModifiers = a.PlanModifiers
+			}
+
+			if diff := cmp.Diff(string(planModifiers.Schema()), testCase.expected); diff != "" {
+				t.Errorf("unexpected schema difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestApplySchemaExtension_ItemsLimits(t *testing.T) {
+	t.Parallel()
+
+	attributes := generatorschema.GeneratorAttributes{
+		"nested": GeneratorSetNestedAttribute{
+			NestedObject: GeneratorNestedAttributeObject{
+				Attributes: generatorschema.GeneratorAttributes{},
+			},
+			Validators: convert.NewValidators(convert.ValidatorTypeSet, nil),
+		},
+	}
+
+	blocks := generatorschema.GeneratorBlocks{
+		"block": GeneratorListNestedBlock{
+			NestedObject: GeneratorNestedBlockObject{
+				Attributes: generatorschema.GeneratorAttributes{},
+				Blocks:     generatorschema.GeneratorBlocks{},
+			},
+			Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{
+				{
+					SchemaDefinition: "my_validator.Validate()",
+				},
+			}),
+		},
+	}
+
+	err := applySchemaExtension(`resource "example"`, attributes, blocks, extension.Schema{
+		Attributes: extension.Attributes{
+			{
+				Name: "nested",
+				SetNested: &extension.NestedAttribute{
+					ItemsLimits: extension.ItemsLimits{
+						MinItems: pointer(int64(2)),
+					},
+				},
+			},
+		},
+		Blocks: extension.Blocks{
+			{
+				Name: "block",
+				ListNested: &extension.NestedBlock{
+					ItemsLimits: extension.ItemsLimits{
+						MinItems: pointer(int64(1)),
+						MaxItems: pointer(int64(1)),
+					},
+				},
+			},
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	nested := attributes["nested"].(GeneratorSetNestedAttribute)
+
+	expectedNested := `Validators: []validator.Set{
+setvalidator.SizeAtLeast(2),
+},
+`
+
+	if diff := cmp.Diff(string(nested.Validators.Schema()), expectedNested); diff != "" {
+		t.Errorf("unexpected schema difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(nested.Documentation().MinItems, pointer(int64(2))); diff != "" {
+		t.Errorf("unexpected docs difference: %s", diff)
+	}
+
+	block := blocks["block"].(GeneratorListNestedBlock)
+
+	expectedBlock := `Validators: []validator.List{
+listvalidator.SizeBetween(1, 1),
+my_validator.Validate(),
+},
+`
+
+	if diff := cmp.Diff(string(block.Validators.Schema()), expectedBlock); diff != "" {
+		t.Errorf("unexpected schema difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(block.Documentation().MaxItems, pointer(int64(1))); diff != "" {
+		t.Errorf("unexpected docs difference: %s", diff)
+	}
+}
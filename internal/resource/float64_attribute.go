@@ -24,6 +24,7 @@ type GeneratorFloat64Attribute struct {
 	PlanModifiers            convert.PlanModifiers
 	Sensitive                convert.Sensitive
 	Validators               convert.Validators
+	WriteOnly                convert.WriteOnly
 }
 
 func NewGeneratorFloat64Attribute(name string, a *resource.Float64Attribute) (GeneratorFloat64Attribute, error) {
@@ -64,6 +65,21 @@ func (g GeneratorFloat64Attribute) GeneratorSchemaType() generatorschema.Type {
 	return generatorschema.GeneratorFloat64Attribute
 }
 
+func (g GeneratorFloat64Attribute) Documentation() generatorschema.Documentation {
+	return generatorschema.Documentation{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		ConflictsWith:      g.Validators.ConflictsWith(),
+		Default:            g.Default.StaticValue(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Example:            g.Validators.Example(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		WriteOnly:          g.WriteOnly.IsWriteOnly(),
+	}
+}
+
 func (g GeneratorFloat64Attribute) Imports() *generatorschema.Imports {
 	imports := generatorschema.NewImports()
 
@@ -123,7 +139,11 @@ func (g GeneratorFloat64Attribute) Equal(ga generatorschema.GeneratorAttribute)
 		return false
 	}
 
-	return g.Validators.Equal(h.Validators)
+	if !g.Validators.Equal(h.Validators) {
+		return false
+	}
+
+	return g.WriteOnly.Equal(h.WriteOnly)
 }
 
 func (g GeneratorFloat64Attribute) Schema(name generatorschema.FrameworkIdentifier) (string, error) {
@@ -133,6 +153,7 @@ func (g GeneratorFloat64Attribute) Schema(name generatorschema.FrameworkIdentifi
 	b.Write(g.CustomType.Schema())
 	b.Write(g.ComputedOptionalRequired.Schema())
 	b.Write(g.Sensitive.Schema())
+	b.Write(g.WriteOnly.Schema())
 	b.Write(g.Description.Schema())
 	b.Write(g.DeprecationMessage.Schema())
 	b.Write(g.PlanModifiers.Schema())
@@ -159,14 +180,14 @@ func (g GeneratorFloat64Attribute) ModelField(name generatorschema.FrameworkIden
 	return field, nil
 }
 
-func (g GeneratorFloat64Attribute) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorFloat64Attribute) CustomTypeAndValue(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	float64Type := generatorschema.NewCustomFloat64Type(name)
+	float64Type := generatorschema.NewCustomFloat64Type(name, gen)
 
 	b, err := float64Type.Render()
 
@@ -176,7 +197,7 @@ func (g GeneratorFloat64Attribute) CustomTypeAndValue(name string) ([]byte, erro
 
 	buf.Write(b)
 
-	float64Value := generatorschema.NewCustomFloat64Value(name)
+	float64Value := generatorschema.NewCustomFloat64Value(name, gen)
 
 	b, err = float64Value.Render()
 
@@ -189,12 +210,12 @@ func (g GeneratorFloat64Attribute) CustomTypeAndValue(name string) ([]byte, erro
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorFloat64Attribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorFloat64Attribute) ToFromFunctions(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	toFrom := generatorschema.NewToFromFloat64(name, g.AssociatedExternalType)
+	toFrom := generatorschema.NewToFromFloat64(name, g.AssociatedExternalType, gen)
 
 	b, err := toFrom.Render()
 
@@ -223,7 +244,7 @@ func (g GeneratorFloat64Attribute) AttrValue(name generatorschema.FrameworkIdent
 	return "basetypes.Float64Value"
 }
 
-func (g GeneratorFloat64Attribute) To() (generatorschema.ToFromConversion, error) {
+func (g GeneratorFloat64Attribute) To(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
@@ -235,14 +256,16 @@ func (g GeneratorFloat64Attribute) To() (generatorschema.ToFromConversion, error
 	}, nil
 }
 
-func (g GeneratorFloat64Attribute) From() (generatorschema.ToFromConversion, error) {
+func (g GeneratorFloat64Attribute) From(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
+			ConfigOnly:   g.WriteOnly.IsWriteOnly(),
 		}, nil
 	}
 
 	return generatorschema.ToFromConversion{
-		Default: "Float64PointerValue",
+		Default:    "Float64PointerValue",
+		ConfigOnly: g.WriteOnly.IsWriteOnly(),
 	}, nil
 }
@@ -734,6 +734,14 @@ Computed: true,
 			},
 			expected: `"float64_attribute": schema.Float64Attribute{
 Sensitive: true,
+},`,
+		},
+		"write-only": {
+			input: GeneratorFloat64Attribute{
+				WriteOnly: convert.NewWriteOnly(true),
+			},
+			expected: `"float64_attribute": schema.Float64Attribute{
+WriteOnly: true,
 },`,
 		},
 
@@ -518,7 +518,7 @@ func TestGeneratorInt32Attribute_Imports(t *testing.T) {
 					Path: generatorschema.TypesImport,
 				},
 				{
-					Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/Int32default",
+					Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default",
 				},
 			},
 		},
@@ -734,6 +734,14 @@ Computed: true,
 			},
 			expected: `"Int32_attribute": schema.Int32Attribute{
 Sensitive: true,
+},`,
+		},
+		"write-only": {
+			input: GeneratorInt32Attribute{
+				WriteOnly: convert.NewWriteOnly(true),
+			},
+			expected: `"Int32_attribute": schema.Int32Attribute{
+WriteOnly: true,
 },`,
 		},
 
@@ -802,7 +810,7 @@ my_other_plan_modifier.Modify(),
 				}),
 			},
 			expected: `"Int32_attribute": schema.Int32Attribute{
-Default: Int32default.StaticInt32(1234),
+Default: int32default.StaticInt32(1234),
 },`,
 		},
 
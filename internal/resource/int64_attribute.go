@@ -24,6 +24,7 @@ type GeneratorInt64Attribute struct {
 	PlanModifiers            convert.PlanModifiers
 	Sensitive                convert.Sensitive
 	Validators               convert.Validators
+	WriteOnly                convert.WriteOnly
 }
 
 func NewGeneratorInt64Attribute(name string, a *resource.Int64Attribute) (GeneratorInt64Attribute, error) {
@@ -64,6 +65,21 @@ func (g GeneratorInt64Attribute) GeneratorSchemaType() generatorschema.Type {
 	return generatorschema.GeneratorInt64Attribute
 }
 
+func (g GeneratorInt64Attribute) Documentation() generatorschema.Documentation {
+	return generatorschema.Documentation{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		ConflictsWith:      g.Validators.ConflictsWith(),
+		Default:            g.Default.StaticValue(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Example:            g.Validators.Example(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		WriteOnly:          g.WriteOnly.IsWriteOnly(),
+	}
+}
+
 func (g GeneratorInt64Attribute) Imports() *generatorschema.Imports {
 	imports := generatorschema.NewImports()
 
@@ -123,7 +139,11 @@ func (g GeneratorInt64Attribute) Equal(ga generatorschema.GeneratorAttribute) bo
 		return false
 	}
 
-	return g.Validators.Equal(h.Validators)
+	if !g.Validators.Equal(h.Validators) {
+		return false
+	}
+
+	return g.WriteOnly.Equal(h.WriteOnly)
 }
 
 func (g GeneratorInt64Attribute) Schema(name generatorschema.FrameworkIdentifier) (string, error) {
@@ -133,6 +153,7 @@ func (g GeneratorInt64Attribute) Schema(name generatorschema.FrameworkIdentifier
 	b.Write(g.CustomType.Schema())
 	b.Write(g.ComputedOptionalRequired.Schema())
 	b.Write(g.Sensitive.Schema())
+	b.Write(g.WriteOnly.Schema())
 	b.Write(g.Description.Schema())
 	b.Write(g.DeprecationMessage.Schema())
 	b.Write(g.PlanModifiers.Schema())
@@ -159,14 +180,14 @@ func (g GeneratorInt64Attribute) ModelField(name generatorschema.FrameworkIdenti
 	return field, nil
 }
 
-func (g GeneratorInt64Attribute) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorInt64Attribute) CustomTypeAndValue(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	int64Type := generatorschema.NewCustomInt64Type(name)
+	int64Type := generatorschema.NewCustomInt64Type(name, gen)
 
 	b, err := int64Type.Render()
 
@@ -176,7 +197,7 @@ func (g GeneratorInt64Attribute) CustomTypeAndValue(name string) ([]byte, error)
 
 	buf.Write(b)
 
-	int64Value := generatorschema.NewCustomInt64Value(name)
+	int64Value := generatorschema.NewCustomInt64Value(name, gen)
 
 	b, err = int64Value.Render()
 
@@ -189,12 +210,12 @@ func (g GeneratorInt64Attribute) CustomTypeAndValue(name string) ([]byte, error)
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorInt64Attribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorInt64Attribute) ToFromFunctions(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	toFrom := generatorschema.NewToFromInt64(name, g.AssociatedExternalType)
+	toFrom := generatorschema.NewToFromInt64(name, g.AssociatedExternalType, gen)
 
 	b, err := toFrom.Render()
 
@@ -223,7 +244,7 @@ func (g GeneratorInt64Attribute) AttrValue(name generatorschema.FrameworkIdentif
 	return "basetypes.Int64Value"
 }
 
-func (g GeneratorInt64Attribute) To() (generatorschema.ToFromConversion, error) {
+func (g GeneratorInt64Attribute) To(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
@@ -235,14 +256,16 @@ func (g GeneratorInt64Attribute) To() (generatorschema.ToFromConversion, error)
 	}, nil
 }
 
-func (g GeneratorInt64Attribute) From() (generatorschema.ToFromConversion, error) {
+func (g GeneratorInt64Attribute) From(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
+			ConfigOnly:   g.WriteOnly.IsWriteOnly(),
 		}, nil
 	}
 
 	return generatorschema.ToFromConversion{
-		Default: "Int64PointerValue",
+		Default:    "Int64PointerValue",
+		ConfigOnly: g.WriteOnly.IsWriteOnly(),
 	}, nil
 }
@@ -734,6 +734,14 @@ Computed: true,
 			},
 			expected: `"int64_attribute": schema.Int64Attribute{
 Sensitive: true,
+},`,
+		},
+		"write-only": {
+			input: GeneratorInt64Attribute{
+				WriteOnly: convert.NewWriteOnly(true),
+			},
+			expected: `"int64_attribute": schema.Int64Attribute{
+WriteOnly: true,
 },`,
 		},
 
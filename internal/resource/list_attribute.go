@@ -19,7 +19,7 @@ type GeneratorListAttribute struct {
 	AssociatedExternalType   *generatorschema.AssocExtType
 	ComputedOptionalRequired convert.ComputedOptionalRequired
 	CustomType               convert.CustomTypeCollection
-	Default                  convert.DefaultCustom
+	Default                  convert.DefaultCollection
 	DeprecationMessage       convert.DeprecationMessage
 	Description              convert.Description
 	ElementType              specschema.ElementType
@@ -27,6 +27,7 @@ type GeneratorListAttribute struct {
 	PlanModifiers            convert.PlanModifiers
 	Sensitive                convert.Sensitive
 	Validators               convert.Validators
+	WriteOnly                convert.WriteOnly
 }
 
 func NewGeneratorListAttribute(name string, a *resource.ListAttribute) (GeneratorListAttribute, error) {
@@ -40,7 +41,7 @@ func NewGeneratorListAttribute(name string, a *resource.ListAttribute) (Generato
 
 	ctc := convert.NewCustomTypeCollection(a.CustomType, a.AssociatedExternalType, convert.CustomCollectionTypeList, string(et.ElementType()), name)
 
-	dc := convert.NewDefaultCustom(a.Default.CustomDefault())
+	dc := convert.NewDefaultCollection(a.Default.CustomDefault())
 
 	d := convert.NewDescription(a.Description)
 
@@ -71,6 +72,21 @@ func (g GeneratorListAttribute) GeneratorSchemaType() generatorschema.Type {
 	return generatorschema.GeneratorListAttribute
 }
 
+func (g GeneratorListAttribute) Documentation() generatorschema.Documentation {
+	return generatorschema.Documentation{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		ConflictsWith:      g.Validators.ConflictsWith(),
+		Default:            g.Default.StaticValue(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Example:            g.Validators.Example(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		WriteOnly:          g.WriteOnly.IsWriteOnly(),
+	}
+}
+
 func (g GeneratorListAttribute) ElemType() specschema.ElementType {
 	return g.ElementType
 }
@@ -146,7 +162,11 @@ func (g GeneratorListAttribute) Equal(ga generatorschema.GeneratorAttribute) boo
 		return false
 	}
 
-	return g.Validators.Equal(h.Validators)
+	if !g.Validators.Equal(h.Validators) {
+		return false
+	}
+
+	return g.WriteOnly.Equal(h.WriteOnly)
 }
 
 func (g GeneratorListAttribute) Schema(name generatorschema.FrameworkIdentifier) (string, error) {
@@ -161,6 +181,7 @@ func (g GeneratorListAttribute) Schema(name generatorschema.FrameworkIdentifier)
 	}
 	b.Write(g.ComputedOptionalRequired.Schema())
 	b.Write(g.Sensitive.Schema())
+	b.Write(g.WriteOnly.Schema())
 	b.Write(g.Description.Schema())
 	b.Write(g.DeprecationMessage.Schema())
 	b.Write(g.PlanModifiers.Schema())
@@ -187,14 +208,14 @@ func (g GeneratorListAttribute) ModelField(name generatorschema.FrameworkIdentif
 	return field, nil
 }
 
-func (g GeneratorListAttribute) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorListAttribute) CustomTypeAndValue(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	listType := generatorschema.NewCustomListType(name)
+	listType := generatorschema.NewCustomListType(name, gen)
 
 	b, err := listType.Render()
 
@@ -206,7 +227,7 @@ func (g GeneratorListAttribute) CustomTypeAndValue(name string) ([]byte, error)
 
 	elemType := generatorschema.GetElementType(g.ElementType)
 
-	listValue := generatorschema.NewCustomListValue(name, elemType)
+	listValue := generatorschema.NewCustomListValue(name, elemType, gen)
 
 	b, err = listValue.Render()
 
@@ -219,7 +240,7 @@ func (g GeneratorListAttribute) CustomTypeAndValue(name string) ([]byte, error)
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorListAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorListAttribute) ToFromFunctions(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
@@ -227,13 +248,19 @@ func (g GeneratorListAttribute) ToFromFunctions(name string) ([]byte, error) {
 	elementTypeType := generatorschema.GetElementType(g.ElementType)
 	elementTypeValue := generatorschema.GetElementValueType(g.ElementType)
 
-	elementFrom, err := generatorschema.GetElementFromFunc(g.ElementType)
+	elementTo, err := generatorschema.GetElementToFunc(g.ElementType, gen)
+
+	if err != nil {
+		return nil, err
+	}
+
+	elementFrom, err := generatorschema.GetElementFromFunc(g.ElementType, gen)
 
 	if err != nil {
 		return nil, err
 	}
 
-	toFrom := generatorschema.NewToFromList(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementFrom)
+	toFrom := generatorschema.NewToFromList(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementTo, elementFrom, gen)
 
 	b, err := toFrom.Render()
 
@@ -268,14 +295,24 @@ func (g GeneratorListAttribute) AttrValue(name generatorschema.FrameworkIdentifi
 	return "basetypes.ListValue"
 }
 
-func (g GeneratorListAttribute) To() (generatorschema.ToFromConversion, error) {
+func (g GeneratorListAttribute) To(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
 		}, nil
 	}
 
-	elementGoType, err := generatorschema.ElementTypeGoType(g.ElementType)
+	elementGoType, err := generatorschema.ElementTypeGoType(g.ElementType, gen)
+
+	if err != nil {
+		return generatorschema.ToFromConversion{}, err
+	}
+
+	toFunc, err := generatorschema.GetCollectionToFunc(specschema.ElementType{
+		List: &specschema.ListType{
+			ElementType: g.ElementType,
+		},
+	}, gen)
 
 	if err != nil {
 		return generatorschema.ToFromConversion{}, err
@@ -284,14 +321,16 @@ func (g GeneratorListAttribute) To() (generatorschema.ToFromConversion, error) {
 	return generatorschema.ToFromConversion{
 		CollectionType: generatorschema.CollectionFields{
 			GoType: fmt.Sprintf("[]%s", elementGoType),
+			ToFunc: toFunc,
 		},
 	}, nil
 }
 
-func (g GeneratorListAttribute) From() (generatorschema.ToFromConversion, error) {
+func (g GeneratorListAttribute) From(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
+			ConfigOnly:   g.WriteOnly.IsWriteOnly(),
 		}, nil
 	}
 
@@ -301,11 +340,42 @@ func (g GeneratorListAttribute) From() (generatorschema.ToFromConversion, error)
 		return generatorschema.ToFromConversion{}, err
 	}
 
+	fromFunc, err := generatorschema.GetCollectionFromFunc(specschema.ElementType{
+		List: &specschema.ListType{
+			ElementType: g.ElementType,
+		},
+	}, gen)
+
+	if err != nil {
+		return generatorschema.ToFromConversion{}, err
+	}
+
+	if !g.WriteOnly.IsWriteOnly() {
+		return generatorschema.ToFromConversion{
+			CollectionType: generatorschema.CollectionFields{
+				ElementType:   elementType,
+				TypeValueFrom: "types.ListValueFrom",
+				FromFunc:      fromFunc,
+			},
+		}, nil
+	}
+
+	// The Go type is required to construct a null value, as the value of a
+	// write-only attribute is never read from the associated external type.
+	elementGoType, err := generatorschema.ElementTypeGoType(g.ElementType, gen)
+
+	if err != nil {
+		return generatorschema.ToFromConversion{}, err
+	}
+
 	return generatorschema.ToFromConversion{
 		CollectionType: generatorschema.CollectionFields{
 			ElementType:   elementType,
+			GoType:        fmt.Sprintf("[]%s", elementGoType),
 			TypeValueFrom: "types.ListValueFrom",
+			FromFunc:      fromFunc,
 		},
+		ConfigOnly: true,
 	}, nil
 }
 
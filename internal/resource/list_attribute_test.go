@@ -13,6 +13,7 @@ import (
 	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
 
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
 	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
@@ -640,7 +641,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					"types.StringType",
 					"name",
 				),
-				Default: convert.NewDefaultCustom(&specschema.CustomDefault{
+				Default: convert.NewDefaultCollection(&specschema.CustomDefault{
 					Imports: []code.Import{
 						{
 							Path: "github.com/.../my_default",
@@ -1071,7 +1072,7 @@ func TestGeneratorListAttribute_Imports(t *testing.T) {
 		},
 		"default-custom-nil": {
 			input: GeneratorListAttribute{
-				Default: convert.NewDefaultCustom(nil),
+				Default: convert.NewDefaultCollection(nil),
 			},
 			expected: []code.Import{
 				{
@@ -1081,7 +1082,7 @@ func TestGeneratorListAttribute_Imports(t *testing.T) {
 		},
 		"default-custom-import-nil": {
 			input: GeneratorListAttribute{
-				Default: convert.NewDefaultCustom(
+				Default: convert.NewDefaultCollection(
 					&specschema.CustomDefault{},
 				),
 			},
@@ -1093,7 +1094,7 @@ func TestGeneratorListAttribute_Imports(t *testing.T) {
 		},
 		"default-custom-import-empty-string": {
 			input: GeneratorListAttribute{
-				Default: convert.NewDefaultCustom(
+				Default: convert.NewDefaultCollection(
 					&specschema.CustomDefault{
 						Imports: []code.Import{
 							{
@@ -1111,7 +1112,7 @@ func TestGeneratorListAttribute_Imports(t *testing.T) {
 		},
 		"default-custom-import": {
 			input: GeneratorListAttribute{
-				Default: convert.NewDefaultCustom(
+				Default: convert.NewDefaultCollection(
 					&specschema.CustomDefault{
 						Imports: []code.Import{
 							{
@@ -1280,6 +1281,32 @@ ElementType: types.BoolType,
 },`,
 		},
 
+		"element-type-float32": {
+			input: GeneratorListAttribute{
+				ElementTypeCollection: convert.NewElementType(specschema.ElementType{
+					Float64: extension.AsFloat32(&specschema.Float64Type{}),
+				}),
+			},
+			expected: `"list_attribute": schema.ListAttribute{
+ElementType: types.Float32Type,
+},`,
+		},
+
+		"element-type-float32-custom-type": {
+			input: GeneratorListAttribute{
+				ElementTypeCollection: convert.NewElementType(specschema.ElementType{
+					Float64: extension.AsFloat32(&specschema.Float64Type{
+						CustomType: &specschema.CustomType{
+							Type: "ratio.RatioType{}",
+						},
+					}),
+				}),
+			},
+			expected: `"list_attribute": schema.ListAttribute{
+ElementType: ratio.RatioType{},
+},`,
+		},
+
 		"element-type-list": {
 			input: GeneratorListAttribute{
 				ElementTypeCollection: convert.NewElementType(specschema.ElementType{
@@ -1616,6 +1643,18 @@ Computed: true,
 			expected: `"list_attribute": schema.ListAttribute{
 ElementType: types.StringType,
 Sensitive: true,
+},`,
+		},
+		"write-only": {
+			input: GeneratorListAttribute{
+				ElementTypeCollection: convert.NewElementType(specschema.ElementType{
+					String: &specschema.StringType{},
+				}),
+				WriteOnly: convert.NewWriteOnly(true),
+			},
+			expected: `"list_attribute": schema.ListAttribute{
+ElementType: types.StringType,
+WriteOnly: true,
 },`,
 		},
 
@@ -1695,7 +1734,7 @@ my_other_plan_modifier.Modify(),
 
 		"default-custom": {
 			input: GeneratorListAttribute{
-				Default: convert.NewDefaultCustom(&specschema.CustomDefault{
+				Default: convert.NewDefaultCollection(&specschema.CustomDefault{
 					SchemaDefinition: "my_list_default.Default()",
 				}),
 				ElementTypeCollection: convert.NewElementType(specschema.ElementType{
@@ -640,7 +640,7 @@ func TestGeneratorMapAttribute_New(t *testing.T) {
 					"types.StringType",
 					"name",
 				),
-				Default: convert.NewDefaultCustom(&specschema.CustomDefault{
+				Default: convert.NewDefaultCollection(&specschema.CustomDefault{
 					Imports: []code.Import{
 						{
 							Path: "github.com/.../my_default",
@@ -1071,7 +1071,7 @@ func TestGeneratorMapAttribute_Imports(t *testing.T) {
 		},
 		"default-custom-nil": {
 			input: GeneratorMapAttribute{
-				Default: convert.NewDefaultCustom(nil),
+				Default: convert.NewDefaultCollection(nil),
 			},
 			expected: []code.Import{
 				{
@@ -1081,7 +1081,7 @@ func TestGeneratorMapAttribute_Imports(t *testing.T) {
 		},
 		"default-custom-import-nil": {
 			input: GeneratorMapAttribute{
-				Default: convert.NewDefaultCustom(
+				Default: convert.NewDefaultCollection(
 					&specschema.CustomDefault{},
 				),
 			},
@@ -1093,7 +1093,7 @@ func TestGeneratorMapAttribute_Imports(t *testing.T) {
 		},
 		"default-custom-import-empty-string": {
 			input: GeneratorMapAttribute{
-				Default: convert.NewDefaultCustom(
+				Default: convert.NewDefaultCollection(
 					&specschema.CustomDefault{
 						Imports: []code.Import{
 							{
@@ -1111,7 +1111,7 @@ func TestGeneratorMapAttribute_Imports(t *testing.T) {
 		},
 		"default-custom-import": {
 			input: GeneratorMapAttribute{
-				Default: convert.NewDefaultCustom(
+				Default: convert.NewDefaultCollection(
 					&specschema.CustomDefault{
 						Imports: []code.Import{
 							{
@@ -1616,6 +1616,18 @@ Computed: true,
 			expected: `"map_attribute": schema.MapAttribute{
 ElementType: types.StringType,
 Sensitive: true,
+},`,
+		},
+		"write-only": {
+			input: GeneratorMapAttribute{
+				ElementTypeCollection: convert.NewElementType(specschema.ElementType{
+					String: &specschema.StringType{},
+				}),
+				WriteOnly: convert.NewWriteOnly(true),
+			},
+			expected: `"map_attribute": schema.MapAttribute{
+ElementType: types.StringType,
+WriteOnly: true,
 },`,
 		},
 
@@ -1695,7 +1707,7 @@ my_other_plan_modifier.Modify(),
 
 		"default-custom": {
 			input: GeneratorMapAttribute{
-				Default: convert.NewDefaultCustom(&specschema.CustomDefault{
+				Default: convert.NewDefaultCollection(&specschema.CustomDefault{
 					SchemaDefinition: "my_map_default.Default()",
 				}),
 				ElementTypeCollection: convert.NewElementType(specschema.ElementType{
@@ -4,6 +4,7 @@
 package resource
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -13,6 +14,7 @@ import (
 	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
 
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
 	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
@@ -44,9 +46,7 @@ func TestGeneratorMapNestedAttribute_New(t *testing.T) {
 					},
 				},
 			},
-			expectedError: fmt.Errorf("attribute type not defined: %+v", resource.Attribute{
-				Name: "empty",
-			}),
+			expectedError: errors.Join(diagnostic.NewError(errors.New(`attribute "empty" type not defined`), "attributes", 0)),
 		},
 		"attributes-bool": {
 			input: &resource.MapNestedAttribute{
@@ -1704,6 +1704,31 @@ Sensitive: true,
 },`,
 		},
 
+		"write-only": {
+			input: GeneratorMapNestedAttribute{
+				NestedAttributeObject: NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{},
+					nil,
+					convert.PlanModifiers{},
+					convert.Validators{},
+					"map_nested_attribute",
+				),
+				WriteOnly: convert.NewWriteOnly(true),
+			},
+			expected: `"map_nested_attribute": schema.MapNestedAttribute{
+NestedObject: schema.NestedAttributeObject{
+Attributes: map[string]schema.Attribute{
+},
+CustomType: MapNestedAttributeType{
+ObjectType: types.ObjectType{
+AttrTypes: MapNestedAttributeValue{}.AttributeTypes(ctx),
+},
+},
+},
+WriteOnly: true,
+},`,
+		},
+
 		"description": {
 			input: GeneratorMapNestedAttribute{
 				Description: convert.NewDescription(pointer("description")),
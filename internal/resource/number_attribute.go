@@ -24,6 +24,7 @@ type GeneratorNumberAttribute struct {
 	PlanModifiers            convert.PlanModifiers
 	Sensitive                convert.Sensitive
 	Validators               convert.Validators
+	WriteOnly                convert.WriteOnly
 }
 
 func NewGeneratorNumberAttribute(name string, a *resource.NumberAttribute) (GeneratorNumberAttribute, error) {
@@ -64,6 +65,20 @@ func (g GeneratorNumberAttribute) GeneratorSchemaType() generatorschema.Type {
 	return generatorschema.GeneratorNumberAttribute
 }
 
+func (g GeneratorNumberAttribute) Documentation() generatorschema.Documentation {
+	return generatorschema.Documentation{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		ConflictsWith:      g.Validators.ConflictsWith(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Example:            g.Validators.Example(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		WriteOnly:          g.WriteOnly.IsWriteOnly(),
+	}
+}
+
 func (g GeneratorNumberAttribute) Imports() *generatorschema.Imports {
 	imports := generatorschema.NewImports()
 
@@ -123,7 +138,11 @@ func (g GeneratorNumberAttribute) Equal(ga generatorschema.GeneratorAttribute) b
 		return false
 	}
 
-	return g.Validators.Equal(h.Validators)
+	if !g.Validators.Equal(h.Validators) {
+		return false
+	}
+
+	return g.WriteOnly.Equal(h.WriteOnly)
 }
 
 func (g GeneratorNumberAttribute) Schema(name generatorschema.FrameworkIdentifier) (string, error) {
@@ -133,6 +152,7 @@ func (g GeneratorNumberAttribute) Schema(name generatorschema.FrameworkIdentifie
 	b.Write(g.CustomType.Schema())
 	b.Write(g.ComputedOptionalRequired.Schema())
 	b.Write(g.Sensitive.Schema())
+	b.Write(g.WriteOnly.Schema())
 	b.Write(g.Description.Schema())
 	b.Write(g.DeprecationMessage.Schema())
 	b.Write(g.PlanModifiers.Schema())
@@ -159,14 +179,14 @@ func (g GeneratorNumberAttribute) ModelField(name generatorschema.FrameworkIdent
 	return field, nil
 }
 
-func (g GeneratorNumberAttribute) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorNumberAttribute) CustomTypeAndValue(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	numberType := generatorschema.NewCustomNumberType(name)
+	numberType := generatorschema.NewCustomNumberType(name, gen)
 
 	b, err := numberType.Render()
 
@@ -176,7 +196,7 @@ func (g GeneratorNumberAttribute) CustomTypeAndValue(name string) ([]byte, error
 
 	buf.Write(b)
 
-	numberValue := generatorschema.NewCustomNumberValue(name)
+	numberValue := generatorschema.NewCustomNumberValue(name, gen)
 
 	b, err = numberValue.Render()
 
@@ -189,12 +209,12 @@ func (g GeneratorNumberAttribute) CustomTypeAndValue(name string) ([]byte, error
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorNumberAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorNumberAttribute) ToFromFunctions(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	toFrom := generatorschema.NewToFromNumber(name, g.AssociatedExternalType)
+	toFrom := generatorschema.NewToFromNumber(name, g.AssociatedExternalType, gen)
 
 	b, err := toFrom.Render()
 
@@ -223,7 +243,7 @@ func (g GeneratorNumberAttribute) AttrValue(name generatorschema.FrameworkIdenti
 	return "basetypes.NumberValue"
 }
 
-func (g GeneratorNumberAttribute) To() (generatorschema.ToFromConversion, error) {
+func (g GeneratorNumberAttribute) To(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
@@ -235,14 +255,16 @@ func (g GeneratorNumberAttribute) To() (generatorschema.ToFromConversion, error)
 	}, nil
 }
 
-func (g GeneratorNumberAttribute) From() (generatorschema.ToFromConversion, error) {
+func (g GeneratorNumberAttribute) From(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
+			ConfigOnly:   g.WriteOnly.IsWriteOnly(),
 		}, nil
 	}
 
 	return generatorschema.ToFromConversion{
-		Default: "NumberValue",
+		Default:    "NumberValue",
+		ConfigOnly: g.WriteOnly.IsWriteOnly(),
 	}, nil
 }
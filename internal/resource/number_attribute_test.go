@@ -699,6 +699,14 @@ Computed: true,
 			},
 			expected: `"number_attribute": schema.NumberAttribute{
 Sensitive: true,
+},`,
+		},
+		"write-only": {
+			input: GeneratorNumberAttribute{
+				WriteOnly: convert.NewWriteOnly(true),
+			},
+			expected: `"number_attribute": schema.NumberAttribute{
+WriteOnly: true,
 },`,
 		},
 
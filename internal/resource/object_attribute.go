@@ -22,12 +22,13 @@ type GeneratorObjectAttribute struct {
 	AttributeTypesObject     convert.ObjectAttributeTypes
 	ComputedOptionalRequired convert.ComputedOptionalRequired
 	CustomType               convert.CustomTypeObject
-	Default                  convert.DefaultCustom
+	Default                  convert.DefaultObject
 	DeprecationMessage       convert.DeprecationMessage
 	Description              convert.Description
 	PlanModifiers            convert.PlanModifiers
 	Sensitive                convert.Sensitive
 	Validators               convert.Validators
+	WriteOnly                convert.WriteOnly
 }
 
 func NewGeneratorObjectAttribute(name string, a *resource.ObjectAttribute) (GeneratorObjectAttribute, error) {
@@ -39,7 +40,7 @@ func NewGeneratorObjectAttribute(name string, a *resource.ObjectAttribute) (Gene
 
 	cto := convert.NewCustomTypeObject(a.CustomType, a.AssociatedExternalType, name)
 
-	dc := convert.NewDefaultCustom(a.Default.CustomDefault())
+	dc := convert.NewDefaultObject(a.Default.CustomDefault())
 
 	d := convert.NewDescription(a.Description)
 
@@ -72,6 +73,21 @@ func (g GeneratorObjectAttribute) GeneratorSchemaType() generatorschema.Type {
 	return generatorschema.GeneratorObjectAttribute
 }
 
+func (g GeneratorObjectAttribute) Documentation() generatorschema.Documentation {
+	return generatorschema.Documentation{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		ConflictsWith:      g.Validators.ConflictsWith(),
+		Default:            g.Default.StaticValue(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Example:            g.Validators.Example(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		WriteOnly:          g.WriteOnly.IsWriteOnly(),
+	}
+}
+
 func (g GeneratorObjectAttribute) AttrTypes() specschema.ObjectAttributeTypes {
 	return g.AttributeTypes
 }
@@ -153,7 +169,11 @@ func (g GeneratorObjectAttribute) Equal(ga generatorschema.GeneratorAttribute) b
 		return false
 	}
 
-	return g.Validators.Equal(h.Validators)
+	if !g.Validators.Equal(h.Validators) {
+		return false
+	}
+
+	return g.WriteOnly.Equal(h.WriteOnly)
 }
 
 func (g GeneratorObjectAttribute) Schema(name generatorschema.FrameworkIdentifier) (string, error) {
@@ -168,6 +188,7 @@ func (g GeneratorObjectAttribute) Schema(name generatorschema.FrameworkIdentifie
 	}
 	b.Write(g.ComputedOptionalRequired.Schema())
 	b.Write(g.Sensitive.Schema())
+	b.Write(g.WriteOnly.Schema())
 	b.Write(g.Description.Schema())
 	b.Write(g.DeprecationMessage.Schema())
 	b.Write(g.PlanModifiers.Schema())
@@ -194,14 +215,14 @@ func (g GeneratorObjectAttribute) ModelField(name generatorschema.FrameworkIdent
 	return field, nil
 }
 
-func (g GeneratorObjectAttribute) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorObjectAttribute) CustomTypeAndValue(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	objectType := generatorschema.NewCustomObjectType(name)
+	objectType := generatorschema.NewCustomObjectType(name, gen)
 
 	b, err := objectType.Render()
 
@@ -213,7 +234,7 @@ func (g GeneratorObjectAttribute) CustomTypeAndValue(name string) ([]byte, error
 
 	attrTypes := generatorschema.GetAttrTypes(g.AttrTypes())
 
-	objectValue := generatorschema.NewCustomObjectValue(name, attrTypes)
+	objectValue := generatorschema.NewCustomObjectValue(name, attrTypes, gen)
 
 	b, err = objectValue.Render()
 
@@ -226,24 +247,24 @@ func (g GeneratorObjectAttribute) CustomTypeAndValue(name string) ([]byte, error
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorObjectAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorObjectAttribute) ToFromFunctions(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	attrTypesToFuncs, err := generatorschema.GetAttrTypesToFuncs(g.AttributeTypes)
+	attrTypesToFuncs, err := generatorschema.GetAttrTypesToFuncs(g.AttributeTypes, gen)
 
 	if err != nil {
 		return nil, err
 	}
 
-	attrTypesFromFuncs, err := generatorschema.GetAttrTypesFromFuncs(g.AttributeTypes)
+	attrTypesFromFuncs, err := generatorschema.GetAttrTypesFromFuncs(g.AttributeTypes, gen)
 
 	if err != nil {
 		return nil, err
 	}
 
-	toFrom := generatorschema.NewToFromObject(name, g.AssociatedExternalType, attrTypesToFuncs, attrTypesFromFuncs)
+	toFrom := generatorschema.NewToFromObject(name, g.AssociatedExternalType, attrTypesToFuncs, attrTypesFromFuncs, gen)
 
 	b, err := toFrom.Render()
 
@@ -278,7 +299,7 @@ func (g GeneratorObjectAttribute) AttrValue(name generatorschema.FrameworkIdenti
 	return "basetypes.ObjectValue"
 }
 
-func (g GeneratorObjectAttribute) To() (generatorschema.ToFromConversion, error) {
+func (g GeneratorObjectAttribute) To(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
@@ -288,7 +309,7 @@ func (g GeneratorObjectAttribute) To() (generatorschema.ToFromConversion, error)
 	objectFields := make(map[generatorschema.FrameworkIdentifier]generatorschema.ObjectField, len(g.AttributeTypes))
 
 	for _, v := range g.AttributeTypes {
-		objField, err := generatorschema.ObjectFieldTo(v)
+		objField, err := generatorschema.ObjectFieldTo(v, gen)
 
 		if err != nil {
 			return generatorschema.ToFromConversion{}, err
@@ -302,17 +323,18 @@ func (g GeneratorObjectAttribute) To() (generatorschema.ToFromConversion, error)
 	}, nil
 }
 
-func (g GeneratorObjectAttribute) From() (generatorschema.ToFromConversion, error) {
+func (g GeneratorObjectAttribute) From(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
+			ConfigOnly:   g.WriteOnly.IsWriteOnly(),
 		}, nil
 	}
 
 	objectFields := make(map[generatorschema.FrameworkIdentifier]generatorschema.ObjectField, len(g.AttributeTypes))
 
 	for _, v := range g.AttributeTypes {
-		objField, err := generatorschema.ObjectFieldFrom(v)
+		objField, err := generatorschema.ObjectFieldFrom(v, gen)
 
 		if err != nil {
 			return generatorschema.ToFromConversion{}, err
@@ -323,5 +345,6 @@ func (g GeneratorObjectAttribute) From() (generatorschema.ToFromConversion, erro
 
 	return generatorschema.ToFromConversion{
 		ObjectType: objectFields,
+		ConfigOnly: g.WriteOnly.IsWriteOnly(),
 	}, nil
 }
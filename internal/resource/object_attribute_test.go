@@ -460,7 +460,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorObjectAttribute{
 				CustomType: convert.NewCustomTypeObject(nil, nil, "name"),
-				Default: convert.NewDefaultCustom(&specschema.CustomDefault{
+				Default: convert.NewDefaultObject(&specschema.CustomDefault{
 					Imports: []code.Import{
 						{
 							Path: "github.com/.../my_default",
@@ -853,7 +853,7 @@ func TestGeneratorObjectAttribute_Imports(t *testing.T) {
 		},
 		"default-custom-nil": {
 			input: GeneratorObjectAttribute{
-				Default: convert.NewDefaultCustom(nil),
+				Default: convert.NewDefaultObject(nil),
 			},
 			expected: []code.Import{
 				{
@@ -863,7 +863,7 @@ func TestGeneratorObjectAttribute_Imports(t *testing.T) {
 		},
 		"default-custom-import-nil": {
 			input: GeneratorObjectAttribute{
-				Default: convert.NewDefaultCustom(&specschema.CustomDefault{}),
+				Default: convert.NewDefaultObject(&specschema.CustomDefault{}),
 			},
 			expected: []code.Import{
 				{
@@ -873,7 +873,7 @@ func TestGeneratorObjectAttribute_Imports(t *testing.T) {
 		},
 		"default-custom-import-empty-string": {
 			input: GeneratorObjectAttribute{
-				Default: convert.NewDefaultCustom(&specschema.CustomDefault{
+				Default: convert.NewDefaultObject(&specschema.CustomDefault{
 					Imports: []code.Import{
 						{
 							Path: "",
@@ -889,7 +889,7 @@ func TestGeneratorObjectAttribute_Imports(t *testing.T) {
 		},
 		"default-custom-import": {
 			input: GeneratorObjectAttribute{
-				Default: convert.NewDefaultCustom(&specschema.CustomDefault{
+				Default: convert.NewDefaultObject(&specschema.CustomDefault{
 					Imports: []code.Import{
 						{
 							Path: "github.com/myproject/mydefaults/default",
@@ -1544,6 +1544,24 @@ AttributeTypes: map[string]attr.Type{
 "str": types.StringType,
 },
 Sensitive: true,
+},`,
+		},
+		"write-only": {
+			input: GeneratorObjectAttribute{
+				AttributeTypesObject: convert.NewObjectAttributeTypes(specschema.ObjectAttributeTypes{
+					{
+						Name:   "str",
+						String: &specschema.StringType{},
+					},
+				}),
+				WriteOnly:  convert.NewWriteOnly(true),
+				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
+			},
+			expected: `"object_attribute": schema.ObjectAttribute{
+AttributeTypes: map[string]attr.Type{
+"str": types.StringType,
+},
+WriteOnly: true,
 },`,
 		},
 
@@ -1651,7 +1669,7 @@ my_other_plan_modifier.Modify(),
 						String: &specschema.StringType{},
 					},
 				}),
-				Default: convert.NewDefaultCustom(&specschema.CustomDefault{
+				Default: convert.NewDefaultObject(&specschema.CustomDefault{
 					SchemaDefinition: "my_object_default.Default()",
 				}),
 			},
@@ -19,7 +19,7 @@ type GeneratorSetAttribute struct {
 	AssociatedExternalType   *generatorschema.AssocExtType
 	ComputedOptionalRequired convert.ComputedOptionalRequired
 	CustomType               convert.CustomTypeCollection
-	Default                  convert.DefaultCustom
+	Default                  convert.DefaultCollection
 	DeprecationMessage       convert.DeprecationMessage
 	Description              convert.Description
 	ElementType              specschema.ElementType
@@ -40,7 +40,7 @@ func NewGeneratorSetAttribute(name string, a *resource.SetAttribute) (GeneratorS
 
 	ctc := convert.NewCustomTypeCollection(a.CustomType, a.AssociatedExternalType, convert.CustomCollectionTypeSet, string(et.ElementType()), name)
 
-	dc := convert.NewDefaultCustom(a.Default.CustomDefault())
+	dc := convert.NewDefaultCollection(a.Default.CustomDefault())
 
 	d := convert.NewDescription(a.Description)
 
@@ -71,6 +71,20 @@ func (g GeneratorSetAttribute) GeneratorSchemaType() generatorschema.Type {
 	return generatorschema.GeneratorSetAttribute
 }
 
+func (g GeneratorSetAttribute) Documentation() generatorschema.Documentation {
+	return generatorschema.Documentation{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		ConflictsWith:      g.Validators.ConflictsWith(),
+		Default:            g.Default.StaticValue(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Example:            g.Validators.Example(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+	}
+}
+
 func (g GeneratorSetAttribute) ElemType() specschema.ElementType {
 	return g.ElementType
 }
@@ -187,14 +201,14 @@ func (g GeneratorSetAttribute) ModelField(name generatorschema.FrameworkIdentifi
 	return field, nil
 }
 
-func (g GeneratorSetAttribute) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorSetAttribute) CustomTypeAndValue(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	listType := generatorschema.NewCustomSetType(name)
+	listType := generatorschema.NewCustomSetType(name, gen)
 
 	b, err := listType.Render()
 
@@ -206,7 +220,7 @@ func (g GeneratorSetAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 
 	elemType := generatorschema.GetElementType(g.ElementType)
 
-	listValue := generatorschema.NewCustomSetValue(name, elemType)
+	listValue := generatorschema.NewCustomSetValue(name, elemType, gen)
 
 	b, err = listValue.Render()
 
@@ -219,7 +233,7 @@ func (g GeneratorSetAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorSetAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorSetAttribute) ToFromFunctions(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
@@ -227,13 +241,19 @@ func (g GeneratorSetAttribute) ToFromFunctions(name string) ([]byte, error) {
 	elementTypeType := generatorschema.GetElementType(g.ElementType)
 	elementTypeValue := generatorschema.GetElementValueType(g.ElementType)
 
-	elementFrom, err := generatorschema.GetElementFromFunc(g.ElementType)
+	elementTo, err := generatorschema.GetElementToFunc(g.ElementType, gen)
+
+	if err != nil {
+		return nil, err
+	}
+
+	elementFrom, err := generatorschema.GetElementFromFunc(g.ElementType, gen)
 
 	if err != nil {
 		return nil, err
 	}
 
-	toFrom := generatorschema.NewToFromSet(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementFrom)
+	toFrom := generatorschema.NewToFromSet(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementTo, elementFrom, gen)
 
 	b, err := toFrom.Render()
 
@@ -268,14 +288,24 @@ func (g GeneratorSetAttribute) AttrValue(name generatorschema.FrameworkIdentifie
 	return "basetypes.SetValue"
 }
 
-func (g GeneratorSetAttribute) To() (generatorschema.ToFromConversion, error) {
+func (g GeneratorSetAttribute) To(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
 		}, nil
 	}
 
-	elementGoType, err := generatorschema.ElementTypeGoType(g.ElementType)
+	elementGoType, err := generatorschema.ElementTypeGoType(g.ElementType, gen)
+
+	if err != nil {
+		return generatorschema.ToFromConversion{}, err
+	}
+
+	toFunc, err := generatorschema.GetCollectionToFunc(specschema.ElementType{
+		Set: &specschema.SetType{
+			ElementType: g.ElementType,
+		},
+	}, gen)
 
 	if err != nil {
 		return generatorschema.ToFromConversion{}, err
@@ -284,11 +314,12 @@ func (g GeneratorSetAttribute) To() (generatorschema.ToFromConversion, error) {
 	return generatorschema.ToFromConversion{
 		CollectionType: generatorschema.CollectionFields{
 			GoType: fmt.Sprintf("[]%s", elementGoType),
+			ToFunc: toFunc,
 		},
 	}, nil
 }
 
-func (g GeneratorSetAttribute) From() (generatorschema.ToFromConversion, error) {
+func (g GeneratorSetAttribute) From(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
@@ -301,10 +332,21 @@ func (g GeneratorSetAttribute) From() (generatorschema.ToFromConversion, error)
 		return generatorschema.ToFromConversion{}, err
 	}
 
+	fromFunc, err := generatorschema.GetCollectionFromFunc(specschema.ElementType{
+		Set: &specschema.SetType{
+			ElementType: g.ElementType,
+		},
+	}, gen)
+
+	if err != nil {
+		return generatorschema.ToFromConversion{}, err
+	}
+
 	return generatorschema.ToFromConversion{
 		CollectionType: generatorschema.CollectionFields{
 			ElementType:   elementType,
 			TypeValueFrom: "types.SetValueFrom",
+			FromFunc:      fromFunc,
 		},
 	}, nil
 }
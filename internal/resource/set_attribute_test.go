@@ -640,7 +640,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					"types.StringType",
 					"name",
 				),
-				Default: convert.NewDefaultCustom(&specschema.CustomDefault{
+				Default: convert.NewDefaultCollection(&specschema.CustomDefault{
 					Imports: []code.Import{
 						{
 							Path: "github.com/.../my_default",
@@ -1071,7 +1071,7 @@ func TestGeneratorSetAttribute_Imports(t *testing.T) {
 		},
 		"default-custom-nil": {
 			input: GeneratorSetAttribute{
-				Default: convert.NewDefaultCustom(nil),
+				Default: convert.NewDefaultCollection(nil),
 			},
 			expected: []code.Import{
 				{
@@ -1081,7 +1081,7 @@ func TestGeneratorSetAttribute_Imports(t *testing.T) {
 		},
 		"default-custom-import-nil": {
 			input: GeneratorSetAttribute{
-				Default: convert.NewDefaultCustom(
+				Default: convert.NewDefaultCollection(
 					&specschema.CustomDefault{},
 				),
 			},
@@ -1093,7 +1093,7 @@ func TestGeneratorSetAttribute_Imports(t *testing.T) {
 		},
 		"default-custom-import-empty-string": {
 			input: GeneratorSetAttribute{
-				Default: convert.NewDefaultCustom(
+				Default: convert.NewDefaultCollection(
 					&specschema.CustomDefault{
 						Imports: []code.Import{
 							{
@@ -1111,7 +1111,7 @@ func TestGeneratorSetAttribute_Imports(t *testing.T) {
 		},
 		"default-custom-import": {
 			input: GeneratorSetAttribute{
-				Default: convert.NewDefaultCustom(
+				Default: convert.NewDefaultCollection(
 					&specschema.CustomDefault{
 						Imports: []code.Import{
 							{
@@ -1695,7 +1695,7 @@ my_other_plan_modifier.Modify(),
 
 		"default-custom": {
 			input: GeneratorSetAttribute{
-				Default: convert.NewDefaultCustom(&specschema.CustomDefault{
+				Default: convert.NewDefaultCollection(&specschema.CustomDefault{
 					SchemaDefinition: "my_set_default.Default()",
 				}),
 				ElementTypeCollection: convert.NewElementType(specschema.ElementType{
@@ -4,6 +4,7 @@
 package resource
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -13,6 +14,7 @@ import (
 	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
 
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
 	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
@@ -36,9 +38,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 					},
 				},
 			},
-			expectedError: fmt.Errorf("attribute type not defined: %+v", resource.Attribute{
-				Name: "empty",
-			}),
+			expectedError: errors.Join(diagnostic.NewError(errors.New(`attribute "empty" type not defined`), "attributes", 0)),
 		},
 		"attributes-bool": {
 			input: &resource.SingleNestedAttribute{
@@ -1109,6 +1109,23 @@ Sensitive: true,
 },`,
 		},
 
+		"write-only": {
+			input: GeneratorSingleNestedAttribute{
+				CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_attribute"),
+				WriteOnly:  convert.NewWriteOnly(true),
+			},
+			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
+Attributes: map[string]schema.Attribute{
+},
+CustomType: SingleNestedAttributeType{
+ObjectType: types.ObjectType{
+AttrTypes: SingleNestedAttributeValue{}.AttributeTypes(ctx),
+},
+},
+WriteOnly: true,
+},`,
+		},
+
 		"description": {
 			input: GeneratorSingleNestedAttribute{
 				CustomType:  convert.NewCustomTypeNestedObject(nil, "single_nested_attribute"),
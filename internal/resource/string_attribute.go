@@ -24,6 +24,7 @@ type GeneratorStringAttribute struct {
 	PlanModifiers            convert.PlanModifiers
 	Sensitive                convert.Sensitive
 	Validators               convert.Validators
+	WriteOnly                convert.WriteOnly
 }
 
 func NewGeneratorStringAttribute(name string, a *resource.StringAttribute) (GeneratorStringAttribute, error) {
@@ -64,6 +65,21 @@ func (g GeneratorStringAttribute) GeneratorSchemaType() generatorschema.Type {
 	return generatorschema.GeneratorStringAttribute
 }
 
+func (g GeneratorStringAttribute) Documentation() generatorschema.Documentation {
+	return generatorschema.Documentation{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		ConflictsWith:      g.Validators.ConflictsWith(),
+		Default:            g.Default.StaticValue(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		Description:        g.Description.Description(),
+		Example:            g.Validators.Example(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		WriteOnly:          g.WriteOnly.IsWriteOnly(),
+	}
+}
+
 func (g GeneratorStringAttribute) Imports() *generatorschema.Imports {
 	imports := generatorschema.NewImports()
 
@@ -123,7 +139,11 @@ func (g GeneratorStringAttribute) Equal(ga generatorschema.GeneratorAttribute) b
 		return false
 	}
 
-	return g.Validators.Equal(h.Validators)
+	if !g.Validators.Equal(h.Validators) {
+		return false
+	}
+
+	return g.WriteOnly.Equal(h.WriteOnly)
 }
 
 func (g GeneratorStringAttribute) Schema(name generatorschema.FrameworkIdentifier) (string, error) {
@@ -133,6 +153,7 @@ func (g GeneratorStringAttribute) Schema(name generatorschema.FrameworkIdentifie
 	b.Write(g.CustomType.Schema())
 	b.Write(g.ComputedOptionalRequired.Schema())
 	b.Write(g.Sensitive.Schema())
+	b.Write(g.WriteOnly.Schema())
 	b.Write(g.Description.Schema())
 	b.Write(g.DeprecationMessage.Schema())
 	b.Write(g.PlanModifiers.Schema())
@@ -159,14 +180,14 @@ func (g GeneratorStringAttribute) ModelField(name generatorschema.FrameworkIdent
 	return field, nil
 }
 
-func (g GeneratorStringAttribute) CustomTypeAndValue(name string) ([]byte, error) {
+func (g GeneratorStringAttribute) CustomTypeAndValue(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
 	var buf bytes.Buffer
 
-	stringType := generatorschema.NewCustomStringType(name)
+	stringType := generatorschema.NewCustomStringType(name, gen)
 
 	b, err := stringType.Render()
 
@@ -176,7 +197,7 @@ func (g GeneratorStringAttribute) CustomTypeAndValue(name string) ([]byte, error
 
 	buf.Write(b)
 
-	stringValue := generatorschema.NewCustomStringValue(name)
+	stringValue := generatorschema.NewCustomStringValue(name, gen)
 
 	b, err = stringValue.Render()
 
@@ -189,12 +210,12 @@ func (g GeneratorStringAttribute) CustomTypeAndValue(name string) ([]byte, error
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorStringAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorStringAttribute) ToFromFunctions(name string, gen *generatorschema.Generator) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	toFrom := generatorschema.NewToFromString(name, g.AssociatedExternalType)
+	toFrom := generatorschema.NewToFromString(name, g.AssociatedExternalType, gen)
 
 	b, err := toFrom.Render()
 
@@ -223,7 +244,7 @@ func (g GeneratorStringAttribute) AttrValue(name generatorschema.FrameworkIdenti
 	return "basetypes.StringValue"
 }
 
-func (g GeneratorStringAttribute) To() (generatorschema.ToFromConversion, error) {
+func (g GeneratorStringAttribute) To(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
@@ -235,14 +256,16 @@ func (g GeneratorStringAttribute) To() (generatorschema.ToFromConversion, error)
 	}, nil
 }
 
-func (g GeneratorStringAttribute) From() (generatorschema.ToFromConversion, error) {
+func (g GeneratorStringAttribute) From(gen *generatorschema.Generator) (generatorschema.ToFromConversion, error) {
 	if g.AssociatedExternalType != nil {
 		return generatorschema.ToFromConversion{
 			AssocExtType: g.AssociatedExternalType,
+			ConfigOnly:   g.WriteOnly.IsWriteOnly(),
 		}, nil
 	}
 
 	return generatorschema.ToFromConversion{
-		Default: "StringPointerValue",
+		Default:    "StringPointerValue",
+		ConfigOnly: g.WriteOnly.IsWriteOnly(),
 	}, nil
 }
@@ -734,6 +734,14 @@ Computed: true,
 			},
 			expected: `"string_attribute": schema.StringAttribute{
 Sensitive: true,
+},`,
+		},
+		"write-only": {
+			input: GeneratorStringAttribute{
+				WriteOnly: convert.NewWriteOnly(true),
+			},
+			expected: `"string_attribute": schema.StringAttribute{
+WriteOnly: true,
 },`,
 		},
 
@@ -922,3 +930,74 @@ func TestGeneratorStringAttribute_ModelField(t *testing.T) {
 		})
 	}
 }
+
+func TestGeneratorStringAttribute_Documentation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    GeneratorStringAttribute
+		expected generatorschema.Documentation
+	}{
+		"default": {},
+		"computed-optional": {
+			input: GeneratorStringAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.ComputedOptional),
+			},
+			expected: generatorschema.Documentation{
+				Computed: true,
+				Optional: true,
+			},
+		},
+		"required": {
+			input: GeneratorStringAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
+			},
+			expected: generatorschema.Documentation{
+				Required: true,
+			},
+		},
+		"description-deprecation-message": {
+			input: GeneratorStringAttribute{
+				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
+				Description:        convert.NewDescription(pointer("description")),
+			},
+			expected: generatorschema.Documentation{
+				DeprecationMessage: "deprecation message",
+				Description:        "description",
+			},
+		},
+		"default-static": {
+			input: GeneratorStringAttribute{
+				Default: convert.NewDefaultString(&specschema.StringDefault{
+					Static: pointer("${var.example}"),
+				}),
+			},
+			expected: generatorschema.Documentation{
+				Default: `"$${var.example}"`,
+			},
+		},
+		"sensitive-write-only": {
+			input: GeneratorStringAttribute{
+				Sensitive: convert.NewSensitive(pointer(true)),
+				WriteOnly: convert.NewWriteOnly(true),
+			},
+			expected: generatorschema.Documentation{
+				Sensitive: true,
+				WriteOnly: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.Documentation()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
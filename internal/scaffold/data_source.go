@@ -10,13 +10,25 @@ import (
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
 
-// DataSourceBytes will create scaffolding Go code bytes for a Terraform Plugin Framework data source
-func DataSourceBytes(dataSourceIdentifier schema.FrameworkIdentifier, packageName string) ([]byte, error) {
+// DataSourceBytes will create scaffolding Go code bytes for a Terraform Plugin Framework data source.
+// If generated is not nil, the data source will use the schema and model generated from the specification.
+func DataSourceBytes(dataSourceIdentifier schema.FrameworkIdentifier, packageName string, generated *Generated) ([]byte, error) {
 	t, err := template.New("data_source_scaffold").Parse(dataSourceScaffoldGoTemplate)
 	if err != nil {
 		return nil, err
 	}
 
+	t, err = t.Parse(externalTypesGoTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	modelType := dataSourceIdentifier.ToCamelCase() + "DataSourceModel"
+
+	if generated != nil {
+		modelType = generated.Qualifier() + dataSourceIdentifier.ToPascalCase() + "Model"
+	}
+
 	var buf bytes.Buffer
 
 	templateData := struct {
@@ -24,11 +36,15 @@ func DataSourceBytes(dataSourceIdentifier schema.FrameworkIdentifier, packageNam
 		NameSnake   string
 		NameCamel   string
 		NamePascal  string
+		Generated   *Generated
+		ModelType   string
 	}{
 		PackageName: packageName,
 		NameSnake:   string(dataSourceIdentifier),
 		NameCamel:   dataSourceIdentifier.ToCamelCase(),
 		NamePascal:  dataSourceIdentifier.ToPascalCase(),
+		Generated:   generated,
+		ModelType:   modelType,
 	}
 
 	err = t.Execute(&buf, templateData)
@@ -15,3 +15,9 @@ var dataSourceScaffoldGoTemplate string
 
 //go:embed templates/provider_scaffold.gotmpl
 var providerScaffoldGoTemplate string
+
+//go:embed templates/external_types.gotmpl
+var externalTypesGoTemplate string
+
+//go:embed templates/provider_registry.gotmpl
+var providerRegistryGoTemplate string
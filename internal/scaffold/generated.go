@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold
+
+import (
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// Generated describes the code generated from a specification for a data
+// source, provider or resource. Scaffolding code which is given a Generated
+// calls the generated schema function and uses the generated model, rather
+// than defining its own.
+type Generated struct {
+	// ImportPath is the Go import path of the package containing the
+	// generated code. If empty, the generated code is expected to be in
+	// the same package as the scaffolding code.
+	ImportPath string
+
+	// PackageName is the name of the package containing the generated code,
+	// which need not match the last element of ImportPath, as the generate
+	// command sets it with the --package flag. If empty, the last element of
+	// ImportPath is used.
+	PackageName string
+
+	// Identity is true if a resource identity schema and model have been
+	// generated.
+	Identity bool
+
+	// ConfigValidators is true if a function returning the config validators
+	// of a data source or resource has been generated.
+	ConfigValidators bool
+
+	// ExternalTypes contains the attributes and blocks of the schema which
+	// have an associated external type, and therefore generated To and From
+	// functions.
+	ExternalTypes []ExternalType
+}
+
+// ExternalType is an attribute or block of the schema which has an
+// associated external type.
+type ExternalType struct {
+	Name         schema.FrameworkIdentifier
+	AssocExtType *schema.AssocExtType
+
+	// Collection is "list", "map" or "set" for nested attributes and blocks
+	// which are collections of objects, and is empty for single nested
+	// attributes and blocks.
+	Collection string
+}
+
+// NewGenerated returns a Generated for the schema, with the generated code
+// in the package named packageName at importPath.
+func NewGenerated(s schema.GeneratorSchema, importPath, packageName string) *Generated {
+	g := &Generated{
+		ImportPath:       importPath,
+		PackageName:      packageName,
+		Identity:         len(s.Identity) > 0,
+		ConfigValidators: s.ConfigValidators != nil && len(s.ConfigValidators.Validators) > 0,
+	}
+
+	for _, k := range s.Attributes.SortedKeys() {
+		g.addExternalType(k, s.Attributes[k])
+	}
+
+	for _, k := range s.Blocks.SortedKeys() {
+		g.addExternalType(k, s.Blocks[k])
+	}
+
+	return g
+}
+
+func (g *Generated) addExternalType(name string, v any) {
+	a, ok := v.(schema.AssociatedExternalTyped)
+
+	if !ok || a.AssocExtType() == nil {
+		return
+	}
+
+	e := ExternalType{
+		Name:         schema.FrameworkIdentifier(name),
+		AssocExtType: a.AssocExtType(),
+	}
+
+	if t, ok := v.(interface{ GeneratorSchemaType() schema.Type }); ok {
+		switch t.GeneratorSchemaType() {
+		case schema.GeneratorListNestedAttribute, schema.GeneratorListNestedBlock:
+			e.Collection = "list"
+		case schema.GeneratorMapNestedAttribute:
+			e.Collection = "map"
+		case schema.GeneratorSetNestedAttribute, schema.GeneratorSetNestedBlock:
+			e.Collection = "set"
+		}
+	}
+
+	g.ExternalTypes = append(g.ExternalTypes, e)
+}
+
+// Alias returns the name with which the package containing the generated
+// code is imported. The import is always given this name explicitly, so that
+// the scaffolded code compiles whatever the name of the generated package.
+func (g *Generated) Alias() string {
+	if g == nil || g.ImportPath == "" {
+		return ""
+	}
+
+	if g.PackageName != "" {
+		return g.PackageName
+	}
+
+	return path.Base(g.ImportPath)
+}
+
+// Qualifier returns the prefix for identifiers declared in the generated
+// code, which is the import alias of the generated code, if it is in a
+// different package.
+func (g *Generated) Qualifier() string {
+	if g == nil || g.ImportPath == "" {
+		return ""
+	}
+
+	return g.Alias() + "."
+}
+
+// Imports returns the import specs which are required by the associated
+// external types, in path order.
+func (g *Generated) Imports() []string {
+	if g == nil {
+		return nil
+	}
+
+	specs := make(map[string]string)
+
+	for _, e := range g.ExternalTypes {
+		i := e.AssocExtType.Import
+
+		if i == nil || i.Path == "" {
+			continue
+		}
+
+		spec := fmt.Sprintf("%q", i.Path)
+
+		if i.Alias != nil {
+			spec = fmt.Sprintf("%s %q", *i.Alias, i.Path)
+		}
+
+		specs[i.Path] = spec
+	}
+
+	paths := make([]string, 0, len(specs))
+
+	for p := range specs {
+		paths = append(paths, p)
+	}
+
+	sort.Strings(paths)
+
+	imports := make([]string, 0, len(paths))
+
+	for _, p := range paths {
+		imports = append(imports, specs[p])
+	}
+
+	return imports
+}
+
+// HasCollections returns true if any of the associated external types are
+// for nested attributes or blocks which are collections of objects.
+func (g *Generated) HasCollections() bool {
+	if g == nil {
+		return false
+	}
+
+	for _, e := range g.ExternalTypes {
+		if e.Collection != "" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -10,8 +10,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
 
-// ProviderBytes will create scaffolding Go code bytes for a Terraform Plugin Framework provider
-func ProviderBytes(providerIdentifier schema.FrameworkIdentifier, packageName string) ([]byte, error) {
+// ProviderBytes will create scaffolding Go code bytes for a Terraform Plugin Framework provider.
+// If generated is not nil, the provider will use the schema and model generated from the specification.
+func ProviderBytes(providerIdentifier schema.FrameworkIdentifier, packageName string, generated *Generated) ([]byte, error) {
 	t, err := template.New("provider_scaffold").Parse(providerScaffoldGoTemplate)
 	if err != nil {
 		return nil, err
@@ -23,10 +24,14 @@ func ProviderBytes(providerIdentifier schema.FrameworkIdentifier, packageName st
 		PackageName string
 		NameSnake   string
 		NameCamel   string
+		NamePascal  string
+		Generated   *Generated
 	}{
 		PackageName: packageName,
 		NameSnake:   string(providerIdentifier),
 		NameCamel:   providerIdentifier.ToCamelCase(),
+		NamePascal:  providerIdentifier.ToPascalCase(),
+		Generated:   generated,
 	}
 
 	err = t.Execute(&buf, templateData)
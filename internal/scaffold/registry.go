@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold
+
+import (
+	"bytes"
+	"sort"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// RegistryBytes will create Go code bytes which list the constructors of the data sources and resources,
+// named as in the data source and resource scaffolding, for registering with a Terraform Plugin Framework provider.
+func RegistryBytes(packageName string, dataSourceIdentifiers, resourceIdentifiers []schema.FrameworkIdentifier) ([]byte, error) {
+	t, err := template.New("provider_registry").Parse(providerRegistryGoTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	templateData := struct {
+		PackageName string
+		DataSources []schema.FrameworkIdentifier
+		Resources   []schema.FrameworkIdentifier
+	}{
+		PackageName: packageName,
+		DataSources: sortedIdentifiers(dataSourceIdentifiers),
+		Resources:   sortedIdentifiers(resourceIdentifiers),
+	}
+
+	err = t.Execute(&buf, templateData)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func sortedIdentifiers(identifiers []schema.FrameworkIdentifier) []schema.FrameworkIdentifier {
+	sorted := make([]schema.FrameworkIdentifier, len(identifiers))
+
+	copy(sorted, identifiers)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	return sorted
+}
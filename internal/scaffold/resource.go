@@ -10,25 +10,49 @@ import (
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
 
-// ResourceBytes will create scaffolding Go code bytes for a Terraform Plugin Framework resource
-func ResourceBytes(resourceIdentifier schema.FrameworkIdentifier, packageName string) ([]byte, error) {
+// ResourceBytes will create scaffolding Go code bytes for a Terraform Plugin Framework resource.
+// If identity is true, the resource will also implement resource.ResourceWithIdentity. If generated
+// is not nil, the resource will use the schema and model generated from the specification.
+func ResourceBytes(resourceIdentifier schema.FrameworkIdentifier, packageName string, identity bool, generated *Generated) ([]byte, error) {
 	t, err := template.New("resource_scaffold").Parse(resourceScaffoldGoTemplate)
 	if err != nil {
 		return nil, err
 	}
 
+	t, err = t.Parse(externalTypesGoTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	modelType := resourceIdentifier.ToCamelCase() + "ResourceModel"
+	identityModelType := resourceIdentifier.ToCamelCase() + "ResourceIdentityModel"
+
+	if generated != nil {
+		identity = generated.Identity
+		modelType = generated.Qualifier() + resourceIdentifier.ToPascalCase() + "Model"
+		identityModelType = generated.Qualifier() + resourceIdentifier.ToPascalCase() + "IdentityModel"
+	}
+
 	var buf bytes.Buffer
 
 	templateData := struct {
-		PackageName string
-		NameSnake   string
-		NameCamel   string
-		NamePascal  string
+		PackageName       string
+		NameSnake         string
+		NameCamel         string
+		NamePascal        string
+		Identity          bool
+		Generated         *Generated
+		ModelType         string
+		IdentityModelType string
 	}{
-		PackageName: packageName,
-		NameSnake:   string(resourceIdentifier),
-		NameCamel:   resourceIdentifier.ToCamelCase(),
-		NamePascal:  resourceIdentifier.ToPascalCase(),
+		PackageName:       packageName,
+		NameSnake:         string(resourceIdentifier),
+		NameCamel:         resourceIdentifier.ToCamelCase(),
+		NamePascal:        resourceIdentifier.ToPascalCase(),
+		Identity:          identity,
+		Generated:         generated,
+		ModelType:         modelType,
+		IdentityModelType: identityModelType,
 	}
 
 	err = t.Execute(&buf, templateData)
@@ -23,6 +23,10 @@ func (g GeneratorAttributes) AttributeTypes() (map[string]string, error) {
 		switch g[k].GeneratorSchemaType() {
 		case GeneratorBoolAttribute:
 			attributeTypes[k] = "Bool"
+		case GeneratorDynamicAttribute:
+			attributeTypes[k] = "Dynamic"
+		case GeneratorFloat32Attribute:
+			attributeTypes[k] = "Float32"
 		case GeneratorFloat64Attribute:
 			attributeTypes[k] = "Float64"
 		case GeneratorInt32Attribute:
@@ -173,14 +177,14 @@ func (g GeneratorAttributes) Equal(other GeneratorAttributes) bool {
 
 // FromFuncs returns a mapping of attribute names to string representations of the
 // function that converts a Go value to a framework value.
-func (g GeneratorAttributes) FromFuncs() (map[string]ToFromConversion, error) {
+func (g GeneratorAttributes) FromFuncs(gen *Generator) (map[string]ToFromConversion, error) {
 	attributeKeys := g.SortedKeys()
 
 	fromFuncs := make(map[string]ToFromConversion, len(g))
 
 	for _, k := range attributeKeys {
 		if a, ok := g[k].(From); ok {
-			v, err := a.From()
+			v, err := a.From(gen)
 
 			var unimplError *UnimplementedError
 
@@ -192,6 +196,10 @@ func (g GeneratorAttributes) FromFuncs() (map[string]ToFromConversion, error) {
 				return nil, err
 			}
 
+			if v.Default != "" {
+				v.TypeMapping = gen.attributeTypeMapping(g[k].GeneratorSchemaType())
+			}
+
 			fromFuncs[k] = v
 		}
 	}
@@ -244,14 +252,14 @@ func (g GeneratorAttributes) Schema() (string, error) {
 // ToFuncs returns a mapping of attribute names to string representations of the
 // function that converts a framework value to a Go value. If an UnimplementedError
 // is encountered, it is logged and execution continues.
-func (g GeneratorAttributes) ToFuncs() (map[string]ToFromConversion, error) {
+func (g GeneratorAttributes) ToFuncs(gen *Generator) (map[string]ToFromConversion, error) {
 	attributeKeys := g.SortedKeys()
 
 	toFuncs := make(map[string]ToFromConversion, len(g))
 
 	for _, k := range attributeKeys {
 		if a, ok := g[k].(To); ok {
-			v, err := a.To()
+			v, err := a.To(gen)
 
 			var unimplError *UnimplementedError
 
@@ -263,6 +271,10 @@ func (g GeneratorAttributes) ToFuncs() (map[string]ToFromConversion, error) {
 				return nil, err
 			}
 
+			if v.Default != "" {
+				v.TypeMapping = gen.attributeTypeMapping(g[k].GeneratorSchemaType())
+			}
+
 			toFuncs[k] = v
 		}
 	}
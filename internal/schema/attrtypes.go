@@ -9,6 +9,8 @@ import (
 	"strings"
 
 	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
 )
 
 // GetAttrTypes generates the strings for use within templates for specifying the types to use with
@@ -30,6 +32,18 @@ func GetAttrTypes(attrTypes specschema.ObjectAttributeTypes) string {
 			} else {
 				aTypes.WriteString("types.BoolType")
 			}
+		case v.Dynamic != nil:
+			if v.Dynamic.CustomType != nil {
+				aTypes.WriteString(v.Dynamic.CustomType.Type)
+			} else {
+				aTypes.WriteString("types.DynamicType")
+			}
+		case v.Float64 != nil && extension.IsFloat32(v.Float64):
+			if v.Float64.CustomType != nil {
+				aTypes.WriteString(v.Float64.CustomType.Type)
+			} else {
+				aTypes.WriteString("types.Float32Type")
+			}
 		case v.Float64 != nil:
 			if v.Float64.CustomType != nil {
 				aTypes.WriteString(v.Float64.CustomType.Type)
@@ -95,21 +109,42 @@ func GetAttrTypes(attrTypes specschema.ObjectAttributeTypes) string {
 type AttrTypesToFuncs struct {
 	AttrValue string
 	ToFunc    string
+	// ToFuncLiteral is set in place of ToFunc for collection and object
+	// attribute types, and primitive attribute types with a type mapping.
+	ToFuncLiteral string
 }
 
 // GetAttrTypesToFuncs returns string representations of the function that is used
 // for converting to an API Go type from a framework type.
-// TODO: Handle custom type, and types other than primitives.
-func GetAttrTypesToFuncs(a specschema.ObjectAttributeTypes) (map[string]AttrTypesToFuncs, error) {
+// TODO: Handle custom type.
+func GetAttrTypesToFuncs(a specschema.ObjectAttributeTypes, gen *Generator) (map[string]AttrTypesToFuncs, error) {
 	attrTypesFuncs := make(map[string]AttrTypesToFuncs, len(a))
 
 	for _, v := range a {
 		switch {
+		case isMappedPrimitive(objectAttributeElementType(v), gen):
+			field, err := nestedObjectFieldTo(v, gen)
+
+			if err != nil {
+				return nil, err
+			}
+
+			attrTypesFuncs[v.Name] = AttrTypesToFuncs{
+				AttrValue:     field.Type,
+				ToFuncLiteral: field.ToFuncLiteral,
+			}
 		case v.Bool != nil:
 			attrTypesFuncs[v.Name] = AttrTypesToFuncs{
 				AttrValue: "types.Bool",
 				ToFunc:    "ValueBoolPointer",
 			}
+		case v.Dynamic != nil:
+			return nil, NewUnimplementedError(errors.New("dynamic attribute type is not yet implemented"))
+		case v.Float64 != nil && extension.IsFloat32(v.Float64):
+			attrTypesFuncs[v.Name] = AttrTypesToFuncs{
+				AttrValue: "types.Float32",
+				ToFunc:    "ValueFloat32Pointer",
+			}
 		case v.Float64 != nil:
 			attrTypesFuncs[v.Name] = AttrTypesToFuncs{
 				AttrValue: "types.Float64",
@@ -125,20 +160,22 @@ func GetAttrTypesToFuncs(a specschema.ObjectAttributeTypes) (map[string]AttrType
 				AttrValue: "types.Int64",
 				ToFunc:    "ValueInt64Pointer",
 			}
-		case v.List != nil:
-			return nil, NewUnimplementedError(errors.New("list attribute type is not yet implemented"))
-		case v.Map != nil:
-			return nil, NewUnimplementedError(errors.New("map attribute type is not yet implemented"))
+		case v.List != nil, v.Map != nil, v.Object != nil, v.Set != nil:
+			field, err := nestedObjectFieldTo(v, gen)
 
+			if err != nil {
+				return nil, err
+			}
+
+			attrTypesFuncs[v.Name] = AttrTypesToFuncs{
+				AttrValue:     field.Type,
+				ToFuncLiteral: field.ToFuncLiteral,
+			}
 		case v.Number != nil:
 			attrTypesFuncs[v.Name] = AttrTypesToFuncs{
 				AttrValue: "types.Number",
 				ToFunc:    "ValueBigFloat",
 			}
-		case v.Object != nil:
-			return nil, NewUnimplementedError(errors.New("object attribute type is not yet implemented"))
-		case v.Set != nil:
-			return nil, NewUnimplementedError(errors.New("set attribute type is not yet implemented"))
 		case v.String != nil:
 			attrTypesFuncs[v.Name] = AttrTypesToFuncs{
 				AttrValue: "types.String",
@@ -152,30 +189,42 @@ func GetAttrTypesToFuncs(a specschema.ObjectAttributeTypes) (map[string]AttrType
 
 // GetAttrTypesFromFuncs returns string representations of the function that is used
 // for converting from an API Go type to a framework type.
-// TODO: Handle custom type, and types other than primitives.
-func GetAttrTypesFromFuncs(a specschema.ObjectAttributeTypes) (map[string]string, error) {
+// TODO: Handle custom type.
+func GetAttrTypesFromFuncs(a specschema.ObjectAttributeTypes, gen *Generator) (map[string]string, error) {
 	attrTypesFuncs := make(map[string]string, len(a))
 
 	for _, v := range a {
 		switch {
+		case isMappedPrimitive(objectAttributeElementType(v), gen):
+			field, err := nestedObjectFieldFrom(v, gen)
+
+			if err != nil {
+				return nil, err
+			}
+
+			attrTypesFuncs[v.Name] = field.FromFuncLiteral
 		case v.Bool != nil:
 			attrTypesFuncs[v.Name] = "types.BoolPointerValue"
+		case v.Dynamic != nil:
+			return nil, NewUnimplementedError(errors.New("dynamic attribute type is not yet implemented"))
+		case v.Float64 != nil && extension.IsFloat32(v.Float64):
+			attrTypesFuncs[v.Name] = "types.Float32PointerValue"
 		case v.Float64 != nil:
 			attrTypesFuncs[v.Name] = "types.Float64PointerValue"
 		case v.Int32 != nil:
 			attrTypesFuncs[v.Name] = "types.Int32PointerValue"
 		case v.Int64 != nil:
 			attrTypesFuncs[v.Name] = "types.Int64PointerValue"
-		case v.List != nil:
-			return nil, NewUnimplementedError(errors.New("list attribute type is not yet implemented"))
-		case v.Map != nil:
-			return nil, NewUnimplementedError(errors.New("map attribute type is not yet implemented"))
+		case v.List != nil, v.Map != nil, v.Object != nil, v.Set != nil:
+			field, err := nestedObjectFieldFrom(v, gen)
+
+			if err != nil {
+				return nil, err
+			}
+
+			attrTypesFuncs[v.Name] = field.FromFuncLiteral
 		case v.Number != nil:
 			attrTypesFuncs[v.Name] = "types.NumberValue"
-		case v.Object != nil:
-			return nil, NewUnimplementedError(errors.New("object attribute type is not yet implemented"))
-		case v.Set != nil:
-			return nil, NewUnimplementedError(errors.New("set attribute type is not yet implemented"))
 		case v.String != nil:
 			attrTypesFuncs[v.Name] = "types.StringPointerValue"
 		}
@@ -107,6 +107,8 @@ func (g GeneratorBlocks) FromFuncs() map[string]string {
 		switch g[k].GeneratorSchemaType() {
 		case GeneratorBoolAttribute:
 			fromFuncs[k] = "BoolPointerValue"
+		case GeneratorFloat32Attribute:
+			fromFuncs[k] = "Float32PointerValue"
 		case GeneratorFloat64Attribute:
 			fromFuncs[k] = "Float64PointerValue"
 		case GeneratorInt32Attribute:
@@ -177,6 +179,8 @@ func (g GeneratorBlocks) ToFuncs() map[string]string {
 		switch g[k].GeneratorSchemaType() {
 		case GeneratorBoolAttribute:
 			toFuncs[k] = "ValueBoolPointer"
+		case GeneratorFloat32Attribute:
+			toFuncs[k] = "ValueFloat32Pointer"
 		case GeneratorFloat64Attribute:
 			toFuncs[k] = "ValueFloat64Pointer"
 		case GeneratorInt32Attribute:
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+)
+
+// ConfigValidators are rendered as a function which returns the config
+// validators of a resource or data source, such as
+// ExampleResourceConfigValidators.
+type ConfigValidators struct {
+	// Package is the name of the framework package which defines the
+	// ConfigValidator interface, either datasource or resource.
+	Package    string
+	Validators []ConfigValidator
+}
+
+// ConfigValidator is a validator of the resourcevalidator or
+// datasourcevalidator package, such as ExactlyOneOf, with the path
+// expressions of the attributes and blocks it references.
+type ConfigValidator struct {
+	Name            string
+	PathExpressions []string
+
+	// Paths are the attribute and block names separated by dots, such as
+	// "block.attribute", from which the PathExpressions are rendered.
+	Paths []string
+}
+
+// NewConfigValidators returns the ConfigValidators for the supplied
+// additions to the specification, once each of the referenced paths has been
+// found in the schema. Every path which is not found is reported, with the
+// JSON pointer of the path relative to the config validators.
+func NewConfigValidators(packageName string, g GeneratorSchema, validators extension.ConfigValidators) (*ConfigValidators, error) {
+	if len(validators) == 0 {
+		return nil, nil
+	}
+
+	c := &ConfigValidators{
+		Package: packageName,
+	}
+
+	var errs []error
+
+	for i, v := range validators {
+		name, paths := v.Validator()
+
+		validator := ConfigValidator{
+			Name:  name,
+			Paths: paths,
+		}
+
+		for j, p := range paths {
+			expression, err := g.PathExpression(p)
+
+			if err != nil {
+				errs = append(errs, diagnostic.NewError(fmt.Errorf("config validator %d: %w", i, err), i, v.Property(), j))
+
+				continue
+			}
+
+			validator.PathExpressions = append(validator.PathExpressions, expression)
+		}
+
+		c.Validators = append(c.Validators, validator)
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return c, nil
+}
+
+// Imports returns the framework and terraform-plugin-framework-validators
+// packages used by the config validators.
+func (c *ConfigValidators) Imports() *Imports {
+	imports := NewImports()
+
+	if c == nil {
+		return imports
+	}
+
+	imports.Add([]code.Import{
+		{
+			Path: fmt.Sprintf("github.com/hashicorp/terraform-plugin-framework/%s", c.Package),
+		},
+		{
+			Path: fmt.Sprintf("%s/%svalidator", FrameworkValidatorsImport, c.Package),
+		},
+		{
+			Path: PathImport,
+		},
+	}...)
+
+	return imports
+}
+
+// Schema returns the elements of the slice returned by the config
+// validators function.
+func (c *ConfigValidators) Schema() string {
+	if c == nil {
+		return ""
+	}
+
+	var b strings.Builder
+
+	for _, v := range c.Validators {
+		b.WriteString(fmt.Sprintf("\n%svalidator.%s(\n", c.Package, v.Name))
+
+		for _, p := range v.PathExpressions {
+			b.WriteString(fmt.Sprintf("%s,\n", p))
+		}
+
+		b.WriteString("),")
+	}
+
+	return b.String()
+}
+
+// PathExpression returns the path expression for a path of attribute and
+// block names separated by dots, such as "block.attribute". The elements of
+// list, map and set nested attributes and blocks are matched with
+// AtAnyListIndex, AtAnyMapKey and AtAnySetValue respectively. An error is
+// returned if the path is not defined in the schema.
+func (g GeneratorSchema) PathExpression(p string) (string, error) {
+	var b strings.Builder
+
+	attributes, blocks := g.Attributes, g.Blocks
+
+	steps := strings.Split(p, ".")
+
+	for i, step := range steps {
+		if i == 0 {
+			b.WriteString(fmt.Sprintf("path.MatchRoot(%q)", step))
+		} else {
+			b.WriteString(fmt.Sprintf(".AtName(%q)", step))
+		}
+
+		var element interface{ GeneratorSchemaType() Type }
+
+		if a, ok := attributes[step]; ok {
+			element = a
+		} else if bl, ok := blocks[step]; ok {
+			element = bl
+		} else {
+			return "", fmt.Errorf("path %q is not defined in the schema", p)
+		}
+
+		if i == len(steps)-1 {
+			break
+		}
+
+		nested, ok := element.(Attributes)
+
+		if !ok {
+			return "", fmt.Errorf("path %q is not defined in the schema, as %q is not a nested attribute or block", p, strings.Join(steps[:i+1], "."))
+		}
+
+		switch element.GeneratorSchemaType() {
+		case GeneratorListNestedAttribute, GeneratorListNestedBlock:
+			b.WriteString(".AtAnyListIndex()")
+		case GeneratorMapNestedAttribute:
+			b.WriteString(".AtAnyMapKey()")
+		case GeneratorSetNestedAttribute, GeneratorSetNestedBlock:
+			b.WriteString(".AtAnySetValue()")
+		}
+
+		attributes, blocks = nested.GetAttributes(), nil
+
+		if nestedBlocks, ok := element.(Blocks); ok {
+			blocks = nestedBlocks.GetBlocks()
+		}
+	}
+
+	return b.String(), nil
+}
@@ -13,15 +13,15 @@ type CustomBoolType struct {
 	templates map[string]string
 }
 
-func NewCustomBoolType(name string) CustomBoolType {
+func NewCustomBoolType(name string, gen *Generator) CustomBoolType {
 	t := map[string]string{
-		"equal":              BoolTypeEqualTemplate,
-		"string":             BoolTypeStringTemplate,
-		"type":               BoolTypeTypeTemplate,
-		"typable":            BoolTypeTypableTemplate,
-		"valueFromBool":      BoolTypeValueFromBoolTemplate,
-		"valueFromTerraform": BoolTypeValueFromTerraformTemplate,
-		"valueType":          BoolTypeValueTypeTemplate,
+		"equal":              gen.template("bool_type_equal.gotmpl"),
+		"string":             gen.template("bool_type_string.gotmpl"),
+		"type":               gen.template("bool_type_type.gotmpl"),
+		"typable":            gen.template("bool_type_typable.gotmpl"),
+		"valueFromBool":      gen.template("bool_type_value_from_bool.gotmpl"),
+		"valueFromTerraform": gen.template("bool_type_value_from_terraform.gotmpl"),
+		"valueType":          gen.template("bool_type_value_type.gotmpl"),
 	}
 
 	return CustomBoolType{
@@ -217,12 +217,12 @@ type CustomBoolValue struct {
 	templates map[string]string
 }
 
-func NewCustomBoolValue(name string) CustomBoolValue {
+func NewCustomBoolValue(name string, gen *Generator) CustomBoolValue {
 	t := map[string]string{
-		"equal":    BoolValueEqualTemplate,
-		"type":     BoolValueTypeTemplate,
-		"valuable": BoolValueValuableTemplate,
-		"value":    BoolValueValueTemplate,
+		"equal":    gen.template("bool_value_equal.gotmpl"),
+		"type":     gen.template("bool_value_type.gotmpl"),
+		"valuable": gen.template("bool_value_valuable.gotmpl"),
+		"value":    gen.template("bool_value_value.gotmpl"),
 	}
 
 	return CustomBoolValue{
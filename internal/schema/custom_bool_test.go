@@ -36,7 +36,7 @@ return t.BoolType.Equal(other.BoolType)
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customBoolType := NewCustomBoolType(testCase.name)
+			customBoolType := NewCustomBoolType(testCase.name, nil)
 
 			got, err := customBoolType.renderEqual()
 
@@ -73,7 +73,7 @@ return "ExampleType"
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customBoolType := NewCustomBoolType(testCase.name)
+			customBoolType := NewCustomBoolType(testCase.name, nil)
 
 			got, err := customBoolType.renderString()
 
@@ -107,7 +107,7 @@ func TestCustomBoolType_renderTypable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customBoolType := NewCustomBoolType(testCase.name)
+			customBoolType := NewCustomBoolType(testCase.name, nil)
 
 			got, err := customBoolType.renderTypable()
 
@@ -143,7 +143,7 @@ basetypes.BoolType
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customBoolType := NewCustomBoolType(testCase.name)
+			customBoolType := NewCustomBoolType(testCase.name, nil)
 
 			got, err := customBoolType.renderType()
 
@@ -186,7 +186,7 @@ BoolValue: in,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customBoolType := NewCustomBoolType(testCase.name)
+			customBoolType := NewCustomBoolType(testCase.name, nil)
 
 			got, err := customBoolType.renderValueFromBool()
 
@@ -241,7 +241,7 @@ return boolValuable, nil
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customBoolType := NewCustomBoolType(testCase.name)
+			customBoolType := NewCustomBoolType(testCase.name, nil)
 
 			got, err := customBoolType.renderValueFromTerraform()
 
@@ -278,7 +278,7 @@ return ExampleValue{}
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customBoolType := NewCustomBoolType(testCase.name)
+			customBoolType := NewCustomBoolType(testCase.name, nil)
 
 			got, err := customBoolType.renderValueType()
 
@@ -325,7 +325,7 @@ return v.BoolValue.Equal(other.BoolValue)
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customBoolValue := NewCustomBoolValue(testCase.name)
+			customBoolValue := NewCustomBoolValue(testCase.name, nil)
 
 			got, err := customBoolValue.renderEqual()
 
@@ -363,7 +363,7 @@ return ExampleType{
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customBoolValue := NewCustomBoolValue(testCase.name)
+			customBoolValue := NewCustomBoolValue(testCase.name, nil)
 
 			got, err := customBoolValue.renderType()
 
@@ -397,7 +397,7 @@ func TestCustomBoolValue_renderValuable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customBoolValue := NewCustomBoolValue(testCase.name)
+			customBoolValue := NewCustomBoolValue(testCase.name, nil)
 
 			got, err := customBoolValue.renderValuable()
 
@@ -433,7 +433,7 @@ basetypes.BoolValue
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customBoolValue := NewCustomBoolValue(testCase.name)
+			customBoolValue := NewCustomBoolValue(testCase.name, nil)
 
 			got, err := customBoolValue.renderValue()
 
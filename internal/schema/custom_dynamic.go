@@ -0,0 +1,345 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"bytes"
+	"text/template"
+)
+
+type CustomDynamicType struct {
+	Name      FrameworkIdentifier
+	templates map[string]string
+}
+
+func NewCustomDynamicType(name string, gen *Generator) CustomDynamicType {
+	t := map[string]string{
+		"equal":              gen.template("dynamic_type_equal.gotmpl"),
+		"string":             gen.template("dynamic_type_string.gotmpl"),
+		"type":               gen.template("dynamic_type_type.gotmpl"),
+		"typable":            gen.template("dynamic_type_typable.gotmpl"),
+		"valueFromDynamic":   gen.template("dynamic_type_value_from_dynamic.gotmpl"),
+		"valueFromTerraform": gen.template("dynamic_type_value_from_terraform.gotmpl"),
+		"valueType":          gen.template("dynamic_type_value_type.gotmpl"),
+	}
+
+	return CustomDynamicType{
+		Name:      FrameworkIdentifier(name),
+		templates: t,
+	}
+}
+
+func (c CustomDynamicType) Render() ([]byte, error) {
+	var buf bytes.Buffer
+
+	renderFuncs := []func() ([]byte, error){
+		c.renderTypable,
+		c.renderType,
+		c.renderEqual,
+		c.renderString,
+		c.renderValueFromDynamic,
+		c.renderValueFromTerraform,
+		c.renderValueType,
+	}
+
+	for _, f := range renderFuncs {
+		b, err := f()
+
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write([]byte("\n"))
+
+		buf.Write(b)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomDynamicType) renderEqual() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["equal"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomDynamicType) renderString() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["string"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomDynamicType) renderType() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["type"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomDynamicType) renderTypable() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["typable"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomDynamicType) renderValueFromDynamic() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["valueFromDynamic"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomDynamicType) renderValueFromTerraform() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["valueFromTerraform"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomDynamicType) renderValueType() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["valueType"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type CustomDynamicValue struct {
+	Name      FrameworkIdentifier
+	templates map[string]string
+}
+
+func NewCustomDynamicValue(name string, gen *Generator) CustomDynamicValue {
+	t := map[string]string{
+		"equal":    gen.template("dynamic_value_equal.gotmpl"),
+		"type":     gen.template("dynamic_value_type.gotmpl"),
+		"valuable": gen.template("dynamic_value_valuable.gotmpl"),
+		"value":    gen.template("dynamic_value_value.gotmpl"),
+	}
+
+	return CustomDynamicValue{
+		Name:      FrameworkIdentifier(name),
+		templates: t,
+	}
+}
+
+func (c CustomDynamicValue) Render() ([]byte, error) {
+	var buf bytes.Buffer
+
+	renderFuncs := []func() ([]byte, error){
+		c.renderValuable,
+		c.renderValue,
+		c.renderEqual,
+		c.renderType,
+	}
+
+	for _, f := range renderFuncs {
+		b, err := f()
+
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write([]byte("\n"))
+
+		buf.Write(b)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomDynamicValue) renderEqual() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["equal"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomDynamicValue) renderType() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["type"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomDynamicValue) renderValuable() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["valuable"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomDynamicValue) renderValue() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["value"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
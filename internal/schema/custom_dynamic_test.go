@@ -0,0 +1,449 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCustomDynamicType_renderEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name: "Example",
+			expected: []byte(`func (t ExampleType) Equal(o attr.Type) bool {
+other, ok := o.(ExampleType)
+
+if !ok {
+return false
+}
+
+return t.DynamicType.Equal(other.DynamicType)
+}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customDynamicType := NewCustomDynamicType(testCase.name, nil)
+
+			got, err := customDynamicType.renderEqual()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomDynamicType_renderString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name: "Example",
+			expected: []byte(`
+func (t ExampleType) String() string {
+return "ExampleType"
+}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customDynamicType := NewCustomDynamicType(testCase.name, nil)
+
+			got, err := customDynamicType.renderString()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomDynamicType_renderTypable(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name:     "Example",
+			expected: []byte(`var _ basetypes.DynamicTypable = ExampleType{}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customDynamicType := NewCustomDynamicType(testCase.name, nil)
+
+			got, err := customDynamicType.renderTypable()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomDynamicType_renderType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name: "Example",
+			expected: []byte(`type ExampleType struct {
+basetypes.DynamicType
+}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customDynamicType := NewCustomDynamicType(testCase.name, nil)
+
+			got, err := customDynamicType.renderType()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomDynamicType_renderValueFromDynamic(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		attrValues    map[string]string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name: "Example",
+			attrValues: map[string]string{
+				"dynamic_attribute": "basetypes.DynamicValue",
+			},
+			expected: []byte(`
+func (t ExampleType) ValueFromDynamic(ctx context.Context, in basetypes.DynamicValue) (basetypes.DynamicValuable, diag.Diagnostics) {
+return ExampleValue{
+DynamicValue: in,
+}, nil
+}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customDynamicType := NewCustomDynamicType(testCase.name, nil)
+
+			got, err := customDynamicType.renderValueFromDynamic()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomDynamicType_renderValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name: "Example",
+			expected: []byte(`
+func (t ExampleType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+attrValue, err := t.DynamicType.ValueFromTerraform(ctx, in)
+
+if err != nil {
+return nil, err
+}
+
+dynamicValue, ok := attrValue.(basetypes.DynamicValue)
+
+if !ok {
+return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+}
+
+dynamicValuable, diags := t.ValueFromDynamic(ctx, dynamicValue)
+
+if diags.HasError() {
+return nil, fmt.Errorf("unexpected error converting DynamicValue to DynamicValuable: %v", diags)
+}
+
+return dynamicValuable, nil
+}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customDynamicType := NewCustomDynamicType(testCase.name, nil)
+
+			got, err := customDynamicType.renderValueFromTerraform()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomDynamicType_renderValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name: "Example",
+			expected: []byte(`
+func (t ExampleType) ValueType(ctx context.Context) attr.Value {
+return ExampleValue{}
+}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customDynamicType := NewCustomDynamicType(testCase.name, nil)
+
+			got, err := customDynamicType.renderValueType()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomDynamicValue_renderEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		attrValues    map[string]string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name: "Example",
+			attrValues: map[string]string{
+				"dynamic_attribute": "basetypes.DynamicValue",
+			},
+			expected: []byte(`
+func (v ExampleValue) Equal(o attr.Value) bool {
+other, ok := o.(ExampleValue)
+
+if !ok {
+return false
+}
+
+return v.DynamicValue.Equal(other.DynamicValue)
+}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customDynamicValue := NewCustomDynamicValue(testCase.name, nil)
+
+			got, err := customDynamicValue.renderEqual()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomDynamicValue_renderType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name: "Example",
+			expected: []byte(`
+func (v ExampleValue) Type(ctx context.Context) attr.Type {
+return ExampleType{
+}
+}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customDynamicValue := NewCustomDynamicValue(testCase.name, nil)
+
+			got, err := customDynamicValue.renderType()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomDynamicValue_renderValuable(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name:     "Example",
+			expected: []byte(`var _ basetypes.DynamicValuable = ExampleValue{}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customDynamicValue := NewCustomDynamicValue(testCase.name, nil)
+
+			got, err := customDynamicValue.renderValuable()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomDynamicValue_renderValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name: "Example",
+			expected: []byte(`type ExampleValue struct {
+basetypes.DynamicValue
+}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customDynamicValue := NewCustomDynamicValue(testCase.name, nil)
+
+			got, err := customDynamicValue.renderValue()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,345 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"bytes"
+	"text/template"
+)
+
+type CustomFloat32Type struct {
+	Name      FrameworkIdentifier
+	templates map[string]string
+}
+
+func NewCustomFloat32Type(name string, gen *Generator) CustomFloat32Type {
+	t := map[string]string{
+		"equal":              gen.template("float32_type_equal.gotmpl"),
+		"string":             gen.template("float32_type_string.gotmpl"),
+		"type":               gen.template("float32_type_type.gotmpl"),
+		"typable":            gen.template("float32_type_typable.gotmpl"),
+		"valueFromFloat32":   gen.template("float32_type_value_from_float32.gotmpl"),
+		"valueFromTerraform": gen.template("float32_type_value_from_terraform.gotmpl"),
+		"valueType":          gen.template("float32_type_value_type.gotmpl"),
+	}
+
+	return CustomFloat32Type{
+		Name:      FrameworkIdentifier(name),
+		templates: t,
+	}
+}
+
+func (c CustomFloat32Type) Render() ([]byte, error) {
+	var buf bytes.Buffer
+
+	renderFuncs := []func() ([]byte, error){
+		c.renderTypable,
+		c.renderType,
+		c.renderEqual,
+		c.renderString,
+		c.renderValueFromFloat32,
+		c.renderValueFromTerraform,
+		c.renderValueType,
+	}
+
+	for _, f := range renderFuncs {
+		b, err := f()
+
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write([]byte("\n"))
+
+		buf.Write(b)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomFloat32Type) renderEqual() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["equal"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomFloat32Type) renderString() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["string"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomFloat32Type) renderType() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["type"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomFloat32Type) renderTypable() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["typable"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomFloat32Type) renderValueFromFloat32() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["valueFromFloat32"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomFloat32Type) renderValueFromTerraform() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["valueFromTerraform"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomFloat32Type) renderValueType() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["valueType"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type CustomFloat32Value struct {
+	Name      FrameworkIdentifier
+	templates map[string]string
+}
+
+func NewCustomFloat32Value(name string, gen *Generator) CustomFloat32Value {
+	t := map[string]string{
+		"equal":    gen.template("float32_value_equal.gotmpl"),
+		"type":     gen.template("float32_value_type.gotmpl"),
+		"valuable": gen.template("float32_value_valuable.gotmpl"),
+		"value":    gen.template("float32_value_value.gotmpl"),
+	}
+
+	return CustomFloat32Value{
+		Name:      FrameworkIdentifier(name),
+		templates: t,
+	}
+}
+
+func (c CustomFloat32Value) Render() ([]byte, error) {
+	var buf bytes.Buffer
+
+	renderFuncs := []func() ([]byte, error){
+		c.renderValuable,
+		c.renderValue,
+		c.renderEqual,
+		c.renderType,
+	}
+
+	for _, f := range renderFuncs {
+		b, err := f()
+
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write([]byte("\n"))
+
+		buf.Write(b)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomFloat32Value) renderEqual() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["equal"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomFloat32Value) renderType() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["type"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomFloat32Value) renderValuable() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["valuable"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c CustomFloat32Value) renderValue() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(c.templates["value"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name string
+	}{
+		Name: c.Name.ToPascalCase(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
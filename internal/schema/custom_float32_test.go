@@ -0,0 +1,449 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCustomFloat32Type_renderEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name: "Example",
+			expected: []byte(`func (t ExampleType) Equal(o attr.Type) bool {
+other, ok := o.(ExampleType)
+
+if !ok {
+return false
+}
+
+return t.Float32Type.Equal(other.Float32Type)
+}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customFloat32Type := NewCustomFloat32Type(testCase.name, nil)
+
+			got, err := customFloat32Type.renderEqual()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomFloat32Type_renderString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name: "Example",
+			expected: []byte(`
+func (t ExampleType) String() string {
+return "ExampleType"
+}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customFloat32Type := NewCustomFloat32Type(testCase.name, nil)
+
+			got, err := customFloat32Type.renderString()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomFloat32Type_renderTypable(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name:     "Example",
+			expected: []byte(`var _ basetypes.Float32Typable = ExampleType{}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customFloat32Type := NewCustomFloat32Type(testCase.name, nil)
+
+			got, err := customFloat32Type.renderTypable()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomFloat32Type_renderType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name: "Example",
+			expected: []byte(`type ExampleType struct {
+basetypes.Float32Type
+}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customFloat32Type := NewCustomFloat32Type(testCase.name, nil)
+
+			got, err := customFloat32Type.renderType()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomFloat32Type_renderValueFromFloat32(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		attrValues    map[string]string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name: "Example",
+			attrValues: map[string]string{
+				"bool_attribute": "basetypes.Float32Value",
+			},
+			expected: []byte(`
+func (t ExampleType) ValueFromFloat32(ctx context.Context, in basetypes.Float32Value) (basetypes.Float32Valuable, diag.Diagnostics) {
+return ExampleValue{
+Float32Value: in,
+}, nil
+}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customFloat32Type := NewCustomFloat32Type(testCase.name, nil)
+
+			got, err := customFloat32Type.renderValueFromFloat32()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomFloat32Type_renderValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name: "Example",
+			expected: []byte(`
+func (t ExampleType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+attrValue, err := t.Float32Type.ValueFromTerraform(ctx, in)
+
+if err != nil {
+return nil, err
+}
+
+boolValue, ok := attrValue.(basetypes.Float32Value)
+
+if !ok {
+return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+}
+
+boolValuable, diags := t.ValueFromFloat32(ctx, boolValue)
+
+if diags.HasError() {
+return nil, fmt.Errorf("unexpected error converting Float32Value to Float32Valuable: %v", diags)
+}
+
+return boolValuable, nil
+}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customFloat32Type := NewCustomFloat32Type(testCase.name, nil)
+
+			got, err := customFloat32Type.renderValueFromTerraform()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomFloat32Type_renderValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name: "Example",
+			expected: []byte(`
+func (t ExampleType) ValueType(ctx context.Context) attr.Value {
+return ExampleValue{}
+}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customFloat32Type := NewCustomFloat32Type(testCase.name, nil)
+
+			got, err := customFloat32Type.renderValueType()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomFloat32Value_renderEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		attrValues    map[string]string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name: "Example",
+			attrValues: map[string]string{
+				"bool_attribute": "basetypes.Float32Value",
+			},
+			expected: []byte(`
+func (v ExampleValue) Equal(o attr.Value) bool {
+other, ok := o.(ExampleValue)
+
+if !ok {
+return false
+}
+
+return v.Float32Value.Equal(other.Float32Value)
+}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customFloat32Value := NewCustomFloat32Value(testCase.name, nil)
+
+			got, err := customFloat32Value.renderEqual()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomFloat32Value_renderType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name: "Example",
+			expected: []byte(`
+func (v ExampleValue) Type(ctx context.Context) attr.Type {
+return ExampleType{
+}
+}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customFloat32Value := NewCustomFloat32Value(testCase.name, nil)
+
+			got, err := customFloat32Value.renderType()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomFloat32Value_renderValuable(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name:     "Example",
+			expected: []byte(`var _ basetypes.Float32Valuable = ExampleValue{}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customFloat32Value := NewCustomFloat32Value(testCase.name, nil)
+
+			got, err := customFloat32Value.renderValuable()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomFloat32Value_renderValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name: "Example",
+			expected: []byte(`type ExampleValue struct {
+basetypes.Float32Value
+}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customFloat32Value := NewCustomFloat32Value(testCase.name, nil)
+
+			got, err := customFloat32Value.renderValue()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
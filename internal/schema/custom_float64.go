@@ -13,15 +13,15 @@ type CustomFloat64Type struct {
 	templates map[string]string
 }
 
-func NewCustomFloat64Type(name string) CustomFloat64Type {
+func NewCustomFloat64Type(name string, gen *Generator) CustomFloat64Type {
 	t := map[string]string{
-		"equal":              Float64TypeEqualTemplate,
-		"string":             Float64TypeStringTemplate,
-		"type":               Float64TypeTypeTemplate,
-		"typable":            Float64TypeTypableTemplate,
-		"valueFromFloat64":   Float64TypeValueFromFloat64Template,
-		"valueFromTerraform": Float64TypeValueFromTerraformTemplate,
-		"valueType":          Float64TypeValueTypeTemplate,
+		"equal":              gen.template("float64_type_equal.gotmpl"),
+		"string":             gen.template("float64_type_string.gotmpl"),
+		"type":               gen.template("float64_type_type.gotmpl"),
+		"typable":            gen.template("float64_type_typable.gotmpl"),
+		"valueFromFloat64":   gen.template("float64_type_value_from_float64.gotmpl"),
+		"valueFromTerraform": gen.template("float64_type_value_from_terraform.gotmpl"),
+		"valueType":          gen.template("float64_type_value_type.gotmpl"),
 	}
 
 	return CustomFloat64Type{
@@ -217,12 +217,12 @@ type CustomFloat64Value struct {
 	templates map[string]string
 }
 
-func NewCustomFloat64Value(name string) CustomFloat64Value {
+func NewCustomFloat64Value(name string, gen *Generator) CustomFloat64Value {
 	t := map[string]string{
-		"equal":    Float64ValueEqualTemplate,
-		"type":     Float64ValueTypeTemplate,
-		"valuable": Float64ValueValuableTemplate,
-		"value":    Float64ValueValueTemplate,
+		"equal":    gen.template("float64_value_equal.gotmpl"),
+		"type":     gen.template("float64_value_type.gotmpl"),
+		"valuable": gen.template("float64_value_valuable.gotmpl"),
+		"value":    gen.template("float64_value_value.gotmpl"),
 	}
 
 	return CustomFloat64Value{
@@ -36,7 +36,7 @@ return t.Float64Type.Equal(other.Float64Type)
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customFloat64Type := NewCustomFloat64Type(testCase.name)
+			customFloat64Type := NewCustomFloat64Type(testCase.name, nil)
 
 			got, err := customFloat64Type.renderEqual()
 
@@ -73,7 +73,7 @@ return "ExampleType"
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customFloat64Type := NewCustomFloat64Type(testCase.name)
+			customFloat64Type := NewCustomFloat64Type(testCase.name, nil)
 
 			got, err := customFloat64Type.renderString()
 
@@ -107,7 +107,7 @@ func TestCustomFloat64Type_renderTypable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customFloat64Type := NewCustomFloat64Type(testCase.name)
+			customFloat64Type := NewCustomFloat64Type(testCase.name, nil)
 
 			got, err := customFloat64Type.renderTypable()
 
@@ -143,7 +143,7 @@ basetypes.Float64Type
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customFloat64Type := NewCustomFloat64Type(testCase.name)
+			customFloat64Type := NewCustomFloat64Type(testCase.name, nil)
 
 			got, err := customFloat64Type.renderType()
 
@@ -186,7 +186,7 @@ Float64Value: in,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customFloat64Type := NewCustomFloat64Type(testCase.name)
+			customFloat64Type := NewCustomFloat64Type(testCase.name, nil)
 
 			got, err := customFloat64Type.renderValueFromFloat64()
 
@@ -241,7 +241,7 @@ return boolValuable, nil
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customFloat64Type := NewCustomFloat64Type(testCase.name)
+			customFloat64Type := NewCustomFloat64Type(testCase.name, nil)
 
 			got, err := customFloat64Type.renderValueFromTerraform()
 
@@ -278,7 +278,7 @@ return ExampleValue{}
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customFloat64Type := NewCustomFloat64Type(testCase.name)
+			customFloat64Type := NewCustomFloat64Type(testCase.name, nil)
 
 			got, err := customFloat64Type.renderValueType()
 
@@ -325,7 +325,7 @@ return v.Float64Value.Equal(other.Float64Value)
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customFloat64Value := NewCustomFloat64Value(testCase.name)
+			customFloat64Value := NewCustomFloat64Value(testCase.name, nil)
 
 			got, err := customFloat64Value.renderEqual()
 
@@ -363,7 +363,7 @@ return ExampleType{
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customFloat64Value := NewCustomFloat64Value(testCase.name)
+			customFloat64Value := NewCustomFloat64Value(testCase.name, nil)
 
 			got, err := customFloat64Value.renderType()
 
@@ -397,7 +397,7 @@ func TestCustomFloat64Value_renderValuable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customFloat64Value := NewCustomFloat64Value(testCase.name)
+			customFloat64Value := NewCustomFloat64Value(testCase.name, nil)
 
 			got, err := customFloat64Value.renderValuable()
 
@@ -433,7 +433,7 @@ basetypes.Float64Value
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customFloat64Value := NewCustomFloat64Value(testCase.name)
+			customFloat64Value := NewCustomFloat64Value(testCase.name, nil)
 
 			got, err := customFloat64Value.renderValue()
 
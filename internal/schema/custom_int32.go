@@ -13,15 +13,15 @@ type CustomInt32Type struct {
 	templates map[string]string
 }
 
-func NewCustomInt32Type(name string) CustomInt32Type {
+func NewCustomInt32Type(name string, gen *Generator) CustomInt32Type {
 	t := map[string]string{
-		"equal":              Int32TypeEqualTemplate,
-		"string":             Int32TypeStringTemplate,
-		"type":               Int32TypeTypeTemplate,
-		"typable":            Int32TypeTypableTemplate,
-		"valueFromInt32":     Int32TypeValueFromInt32Template,
-		"valueFromTerraform": Int32TypeValueFromTerraformTemplate,
-		"valueType":          Int32TypeValueTypeTemplate,
+		"equal":              gen.template("int32_type_equal.gotmpl"),
+		"string":             gen.template("int32_type_string.gotmpl"),
+		"type":               gen.template("int32_type_type.gotmpl"),
+		"typable":            gen.template("int32_type_typable.gotmpl"),
+		"valueFromInt32":     gen.template("int32_type_value_from_int32.gotmpl"),
+		"valueFromTerraform": gen.template("int32_type_value_from_terraform.gotmpl"),
+		"valueType":          gen.template("int32_type_value_type.gotmpl"),
 	}
 
 	return CustomInt32Type{
@@ -217,12 +217,12 @@ type CustomInt32Value struct {
 	templates map[string]string
 }
 
-func NewCustomInt32Value(name string) CustomInt32Value {
+func NewCustomInt32Value(name string, gen *Generator) CustomInt32Value {
 	t := map[string]string{
-		"equal":    Int32ValueEqualTemplate,
-		"type":     Int32ValueTypeTemplate,
-		"valuable": Int32ValueValuableTemplate,
-		"value":    Int32ValueValueTemplate,
+		"equal":    gen.template("int32_value_equal.gotmpl"),
+		"type":     gen.template("int32_value_type.gotmpl"),
+		"valuable": gen.template("int32_value_valuable.gotmpl"),
+		"value":    gen.template("int32_value_value.gotmpl"),
 	}
 
 	return CustomInt32Value{
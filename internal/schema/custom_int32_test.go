@@ -36,7 +36,7 @@ return t.Int32Type.Equal(other.Int32Type)
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt32Type := NewCustomInt32Type(testCase.name)
+			customInt32Type := NewCustomInt32Type(testCase.name, nil)
 
 			got, err := customInt32Type.renderEqual()
 
@@ -73,7 +73,7 @@ return "ExampleType"
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt32Type := NewCustomInt32Type(testCase.name)
+			customInt32Type := NewCustomInt32Type(testCase.name, nil)
 
 			got, err := customInt32Type.renderString()
 
@@ -107,7 +107,7 @@ func TestCustomInt32Type_renderTypable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt32Type := NewCustomInt32Type(testCase.name)
+			customInt32Type := NewCustomInt32Type(testCase.name, nil)
 
 			got, err := customInt32Type.renderTypable()
 
@@ -143,7 +143,7 @@ basetypes.Int32Type
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt32Type := NewCustomInt32Type(testCase.name)
+			customInt32Type := NewCustomInt32Type(testCase.name, nil)
 
 			got, err := customInt32Type.renderType()
 
@@ -186,7 +186,7 @@ Int32Value: in,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt32Type := NewCustomInt32Type(testCase.name)
+			customInt32Type := NewCustomInt32Type(testCase.name, nil)
 
 			got, err := customInt32Type.renderValueFromInt32()
 
@@ -241,7 +241,7 @@ return boolValuable, nil
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt32Type := NewCustomInt32Type(testCase.name)
+			customInt32Type := NewCustomInt32Type(testCase.name, nil)
 
 			got, err := customInt32Type.renderValueFromTerraform()
 
@@ -278,7 +278,7 @@ return ExampleValue{}
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt32Type := NewCustomInt32Type(testCase.name)
+			customInt32Type := NewCustomInt32Type(testCase.name, nil)
 
 			got, err := customInt32Type.renderValueType()
 
@@ -325,7 +325,7 @@ return v.Int32Value.Equal(other.Int32Value)
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt32Value := NewCustomInt32Value(testCase.name)
+			customInt32Value := NewCustomInt32Value(testCase.name, nil)
 
 			got, err := customInt32Value.renderEqual()
 
@@ -363,7 +363,7 @@ return ExampleType{
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt32Value := NewCustomInt32Value(testCase.name)
+			customInt32Value := NewCustomInt32Value(testCase.name, nil)
 
 			got, err := customInt32Value.renderType()
 
@@ -397,7 +397,7 @@ func TestCustomInt32Value_renderValuable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt32Value := NewCustomInt32Value(testCase.name)
+			customInt32Value := NewCustomInt32Value(testCase.name, nil)
 
 			got, err := customInt32Value.renderValuable()
 
@@ -433,7 +433,7 @@ basetypes.Int32Value
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt32Value := NewCustomInt32Value(testCase.name)
+			customInt32Value := NewCustomInt32Value(testCase.name, nil)
 
 			got, err := customInt32Value.renderValue()
 
@@ -13,15 +13,15 @@ type CustomInt64Type struct {
 	templates map[string]string
 }
 
-func NewCustomInt64Type(name string) CustomInt64Type {
+func NewCustomInt64Type(name string, gen *Generator) CustomInt64Type {
 	t := map[string]string{
-		"equal":              Int64TypeEqualTemplate,
-		"string":             Int64TypeStringTemplate,
-		"type":               Int64TypeTypeTemplate,
-		"typable":            Int64TypeTypableTemplate,
-		"valueFromInt64":     Int64TypeValueFromInt64Template,
-		"valueFromTerraform": Int64TypeValueFromTerraformTemplate,
-		"valueType":          Int64TypeValueTypeTemplate,
+		"equal":              gen.template("int64_type_equal.gotmpl"),
+		"string":             gen.template("int64_type_string.gotmpl"),
+		"type":               gen.template("int64_type_type.gotmpl"),
+		"typable":            gen.template("int64_type_typable.gotmpl"),
+		"valueFromInt64":     gen.template("int64_type_value_from_int64.gotmpl"),
+		"valueFromTerraform": gen.template("int64_type_value_from_terraform.gotmpl"),
+		"valueType":          gen.template("int64_type_value_type.gotmpl"),
 	}
 
 	return CustomInt64Type{
@@ -217,12 +217,12 @@ type CustomInt64Value struct {
 	templates map[string]string
 }
 
-func NewCustomInt64Value(name string) CustomInt64Value {
+func NewCustomInt64Value(name string, gen *Generator) CustomInt64Value {
 	t := map[string]string{
-		"equal":    Int64ValueEqualTemplate,
-		"type":     Int64ValueTypeTemplate,
-		"valuable": Int64ValueValuableTemplate,
-		"value":    Int64ValueValueTemplate,
+		"equal":    gen.template("int64_value_equal.gotmpl"),
+		"type":     gen.template("int64_value_type.gotmpl"),
+		"valuable": gen.template("int64_value_valuable.gotmpl"),
+		"value":    gen.template("int64_value_value.gotmpl"),
 	}
 
 	return CustomInt64Value{
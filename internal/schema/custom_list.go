@@ -13,15 +13,15 @@ type CustomListType struct {
 	templates map[string]string
 }
 
-func NewCustomListType(name string) CustomListType {
+func NewCustomListType(name string, gen *Generator) CustomListType {
 	t := map[string]string{
-		"equal":              ListTypeEqualTemplate,
-		"string":             ListTypeStringTemplate,
-		"type":               ListTypeTypeTemplate,
-		"typable":            ListTypeTypableTemplate,
-		"valueFromList":      ListTypeValueFromListTemplate,
-		"valueFromTerraform": ListTypeValueFromTerraformTemplate,
-		"valueType":          ListTypeValueTypeTemplate,
+		"equal":              gen.template("list_type_equal.gotmpl"),
+		"string":             gen.template("list_type_string.gotmpl"),
+		"type":               gen.template("list_type_type.gotmpl"),
+		"typable":            gen.template("list_type_typable.gotmpl"),
+		"valueFromList":      gen.template("list_type_value_from_list.gotmpl"),
+		"valueFromTerraform": gen.template("list_type_value_from_terraform.gotmpl"),
+		"valueType":          gen.template("list_type_value_type.gotmpl"),
 	}
 
 	return CustomListType{
@@ -218,12 +218,12 @@ type CustomListValue struct {
 	templates   map[string]string
 }
 
-func NewCustomListValue(name, elemType string) CustomListValue {
+func NewCustomListValue(name, elemType string, gen *Generator) CustomListValue {
 	t := map[string]string{
-		"equal":    ListValueEqualTemplate,
-		"type":     ListValueTypeTemplate,
-		"valuable": ListValueValuableTemplate,
-		"value":    ListValueValueTemplate,
+		"equal":    gen.template("list_value_equal.gotmpl"),
+		"type":     gen.template("list_value_type.gotmpl"),
+		"valuable": gen.template("list_value_valuable.gotmpl"),
+		"value":    gen.template("list_value_value.gotmpl"),
 	}
 
 	return CustomListValue{
@@ -36,7 +36,7 @@ return t.ListType.Equal(other.ListType)
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customListType := NewCustomListType(testCase.name)
+			customListType := NewCustomListType(testCase.name, nil)
 
 			got, err := customListType.renderEqual()
 
@@ -73,7 +73,7 @@ return "ExampleType"
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customListType := NewCustomListType(testCase.name)
+			customListType := NewCustomListType(testCase.name, nil)
 
 			got, err := customListType.renderString()
 
@@ -107,7 +107,7 @@ func TestCustomListType_renderTypable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customListType := NewCustomListType(testCase.name)
+			customListType := NewCustomListType(testCase.name, nil)
 
 			got, err := customListType.renderTypable()
 
@@ -143,7 +143,7 @@ basetypes.ListType
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customListType := NewCustomListType(testCase.name)
+			customListType := NewCustomListType(testCase.name, nil)
 
 			got, err := customListType.renderType()
 
@@ -186,7 +186,7 @@ ListValue: in,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customListType := NewCustomListType(testCase.name)
+			customListType := NewCustomListType(testCase.name, nil)
 
 			got, err := customListType.renderValueFromList()
 
@@ -241,7 +241,7 @@ return listValuable, nil
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customListType := NewCustomListType(testCase.name)
+			customListType := NewCustomListType(testCase.name, nil)
 
 			got, err := customListType.renderValueFromTerraform()
 
@@ -278,7 +278,7 @@ return ExampleValue{}
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customListType := NewCustomListType(testCase.name)
+			customListType := NewCustomListType(testCase.name, nil)
 
 			got, err := customListType.renderValueType()
 
@@ -323,7 +323,7 @@ return v.ListValue.Equal(other.ListValue)
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customListValue := NewCustomListValue(testCase.name, testCase.elementType)
+			customListValue := NewCustomListValue(testCase.name, testCase.elementType, nil)
 
 			got, err := customListValue.renderEqual()
 
@@ -366,7 +366,7 @@ ElemType: types.BoolType,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customListValue := NewCustomListValue(testCase.name, testCase.elementType)
+			customListValue := NewCustomListValue(testCase.name, testCase.elementType, nil)
 
 			got, err := customListValue.renderType()
 
@@ -402,7 +402,7 @@ func TestCustomListValue_renderValuable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customListValue := NewCustomListValue(testCase.name, testCase.elementType)
+			customListValue := NewCustomListValue(testCase.name, testCase.elementType, nil)
 
 			got, err := customListValue.renderValuable()
 
@@ -440,7 +440,7 @@ basetypes.ListValue
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customListValue := NewCustomListValue(testCase.name, testCase.elementType)
+			customListValue := NewCustomListValue(testCase.name, testCase.elementType, nil)
 
 			got, err := customListValue.renderValue()
 
@@ -13,15 +13,15 @@ type CustomMapType struct {
 	templates map[string]string
 }
 
-func NewCustomMapType(name string) CustomMapType {
+func NewCustomMapType(name string, gen *Generator) CustomMapType {
 	t := map[string]string{
-		"equal":              MapTypeEqualTemplate,
-		"string":             MapTypeStringTemplate,
-		"type":               MapTypeTypeTemplate,
-		"typable":            MapTypeTypableTemplate,
-		"valueFromMap":       MapTypeValueFromMapTemplate,
-		"valueFromTerraform": MapTypeValueFromTerraformTemplate,
-		"valueType":          MapTypeValueTypeTemplate,
+		"equal":              gen.template("map_type_equal.gotmpl"),
+		"string":             gen.template("map_type_string.gotmpl"),
+		"type":               gen.template("map_type_type.gotmpl"),
+		"typable":            gen.template("map_type_typable.gotmpl"),
+		"valueFromMap":       gen.template("map_type_value_from_map.gotmpl"),
+		"valueFromTerraform": gen.template("map_type_value_from_terraform.gotmpl"),
+		"valueType":          gen.template("map_type_value_type.gotmpl"),
 	}
 
 	return CustomMapType{
@@ -218,12 +218,12 @@ type CustomMapValue struct {
 	templates   map[string]string
 }
 
-func NewCustomMapValue(name, elemType string) CustomMapValue {
+func NewCustomMapValue(name, elemType string, gen *Generator) CustomMapValue {
 	t := map[string]string{
-		"equal":    MapValueEqualTemplate,
-		"type":     MapValueTypeTemplate,
-		"valuable": MapValueValuableTemplate,
-		"value":    MapValueValueTemplate,
+		"equal":    gen.template("map_value_equal.gotmpl"),
+		"type":     gen.template("map_value_type.gotmpl"),
+		"valuable": gen.template("map_value_valuable.gotmpl"),
+		"value":    gen.template("map_value_value.gotmpl"),
 	}
 
 	return CustomMapValue{
@@ -36,7 +36,7 @@ return t.MapType.Equal(other.MapType)
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customMapType := NewCustomMapType(testCase.name)
+			customMapType := NewCustomMapType(testCase.name, nil)
 
 			got, err := customMapType.renderEqual()
 
@@ -73,7 +73,7 @@ return "ExampleType"
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customMapType := NewCustomMapType(testCase.name)
+			customMapType := NewCustomMapType(testCase.name, nil)
 
 			got, err := customMapType.renderString()
 
@@ -107,7 +107,7 @@ func TestCustomMapType_renderTypable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customMapType := NewCustomMapType(testCase.name)
+			customMapType := NewCustomMapType(testCase.name, nil)
 
 			got, err := customMapType.renderTypable()
 
@@ -143,7 +143,7 @@ basetypes.MapType
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customMapType := NewCustomMapType(testCase.name)
+			customMapType := NewCustomMapType(testCase.name, nil)
 
 			got, err := customMapType.renderType()
 
@@ -186,7 +186,7 @@ MapValue: in,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customMapType := NewCustomMapType(testCase.name)
+			customMapType := NewCustomMapType(testCase.name, nil)
 
 			got, err := customMapType.renderValueFromMap()
 
@@ -241,7 +241,7 @@ return mapValuable, nil
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customMapType := NewCustomMapType(testCase.name)
+			customMapType := NewCustomMapType(testCase.name, nil)
 
 			got, err := customMapType.renderValueFromTerraform()
 
@@ -278,7 +278,7 @@ return ExampleValue{}
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customMapType := NewCustomMapType(testCase.name)
+			customMapType := NewCustomMapType(testCase.name, nil)
 
 			got, err := customMapType.renderValueType()
 
@@ -323,7 +323,7 @@ return v.MapValue.Equal(other.MapValue)
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customMapValue := NewCustomMapValue(testCase.name, testCase.elementType)
+			customMapValue := NewCustomMapValue(testCase.name, testCase.elementType, nil)
 
 			got, err := customMapValue.renderEqual()
 
@@ -366,7 +366,7 @@ ElemType: types.BoolType,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customMapValue := NewCustomMapValue(testCase.name, testCase.elementType)
+			customMapValue := NewCustomMapValue(testCase.name, testCase.elementType, nil)
 
 			got, err := customMapValue.renderType()
 
@@ -402,7 +402,7 @@ func TestCustomMapValue_renderValuable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customMapValue := NewCustomMapValue(testCase.name, testCase.elementType)
+			customMapValue := NewCustomMapValue(testCase.name, testCase.elementType, nil)
 
 			got, err := customMapValue.renderValuable()
 
@@ -440,7 +440,7 @@ basetypes.MapValue
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customMapValue := NewCustomMapValue(testCase.name, testCase.elementType)
+			customMapValue := NewCustomMapValue(testCase.name, testCase.elementType, nil)
 
 			got, err := customMapValue.renderValue()
 
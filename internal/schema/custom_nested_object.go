@@ -14,19 +14,19 @@ type CustomNestedObjectType struct {
 	templates  map[string]string
 }
 
-func NewCustomNestedObjectType(name string, attrValues map[string]string) CustomNestedObjectType {
+func NewCustomNestedObjectType(name string, attrValues map[string]string, gen *Generator) CustomNestedObjectType {
 	t := map[string]string{
-		"equal":              NestedObjectTypeEqualTemplate,
-		"string":             NestedObjectTypeStringTemplate,
-		"typable":            NestedObjectTypeTypableTemplate,
-		"type":               NestedObjectTypeTypeTemplate,
-		"value":              NestedObjectTypeValueTemplate,
-		"valueFromObject":    NestedObjectTypeValueFromObjectTemplate,
-		"valueFromTerraform": NestedObjectTypeValueFromTerraformTemplate,
-		"valueMust":          NestedObjectTypeValueMustTemplate,
-		"valueNull":          NestedObjectTypeValueNullTemplate,
-		"valueType":          NestedObjectTypeValueTypeTemplate,
-		"valueUnknown":       NestedObjectTypeValueUnknownTemplate,
+		"equal":              gen.template("nested_object_type_equal.gotmpl"),
+		"string":             gen.template("nested_object_type_string.gotmpl"),
+		"typable":            gen.template("nested_object_type_typable.gotmpl"),
+		"type":               gen.template("nested_object_type_type.gotmpl"),
+		"value":              gen.template("nested_object_type_value.gotmpl"),
+		"valueFromObject":    gen.template("nested_object_type_value_from_object.gotmpl"),
+		"valueFromTerraform": gen.template("nested_object_type_value_from_terraform.gotmpl"),
+		"valueMust":          gen.template("nested_object_type_value_must.gotmpl"),
+		"valueNull":          gen.template("nested_object_type_value_null.gotmpl"),
+		"valueType":          gen.template("nested_object_type_value_type.gotmpl"),
+		"valueUnknown":       gen.template("nested_object_type_value_unknown.gotmpl"),
 	}
 
 	a := make(map[FrameworkIdentifier]string, len(attrValues))
@@ -329,18 +329,18 @@ type CustomNestedObjectValue struct {
 	templates       map[string]string
 }
 
-func NewCustomNestedObjectValue(name string, attributeTypes, attrTypes, attrValues map[string]string, collectionTypes map[string]map[string]string) CustomNestedObjectValue {
+func NewCustomNestedObjectValue(name string, attributeTypes, attrTypes, attrValues map[string]string, collectionTypes map[string]map[string]string, gen *Generator) CustomNestedObjectValue {
 	t := map[string]string{
-		"attributeTypes":   NestedObjectValueAttributeTypesTemplate,
-		"equal":            NestedObjectValueEqualTemplate,
-		"isNull":           NestedObjectValueIsNullTemplate,
-		"isUnknown":        NestedObjectValueIsUnknownTemplate,
-		"string":           NestedObjectValueStringTemplate,
-		"toObjectValue":    NestedObjectValueToObjectValueTemplate,
-		"toTerraformValue": NestedObjectValueToTerraformValueTemplate,
-		"type":             NestedObjectValueTypeTemplate,
-		"valuable":         NestedObjectValueValuableTemplate,
-		"value":            NestedObjectValueValueTemplate,
+		"attributeTypes":   gen.template("nested_object_value_attribute_types.gotmpl"),
+		"equal":            gen.template("nested_object_value_equal.gotmpl"),
+		"isNull":           gen.template("nested_object_value_is_null.gotmpl"),
+		"isUnknown":        gen.template("nested_object_value_is_unknown.gotmpl"),
+		"string":           gen.template("nested_object_value_string.gotmpl"),
+		"toObjectValue":    gen.template("nested_object_value_to_object_value.gotmpl"),
+		"toTerraformValue": gen.template("nested_object_value_to_terraform_value.gotmpl"),
+		"type":             gen.template("nested_object_value_type.gotmpl"),
+		"valuable":         gen.template("nested_object_value_valuable.gotmpl"),
+		"value":            gen.template("nested_object_value_value.gotmpl"),
 	}
 
 	attribTypes := make(map[FrameworkIdentifier]string, len(attributeTypes))
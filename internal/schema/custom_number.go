@@ -13,15 +13,15 @@ type CustomNumberType struct {
 	templates map[string]string
 }
 
-func NewCustomNumberType(name string) CustomNumberType {
+func NewCustomNumberType(name string, gen *Generator) CustomNumberType {
 	t := map[string]string{
-		"equal":              NumberTypeEqualTemplate,
-		"string":             NumberTypeStringTemplate,
-		"type":               NumberTypeTypeTemplate,
-		"typable":            NumberTypeTypableTemplate,
-		"valueFromNumber":    NumberTypeValueFromNumberTemplate,
-		"valueFromTerraform": NumberTypeValueFromTerraformTemplate,
-		"valueType":          NumberTypeValueTypeTemplate,
+		"equal":              gen.template("number_type_equal.gotmpl"),
+		"string":             gen.template("number_type_string.gotmpl"),
+		"type":               gen.template("number_type_type.gotmpl"),
+		"typable":            gen.template("number_type_typable.gotmpl"),
+		"valueFromNumber":    gen.template("number_type_value_from_number.gotmpl"),
+		"valueFromTerraform": gen.template("number_type_value_from_terraform.gotmpl"),
+		"valueType":          gen.template("number_type_value_type.gotmpl"),
 	}
 
 	return CustomNumberType{
@@ -217,12 +217,12 @@ type CustomNumberValue struct {
 	templates map[string]string
 }
 
-func NewCustomNumberValue(name string) CustomNumberValue {
+func NewCustomNumberValue(name string, gen *Generator) CustomNumberValue {
 	t := map[string]string{
-		"equal":    NumberValueEqualTemplate,
-		"type":     NumberValueTypeTemplate,
-		"valuable": NumberValueValuableTemplate,
-		"value":    NumberValueValueTemplate,
+		"equal":    gen.template("number_value_equal.gotmpl"),
+		"type":     gen.template("number_value_type.gotmpl"),
+		"valuable": gen.template("number_value_valuable.gotmpl"),
+		"value":    gen.template("number_value_value.gotmpl"),
 	}
 
 	return CustomNumberValue{
@@ -36,7 +36,7 @@ return t.NumberType.Equal(other.NumberType)
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customNumberType := NewCustomNumberType(testCase.name)
+			customNumberType := NewCustomNumberType(testCase.name, nil)
 
 			got, err := customNumberType.renderEqual()
 
@@ -73,7 +73,7 @@ return "ExampleType"
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customNumberType := NewCustomNumberType(testCase.name)
+			customNumberType := NewCustomNumberType(testCase.name, nil)
 
 			got, err := customNumberType.renderString()
 
@@ -107,7 +107,7 @@ func TestCustomNumberType_renderTypable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customNumberType := NewCustomNumberType(testCase.name)
+			customNumberType := NewCustomNumberType(testCase.name, nil)
 
 			got, err := customNumberType.renderTypable()
 
@@ -143,7 +143,7 @@ basetypes.NumberType
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customNumberType := NewCustomNumberType(testCase.name)
+			customNumberType := NewCustomNumberType(testCase.name, nil)
 
 			got, err := customNumberType.renderType()
 
@@ -186,7 +186,7 @@ NumberValue: in,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customNumberType := NewCustomNumberType(testCase.name)
+			customNumberType := NewCustomNumberType(testCase.name, nil)
 
 			got, err := customNumberType.renderValueFromNumber()
 
@@ -241,7 +241,7 @@ return boolValuable, nil
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customNumberType := NewCustomNumberType(testCase.name)
+			customNumberType := NewCustomNumberType(testCase.name, nil)
 
 			got, err := customNumberType.renderValueFromTerraform()
 
@@ -278,7 +278,7 @@ return ExampleValue{}
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customNumberType := NewCustomNumberType(testCase.name)
+			customNumberType := NewCustomNumberType(testCase.name, nil)
 
 			got, err := customNumberType.renderValueType()
 
@@ -325,7 +325,7 @@ return v.NumberValue.Equal(other.NumberValue)
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customNumberValue := NewCustomNumberValue(testCase.name)
+			customNumberValue := NewCustomNumberValue(testCase.name, nil)
 
 			got, err := customNumberValue.renderEqual()
 
@@ -363,7 +363,7 @@ return ExampleType{
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customNumberValue := NewCustomNumberValue(testCase.name)
+			customNumberValue := NewCustomNumberValue(testCase.name, nil)
 
 			got, err := customNumberValue.renderType()
 
@@ -397,7 +397,7 @@ func TestCustomNumberValue_renderValuable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customNumberValue := NewCustomNumberValue(testCase.name)
+			customNumberValue := NewCustomNumberValue(testCase.name, nil)
 
 			got, err := customNumberValue.renderValuable()
 
@@ -433,7 +433,7 @@ basetypes.NumberValue
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customNumberValue := NewCustomNumberValue(testCase.name)
+			customNumberValue := NewCustomNumberValue(testCase.name, nil)
 
 			got, err := customNumberValue.renderValue()
 
@@ -13,15 +13,15 @@ type CustomObjectType struct {
 	templates map[string]string
 }
 
-func NewCustomObjectType(name string) CustomObjectType {
+func NewCustomObjectType(name string, gen *Generator) CustomObjectType {
 	t := map[string]string{
-		"equal":              ObjectTypeEqualTemplate,
-		"string":             ObjectTypeStringTemplate,
-		"type":               ObjectTypeTypeTemplate,
-		"typable":            ObjectTypeTypableTemplate,
-		"valueFromObject":    ObjectTypeValueFromObjectTemplate,
-		"valueFromTerraform": ObjectTypeValueFromTerraformTemplate,
-		"valueType":          ObjectTypeValueTypeTemplate,
+		"equal":              gen.template("object_type_equal.gotmpl"),
+		"string":             gen.template("object_type_string.gotmpl"),
+		"type":               gen.template("object_type_type.gotmpl"),
+		"typable":            gen.template("object_type_typable.gotmpl"),
+		"valueFromObject":    gen.template("object_type_value_from_object.gotmpl"),
+		"valueFromTerraform": gen.template("object_type_value_from_terraform.gotmpl"),
+		"valueType":          gen.template("object_type_value_type.gotmpl"),
 	}
 
 	return CustomObjectType{
@@ -218,13 +218,13 @@ type CustomObjectValue struct {
 	templates map[string]string
 }
 
-func NewCustomObjectValue(name, attrTypes string) CustomObjectValue {
+func NewCustomObjectValue(name, attrTypes string, gen *Generator) CustomObjectValue {
 	t := map[string]string{
-		"attributeTypes": ObjectValueAttributeTypesTemplate,
-		"equal":          ObjectValueEqualTemplate,
-		"type":           ObjectValueTypeTemplate,
-		"valuable":       ObjectValueValuableTemplate,
-		"value":          ObjectValueValueTemplate,
+		"attributeTypes": gen.template("object_value_attribute_types.gotmpl"),
+		"equal":          gen.template("object_value_equal.gotmpl"),
+		"type":           gen.template("object_value_type.gotmpl"),
+		"valuable":       gen.template("object_value_valuable.gotmpl"),
+		"value":          gen.template("object_value_value.gotmpl"),
 	}
 
 	return CustomObjectValue{
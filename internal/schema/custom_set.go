@@ -13,15 +13,15 @@ type CustomSetType struct {
 	templates map[string]string
 }
 
-func NewCustomSetType(name string) CustomSetType {
+func NewCustomSetType(name string, gen *Generator) CustomSetType {
 	t := map[string]string{
-		"equal":              SetTypeEqualTemplate,
-		"string":             SetTypeStringTemplate,
-		"type":               SetTypeTypeTemplate,
-		"typable":            SetTypeTypableTemplate,
-		"valueFromSet":       SetTypeValueFromSetTemplate,
-		"valueFromTerraform": SetTypeValueFromTerraformTemplate,
-		"valueType":          SetTypeValueTypeTemplate,
+		"equal":              gen.template("set_type_equal.gotmpl"),
+		"string":             gen.template("set_type_string.gotmpl"),
+		"type":               gen.template("set_type_type.gotmpl"),
+		"typable":            gen.template("set_type_typable.gotmpl"),
+		"valueFromSet":       gen.template("set_type_value_from_set.gotmpl"),
+		"valueFromTerraform": gen.template("set_type_value_from_terraform.gotmpl"),
+		"valueType":          gen.template("set_type_value_type.gotmpl"),
 	}
 
 	return CustomSetType{
@@ -218,12 +218,12 @@ type CustomSetValue struct {
 	templates   map[string]string
 }
 
-func NewCustomSetValue(name, elemType string) CustomSetValue {
+func NewCustomSetValue(name, elemType string, gen *Generator) CustomSetValue {
 	t := map[string]string{
-		"equal":    SetValueEqualTemplate,
-		"type":     SetValueTypeTemplate,
-		"valuable": SetValueValuableTemplate,
-		"value":    SetValueValueTemplate,
+		"equal":    gen.template("set_value_equal.gotmpl"),
+		"type":     gen.template("set_value_type.gotmpl"),
+		"valuable": gen.template("set_value_valuable.gotmpl"),
+		"value":    gen.template("set_value_value.gotmpl"),
 	}
 
 	return CustomSetValue{
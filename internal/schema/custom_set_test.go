@@ -36,7 +36,7 @@ return t.SetType.Equal(other.SetType)
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customSetType := NewCustomSetType(testCase.name)
+			customSetType := NewCustomSetType(testCase.name, nil)
 
 			got, err := customSetType.renderEqual()
 
@@ -73,7 +73,7 @@ return "ExampleType"
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customSetType := NewCustomSetType(testCase.name)
+			customSetType := NewCustomSetType(testCase.name, nil)
 
 			got, err := customSetType.renderString()
 
@@ -107,7 +107,7 @@ func TestCustomSetType_renderTypable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customSetType := NewCustomSetType(testCase.name)
+			customSetType := NewCustomSetType(testCase.name, nil)
 
 			got, err := customSetType.renderTypable()
 
@@ -143,7 +143,7 @@ basetypes.SetType
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customSetType := NewCustomSetType(testCase.name)
+			customSetType := NewCustomSetType(testCase.name, nil)
 
 			got, err := customSetType.renderType()
 
@@ -186,7 +186,7 @@ SetValue: in,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customSetType := NewCustomSetType(testCase.name)
+			customSetType := NewCustomSetType(testCase.name, nil)
 
 			got, err := customSetType.renderValueFromSet()
 
@@ -241,7 +241,7 @@ return listValuable, nil
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customSetType := NewCustomSetType(testCase.name)
+			customSetType := NewCustomSetType(testCase.name, nil)
 
 			got, err := customSetType.renderValueFromTerraform()
 
@@ -278,7 +278,7 @@ return ExampleValue{}
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customSetType := NewCustomSetType(testCase.name)
+			customSetType := NewCustomSetType(testCase.name, nil)
 
 			got, err := customSetType.renderValueType()
 
@@ -323,7 +323,7 @@ return v.SetValue.Equal(other.SetValue)
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customSetValue := NewCustomSetValue(testCase.name, testCase.elementType)
+			customSetValue := NewCustomSetValue(testCase.name, testCase.elementType, nil)
 
 			got, err := customSetValue.renderEqual()
 
@@ -366,7 +366,7 @@ ElemType: types.BoolType,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customSetValue := NewCustomSetValue(testCase.name, testCase.elementType)
+			customSetValue := NewCustomSetValue(testCase.name, testCase.elementType, nil)
 
 			got, err := customSetValue.renderType()
 
@@ -402,7 +402,7 @@ func TestCustomSetValue_renderValuable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customSetValue := NewCustomSetValue(testCase.name, testCase.elementType)
+			customSetValue := NewCustomSetValue(testCase.name, testCase.elementType, nil)
 
 			got, err := customSetValue.renderValuable()
 
@@ -440,7 +440,7 @@ basetypes.SetValue
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customSetValue := NewCustomSetValue(testCase.name, testCase.elementType)
+			customSetValue := NewCustomSetValue(testCase.name, testCase.elementType, nil)
 
 			got, err := customSetValue.renderValue()
 
@@ -13,15 +13,15 @@ type CustomStringType struct {
 	templates map[string]string
 }
 
-func NewCustomStringType(name string) CustomStringType {
+func NewCustomStringType(name string, gen *Generator) CustomStringType {
 	t := map[string]string{
-		"equal":              StringTypeEqualTemplate,
-		"string":             StringTypeStringTemplate,
-		"type":               StringTypeTypeTemplate,
-		"typable":            StringTypeTypableTemplate,
-		"valueFromString":    StringTypeValueFromStringTemplate,
-		"valueFromTerraform": StringTypeValueFromTerraformTemplate,
-		"valueType":          StringTypeValueTypeTemplate,
+		"equal":              gen.template("string_type_equal.gotmpl"),
+		"string":             gen.template("string_type_string.gotmpl"),
+		"type":               gen.template("string_type_type.gotmpl"),
+		"typable":            gen.template("string_type_typable.gotmpl"),
+		"valueFromString":    gen.template("string_type_value_from_string.gotmpl"),
+		"valueFromTerraform": gen.template("string_type_value_from_terraform.gotmpl"),
+		"valueType":          gen.template("string_type_value_type.gotmpl"),
 	}
 
 	return CustomStringType{
@@ -217,12 +217,12 @@ type CustomStringValue struct {
 	templates map[string]string
 }
 
-func NewCustomStringValue(name string) CustomStringValue {
+func NewCustomStringValue(name string, gen *Generator) CustomStringValue {
 	t := map[string]string{
-		"equal":    StringValueEqualTemplate,
-		"type":     StringValueTypeTemplate,
-		"valuable": StringValueValuableTemplate,
-		"value":    StringValueValueTemplate,
+		"equal":    gen.template("string_value_equal.gotmpl"),
+		"type":     gen.template("string_value_type.gotmpl"),
+		"valuable": gen.template("string_value_valuable.gotmpl"),
+		"value":    gen.template("string_value_value.gotmpl"),
 	}
 
 	return CustomStringValue{
@@ -36,7 +36,7 @@ return t.StringType.Equal(other.StringType)
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customStringType := NewCustomStringType(testCase.name)
+			customStringType := NewCustomStringType(testCase.name, nil)
 
 			got, err := customStringType.renderEqual()
 
@@ -73,7 +73,7 @@ return "ExampleType"
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customStringType := NewCustomStringType(testCase.name)
+			customStringType := NewCustomStringType(testCase.name, nil)
 
 			got, err := customStringType.renderString()
 
@@ -107,7 +107,7 @@ func TestCustomStringType_renderTypable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customStringType := NewCustomStringType(testCase.name)
+			customStringType := NewCustomStringType(testCase.name, nil)
 
 			got, err := customStringType.renderTypable()
 
@@ -143,7 +143,7 @@ basetypes.StringType
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customStringType := NewCustomStringType(testCase.name)
+			customStringType := NewCustomStringType(testCase.name, nil)
 
 			got, err := customStringType.renderType()
 
@@ -186,7 +186,7 @@ StringValue: in,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customStringType := NewCustomStringType(testCase.name)
+			customStringType := NewCustomStringType(testCase.name, nil)
 
 			got, err := customStringType.renderValueFromString()
 
@@ -241,7 +241,7 @@ return boolValuable, nil
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customStringType := NewCustomStringType(testCase.name)
+			customStringType := NewCustomStringType(testCase.name, nil)
 
 			got, err := customStringType.renderValueFromTerraform()
 
@@ -278,7 +278,7 @@ return ExampleValue{}
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customStringType := NewCustomStringType(testCase.name)
+			customStringType := NewCustomStringType(testCase.name, nil)
 
 			got, err := customStringType.renderValueType()
 
@@ -325,7 +325,7 @@ return v.StringValue.Equal(other.StringValue)
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customStringValue := NewCustomStringValue(testCase.name)
+			customStringValue := NewCustomStringValue(testCase.name, nil)
 
 			got, err := customStringValue.renderEqual()
 
@@ -363,7 +363,7 @@ return ExampleType{
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customStringValue := NewCustomStringValue(testCase.name)
+			customStringValue := NewCustomStringValue(testCase.name, nil)
 
 			got, err := customStringValue.renderType()
 
@@ -397,7 +397,7 @@ func TestCustomStringValue_renderValuable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customStringValue := NewCustomStringValue(testCase.name)
+			customStringValue := NewCustomStringValue(testCase.name, nil)
 
 			got, err := customStringValue.renderValuable()
 
@@ -433,7 +433,7 @@ basetypes.StringValue
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customStringValue := NewCustomStringValue(testCase.name)
+			customStringValue := NewCustomStringValue(testCase.name, nil)
 
 			got, err := customStringValue.renderValue()
 
@@ -0,0 +1,471 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+)
+
+// Documentation contains the properties of an attribute or block which are
+// included in generated documentation and examples.
+type Documentation struct {
+	Computed bool
+
+	// ConflictsWith are the names of the sibling attributes and blocks which
+	// cannot be configured alongside the attribute.
+	ConflictsWith []string
+
+	// Default is the static default value as a Terraform configuration
+	// expression, such as "true" or "\"value\"".
+	Default string
+
+	DeprecationMessage string
+	Description        string
+
+	// Example is a value which satisfies the constraints of the attribute,
+	// as a Terraform configuration expression, which example configurations
+	// use in place of a placeholder value.
+	Example string
+
+	// MinItems and MaxItems are the minimum and maximum number of elements
+	// of list and set nested attributes and blocks, if defined.
+	MinItems *int64
+	MaxItems *int64
+
+	Optional  bool
+	Required  bool
+	Sensitive bool
+	WriteOnly bool
+}
+
+// DocsTemplates contains the templates used to render documentation. The
+// Default template is used unless an override is supplied for a named
+// resource, data source or provider.
+type DocsTemplates struct {
+	Default   string
+	Overrides map[string]string
+}
+
+// DocsTemplateData is the data which is available to documentation templates.
+type DocsTemplateData struct {
+	// Name is the Terraform type name, for instance "examplecloud_thing",
+	// or the provider name when documenting the provider.
+	Name               string
+	Type               string
+	ProviderName       string
+	Description        string
+	DeprecationMessage string
+	SchemaMarkdown     string
+}
+
+var docsFuncMap = template.FuncMap{
+	// indent pads each line of s, leaving empty lines without trailing
+	// whitespace.
+	"indent": func(spaces int, s string) string {
+		pad := strings.Repeat(" ", spaces)
+		lines := strings.Split(s, "\n")
+
+		for i, line := range lines {
+			if line != "" {
+				lines[i] = pad + line
+			}
+		}
+
+		return strings.Join(lines, "\n")
+	},
+}
+
+// Docs renders Markdown documentation for each schema. The docsType is the
+// type of entity being documented, for instance "Resource".
+func (g GeneratorSchemas) Docs(providerName, docsType string, templates DocsTemplates) (map[string][]byte, error) {
+	docsBytes := make(map[string][]byte, len(g.schemas))
+
+	for k, s := range g.schemas {
+		name := k
+
+		if docsType != "Provider" {
+			name = fmt.Sprintf("%s_%s", providerName, k)
+		}
+
+		tmpl := templates.Default
+		templateName := "default"
+
+		if override, ok := templates.Overrides[k]; ok {
+			tmpl = override
+			templateName = k
+		}
+
+		t, err := template.New(templateName).Funcs(docsFuncMap).Parse(tmpl)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing documentation template for %s: %w", k, err)
+		}
+
+		var buf bytes.Buffer
+
+		err = t.Execute(&buf, DocsTemplateData{
+			Name:               name,
+			Type:               docsType,
+			ProviderName:       providerName,
+			Description:        stringValue(s.Description),
+			DeprecationMessage: stringValue(s.DeprecationMessage),
+			SchemaMarkdown:     s.SchemaMarkdown(),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("error executing documentation template for %s: %w", k, err)
+		}
+
+		docsBytes[k] = buf.Bytes()
+	}
+
+	return docsBytes, nil
+}
+
+// docsSection is a list of attributes and blocks, or of object attribute
+// types, which is rendered under its own heading when nested.
+type docsSection struct {
+	anchor               string
+	path                 string
+	attributes           GeneratorAttributes
+	blocks               GeneratorBlocks
+	objectAttributeTypes specschema.ObjectAttributeTypes
+}
+
+type docsItem struct {
+	group string
+	line  string
+	name  string
+}
+
+const (
+	docsGroupRequired = "Required"
+	docsGroupOptional = "Optional"
+	docsGroupReadOnly = "Read-Only"
+)
+
+// SchemaMarkdown renders the attributes and blocks of the schema as
+// Markdown, in the style of the Terraform Registry. Nested attributes,
+// blocks and objects are rendered in linked sub-sections.
+func (g GeneratorSchema) SchemaMarkdown() string {
+	var b strings.Builder
+
+	b.WriteString("## Schema\n")
+
+	sections := []docsSection{
+		{
+			attributes: g.Attributes,
+			blocks:     g.Blocks,
+		},
+	}
+
+	for i := 0; i < len(sections); i++ {
+		s := sections[i]
+
+		items, nested := s.items()
+
+		sections = append(sections, nested...)
+
+		if i > 0 {
+			b.WriteString(fmt.Sprintf("\n<a id=%q></a>\n### Nested Schema for `%s`\n", s.anchor, s.path))
+		}
+
+		if s.objectAttributeTypes != nil {
+			b.WriteString("\n")
+
+			for _, item := range items {
+				b.WriteString(fmt.Sprintf("- %s\n", item.line))
+			}
+
+			continue
+		}
+
+		for _, group := range []string{docsGroupRequired, docsGroupOptional, docsGroupReadOnly} {
+			var lines []string
+
+			for _, item := range items {
+				if item.group == group {
+					lines = append(lines, item.line)
+				}
+			}
+
+			if len(lines) == 0 {
+				continue
+			}
+
+			if i == 0 {
+				b.WriteString(fmt.Sprintf("\n### %s\n\n", group))
+			} else {
+				b.WriteString(fmt.Sprintf("\n%s:\n\n", group))
+			}
+
+			for _, line := range lines {
+				b.WriteString(fmt.Sprintf("- %s\n", line))
+			}
+		}
+	}
+
+	return b.String()
+}
+
+func (s docsSection) items() ([]docsItem, []docsSection) {
+	var items []docsItem
+
+	var nested []docsSection
+
+	if s.objectAttributeTypes != nil {
+		for _, v := range s.objectAttributeTypes {
+			typeName, n := s.elementType(v.Name, objectAttributeElementType(v))
+
+			if v.Dynamic != nil {
+				typeName = "Dynamic"
+			}
+
+			if n != nil {
+				nested = append(nested, *n)
+			}
+
+			items = append(items, docsItem{
+				line: docsLine(v.Name, typeName, Documentation{}, n),
+				name: v.Name,
+			})
+		}
+
+		return items, nested
+	}
+
+	for _, k := range s.attributes.SortedKeys() {
+		a := s.attributes[k]
+
+		if a == nil {
+			continue
+		}
+
+		var n *docsSection
+
+		typeName := docsTypeName(a.GeneratorSchemaType())
+
+		switch v := a.(type) {
+		case Attributes:
+			n = s.nested("nestedatt", k)
+			n.attributes = v.GetAttributes()
+		case Attrs:
+			n = s.nested("nestedobjatt", k)
+			n.objectAttributeTypes = v.AttrTypes()
+		case Elements:
+			var elemTypeName string
+
+			elemTypeName, n = s.elementType(k, v.ElemType())
+			typeName = fmt.Sprintf("%s of %s", typeName, elemTypeName)
+		}
+
+		if n != nil {
+			nested = append(nested, *n)
+		}
+
+		items = append(items, docsItemFor(k, typeName, a, n, false))
+	}
+
+	for _, k := range s.blocks.SortedKeys() {
+		b := s.blocks[k]
+
+		if b == nil {
+			continue
+		}
+
+		var n *docsSection
+
+		if v, ok := b.(Blocks); ok {
+			n = s.nested("nestedblock", k)
+			n.attributes = v.GetAttributes()
+			n.blocks = v.GetBlocks()
+
+			nested = append(nested, *n)
+		}
+
+		items = append(items, docsItemFor(k, docsTypeName(b.GeneratorSchemaType()), b, n, true))
+	}
+
+	// attributes and blocks are listed together, in name order
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].name < items[j].name
+	})
+
+	return items, nested
+}
+
+func (s docsSection) nested(prefix, name string) *docsSection {
+	path := name
+	anchor := fmt.Sprintf("%s--%s", prefix, name)
+
+	if s.path != "" {
+		path = fmt.Sprintf("%s.%s", s.path, name)
+		anchor = fmt.Sprintf("%s--%s", prefix, strings.ReplaceAll(path, ".", "--"))
+	}
+
+	return &docsSection{
+		anchor: anchor,
+		path:   path,
+	}
+}
+
+// elementType returns the name of the element type, and a nested section
+// if the element type is, or contains, an object.
+func (s docsSection) elementType(name string, e specschema.ElementType) (string, *docsSection) {
+	switch {
+	case e.Bool != nil:
+		return "Boolean", nil
+	case e.Float64 != nil, e.Int32 != nil, e.Int64 != nil, e.Number != nil:
+		return "Number", nil
+	case e.String != nil:
+		return "String", nil
+	case e.List != nil:
+		elemTypeName, n := s.elementType(name, e.List.ElementType)
+		return "List of " + elemTypeName, n
+	case e.Map != nil:
+		elemTypeName, n := s.elementType(name, e.Map.ElementType)
+		return "Map of " + elemTypeName, n
+	case e.Set != nil:
+		elemTypeName, n := s.elementType(name, e.Set.ElementType)
+		return "Set of " + elemTypeName, n
+	case e.Object != nil:
+		n := s.nested("nestedobjatt", name)
+		n.objectAttributeTypes = e.Object.AttributeTypes
+
+		return "Object", n
+	}
+
+	return "", nil
+}
+
+func objectAttributeElementType(o specschema.ObjectAttributeType) specschema.ElementType {
+	return specschema.ElementType{
+		Bool:    o.Bool,
+		Float64: o.Float64,
+		Int32:   o.Int32,
+		Int64:   o.Int64,
+		List:    o.List,
+		Map:     o.Map,
+		Number:  o.Number,
+		Object:  o.Object,
+		Set:     o.Set,
+		String:  o.String,
+	}
+}
+
+// docsItemFor groups blocks as optional unless they are required, as blocks
+// cannot be computed.
+func docsItemFor(name, typeName string, v any, n *docsSection, block bool) docsItem {
+	var d Documentation
+
+	if documented, ok := v.(Documented); ok {
+		d = documented.Documentation()
+	}
+
+	group := docsGroupReadOnly
+
+	switch {
+	case d.Required:
+		group = docsGroupRequired
+	case d.Optional, block:
+		group = docsGroupOptional
+	}
+
+	return docsItem{
+		group: group,
+		line:  docsLine(name, typeName, d, n),
+		name:  name,
+	}
+}
+
+func docsLine(name, typeName string, d Documentation, n *docsSection) string {
+	properties := []string{typeName}
+
+	if d.MinItems != nil {
+		properties = append(properties, fmt.Sprintf("Min: %d", *d.MinItems))
+	}
+
+	if d.MaxItems != nil {
+		properties = append(properties, fmt.Sprintf("Max: %d", *d.MaxItems))
+	}
+
+	if d.Sensitive {
+		properties = append(properties, "Sensitive")
+	}
+
+	if d.WriteOnly {
+		properties = append(properties, "Write-only")
+	}
+
+	if d.DeprecationMessage != "" {
+		properties = append(properties, "Deprecated")
+	}
+
+	line := fmt.Sprintf("`%s` (%s)", name, strings.Join(properties, ", "))
+
+	if d.Description != "" {
+		line += " " + d.Description
+	}
+
+	if d.DeprecationMessage != "" {
+		line += " " + d.DeprecationMessage
+	}
+
+	if n != nil {
+		line += fmt.Sprintf(" (see [below for nested schema](#%s))", n.anchor)
+	}
+
+	return line
+}
+
+func docsTypeName(t Type) string {
+	switch t {
+	case GeneratorBoolAttribute:
+		return "Boolean"
+	case GeneratorDynamicAttribute:
+		return "Dynamic"
+	case GeneratorFloat32Attribute, GeneratorFloat64Attribute, GeneratorInt32Attribute, GeneratorInt64Attribute, GeneratorNumberAttribute:
+		return "Number"
+	case GeneratorListAttribute:
+		return "List"
+	case GeneratorListNestedAttribute:
+		return "Attributes List"
+	case GeneratorListNestedBlock:
+		return "Block List"
+	case GeneratorMapAttribute:
+		return "Map"
+	case GeneratorMapNestedAttribute:
+		return "Attributes Map"
+	case GeneratorObjectAttribute:
+		return "Object"
+	case GeneratorSetAttribute:
+		return "Set"
+	case GeneratorSetNestedAttribute:
+		return "Attributes Set"
+	case GeneratorSetNestedBlock:
+		return "Block Set"
+	case GeneratorSingleNestedAttribute:
+		return "Attributes"
+	case GeneratorSingleNestedBlock:
+		return "Block"
+	case GeneratorStringAttribute:
+		return "String"
+	}
+
+	return ""
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
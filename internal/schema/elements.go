@@ -4,10 +4,11 @@
 package schema
 
 import (
-	"errors"
 	"fmt"
 
 	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
 )
 
 // GetElementType generates the strings for use within templates for specifying the types to use with
@@ -19,6 +20,11 @@ func GetElementType(e specschema.ElementType) string {
 			return e.Bool.CustomType.Type
 		}
 		return "types.BoolType"
+	case e.Float64 != nil && extension.IsFloat32(e.Float64):
+		if e.Float64.CustomType != nil {
+			return e.Float64.CustomType.Type
+		}
+		return "types.Float32Type"
 	case e.Float64 != nil:
 		if e.Float64.CustomType != nil {
 			return e.Float64.CustomType.Type
@@ -78,6 +84,11 @@ func GetElementValueType(e specschema.ElementType) string {
 			return e.Bool.CustomType.ValueType
 		}
 		return "types.Bool"
+	case e.Float64 != nil && extension.IsFloat32(e.Float64):
+		if e.Float64.CustomType != nil {
+			return e.Float64.CustomType.ValueType
+		}
+		return "types.Float32"
 	case e.Float64 != nil:
 		if e.Float64.CustomType != nil {
 			return e.Float64.CustomType.ValueType
@@ -129,31 +140,13 @@ func GetElementValueType(e specschema.ElementType) string {
 }
 
 // GetElementFromFunc returns a string representation of the function that is used
-// for converting from an API Go type to a framework type.
-// TODO: Handle custom type, and types other than primitives.
-func GetElementFromFunc(e specschema.ElementType) (string, error) {
-	switch {
-	case e.Bool != nil:
-		return "types.BoolPointerValue", nil
-	case e.Float64 != nil:
-		return "types.Float64PointerValue", nil
-	case e.Int64 != nil:
-		return "types.Int64PointerValue", nil
-	case e.Int32 != nil:
-		return "types.Int32PointerValue", nil
-	case e.List != nil:
-		return "", NewUnimplementedError(errors.New("list element type is not yet implemented"))
-	case e.Map != nil:
-		return "", NewUnimplementedError(errors.New("map element type is not yet implemented"))
-	case e.Number != nil:
-		return "types.NumberValue", nil
-	case e.Object != nil:
-		return "", NewUnimplementedError(errors.New("object element type is not yet implemented"))
-	case e.Set != nil:
-		return "", NewUnimplementedError(errors.New("set element type is not yet implemented"))
-	case e.String != nil:
-		return "types.StringPointerValue", nil
+// for converting from an API Go type to a framework type. A function literal is
+// returned for collection and object element types, see elementFromFunc.
+// TODO: Handle custom type.
+func GetElementFromFunc(e specschema.ElementType, gen *Generator) (string, error) {
+	if _, ok := elementTypePrimitiveConversion(e, nil); !ok && !isNestedElementType(e) {
+		return "", nil
 	}
 
-	return "", nil
+	return elementFromFunc(e, gen)
 }
@@ -0,0 +1,425 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+)
+
+// The function literals returned by GetElementToFunc and GetElementFromFunc,
+// and by the nested object attribute type conversions, are rendered within
+// the generated To and From functions, and refer to the ctx and diags
+// variables of those functions. Nested collections and objects are converted
+// by nesting function literals, so that conversions of arbitrary depth can be
+// expressed without introducing additional generated declarations.
+
+// primitiveConversion defines the names used when converting a primitive
+// element type to and from its Go type.
+type primitiveConversion struct {
+	// Name is used to construct the basetypes Valuable interface, its
+	// To{Name}Value method, and the framework value type.
+	Name     string
+	GoType   string
+	ToFunc   string
+	FromFunc string
+	// TypeMapping is set if a type mapping has been loaded for the type.
+	TypeMapping *TypeMapping
+}
+
+func elementTypePrimitiveConversion(e specschema.ElementType, gen *Generator) (primitiveConversion, bool) {
+	switch {
+	case e.Bool != nil:
+		return primitiveConversion{"Bool", "*bool", "ValueBoolPointer", "types.BoolPointerValue", gen.typeMapping("bool")}, true
+	case e.Float64 != nil && extension.IsFloat32(e.Float64):
+		return primitiveConversion{"Float32", "*float32", "ValueFloat32Pointer", "types.Float32PointerValue", gen.typeMapping("float32")}, true
+	case e.Float64 != nil:
+		return primitiveConversion{"Float64", "*float64", "ValueFloat64Pointer", "types.Float64PointerValue", gen.typeMapping("float64")}, true
+	case e.Int32 != nil:
+		return primitiveConversion{"Int32", "*int32", "ValueInt32Pointer", "types.Int32PointerValue", gen.typeMapping("int32")}, true
+	case e.Int64 != nil:
+		return primitiveConversion{"Int64", "*int64", "ValueInt64Pointer", "types.Int64PointerValue", gen.typeMapping("int64")}, true
+	case e.Number != nil:
+		return primitiveConversion{"Number", "*big.Float", "ValueBigFloat", "types.NumberValue", gen.typeMapping("number")}, true
+	case e.String != nil:
+		return primitiveConversion{"String", "*string", "ValueStringPointer", "types.StringPointerValue", gen.typeMapping("string")}, true
+	}
+
+	return primitiveConversion{}, false
+}
+
+// to returns the expression which converts the framework value to the Go type.
+func (p primitiveConversion) to(value string) string {
+	if p.TypeMapping != nil {
+		return p.TypeMapping.ToExpression(value)
+	}
+
+	return fmt.Sprintf("%s.%s()", value, p.ToFunc)
+}
+
+// isMappedPrimitive returns true for primitive element types for which a
+// type mapping has been loaded, and which are therefore converted in the same
+// way as collection and object element types.
+func isMappedPrimitive(e specschema.ElementType, gen *Generator) bool {
+	p, ok := elementTypePrimitiveConversion(e, gen)
+
+	return ok && p.TypeMapping != nil
+}
+
+// isNestedElementType returns true for collection and object element types.
+func isNestedElementType(e specschema.ElementType) bool {
+	return e.List != nil || e.Map != nil || e.Object != nil || e.Set != nil
+}
+
+// objectAttributeConversionElementType returns the element type which is
+// equivalent to the object attribute type, so that object attribute types can
+// be converted in the same way as element types.
+func objectAttributeConversionElementType(o specschema.ObjectAttributeType) (specschema.ElementType, error) {
+	if o.Dynamic != nil {
+		return specschema.ElementType{}, NewUnimplementedError(errors.New("dynamic attribute type is not yet implemented"))
+	}
+
+	return objectAttributeElementType(o), nil
+}
+
+// objectGoType returns an anonymous struct type, with a field for each of
+// the object attribute types.
+func objectGoType(attrTypes specschema.ObjectAttributeTypes, gen *Generator) (string, error) {
+	var b strings.Builder
+
+	b.WriteString("struct {\n")
+
+	for _, v := range attrTypes {
+		e, err := objectAttributeConversionElementType(v)
+
+		if err != nil {
+			return "", err
+		}
+
+		goType, err := ElementTypeGoType(e, gen)
+
+		if err != nil {
+			return "", err
+		}
+
+		b.WriteString(fmt.Sprintf("%s %s\n", FrameworkIdentifier(v.Name).ToPascalCase(), goType))
+	}
+
+	b.WriteString("}")
+
+	return b.String(), nil
+}
+
+// GetElementToFunc returns a function literal which converts a framework
+// value of a collection or object element type to the Go type returned by
+// ElementTypeGoType. An empty string is returned for primitive element types
+// without a type mapping, which are converted by ElementsAs.
+func GetElementToFunc(e specschema.ElementType, gen *Generator) (string, error) {
+	if !isNestedElementType(e) && !isMappedPrimitive(e, gen) {
+		return "", nil
+	}
+
+	return elementToFunc(e, gen)
+}
+
+// elementToFunc returns a function literal with the signature
+// func(v attr.Value) (result T), where T is the Go type of the element type.
+func elementToFunc(e specschema.ElementType, gen *Generator) (string, error) {
+	goType, err := ElementTypeGoType(e, gen)
+
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf("func(v attr.Value) (result %s) {\n", goType))
+
+	if p, ok := elementTypePrimitiveConversion(e, gen); ok {
+		writeValuable(&b, p.Name, "p")
+		b.WriteString(fmt.Sprintf("return %s\n}", p.to("p")))
+
+		return b.String(), nil
+	}
+
+	switch {
+	case e.List != nil, e.Set != nil:
+		name, elementType := "List", e.List
+		if e.Set != nil {
+			name, elementType = "Set", (*specschema.ListType)(e.Set)
+		}
+
+		elementTo, err := elementToFunc(elementType.ElementType, gen)
+
+		if err != nil {
+			return "", err
+		}
+
+		writeValuable(&b, name, "c")
+		b.WriteString("if c.IsNull() || c.IsUnknown() {\nreturn\n}\n\n")
+		b.WriteString(fmt.Sprintf("result = make(%s, 0, len(c.Elements()))\n\n", goType))
+		b.WriteString(fmt.Sprintf("for _, e := range c.Elements() {\nresult = append(result, %s(e))\n}\n\n", elementTo))
+	case e.Map != nil:
+		elementTo, err := elementToFunc(e.Map.ElementType, gen)
+
+		if err != nil {
+			return "", err
+		}
+
+		writeValuable(&b, "Map", "c")
+		b.WriteString("if c.IsNull() || c.IsUnknown() {\nreturn\n}\n\n")
+		b.WriteString(fmt.Sprintf("result = make(%s, len(c.Elements()))\n\n", goType))
+		b.WriteString(fmt.Sprintf("for k, e := range c.Elements() {\nresult[k] = %s(e)\n}\n\n", elementTo))
+	case e.Object != nil:
+		writeValuable(&b, "Object", "o")
+		b.WriteString("if o.IsNull() || o.IsUnknown() {\nreturn\n}\n\n")
+		b.WriteString("attributes := o.Attributes()\n\n")
+
+		for _, v := range e.Object.AttributeTypes {
+			attributeElementType, err := objectAttributeConversionElementType(v)
+
+			if err != nil {
+				return "", err
+			}
+
+			attributeTo, err := elementToFunc(attributeElementType, gen)
+
+			if err != nil {
+				return "", err
+			}
+
+			b.WriteString(fmt.Sprintf("result.%s = %s(attributes[%q])\n\n", FrameworkIdentifier(v.Name).ToPascalCase(), attributeTo, v.Name))
+		}
+	default:
+		return "", errors.New("no matching element type found")
+	}
+
+	b.WriteString("return\n}")
+
+	return b.String(), nil
+}
+
+// writeValuable writes the statements which assert that v implements the
+// basetypes Valuable interface for the type name, and declare the converted
+// value as the named variable.
+func writeValuable(b *strings.Builder, typeName, name string) {
+	b.WriteString(fmt.Sprintf("valuable, ok := v.(basetypes.%sValuable)\n\n", typeName))
+	b.WriteString("if !ok {\n")
+	b.WriteString(fmt.Sprintf("diags.AddError(\"Unexpected Value Type\", fmt.Sprintf(`expected basetypes.%sValuable, was: %%T`, v))\n\n", typeName))
+	b.WriteString("return\n}\n\n")
+	b.WriteString(fmt.Sprintf("%s, d := valuable.To%sValue(ctx)\n\n", name, typeName))
+	b.WriteString("diags.Append(d...)\n\n")
+}
+
+// elementFromFunc returns the name of a function, or a function literal,
+// with the signature func(v T) V, where T is the Go type of the element
+// type, and V is the framework value type.
+func elementFromFunc(e specschema.ElementType, gen *Generator) (string, error) {
+	if p, ok := elementTypePrimitiveConversion(e, gen); ok {
+		if p.TypeMapping == nil {
+			return p.FromFunc, nil
+		}
+
+		return fmt.Sprintf("func(v %s) types.%s {\nreturn %s\n}", p.TypeMapping.GoType, p.Name, p.TypeMapping.FromExpression("v")), nil
+	}
+
+	goType, err := ElementTypeGoType(e, gen)
+
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+
+	switch {
+	case e.List != nil, e.Set != nil:
+		name, elementType := "List", e.List
+		if e.Set != nil {
+			name, elementType = "Set", (*specschema.ListType)(e.Set)
+		}
+
+		elementTypeString, err := ElementTypeString(elementType.ElementType)
+
+		if err != nil {
+			return "", err
+		}
+
+		elementFrom, err := elementFromFunc(elementType.ElementType, gen)
+
+		if err != nil {
+			return "", err
+		}
+
+		b.WriteString(fmt.Sprintf("func(v %s) types.%s {\n", goType, name))
+		b.WriteString(fmt.Sprintf("if v == nil {\nreturn types.%sNull(%s)\n}\n\n", name, elementTypeString))
+		b.WriteString("elems := make([]attr.Value, 0, len(v))\n\n")
+		b.WriteString(fmt.Sprintf("for _, e := range v {\nelems = append(elems, %s(e))\n}\n\n", elementFrom))
+		b.WriteString(fmt.Sprintf("c, d := types.%sValue(%s, elems)\n\n", name, elementTypeString))
+		b.WriteString("diags.Append(d...)\n\nreturn c\n}")
+	case e.Map != nil:
+		elementTypeString, err := ElementTypeString(e.Map.ElementType)
+
+		if err != nil {
+			return "", err
+		}
+
+		elementFrom, err := elementFromFunc(e.Map.ElementType, gen)
+
+		if err != nil {
+			return "", err
+		}
+
+		b.WriteString(fmt.Sprintf("func(v %s) types.Map {\n", goType))
+		b.WriteString(fmt.Sprintf("if v == nil {\nreturn types.MapNull(%s)\n}\n\n", elementTypeString))
+		b.WriteString("elems := make(map[string]attr.Value, len(v))\n\n")
+		b.WriteString(fmt.Sprintf("for k, e := range v {\nelems[k] = %s(e)\n}\n\n", elementFrom))
+		b.WriteString(fmt.Sprintf("c, d := types.MapValue(%s, elems)\n\n", elementTypeString))
+		b.WriteString("diags.Append(d...)\n\nreturn c\n}")
+	case e.Object != nil:
+		attrTypesString, err := AttrTypesString(e.Object.AttributeTypes)
+
+		if err != nil {
+			return "", err
+		}
+
+		b.WriteString(fmt.Sprintf("func(v %s) types.Object {\n", goType))
+		b.WriteString(fmt.Sprintf("o, d := types.ObjectValue(map[string]attr.Type{\n%s,\n}, map[string]attr.Value{\n", attrTypesString))
+
+		for _, v := range e.Object.AttributeTypes {
+			attributeElementType, err := objectAttributeConversionElementType(v)
+
+			if err != nil {
+				return "", err
+			}
+
+			attributeFrom, err := elementFromFunc(attributeElementType, gen)
+
+			if err != nil {
+				return "", err
+			}
+
+			b.WriteString(fmt.Sprintf("%q: %s(v.%s),\n", v.Name, attributeFrom, FrameworkIdentifier(v.Name).ToPascalCase()))
+		}
+
+		b.WriteString("})\n\ndiags.Append(d...)\n\nreturn o\n}")
+	default:
+		return "", errors.New("no matching element type found")
+	}
+
+	return b.String(), nil
+}
+
+// GetCollectionToFunc returns a function literal which converts a framework
+// list, map or set value, as defined by the collection element type, to its Go
+// type. An empty string is returned for collections with primitive element
+// types without a type mapping, which are converted by ElementsAs.
+func GetCollectionToFunc(c specschema.ElementType, gen *Generator) (string, error) {
+	if e := collectionElementType(c); !isNestedElementType(e) && !isMappedPrimitive(e, gen) {
+		return "", nil
+	}
+
+	return elementToFunc(c, gen)
+}
+
+// GetCollectionFromFunc returns a function literal which converts the Go type
+// of a list, map or set, as defined by the collection element type, to a
+// framework value. An empty string is returned for collections with primitive
+// element types without a type mapping, which are converted by the ValueFrom
+// functions.
+func GetCollectionFromFunc(c specschema.ElementType, gen *Generator) (string, error) {
+	if e := collectionElementType(c); !isNestedElementType(e) && !isMappedPrimitive(e, gen) {
+		return "", nil
+	}
+
+	return elementFromFunc(c, gen)
+}
+
+func collectionElementType(c specschema.ElementType) specschema.ElementType {
+	switch {
+	case c.List != nil:
+		return c.List.ElementType
+	case c.Map != nil:
+		return c.Map.ElementType
+	case c.Set != nil:
+		return c.Set.ElementType
+	}
+
+	return specschema.ElementType{}
+}
+
+// nestedObjectFieldTo returns the ObjectField for converting a collection or
+// object attribute type, or a primitive attribute type with a type mapping, to
+// its Go type.
+func nestedObjectFieldTo(o specschema.ObjectAttributeType, gen *Generator) (ObjectField, error) {
+	e := objectAttributeElementType(o)
+
+	goType, err := ElementTypeGoType(e, gen)
+
+	if err != nil {
+		return ObjectField{}, err
+	}
+
+	toFunc, err := elementToFunc(e, gen)
+
+	if err != nil {
+		return ObjectField{}, err
+	}
+
+	return ObjectField{
+		GoType:        goType,
+		Type:          nestedElementValueType(e),
+		ToFuncLiteral: toFunc,
+	}, nil
+}
+
+// nestedObjectFieldFrom returns the ObjectField for converting the Go type of
+// a collection or object attribute type, or a primitive attribute type with a
+// type mapping, to a framework value.
+func nestedObjectFieldFrom(o specschema.ObjectAttributeType, gen *Generator) (ObjectField, error) {
+	e := objectAttributeElementType(o)
+
+	elementType, err := ElementTypeString(e)
+
+	if err != nil {
+		return ObjectField{}, err
+	}
+
+	fromFunc, err := elementFromFunc(e, gen)
+
+	if err != nil {
+		return ObjectField{}, err
+	}
+
+	return ObjectField{
+		Type:            elementType,
+		FromFuncLiteral: fromFunc,
+	}, nil
+}
+
+// nestedElementValueType returns the value type of element types,
+// disregarding custom types, as the attribute types of objects
+// are defined by ElementTypeString.
+func nestedElementValueType(e specschema.ElementType) string {
+	if p, ok := elementTypePrimitiveConversion(e, nil); ok {
+		return "types." + p.Name
+	}
+
+	switch {
+	case e.List != nil:
+		return "types.List"
+	case e.Map != nil:
+		return "types.Map"
+	case e.Object != nil:
+		return "types.Object"
+	case e.Set != nil:
+		return "types.Set"
+	}
+
+	return ""
+}
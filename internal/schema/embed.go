@@ -52,6 +52,96 @@ var BoolValueValueTemplate string
 //go:embed templates/bool_value_valuable.gotmpl
 var BoolValueValuableTemplate string
 
+// Dynamic From/To
+
+//go:embed templates/dynamic_from.gotmpl
+var DynamicFromTemplate string
+
+//go:embed templates/dynamic_to.gotmpl
+var DynamicToTemplate string
+
+// Dynamic Type
+
+//go:embed templates/dynamic_type_equal.gotmpl
+var DynamicTypeEqualTemplate string
+
+//go:embed templates/dynamic_type_string.gotmpl
+var DynamicTypeStringTemplate string
+
+//go:embed templates/dynamic_type_type.gotmpl
+var DynamicTypeTypeTemplate string
+
+//go:embed templates/dynamic_type_typable.gotmpl
+var DynamicTypeTypableTemplate string
+
+//go:embed templates/dynamic_type_value_from_dynamic.gotmpl
+var DynamicTypeValueFromDynamicTemplate string
+
+//go:embed templates/dynamic_type_value_from_terraform.gotmpl
+var DynamicTypeValueFromTerraformTemplate string
+
+//go:embed templates/dynamic_type_value_type.gotmpl
+var DynamicTypeValueTypeTemplate string
+
+// Dynamic Value
+
+//go:embed templates/dynamic_value_equal.gotmpl
+var DynamicValueEqualTemplate string
+
+//go:embed templates/dynamic_value_type.gotmpl
+var DynamicValueTypeTemplate string
+
+//go:embed templates/dynamic_value_value.gotmpl
+var DynamicValueValueTemplate string
+
+//go:embed templates/dynamic_value_valuable.gotmpl
+var DynamicValueValuableTemplate string
+
+// Float32 From/To
+
+//go:embed templates/float32_from.gotmpl
+var Float32FromTemplate string
+
+//go:embed templates/float32_to.gotmpl
+var Float32ToTemplate string
+
+// Float32 Type
+
+//go:embed templates/float32_type_equal.gotmpl
+var Float32TypeEqualTemplate string
+
+//go:embed templates/float32_type_string.gotmpl
+var Float32TypeStringTemplate string
+
+//go:embed templates/float32_type_type.gotmpl
+var Float32TypeTypeTemplate string
+
+//go:embed templates/float32_type_typable.gotmpl
+var Float32TypeTypableTemplate string
+
+//go:embed templates/float32_type_value_from_float32.gotmpl
+var Float32TypeValueFromFloat32Template string
+
+//go:embed templates/float32_type_value_from_terraform.gotmpl
+var Float32TypeValueFromTerraformTemplate string
+
+//go:embed templates/float32_type_value_type.gotmpl
+var Float32TypeValueTypeTemplate string
+
+// Float32 Value
+
+//go:embed templates/float32_value_equal.gotmpl
+var Float32ValueEqualTemplate string
+
+//go:embed templates/float32_value_type.gotmpl
+var Float32ValueTypeTemplate string
+
+//go:embed templates/float32_value_value.gotmpl
+var Float32ValueValueTemplate string
+
+//go:embed templates/float32_value_valuable.gotmpl
+var Float32ValueValuableTemplate string
+
 // Float64 From/To
 
 //go:embed templates/float64_from.gotmpl
@@ -400,6 +490,11 @@ var NestedObjectValueValueTemplate string
 //go:embed templates/schema.gotmpl
 var SchemaGoTemplate string
 
+// Documentation
+
+//go:embed templates/docs.md.gotmpl
+var DocsMarkdownTemplate string
+
 // Object From/To
 
 //go:embed templates/object_from.gotmpl
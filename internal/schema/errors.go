@@ -3,7 +3,10 @@
 
 package schema
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+)
 
 // UnimplementedError is used to indicate that the operation
 // being performed is not yet implemented. It is primarily used
@@ -43,3 +46,72 @@ func (e *UnimplementedError) NestedUnimplementedError(parentPath string) *Unimpl
 
 	return newErr
 }
+
+// GeneratorError is used to indicate that generating code failed at the
+// path of a schema, attribute or block, so that errors can be collected
+// across all schemas and reported together.
+type GeneratorError struct {
+	err  error
+	path []string
+}
+
+// Error returns the path followed by the underlying error string.
+func (e *GeneratorError) Error() string {
+	if len(e.path) == 0 {
+		return e.err.Error()
+	}
+
+	return fmt.Sprintf("%s: %s", e.Path(), e.err)
+}
+
+// Unwrap returns the underlying error.
+func (e *GeneratorError) Unwrap() error {
+	return e.err
+}
+
+// Path returns a dot-separated path.
+func (e *GeneratorError) Path() string {
+	return strings.Join(e.path, ".")
+}
+
+// NewGeneratorError returns a GeneratorError populated with the supplied
+// error and path. If the supplied error is itself a GeneratorError, the
+// supplied path is prepended to its path instead.
+func NewGeneratorError(err error, path ...string) *GeneratorError {
+	var parentPath []string
+
+	for _, p := range path {
+		if p != "" {
+			parentPath = append(parentPath, p)
+		}
+	}
+
+	if e, ok := err.(*GeneratorError); ok {
+		return &GeneratorError{
+			err:  e.err,
+			path: append(parentPath, e.path...),
+		}
+	}
+
+	return &GeneratorError{
+		err:  err,
+		path: parentPath,
+	}
+}
+
+// NewGeneratorErrors returns GeneratorErrors populated with the supplied
+// error and path. If the supplied error joins several errors, such as those
+// collected across attributes and blocks, each is prefixed with the path.
+func NewGeneratorErrors(err error, path ...string) []error {
+	if e, ok := err.(interface{ Unwrap() []error }); ok {
+		var errs []error
+
+		for _, v := range e.Unwrap() {
+			errs = append(errs, NewGeneratorErrors(v, path...)...)
+		}
+
+		return errs
+	}
+
+	return []error{NewGeneratorError(err, path...)}
+}
@@ -0,0 +1,590 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+)
+
+// exampleItem is an attribute or block within the body of a configuration
+// block. For attributes, lines holds the value, and for blocks, lines holds
+// the body of the block.
+type exampleItem struct {
+	name  string
+	lines []string
+	block bool
+}
+
+// Examples renders an example Terraform configuration for each schema. The
+// blockType is the type of configuration block, for instance "resource" or
+// "data". Required attributes and blocks, and blocks with a minimum number
+// of elements, are always included, and optional attributes and blocks are
+// included if includeOptional is true, unless the constraints or config
+// validators of the schema only permit one of them to be configured. List and
+// set nested attributes and blocks are repeated to their minimum number of
+// elements, with placeholder values which differ between elements, as
+// Terraform would otherwise merge identical set elements. Attribute values satisfy any one_of, min or max constraint.
+func (g GeneratorSchemas) Examples(providerName, blockType string, includeOptional bool) (map[string][]byte, error) {
+	examplesBytes := make(map[string][]byte, len(g.schemas))
+
+	for k, s := range g.schemas {
+		var b strings.Builder
+
+		b.WriteString(fmt.Sprintf("%s %q \"example\" {\n", blockType, fmt.Sprintf("%s_%s", providerName, k)))
+
+		for _, line := range renderExampleBody(s.exampleItems(includeOptional)) {
+			b.WriteString(indentExampleLine(line) + "\n")
+		}
+
+		b.WriteString("}\n")
+
+		examplesBytes[k] = []byte(b.String())
+	}
+
+	return examplesBytes, nil
+}
+
+func (g GeneratorSchema) exampleItems(includeOptional bool) []exampleItem {
+	return exampleItems(g.Attributes, g.Blocks, exampleOptions{
+		includeOptional: includeOptional,
+		selection:       g.exampleSelection(includeOptional),
+	})
+}
+
+// exampleOptions configures which attributes and blocks are included in an
+// example. The selection holds, by path of attribute and block names separated
+// by dots, the optional attributes and blocks which must be included, or
+// omitted, to satisfy the config validators of the schema. The prefix is the
+// path of the enclosing nested attribute or block, including a trailing dot.
+// The element is the position of the enclosing list or set element, which
+// varies the placeholder values so that the elements of a set are distinct.
+type exampleOptions struct {
+	includeOptional bool
+	selection       map[string]bool
+	prefix          string
+	element         int
+}
+
+// nested returns the options for the attributes and blocks of the nested
+// attribute or block.
+func (o exampleOptions) nested(name string) exampleOptions {
+	o.prefix += name + "."
+
+	return o
+}
+
+// nestedElement returns the options for the attributes and blocks of the
+// element at position i of the list or set nested attribute or block.
+func (o exampleOptions) nestedElement(name string, i int) exampleOptions {
+	o = o.nested(name)
+	o.element += i
+
+	return o
+}
+
+// included returns true if the attribute or block with the documentation is
+// to be included in the example. Required attributes and blocks, and blocks
+// with a minimum number of elements, cannot be omitted, and computed
+// attributes which cannot be configured are never included.
+func (o exampleOptions) included(name string, d Documentation, block bool) bool {
+	if d.Required || (block && d.MinItems != nil && *d.MinItems > 0) {
+		return true
+	}
+
+	if !block && !d.Optional {
+		return false
+	}
+
+	if included, ok := o.selection[o.prefix+name]; ok {
+		return included
+	}
+
+	return o.includeOptional
+}
+
+// exampleSelection returns the optional attributes and blocks, by path, which
+// must be included in, or omitted from, the example to satisfy the config
+// validators. Of the paths of an exactly_one_of validator, only the first
+// which is required, or would otherwise be included, or failing that the
+// first path, is included, and likewise only the first required or
+// otherwise included path of a conflicting validator. The first path of an
+// at_least_one_of validator is included if no other path would be. The paths
+// of a required_together validator are included if any of them is, unless
+// another validator omits them.
+func (g GeneratorSchema) exampleSelection(includeOptional bool) map[string]bool {
+	selection := make(map[string]bool)
+
+	if g.ConfigValidators == nil {
+		return selection
+	}
+
+	// required returns true for paths which cannot be omitted, and included
+	// for paths which are included, given the current selection
+	required, included := exampleSelected(g, exampleOptions{}), exampleSelected(g, exampleOptions{
+		includeOptional: includeOptional,
+		selection:       selection,
+	})
+
+	// include attributes and blocks along with the nested attributes and
+	// blocks which enclose them
+	include := func(p string) {
+		steps := strings.Split(p, ".")
+
+		for i := range steps {
+			if _, ok := selection[strings.Join(steps[:i+1], ".")]; !ok {
+				selection[strings.Join(steps[:i+1], ".")] = true
+			}
+		}
+	}
+
+	for _, v := range g.ConfigValidators.Validators {
+		switch v.Name {
+		case "AtLeastOneOf":
+			if !slices.ContainsFunc(v.Paths, included) {
+				include(v.Paths[0])
+			}
+		case "Conflicting", "ExactlyOneOf":
+			i := slices.IndexFunc(v.Paths, required)
+
+			if i < 0 {
+				i = slices.IndexFunc(v.Paths, included)
+			}
+
+			if i < 0 && v.Name == "Conflicting" {
+				continue
+			}
+
+			for j, p := range v.Paths {
+				if j != max(i, 0) {
+					selection[p] = false
+				}
+			}
+
+			include(v.Paths[max(i, 0)])
+		}
+	}
+
+	for _, v := range g.ConfigValidators.Validators {
+		if v.Name != "RequiredTogether" || !slices.ContainsFunc(v.Paths, included) {
+			continue
+		}
+
+		for _, p := range v.Paths {
+			include(p)
+		}
+	}
+
+	return selection
+}
+
+// exampleSelected returns a function which returns true if the attribute or
+// block at the path is included in the example with the options.
+func exampleSelected(g GeneratorSchema, o exampleOptions) func(p string) bool {
+	return func(p string) bool {
+		d, block, ok := g.exampleDocumentation(p)
+
+		return ok && o.included(p, d, block)
+	}
+}
+
+// exampleDocumentation returns the documentation of the attribute or block at
+// the path, and whether it is a block, or false if the path is not defined in
+// the schema.
+func (g GeneratorSchema) exampleDocumentation(p string) (Documentation, bool, bool) {
+	attributes, blocks := g.Attributes, g.Blocks
+
+	steps := strings.Split(p, ".")
+
+	for i, step := range steps {
+		var element any
+
+		a, isAttribute := attributes[step]
+		b, isBlock := blocks[step]
+
+		switch {
+		case isAttribute:
+			element = a
+		case isBlock:
+			element = b
+		default:
+			return Documentation{}, false, false
+		}
+
+		if i == len(steps)-1 {
+			var d Documentation
+
+			if documented, ok := element.(Documented); ok {
+				d = documented.Documentation()
+			}
+
+			return d, isBlock, true
+		}
+
+		nested, ok := element.(Attributes)
+
+		if !ok {
+			return Documentation{}, false, false
+		}
+
+		attributes, blocks = nested.GetAttributes(), nil
+
+		if nestedBlocks, ok := element.(Blocks); ok {
+			blocks = nestedBlocks.GetBlocks()
+		}
+	}
+
+	return Documentation{}, false, false
+}
+
+// exampleItems returns the attributes, in name order, followed by the blocks,
+// in name order, which are to be included in the example. Of the attributes
+// and blocks which an included attribute conflicts with, only those which
+// are required are included.
+func exampleItems(attributes GeneratorAttributes, blocks GeneratorBlocks, o exampleOptions) []exampleItem {
+	var items []exampleItem
+
+	docs := make(map[string]Documentation)
+
+	included := make(map[string]bool)
+
+	for _, k := range attributes.SortedKeys() {
+		if documented, ok := attributes[k].(Documented); ok {
+			docs[k] = documented.Documentation()
+			included[k] = o.included(k, docs[k], false)
+		}
+	}
+
+	for _, k := range blocks.SortedKeys() {
+		if _, ok := blocks[k].(Blocks); !ok {
+			continue
+		}
+
+		var d Documentation
+
+		if documented, ok := blocks[k].(Documented); ok {
+			d = documented.Documentation()
+		}
+
+		docs[k] = d
+		included[k] = o.included(k, d, true)
+	}
+
+	for _, k := range attributes.SortedKeys() {
+		if !included[k] {
+			continue
+		}
+
+		for _, name := range docs[k].ConflictsWith {
+			if included[name] && docs[name].Required {
+				included[k] = false
+
+				break
+			}
+
+			included[name] = false
+		}
+	}
+
+	for _, k := range attributes.SortedKeys() {
+		if !included[k] {
+			continue
+		}
+
+		items = append(items, exampleItem{
+			name:  k,
+			lines: exampleAttributeValue(k, attributes[k], docs[k], o),
+		})
+	}
+
+	for _, k := range blocks.SortedKeys() {
+		if !included[k] {
+			continue
+		}
+
+		nested := blocks[k].(Blocks)
+
+		for i := range exampleItemsCount(docs[k]) {
+			items = append(items, exampleItem{
+				name:  k,
+				lines: renderExampleBody(exampleItems(nested.GetAttributes(), nested.GetBlocks(), o.nestedElement(k, i))),
+				block: true,
+			})
+		}
+	}
+
+	return items
+}
+
+// exampleAttributeValue returns the static default of the attribute if there
+// is one, otherwise a value which satisfies its constraints, or a placeholder
+// value of the appropriate type. The options are those of the attributes of
+// a nested attribute.
+func exampleAttributeValue(name string, a GeneratorAttribute, d Documentation, o exampleOptions) []string {
+	if d.Default != "" {
+		return []string{d.Default}
+	}
+
+	if d.Example != "" {
+		return []string{d.Example}
+	}
+
+	switch v := a.(type) {
+	case Attributes:
+		object := func(i int) []string {
+			return exampleObject(renderExampleBody(exampleItems(v.GetAttributes(), nil, o.nestedElement(name, i))))
+		}
+
+		switch a.GeneratorSchemaType() {
+		case GeneratorListNestedAttribute, GeneratorSetNestedAttribute:
+			elems := make([][]string, exampleItemsCount(d))
+
+			for i := range elems {
+				elems[i] = object(i)
+			}
+
+			return exampleListOf(elems)
+		case GeneratorMapNestedAttribute:
+			return exampleMap(object(0))
+		}
+
+		return object(0)
+	case Attrs:
+		return exampleObjectAttributeTypes(v.AttrTypes(), o.element)
+	case Elements:
+		elem := exampleElementValue(v.ElemType(), o.element)
+
+		if a.GeneratorSchemaType() == GeneratorMapAttribute {
+			return exampleMap(elem)
+		}
+
+		return exampleList(elem)
+	}
+
+	return []string{examplePrimitiveValue(a.GeneratorSchemaType(), o.element)}
+}
+
+// examplePrimitiveValue returns a placeholder value of the type, which differs
+// for each element of an enclosing list or set, other than booleans, which
+// alternate.
+func examplePrimitiveValue(t Type, element int) string {
+	switch t {
+	case GeneratorBoolAttribute:
+		return fmt.Sprint(element%2 == 0)
+	case GeneratorFloat32Attribute, GeneratorFloat64Attribute:
+		return fmt.Sprintf("%d.5", element+1)
+	case GeneratorInt32Attribute, GeneratorInt64Attribute, GeneratorNumberAttribute:
+		return fmt.Sprint(element + 1)
+	}
+
+	if element > 0 {
+		return fmt.Sprintf(`"example-%d"`, element+1)
+	}
+
+	return `"example"`
+}
+
+func exampleElementValue(e specschema.ElementType, element int) []string {
+	switch {
+	case e.Bool != nil:
+		return []string{examplePrimitiveValue(GeneratorBoolAttribute, element)}
+	case e.Float64 != nil:
+		return []string{examplePrimitiveValue(GeneratorFloat64Attribute, element)}
+	case e.Int32 != nil, e.Int64 != nil, e.Number != nil:
+		return []string{examplePrimitiveValue(GeneratorInt64Attribute, element)}
+	case e.List != nil:
+		return exampleList(exampleElementValue(e.List.ElementType, element))
+	case e.Map != nil:
+		return exampleMap(exampleElementValue(e.Map.ElementType, element))
+	case e.Object != nil:
+		return exampleObjectAttributeTypes(e.Object.AttributeTypes, element)
+	case e.Set != nil:
+		return exampleList(exampleElementValue(e.Set.ElementType, element))
+	}
+
+	return []string{examplePrimitiveValue(GeneratorStringAttribute, element)}
+}
+
+// exampleObjectAttributeTypes includes every attribute type, as object
+// values must define all attributes.
+func exampleObjectAttributeTypes(attrTypes specschema.ObjectAttributeTypes, element int) []string {
+	items := make([]exampleItem, 0, len(attrTypes))
+
+	for _, v := range attrTypes {
+		items = append(items, exampleItem{
+			name:  v.Name,
+			lines: exampleElementValue(objectAttributeElementType(v), element),
+		})
+	}
+
+	return exampleObject(renderExampleBody(items))
+}
+
+func exampleObject(body []string) []string {
+	if len(body) == 0 {
+		return []string{"{}"}
+	}
+
+	lines := []string{"{"}
+
+	lines = append(lines, body...)
+
+	return append(lines, "}")
+}
+
+// exampleList wraps a single element as a list or set, for instance
+// ["example"], or [{ ... }] for an object spanning multiple lines.
+func exampleList(elem []string) []string {
+	lines := make([]string, len(elem))
+
+	copy(lines, elem)
+
+	lines[0] = "[" + lines[0]
+	lines[len(lines)-1] += "]"
+
+	return lines
+}
+
+// exampleListOf wraps the elements as a list or set, placing each element on
+// its own lines if the elements span multiple lines.
+func exampleListOf(elems [][]string) []string {
+	if len(elems) == 1 {
+		return exampleList(elems[0])
+	}
+
+	if len(elems[0]) == 1 {
+		values := make([]string, len(elems))
+
+		for i, elem := range elems {
+			values[i] = elem[0]
+		}
+
+		return []string{"[" + strings.Join(values, ", ") + "]"}
+	}
+
+	lines := []string{"["}
+
+	for _, elem := range elems {
+		lines = append(lines, elem[0])
+		lines = append(lines, indentExampleLines(elem[1:])...)
+		lines[len(lines)-1] += ","
+	}
+
+	return append(lines, "]")
+}
+
+// exampleItemsCount returns the number of elements of a list or set nested
+// attribute or block to include in the example, which is the minimum number
+// of elements if defined, otherwise one.
+func exampleItemsCount(d Documentation) int {
+	if d.MinItems != nil && *d.MinItems > 1 {
+		return int(*d.MinItems)
+	}
+
+	return 1
+}
+
+// exampleMap wraps a single element as a map with a placeholder key.
+func exampleMap(elem []string) []string {
+	if len(elem) == 1 {
+		return []string{fmt.Sprintf("{ key = %s }", elem[0])}
+	}
+
+	return exampleObject(renderExampleBody([]exampleItem{
+		{
+			name:  "key",
+			lines: elem,
+		},
+	}))
+}
+
+// renderExampleBody returns the lines of the body of a block or object, which
+// are indented when rendered within the enclosing braces. The equals signs of
+// consecutive attributes are aligned, following the Terraform style
+// conventions, and blocks are separated by a blank line.
+func renderExampleBody(items []exampleItem) []string {
+	var lines []string
+
+	var group []exampleItem
+
+	flush := func() {
+		width := 0
+
+		for _, item := range group {
+			width = max(width, len(item.name))
+		}
+
+		for _, item := range group {
+			lines = append(lines, fmt.Sprintf("%-*s = %s", width, item.name, item.lines[0]))
+			lines = append(lines, indentExampleLines(item.lines[1:])...)
+		}
+
+		group = nil
+	}
+
+	for i, item := range items {
+		if item.block {
+			flush()
+
+			if i > 0 {
+				lines = append(lines, "")
+			}
+
+			if len(item.lines) == 0 {
+				lines = append(lines, item.name+" {}")
+
+				continue
+			}
+
+			lines = append(lines, item.name+" {")
+
+			for _, line := range item.lines {
+				lines = append(lines, indentExampleLine(line))
+			}
+
+			lines = append(lines, "}")
+
+			continue
+		}
+
+		group = append(group, item)
+
+		// a value spanning multiple lines ends the alignment of equals signs
+		if len(item.lines) > 1 {
+			flush()
+		}
+	}
+
+	flush()
+
+	return lines
+}
+
+// indentExampleLines indents the lines of a nested value. The first and last
+// lines of a nested value are the opening and closing braces or brackets,
+// which are already positioned by the enclosing attribute.
+func indentExampleLines(lines []string) []string {
+	if len(lines) == 0 {
+		return nil
+	}
+
+	indented := make([]string, 0, len(lines))
+
+	for _, line := range lines[:len(lines)-1] {
+		indented = append(indented, indentExampleLine(line))
+	}
+
+	return append(indented, lines[len(lines)-1])
+}
+
+func indentExampleLine(line string) string {
+	if line == "" {
+		return line
+	}
+
+	return "  " + line
+}
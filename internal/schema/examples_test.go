@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
+)
+
+func TestRenderExampleBody(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    []exampleItem
+		expected string
+	}{
+		"empty": {},
+		"aligned": {
+			input: []exampleItem{
+				{
+					name:  "a",
+					lines: exampleElementValue(specschema.ElementType{Bool: &specschema.BoolType{}}, 0),
+				},
+				{
+					name:  "bbb",
+					lines: exampleElementValue(specschema.ElementType{Float64: &specschema.Float64Type{}}, 0),
+				},
+				{
+					name: "cc",
+					lines: exampleElementValue(specschema.ElementType{
+						List: &specschema.ListType{
+							ElementType: specschema.ElementType{String: &specschema.StringType{}},
+						},
+					}, 0),
+				},
+			},
+			expected: `a   = true
+bbb = 1.5
+cc  = ["example"]`,
+		},
+		"multi-line": {
+			input: []exampleItem{
+				{
+					name:  "a",
+					lines: exampleElementValue(specschema.ElementType{Int64: &specschema.Int64Type{}}, 0),
+				},
+				{
+					name: "list_object",
+					lines: exampleElementValue(specschema.ElementType{
+						List: &specschema.ListType{
+							ElementType: specschema.ElementType{
+								Object: &specschema.ObjectType{
+									AttributeTypes: specschema.ObjectAttributeTypes{
+										{
+											Name:   "str",
+											String: &specschema.StringType{},
+										},
+										{
+											Name: "map_list",
+											Map: &specschema.MapType{
+												ElementType: specschema.ElementType{
+													List: &specschema.ListType{
+														ElementType: specschema.ElementType{Number: &specschema.NumberType{}},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					}, 0),
+				},
+				{
+					name:  "b",
+					lines: exampleElementValue(specschema.ElementType{String: &specschema.StringType{}}, 0),
+				},
+				{
+					name: "block",
+					lines: renderExampleBody([]exampleItem{
+						{
+							name:  "nested",
+							lines: exampleElementValue(specschema.ElementType{String: &specschema.StringType{}}, 0),
+						},
+						{
+							name:  "empty_block",
+							block: true,
+						},
+					}),
+					block: true,
+				},
+			},
+			expected: `a           = 1
+list_object = [{
+  str      = "example"
+  map_list = { key = [1] }
+}]
+b = "example"
+
+block {
+  nested = "example"
+
+  empty_block {}
+}`,
+		},
+		"min-items": {
+			input: []exampleItem{
+				{
+					name:  "list",
+					lines: exampleListOf([][]string{{"{}"}, {"{}"}}),
+				},
+				{
+					name: "list_nested",
+					lines: exampleListOf([][]string{
+						exampleObject(renderExampleBody([]exampleItem{
+							{
+								name:  "nested",
+								lines: exampleElementValue(specschema.ElementType{String: &specschema.StringType{}}, 0),
+							},
+						})),
+						exampleObject(renderExampleBody([]exampleItem{
+							{
+								name:  "nested",
+								lines: exampleElementValue(specschema.ElementType{String: &specschema.StringType{}}, 1),
+							},
+						})),
+					}),
+				},
+			},
+			expected: `list        = [{}, {}]
+list_nested = [
+  {
+    nested = "example"
+  },
+  {
+    nested = "example-2"
+  },
+]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := strings.Join(renderExampleBody(testCase.input), "\n")
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+// exampleAttribute is a string attribute with the supplied documentation.
+type exampleAttribute struct {
+	GeneratorAttribute
+
+	documentation Documentation
+}
+
+func (a exampleAttribute) GeneratorSchemaType() Type {
+	return GeneratorStringAttribute
+}
+
+func (a exampleAttribute) Documentation() Documentation {
+	return a.documentation
+}
+
+// exampleSetNestedAttribute is a set nested attribute with the supplied
+// documentation and attributes.
+type exampleSetNestedAttribute struct {
+	exampleAttribute
+
+	attributes GeneratorAttributes
+}
+
+func (a exampleSetNestedAttribute) GeneratorSchemaType() Type {
+	return GeneratorSetNestedAttribute
+}
+
+func (a exampleSetNestedAttribute) GetAttributes() GeneratorAttributes {
+	return a.attributes
+}
+
+func TestGeneratorSchemas_Examples(t *testing.T) {
+	t.Parallel()
+
+	optional := exampleAttribute{
+		documentation: Documentation{
+			Optional: true,
+		},
+	}
+
+	minItems := int64(3)
+
+	testCases := map[string]struct {
+		schema          GeneratorSchema
+		includeOptional bool
+		expected        string
+	}{
+		"constraint-example": {
+			schema: GeneratorSchema{
+				Attributes: GeneratorAttributes{
+					"one_of": exampleAttribute{
+						documentation: Documentation{
+							Example:  `"a"`,
+							Required: true,
+						},
+					},
+				},
+			},
+			expected: `resource "example_thing" "example" {
+  one_of = "a"
+}
+`,
+		},
+		"exactly-one-of": {
+			schema: GeneratorSchema{
+				Attributes: GeneratorAttributes{
+					"first":  optional,
+					"second": optional,
+				},
+				ConfigValidators: &ConfigValidators{
+					Validators: []ConfigValidator{
+						{
+							Name:  "ExactlyOneOf",
+							Paths: []string{"second", "first"},
+						},
+					},
+				},
+			},
+			expected: `resource "example_thing" "example" {
+  second = "example"
+}
+`,
+		},
+		"exactly-one-of-optional": {
+			schema: GeneratorSchema{
+				Attributes: GeneratorAttributes{
+					"first":  optional,
+					"second": optional,
+				},
+				ConfigValidators: &ConfigValidators{
+					Validators: []ConfigValidator{
+						{
+							Name:  "ExactlyOneOf",
+							Paths: []string{"first", "second"},
+						},
+					},
+				},
+			},
+			includeOptional: true,
+			expected: `resource "example_thing" "example" {
+  first = "example"
+}
+`,
+		},
+		"conflicting-required": {
+			schema: GeneratorSchema{
+				Attributes: GeneratorAttributes{
+					"first": optional,
+					"second": exampleAttribute{
+						documentation: Documentation{
+							Required: true,
+						},
+					},
+				},
+				ConfigValidators: &ConfigValidators{
+					Validators: []ConfigValidator{
+						{
+							Name:  "Conflicting",
+							Paths: []string{"first", "second"},
+						},
+					},
+				},
+			},
+			includeOptional: true,
+			expected: `resource "example_thing" "example" {
+  second = "example"
+}
+`,
+		},
+		"conflicts-with-optional": {
+			schema: GeneratorSchema{
+				Attributes: GeneratorAttributes{
+					"first": exampleAttribute{
+						documentation: Documentation{
+							ConflictsWith: []string{"second"},
+							Optional:      true,
+						},
+					},
+					"second": optional,
+					"third":  optional,
+				},
+			},
+			includeOptional: true,
+			expected: `resource "example_thing" "example" {
+  first = "example"
+  third = "example"
+}
+`,
+		},
+		"set-nested-min-items": {
+			schema: GeneratorSchema{
+				Attributes: GeneratorAttributes{
+					"set": exampleSetNestedAttribute{
+						exampleAttribute: exampleAttribute{
+							documentation: Documentation{
+								MinItems: &minItems,
+								Required: true,
+							},
+						},
+						attributes: GeneratorAttributes{
+							"name": exampleAttribute{
+								documentation: Documentation{
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: `resource "example_thing" "example" {
+  set = [
+    {
+      name = "example"
+    },
+    {
+      name = "example-2"
+    },
+    {
+      name = "example-3"
+    },
+  ]
+}
+`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			g := NewGeneratorSchemas(map[string]GeneratorSchema{
+				"thing": testCase.schema,
+			}, GeneratorOptions{})
+
+			got, err := g.Examples("example", "resource", testCase.includeOptional)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(string(got["thing"]), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+// Generator holds the templates and type mappings with which the code of a
+// schema is generated, and records the imports of the type mappings which are
+// used, as the Go type of a type mapping is only referenced when the schema
+// contains the Terraform type within an associated external type. A nil
+// Generator uses the embedded templates, and has no type mappings.
+type Generator struct {
+	options GeneratorOptions
+	imports *Imports
+}
+
+// NewGenerator returns a Generator which uses the templates and type mappings
+// of the options.
+func NewGenerator(options GeneratorOptions) *Generator {
+	return &Generator{
+		options: options,
+		imports: NewImports(),
+	}
+}
+
+// Imports returns the imports of the type mappings which have been used.
+func (g *Generator) Imports() *Imports {
+	if g == nil {
+		return NewImports()
+	}
+
+	return g.imports
+}
+
+// scoped returns a Generator with the same options, which records
+// imports separately, so that the imports of type mappings used by code which
+// is subsequently discarded are not recorded.
+func (g *Generator) scoped() *Generator {
+	if g == nil {
+		return nil
+	}
+
+	return NewGenerator(g.options)
+}
+
+// use records the imports of the scoped Generator, once the code generated
+// with it is used.
+func (g *Generator) use(scoped *Generator) {
+	if g == nil {
+		return
+	}
+
+	g.imports.Append(scoped.Imports())
+}
+
+// typeMapping returns the type mapping for the Terraform type, recording its
+// import as used, or nil if there is no type mapping for the type.
+func (g *Generator) typeMapping(terraformType string) *TypeMapping {
+	if g == nil {
+		return nil
+	}
+
+	t := g.options.TypeMappings[terraformType]
+
+	if t != nil && t.Import != nil {
+		g.imports.Add(*t.Import)
+	}
+
+	return t
+}
+
+// attributeTypeMapping returns the type mapping for the primitive attribute
+// type, or nil if there is no type mapping for the type.
+func (g *Generator) attributeTypeMapping(t Type) *TypeMapping {
+	switch t {
+	case GeneratorBoolAttribute:
+		return g.typeMapping("bool")
+	case GeneratorFloat32Attribute:
+		return g.typeMapping("float32")
+	case GeneratorFloat64Attribute:
+		return g.typeMapping("float64")
+	case GeneratorInt32Attribute:
+		return g.typeMapping("int32")
+	case GeneratorInt64Attribute:
+		return g.typeMapping("int64")
+	case GeneratorNumberAttribute:
+		return g.typeMapping("number")
+	case GeneratorStringAttribute:
+		return g.typeMapping("string")
+	}
+
+	return nil
+}
+
+// template returns the template with the file name of the embedded template,
+// or the embedded template if it is not overridden.
+func (g *Generator) template(name string) string {
+	if g != nil {
+		if t, ok := g.options.Templates[name]; ok {
+			return t
+		}
+	}
+
+	return embeddedTemplates[name]
+}
@@ -8,17 +8,23 @@ import (
 )
 
 const (
-	AttrImport         = "github.com/hashicorp/terraform-plugin-framework/attr"
-	BaseTypesImport    = "github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	ContextImport      = "context"
-	DiagImport         = "github.com/hashicorp/terraform-plugin-framework/diag"
-	FmtImport          = "fmt"
-	MathBigImport      = "math/big"
-	PlanModifierImport = "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	StringsImport      = "strings"
-	TfTypesImport      = "github.com/hashicorp/terraform-plugin-go/tftypes"
-	TypesImport        = "github.com/hashicorp/terraform-plugin-framework/types"
-	ValidatorImport    = "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	AttrImport                = "github.com/hashicorp/terraform-plugin-framework/attr"
+	BaseTypesImport           = "github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	BytesImport               = "bytes"
+	ContextImport             = "context"
+	DiagImport                = "github.com/hashicorp/terraform-plugin-framework/diag"
+	EncodingJSONImport        = "encoding/json"
+	FmtImport                 = "fmt"
+	FrameworkValidatorsImport = "github.com/hashicorp/terraform-plugin-framework-validators"
+	IdentitySchemaImport      = "github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	MathBigImport             = "math/big"
+	PathImport                = "github.com/hashicorp/terraform-plugin-framework/path"
+	PlanModifierImport        = "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	RegexpImport              = "regexp"
+	StringsImport             = "strings"
+	TfTypesImport             = "github.com/hashicorp/terraform-plugin-go/tftypes"
+	TypesImport               = "github.com/hashicorp/terraform-plugin-framework/types"
+	ValidatorImport           = "github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 type Imports struct {
@@ -94,3 +100,27 @@ func AssociatedExternalTypeImports() *Imports {
 
 	return imports
 }
+
+// DynamicAssociatedExternalTypeImports returns the imports which are required
+// by the To and From functions of a dynamic attribute, in addition to
+// AssociatedExternalTypeImports.
+func DynamicAssociatedExternalTypeImports(a *AssocExtType) *Imports {
+	imports := NewImports()
+
+	imports.Add([]code.Import{
+		{
+			Path: EncodingJSONImport,
+		},
+		{
+			Path: MathBigImport,
+		},
+	}...)
+
+	if a.Type() == "json.RawMessage" {
+		imports.Add(code.Import{
+			Path: BytesImport,
+		})
+	}
+
+	return imports
+}
@@ -15,7 +15,7 @@ import (
 	"github.com/greatman/terraform-plugin-codegen-spec/code"
 	specschema "github.com/greatman/terraform-plugin-codegen-spec/schema"
 
-	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
 )
 
@@ -25,9 +25,19 @@ type GeneratorSchema struct {
 	Description         *string
 	MarkdownDescription *string
 	DeprecationMessage  *string
+
+	// Identity contains the attributes of the resource identity schema, and
+	// is only populated for resources which define an identity.
+	Identity GeneratorAttributes
+
+	// ConfigValidators contains the config validators of a data source or
+	// resource, and is only populated if any are defined.
+	ConfigValidators *ConfigValidators
 }
 
-func (g GeneratorSchema) Imports() (string, error) {
+// Imports returns the import specs of the schema code, including the supplied
+// imports of the type mappings used by the to/from functions.
+func (g GeneratorSchema) Imports(typeMappingImports *Imports) string {
 	imports := NewImports()
 
 	imports.Add(
@@ -108,6 +118,22 @@ func (g GeneratorSchema) Imports() (string, error) {
 		imports.Add(v.Imports().All()...)
 	}
 
+	if len(g.Identity) > 0 {
+		imports.Add(code.Import{
+			Path: IdentitySchemaImport,
+		})
+	}
+
+	for _, v := range g.Identity {
+		imports.Add(v.Imports().All()...)
+	}
+
+	imports.Append(g.ConfigValidators.Imports())
+
+	if typeMappingImports != nil {
+		imports.Append(typeMappingImports)
+	}
+
 	var sb strings.Builder
 
 	for _, i := range imports.All() {
@@ -120,10 +146,13 @@ func (g GeneratorSchema) Imports() (string, error) {
 		sb.WriteString(fmt.Sprintf("%s%q\n", alias, i.Path))
 	}
 
-	return sb.String(), nil
+	return sb.String()
 }
 
-func (g GeneratorSchema) Schema(name, packageName, generatorType string) ([]byte, error) {
+// Schema returns the schema code, rendered with the templates of gen. The
+// schema code imports the packages of the type mappings used by the to/from
+// functions, which are recorded by gen when it is supplied to ToFromFunctions.
+func (g GeneratorSchema) Schema(name, packageName, generatorType string, gen *Generator) ([]byte, error) {
 	attributes, err := g.Attributes.Schema()
 
 	if err != nil {
@@ -136,12 +165,14 @@ func (g GeneratorSchema) Schema(name, packageName, generatorType string) ([]byte
 		return nil, err
 	}
 
-	imports, err := g.Imports()
+	identityAttributes, err := g.Identity.Schema()
 
 	if err != nil {
 		return nil, err
 	}
 
+	imports := g.Imports(gen.Imports())
+
 	description := ""
 	if g.Description != nil {
 		description = *g.Description
@@ -158,15 +189,18 @@ func (g GeneratorSchema) Schema(name, packageName, generatorType string) ([]byte
 	}
 
 	templateData := struct {
-		Name                string
-		PackageName         string
-		GeneratorType       string
-		Attributes          string
-		Blocks              string
-		Description         string
-		Imports             string
-		MarkdownDescription string
-		DeprecationMessage  string
+		Name                    string
+		PackageName             string
+		GeneratorType           string
+		Attributes              string
+		Blocks                  string
+		Description             string
+		Imports                 string
+		MarkdownDescription     string
+		DeprecationMessage      string
+		IdentityAttributes      string
+		ConfigValidators        string
+		ConfigValidatorsPackage string
 	}{
 		Name:                FrameworkIdentifier(name).ToPascalCase(),
 		PackageName:         packageName,
@@ -177,9 +211,15 @@ func (g GeneratorSchema) Schema(name, packageName, generatorType string) ([]byte
 		Imports:             imports,
 		MarkdownDescription: markdownDescription,
 		DeprecationMessage:  deprecationMessage,
+		IdentityAttributes:  identityAttributes,
+		ConfigValidators:    g.ConfigValidators.Schema(),
+	}
+
+	if g.ConfigValidators != nil {
+		templateData.ConfigValidatorsPackage = g.ConfigValidators.Package
 	}
 
-	t, err := template.New("schema").Parse(SchemaGoTemplate)
+	t, err := template.New("schema").Parse(gen.template("schema.gotmpl"))
 
 	if err != nil {
 		return nil, err
@@ -195,11 +235,16 @@ func (g GeneratorSchema) Schema(name, packageName, generatorType string) ([]byte
 	return buf.Bytes(), nil
 }
 
+// Models returns the data models of the schema, and of its identity schema
+// if defined. Errors are collected across all attributes, blocks and identity
+// attributes.
 func (g GeneratorSchema) Models(name string) ([]model.Model, error) {
 	var models []model.Model
 
 	var modelFields []model.Field
 
+	var errs []error
+
 	attributeKeys := g.Attributes.SortedKeys()
 
 	for _, k := range attributeKeys {
@@ -210,7 +255,9 @@ func (g GeneratorSchema) Models(name string) ([]model.Model, error) {
 		modelField, err := g.Attributes[k].ModelField(FrameworkIdentifier(k))
 
 		if err != nil {
-			return nil, err
+			errs = append(errs, NewGeneratorErrors(err, k)...)
+
+			continue
 		}
 
 		modelFields = append(modelFields, modelField)
@@ -226,12 +273,36 @@ func (g GeneratorSchema) Models(name string) ([]model.Model, error) {
 		modelField, err := g.Blocks[k].ModelField(FrameworkIdentifier(k))
 
 		if err != nil {
-			return nil, err
+			errs = append(errs, NewGeneratorErrors(err, k)...)
+
+			continue
 		}
 
 		modelFields = append(modelFields, modelField)
 	}
 
+	var identityModelFields []model.Field
+
+	for _, k := range g.Identity.SortedKeys() {
+		if g.Identity[k] == nil {
+			continue
+		}
+
+		modelField, err := g.Identity[k].ModelField(FrameworkIdentifier(k))
+
+		if err != nil {
+			errs = append(errs, NewGeneratorErrors(err, "identity", k)...)
+
+			continue
+		}
+
+		identityModelFields = append(identityModelFields, modelField)
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
 	m := model.Model{
 		Name:   FrameworkIdentifier(name).ToPascalCase(),
 		Fields: modelFields,
@@ -239,14 +310,26 @@ func (g GeneratorSchema) Models(name string) ([]model.Model, error) {
 
 	models = append(models, m)
 
+	if len(g.Identity) == 0 {
+		return models, nil
+	}
+
+	models = append(models, model.Model{
+		Name:   FrameworkIdentifier(name).ToPascalCase() + "Identity",
+		Fields: identityModelFields,
+	})
+
 	return models, nil
 }
 
 // CustomTypeValueBytes iterates over all the attributes and blocks to generate code
 // for custom type and value types for use in the schema and data models.
-func (g GeneratorSchema) CustomTypeValueBytes() ([]byte, error) {
+// Errors are collected across all attributes and blocks.
+func (g GeneratorSchema) CustomTypeValueBytes(gen *Generator) ([]byte, error) {
 	var buf bytes.Buffer
 
+	var errs []error
+
 	attributeKeys := g.Attributes.SortedKeys()
 
 	for _, k := range attributeKeys {
@@ -255,10 +338,12 @@ func (g GeneratorSchema) CustomTypeValueBytes() ([]byte, error) {
 		}
 
 		if c, ok := g.Attributes[k].(CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := c.CustomTypeAndValue(k, gen)
 
 			if err != nil {
-				return nil, err
+				errs = append(errs, NewGeneratorErrors(err, k)...)
+
+				continue
 			}
 
 			buf.Write(b)
@@ -273,16 +358,22 @@ func (g GeneratorSchema) CustomTypeValueBytes() ([]byte, error) {
 		}
 
 		if c, ok := g.Blocks[k].(CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := c.CustomTypeAndValue(k, gen)
 
 			if err != nil {
-				return nil, err
+				errs = append(errs, NewGeneratorErrors(err, k)...)
+
+				continue
 			}
 
 			buf.Write(b)
 		}
 	}
 
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
 	if buf.Len() > 0 {
 		buf.WriteString("\n")
 	}
@@ -292,10 +383,18 @@ func (g GeneratorSchema) CustomTypeValueBytes() ([]byte, error) {
 
 // ToFromFunctions generates code for converting to an associated
 // external type from a framework type, and from an associated
-// external type to a framework type.
-func (g GeneratorSchema) ToFromFunctions(ctx context.Context, logger *slog.Logger) ([]byte, error) {
+// external type to a framework type. Errors are collected across all
+// attributes and blocks. Unimplemented errors are only logged, and recorded
+// by any UnimplementedTracker in the context, unless it is strict. The
+// imports of the type mappings used by the generated code are recorded by gen,
+// excluding those of attributes and blocks for which no code is generated.
+func (g GeneratorSchema) ToFromFunctions(ctx context.Context, logger *slog.Logger, gen *Generator) ([]byte, error) {
 	var buf bytes.Buffer
 
+	var errs []error
+
+	tracker := getUnimplementedTrackerFromContext(ctx)
+
 	attributeKeys := g.Attributes.SortedKeys()
 
 	for _, k := range attributeKeys {
@@ -304,14 +403,30 @@ func (g GeneratorSchema) ToFromFunctions(ctx context.Context, logger *slog.Logge
 		}
 
 		if t, ok := g.Attributes[k].(ToFrom); ok {
-			b, err := t.ToFromFunctions(k)
+			scoped := gen.scoped()
+
+			b, err := t.ToFromFunctions(k, scoped)
 
 			var unimplErr *UnimplementedError
 
 			if errors.As(err, &unimplErr) {
-				logger.Error("error generating to/from methods", "path", fmt.Sprintf("%s.%s.%s", logging.GetPathFromContext(ctx), k, unimplErr.Path()), "err", err)
+				if tracker.isStrict() {
+					errs = append(errs, NewGeneratorError(err, k, unimplErr.Path()))
+
+					continue
+				}
+
+				logger.ErrorContext(ctx, "error generating to/from methods", "path", unimplementedPath(ctx, k, unimplErr), "err", err)
+
+				tracker.skip(ctx, k, unimplErr)
 			} else if err != nil {
-				return nil, err
+				errs = append(errs, NewGeneratorErrors(err, k)...)
+
+				continue
+			}
+
+			if err == nil {
+				gen.use(scoped)
 			}
 
 			buf.Write(b)
@@ -330,20 +445,40 @@ func (g GeneratorSchema) ToFromFunctions(ctx context.Context, logger *slog.Logge
 		}
 
 		if t, ok := g.Blocks[k].(ToFrom); ok {
-			b, err := t.ToFromFunctions(k)
+			scoped := gen.scoped()
+
+			b, err := t.ToFromFunctions(k, scoped)
 
 			var unimplErr *UnimplementedError
 
 			if errors.As(err, &unimplErr) {
-				logger.Error("error generating to/from methods", "path", fmt.Sprintf("%s.%s.%s", logging.GetPathFromContext(ctx), k, unimplErr.Path()), "err", err)
+				if tracker.isStrict() {
+					errs = append(errs, NewGeneratorError(err, k, unimplErr.Path()))
+
+					continue
+				}
+
+				logger.ErrorContext(ctx, "error generating to/from methods", "path", unimplementedPath(ctx, k, unimplErr), "err", err)
+
+				tracker.skip(ctx, k, unimplErr)
 			} else if err != nil {
-				return nil, err
+				errs = append(errs, NewGeneratorErrors(err, k)...)
+
+				continue
+			}
+
+			if err == nil {
+				gen.use(scoped)
 			}
 
 			buf.Write(b)
 		}
 	}
 
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
 	return buf.Bytes(), nil
 }
 
@@ -351,6 +486,8 @@ func ElementTypeString(elementType specschema.ElementType) (string, error) {
 	switch {
 	case elementType.Bool != nil:
 		return "types.BoolType", nil
+	case elementType.Float64 != nil && extension.IsFloat32(elementType.Float64):
+		return "types.Float32Type", nil
 	case elementType.Float64 != nil:
 		return "types.Float64Type", nil
 	case elementType.Int32 != nil:
@@ -391,13 +528,19 @@ func ElementTypeString(elementType specschema.ElementType) (string, error) {
 }
 
 // ElementTypeGoType defaults to the defined pointer types on the basis of the
-// supplied elementType.
-// TODO: Provide a mechanism to allow mapping to be configured. For instance elementType.Float64 => float32
-// TODO: Implement for list, map, object, and set.
-func ElementTypeGoType(elementType specschema.ElementType) (string, error) {
+// supplied elementType. Collection element types are mapped to slices and maps
+// of their element Go type, and object element types are mapped to anonymous
+// structs with a field for each attribute type. The Go type of primitive
+// element types can be configured with the type mappings of the Generator.
+func ElementTypeGoType(elementType specschema.ElementType, gen *Generator) (string, error) {
 	switch {
+	case isMappedPrimitive(elementType, gen):
+		p, _ := elementTypePrimitiveConversion(elementType, gen)
+		return p.TypeMapping.GoType, nil
 	case elementType.Bool != nil:
 		return "*bool", nil
+	case elementType.Float64 != nil && extension.IsFloat32(elementType.Float64):
+		return "*float32", nil
 	case elementType.Float64 != nil:
 		return "*float64", nil
 	case elementType.Int32 != nil:
@@ -405,15 +548,27 @@ func ElementTypeGoType(elementType specschema.ElementType) (string, error) {
 	case elementType.Int64 != nil:
 		return "*int64", nil
 	case elementType.List != nil:
-		return "", NewUnimplementedError(errors.New("list element type is not yet implemented"))
+		elemGoType, err := ElementTypeGoType(elementType.List.ElementType, gen)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("[]%s", elemGoType), nil
 	case elementType.Map != nil:
-		return "", NewUnimplementedError(errors.New("map element type is not yet implemented"))
+		elemGoType, err := ElementTypeGoType(elementType.Map.ElementType, gen)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("map[string]%s", elemGoType), nil
 	case elementType.Number != nil:
 		return "*big.Float", nil
 	case elementType.Object != nil:
-		return "", NewUnimplementedError(errors.New("object element type is not yet implemented"))
+		return objectGoType(elementType.Object.AttributeTypes, gen)
 	case elementType.Set != nil:
-		return "", NewUnimplementedError(errors.New("set element type is not yet implemented"))
+		elemGoType, err := ElementTypeGoType(elementType.Set.ElementType, gen)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("[]%s", elemGoType), nil
 	case elementType.String != nil:
 		return "*string", nil
 	}
@@ -428,6 +583,10 @@ func AttrTypesString(attrTypes specschema.ObjectAttributeTypes) (string, error)
 		switch {
 		case v.Bool != nil:
 			attrTypesStr = append(attrTypesStr, fmt.Sprintf("%q: types.BoolType", v.Name))
+		case v.Dynamic != nil:
+			attrTypesStr = append(attrTypesStr, fmt.Sprintf("%q: types.DynamicType", v.Name))
+		case v.Float64 != nil && extension.IsFloat32(v.Float64):
+			attrTypesStr = append(attrTypesStr, fmt.Sprintf("%q: types.Float32Type", v.Name))
 		case v.Float64 != nil:
 			attrTypesStr = append(attrTypesStr, fmt.Sprintf("%q: types.Float64Type", v.Name))
 		case v.Int32 != nil:
@@ -468,20 +627,36 @@ func AttrTypesString(attrTypes specschema.ObjectAttributeTypes) (string, error)
 	return strings.Join(attrTypesStr, ",\n"), nil
 }
 
-func ObjectFieldTo(o specschema.ObjectAttributeType) (ObjectField, error) {
+func ObjectFieldTo(o specschema.ObjectAttributeType, gen *Generator) (ObjectField, error) {
 	switch {
+	case isMappedPrimitive(objectAttributeElementType(o), gen):
+		return nestedObjectFieldTo(o, gen)
 	case o.Bool != nil:
 		return ObjectField{
 			GoType: "*bool",
 			Type:   "types.Bool",
 			ToFunc: "ValueBoolPointer",
 		}, nil
+	case o.Dynamic != nil:
+		return ObjectField{}, NewUnimplementedError(errors.New("dynamic attribute type is not yet implemented"))
+	case o.Float64 != nil && extension.IsFloat32(o.Float64):
+		return ObjectField{
+			GoType: "*float32",
+			Type:   "types.Float32",
+			ToFunc: "ValueFloat32Pointer",
+		}, nil
 	case o.Float64 != nil:
 		return ObjectField{
 			GoType: "*float64",
 			Type:   "types.Float64",
 			ToFunc: "ValueFloat64Pointer",
 		}, nil
+	case o.Int32 != nil:
+		return ObjectField{
+			GoType: "*int32",
+			Type:   "types.Int32",
+			ToFunc: "ValueInt32Pointer",
+		}, nil
 	case o.Int64 != nil:
 		return ObjectField{
 			GoType: "*int64",
@@ -489,9 +664,9 @@ func ObjectFieldTo(o specschema.ObjectAttributeType) (ObjectField, error) {
 			ToFunc: "ValueInt64Pointer",
 		}, nil
 	case o.List != nil:
-		return ObjectField{}, NewUnimplementedError(errors.New("list attribute type is not yet implemented"))
+		return nestedObjectFieldTo(o, gen)
 	case o.Map != nil:
-		return ObjectField{}, NewUnimplementedError(errors.New("map attribute type is not yet implemented"))
+		return nestedObjectFieldTo(o, gen)
 	case o.Number != nil:
 		return ObjectField{
 			GoType: "*big.Float",
@@ -499,9 +674,9 @@ func ObjectFieldTo(o specschema.ObjectAttributeType) (ObjectField, error) {
 			ToFunc: "ValueBigFloat",
 		}, nil
 	case o.Object != nil:
-		return ObjectField{}, NewUnimplementedError(errors.New("object attribute type is not yet implemented"))
+		return nestedObjectFieldTo(o, gen)
 	case o.Set != nil:
-		return ObjectField{}, NewUnimplementedError(errors.New("set attribute type is not yet implemented"))
+		return nestedObjectFieldTo(o, gen)
 	case o.String != nil:
 		return ObjectField{
 			GoType: "*string",
@@ -513,36 +688,50 @@ func ObjectFieldTo(o specschema.ObjectAttributeType) (ObjectField, error) {
 	return ObjectField{}, errors.New("no matching object attribute type found")
 }
 
-func ObjectFieldFrom(o specschema.ObjectAttributeType) (ObjectField, error) {
+func ObjectFieldFrom(o specschema.ObjectAttributeType, gen *Generator) (ObjectField, error) {
 	switch {
+	case isMappedPrimitive(objectAttributeElementType(o), gen):
+		return nestedObjectFieldFrom(o, gen)
 	case o.Bool != nil:
 		return ObjectField{
 			Type:     "types.BoolType",
 			FromFunc: "BoolPointerValue",
 		}, nil
+	case o.Dynamic != nil:
+		return ObjectField{}, NewUnimplementedError(errors.New("dynamic attribute type is not yet implemented"))
+	case o.Float64 != nil && extension.IsFloat32(o.Float64):
+		return ObjectField{
+			Type:     "types.Float32Type",
+			FromFunc: "Float32PointerValue",
+		}, nil
 	case o.Float64 != nil:
 		return ObjectField{
 			Type:     "types.Float64Type",
 			FromFunc: "Float64PointerValue",
 		}, nil
+	case o.Int32 != nil:
+		return ObjectField{
+			Type:     "types.Int32Type",
+			FromFunc: "Int32PointerValue",
+		}, nil
 	case o.Int64 != nil:
 		return ObjectField{
 			Type:     "types.Int64Type",
 			FromFunc: "Int64PointerValue",
 		}, nil
 	case o.List != nil:
-		return ObjectField{}, NewUnimplementedError(errors.New("list attribute type is not yet implemented"))
+		return nestedObjectFieldFrom(o, gen)
 	case o.Map != nil:
-		return ObjectField{}, NewUnimplementedError(errors.New("map attribute type is not yet implemented"))
+		return nestedObjectFieldFrom(o, gen)
 	case o.Number != nil:
 		return ObjectField{
 			Type:     "types.NumberType",
 			FromFunc: "NumberValue",
 		}, nil
 	case o.Object != nil:
-		return ObjectField{}, NewUnimplementedError(errors.New("object attribute type is not yet implemented"))
+		return nestedObjectFieldFrom(o, gen)
 	case o.Set != nil:
-		return ObjectField{}, NewUnimplementedError(errors.New("set attribute type is not yet implemented"))
+		return nestedObjectFieldFrom(o, gen)
 	case o.String != nil:
 		return ObjectField{
 			Type:     "types.StringType",
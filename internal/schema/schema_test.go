@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/greatman/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/extension"
+)
+
+func TestObjectFieldTo(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         schema.ObjectAttributeType
+		expected      ObjectField
+		expectedError error
+	}{
+		"bool": {
+			input: schema.ObjectAttributeType{
+				Bool: &schema.BoolType{},
+			},
+			expected: ObjectField{
+				GoType: "*bool",
+				Type:   "types.Bool",
+				ToFunc: "ValueBoolPointer",
+			},
+		},
+		"dynamic": {
+			input: schema.ObjectAttributeType{
+				Dynamic: &schema.DynamicType{},
+			},
+			expectedError: NewUnimplementedError(errors.New("dynamic attribute type is not yet implemented")),
+		},
+		"float32": {
+			input: schema.ObjectAttributeType{
+				Float64: extension.AsFloat32(&schema.Float64Type{}),
+			},
+			expected: ObjectField{
+				GoType: "*float32",
+				Type:   "types.Float32",
+				ToFunc: "ValueFloat32Pointer",
+			},
+		},
+		"float64": {
+			input: schema.ObjectAttributeType{
+				Float64: &schema.Float64Type{},
+			},
+			expected: ObjectField{
+				GoType: "*float64",
+				Type:   "types.Float64",
+				ToFunc: "ValueFloat64Pointer",
+			},
+		},
+		"int32": {
+			input: schema.ObjectAttributeType{
+				Int32: &schema.Int32Type{},
+			},
+			expected: ObjectField{
+				GoType: "*int32",
+				Type:   "types.Int32",
+				ToFunc: "ValueInt32Pointer",
+			},
+		},
+		"int64": {
+			input: schema.ObjectAttributeType{
+				Int64: &schema.Int64Type{},
+			},
+			expected: ObjectField{
+				GoType: "*int64",
+				Type:   "types.Int64",
+				ToFunc: "ValueInt64Pointer",
+			},
+		},
+		"number": {
+			input: schema.ObjectAttributeType{
+				Number: &schema.NumberType{},
+			},
+			expected: ObjectField{
+				GoType: "*big.Float",
+				Type:   "types.Number",
+				ToFunc: "ValueBigFloat",
+			},
+		},
+		"string": {
+			input: schema.ObjectAttributeType{
+				String: &schema.StringType{},
+			},
+			expected: ObjectField{
+				GoType: "*string",
+				Type:   "types.String",
+				ToFunc: "ValueStringPointer",
+			},
+		},
+		"none": {
+			input:         schema.ObjectAttributeType{},
+			expectedError: errors.New("no matching object attribute type found"),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ObjectFieldTo(testCase.input, nil)
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectFieldFrom(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         schema.ObjectAttributeType
+		expected      ObjectField
+		expectedError error
+	}{
+		"bool": {
+			input: schema.ObjectAttributeType{
+				Bool: &schema.BoolType{},
+			},
+			expected: ObjectField{
+				Type:     "types.BoolType",
+				FromFunc: "BoolPointerValue",
+			},
+		},
+		"dynamic": {
+			input: schema.ObjectAttributeType{
+				Dynamic: &schema.DynamicType{},
+			},
+			expectedError: NewUnimplementedError(errors.New("dynamic attribute type is not yet implemented")),
+		},
+		"float32": {
+			input: schema.ObjectAttributeType{
+				Float64: extension.AsFloat32(&schema.Float64Type{}),
+			},
+			expected: ObjectField{
+				Type:     "types.Float32Type",
+				FromFunc: "Float32PointerValue",
+			},
+		},
+		"float64": {
+			input: schema.ObjectAttributeType{
+				Float64: &schema.Float64Type{},
+			},
+			expected: ObjectField{
+				Type:     "types.Float64Type",
+				FromFunc: "Float64PointerValue",
+			},
+		},
+		"int32": {
+			input: schema.ObjectAttributeType{
+				Int32: &schema.Int32Type{},
+			},
+			expected: ObjectField{
+				Type:     "types.Int32Type",
+				FromFunc: "Int32PointerValue",
+			},
+		},
+		"int64": {
+			input: schema.ObjectAttributeType{
+				Int64: &schema.Int64Type{},
+			},
+			expected: ObjectField{
+				Type:     "types.Int64Type",
+				FromFunc: "Int64PointerValue",
+			},
+		},
+		"number": {
+			input: schema.ObjectAttributeType{
+				Number: &schema.NumberType{},
+			},
+			expected: ObjectField{
+				Type:     "types.NumberType",
+				FromFunc: "NumberValue",
+			},
+		},
+		"string": {
+			input: schema.ObjectAttributeType{
+				String: &schema.StringType{},
+			},
+			expected: ObjectField{
+				Type:     "types.StringType",
+				FromFunc: "StringPointerValue",
+			},
+		},
+		"none": {
+			input:         schema.ObjectAttributeType{},
+			expectedError: errors.New("no matching object attribute type found"),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ObjectFieldFrom(testCase.input, nil)
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -6,50 +6,108 @@ package schema
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/logging"
 )
 
-// TODO: Field(s) could be added to handle end-user supplying their own templates to allow overriding.
+// GeneratorOptions configures the code generated by GeneratorSchemas.
+type GeneratorOptions struct {
+	// Templates override the embedded templates with which code is
+	// generated, and can be loaded with LoadTemplates.
+	Templates Templates
+
+	// TypeMappings configures the Go types used for primitive types within
+	// associated external types, and can be loaded with LoadTypeMappings.
+	TypeMappings TypeMappings
+}
+
+// GeneratorSchemas renders code using the embedded templates, which can be
+// replaced by end-user supplied templates in the GeneratorOptions.
 type GeneratorSchemas struct {
 	schemas map[string]GeneratorSchema
+
+	// generators holds the Generator of each schema, which records the
+	// imports of the type mappings used by its to/from functions.
+	generators map[string]*Generator
 }
 
-func NewGeneratorSchemas(schemas map[string]GeneratorSchema) GeneratorSchemas {
+func NewGeneratorSchemas(schemas map[string]GeneratorSchema, options GeneratorOptions) GeneratorSchemas {
+	generators := make(map[string]*Generator, len(schemas))
+
+	for k := range schemas {
+		generators[k] = NewGenerator(options)
+	}
+
 	return GeneratorSchemas{
-		schemas: schemas,
+		schemas:    schemas,
+		generators: generators,
 	}
 }
 
+// sortedNames returns the names of the schemas in order, so that errors
+// which are collected across all schemas are reported consistently.
+func (g GeneratorSchemas) sortedNames() []string {
+	names := make([]string, 0, len(g.schemas))
+
+	for k := range g.schemas {
+		names = append(names, k)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Schemas returns the schema code for each schema. Errors are collected
+// across all schemas, and are tagged with the schema name. The schema code
+// imports the packages of the type mappings used by the to/from functions, so
+// ToFromFunctions must be called beforehand if type mappings are configured.
 func (g GeneratorSchemas) Schemas(packageName, generatorType string) (map[string][]byte, error) {
 	schemasBytes := make(map[string][]byte, len(g.schemas))
 
-	for k, s := range g.schemas {
+	var errs []error
+
+	for _, k := range g.sortedNames() {
+		s := g.schemas[k]
 
 		pkgName := packageName
 		if pkgName == "" {
 			pkgName = fmt.Sprintf("%s_%s", strings.ToLower(generatorType), k)
 		}
 
-		b, err := s.Schema(k, pkgName, generatorType)
+		b, err := s.Schema(k, pkgName, generatorType, g.generators[k])
 
 		if err != nil {
-			return nil, err
+			errs = append(errs, NewGeneratorErrors(err, k)...)
+
+			continue
 		}
 
 		schemasBytes[k] = b
 	}
 
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
 	return schemasBytes, nil
 }
 
+// Models returns the model code for each schema. Errors are collected
+// across all schemas, and are tagged with the schema name.
 func (g GeneratorSchemas) Models() (map[string][]byte, error) {
 	modelsBytes := make(map[string][]byte, len(g.schemas))
 
-	for name, schema := range g.schemas {
+	var errs []error
+
+	for _, name := range g.sortedNames() {
+		schema := g.schemas[name]
+
 		var buf bytes.Buffer
 
 		generatorSchema := GeneratorSchema{
@@ -58,11 +116,14 @@ func (g GeneratorSchemas) Models() (map[string][]byte, error) {
 			Description:         schema.Description,
 			MarkdownDescription: schema.MarkdownDescription,
 			DeprecationMessage:  schema.DeprecationMessage,
+			Identity:            schema.Identity,
 		}
 
 		models, err := generatorSchema.Models(name)
 		if err != nil {
-			return nil, err
+			errs = append(errs, NewGeneratorErrors(err, name)...)
+
+			continue
 		}
 
 		for _, m := range models {
@@ -72,37 +133,63 @@ func (g GeneratorSchemas) Models() (map[string][]byte, error) {
 		modelsBytes[name] = buf.Bytes()
 	}
 
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
 	return modelsBytes, nil
 }
 
+// CustomTypeValue returns the custom type and value type code for each
+// schema. Errors are collected across all schemas, and are tagged with the
+// schema name.
 func (g GeneratorSchemas) CustomTypeValue() (map[string][]byte, error) {
 	customTypeValueBytes := make(map[string][]byte, len(g.schemas))
 
-	for name, s := range g.schemas {
-		b, err := s.CustomTypeValueBytes()
+	var errs []error
+
+	for _, name := range g.sortedNames() {
+		b, err := g.schemas[name].CustomTypeValueBytes(g.generators[name])
 		if err != nil {
-			return nil, err
+			errs = append(errs, NewGeneratorErrors(err, name)...)
+
+			continue
 		}
 
 		customTypeValueBytes[name] = b
 	}
 
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
 	return customTypeValueBytes, nil
 }
 
+// ToFromFunctions returns the to/from function code for each schema, and
+// records the imports of the type mappings which each uses. Errors are
+// collected across all schemas, and are tagged with the schema name.
 func (g GeneratorSchemas) ToFromFunctions(ctx context.Context, logger *slog.Logger) (map[string][]byte, error) {
 	modelsExpandFlattenBytes := make(map[string][]byte, len(g.schemas))
 
-	for name, s := range g.schemas {
+	var errs []error
+
+	for _, name := range g.sortedNames() {
 		ctxWithPath := logging.SetPathInContext(ctx, name)
 
-		b, err := s.ToFromFunctions(ctxWithPath, logger)
+		b, err := g.schemas[name].ToFromFunctions(ctxWithPath, logger, g.generators[name])
 		if err != nil {
-			return nil, err
+			errs = append(errs, NewGeneratorErrors(err, name)...)
+
+			continue
 		}
 
 		modelsExpandFlattenBytes[name] = b
 	}
 
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
 	return modelsExpandFlattenBytes, nil
 }
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+)
+
+type invalidAttribute struct {
+	GeneratorAttribute
+}
+
+func (a invalidAttribute) ModelField(name FrameworkIdentifier) (model.Field, error) {
+	return model.Field{}, errors.New("invalid attribute")
+}
+
+func TestGeneratorSchemas_Models_Errors(t *testing.T) {
+	t.Parallel()
+
+	g := NewGeneratorSchemas(map[string]GeneratorSchema{
+		"example_b": {
+			Attributes: GeneratorAttributes{
+				"attr": invalidAttribute{},
+			},
+		},
+		"example_a": {
+			Attributes: GeneratorAttributes{
+				"attr_one": invalidAttribute{},
+				"attr_two": invalidAttribute{},
+			},
+			Identity: GeneratorAttributes{
+				"id_one": invalidAttribute{},
+				"id_two": invalidAttribute{},
+			},
+		},
+	}, GeneratorOptions{})
+
+	_, err := g.Models()
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	expected := "example_a.attr_one: invalid attribute\n" +
+		"example_a.attr_two: invalid attribute\n" +
+		"example_a.identity.id_one: invalid attribute\n" +
+		"example_a.identity.id_two: invalid attribute\n" +
+		"example_b.attr: invalid attribute"
+
+	if diff := cmp.Diff(err.Error(), expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	var generatorErr *GeneratorError
+
+	if !errors.As(err, &generatorErr) {
+		t.Fatalf("expected GeneratorError, got %T", err)
+	}
+
+	if diff := cmp.Diff(generatorErr.Path(), "example_a.attr_one"); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// embeddedTemplates maps the file name of each embedded code generation
+// template to the template, so that the template can be overridden by a file
+// of the same name. The documentation template is not included, as it is
+// overridden by the templates of the generate docs command.
+var embeddedTemplates = map[string]string{
+	"bool_from.gotmpl":                               BoolFromTemplate,
+	"bool_to.gotmpl":                                 BoolToTemplate,
+	"bool_type_equal.gotmpl":                         BoolTypeEqualTemplate,
+	"bool_type_string.gotmpl":                        BoolTypeStringTemplate,
+	"bool_type_typable.gotmpl":                       BoolTypeTypableTemplate,
+	"bool_type_type.gotmpl":                          BoolTypeTypeTemplate,
+	"bool_type_value_from_bool.gotmpl":               BoolTypeValueFromBoolTemplate,
+	"bool_type_value_from_terraform.gotmpl":          BoolTypeValueFromTerraformTemplate,
+	"bool_type_value_type.gotmpl":                    BoolTypeValueTypeTemplate,
+	"bool_value_equal.gotmpl":                        BoolValueEqualTemplate,
+	"bool_value_type.gotmpl":                         BoolValueTypeTemplate,
+	"bool_value_valuable.gotmpl":                     BoolValueValuableTemplate,
+	"bool_value_value.gotmpl":                        BoolValueValueTemplate,
+	"dynamic_from.gotmpl":                            DynamicFromTemplate,
+	"dynamic_to.gotmpl":                              DynamicToTemplate,
+	"dynamic_type_equal.gotmpl":                      DynamicTypeEqualTemplate,
+	"dynamic_type_string.gotmpl":                     DynamicTypeStringTemplate,
+	"dynamic_type_typable.gotmpl":                    DynamicTypeTypableTemplate,
+	"dynamic_type_type.gotmpl":                       DynamicTypeTypeTemplate,
+	"dynamic_type_value_from_dynamic.gotmpl":         DynamicTypeValueFromDynamicTemplate,
+	"dynamic_type_value_from_terraform.gotmpl":       DynamicTypeValueFromTerraformTemplate,
+	"dynamic_type_value_type.gotmpl":                 DynamicTypeValueTypeTemplate,
+	"dynamic_value_equal.gotmpl":                     DynamicValueEqualTemplate,
+	"dynamic_value_type.gotmpl":                      DynamicValueTypeTemplate,
+	"dynamic_value_valuable.gotmpl":                  DynamicValueValuableTemplate,
+	"dynamic_value_value.gotmpl":                     DynamicValueValueTemplate,
+	"float32_from.gotmpl":                            Float32FromTemplate,
+	"float32_to.gotmpl":                              Float32ToTemplate,
+	"float32_type_equal.gotmpl":                      Float32TypeEqualTemplate,
+	"float32_type_string.gotmpl":                     Float32TypeStringTemplate,
+	"float32_type_typable.gotmpl":                    Float32TypeTypableTemplate,
+	"float32_type_type.gotmpl":                       Float32TypeTypeTemplate,
+	"float32_type_value_from_float32.gotmpl":         Float32TypeValueFromFloat32Template,
+	"float32_type_value_from_terraform.gotmpl":       Float32TypeValueFromTerraformTemplate,
+	"float32_type_value_type.gotmpl":                 Float32TypeValueTypeTemplate,
+	"float32_value_equal.gotmpl":                     Float32ValueEqualTemplate,
+	"float32_value_type.gotmpl":                      Float32ValueTypeTemplate,
+	"float32_value_valuable.gotmpl":                  Float32ValueValuableTemplate,
+	"float32_value_value.gotmpl":                     Float32ValueValueTemplate,
+	"float64_from.gotmpl":                            Float64FromTemplate,
+	"float64_to.gotmpl":                              Float64ToTemplate,
+	"float64_type_equal.gotmpl":                      Float64TypeEqualTemplate,
+	"float64_type_string.gotmpl":                     Float64TypeStringTemplate,
+	"float64_type_typable.gotmpl":                    Float64TypeTypableTemplate,
+	"float64_type_type.gotmpl":                       Float64TypeTypeTemplate,
+	"float64_type_value_from_float64.gotmpl":         Float64TypeValueFromFloat64Template,
+	"float64_type_value_from_terraform.gotmpl":       Float64TypeValueFromTerraformTemplate,
+	"float64_type_value_type.gotmpl":                 Float64TypeValueTypeTemplate,
+	"float64_value_equal.gotmpl":                     Float64ValueEqualTemplate,
+	"float64_value_type.gotmpl":                      Float64ValueTypeTemplate,
+	"float64_value_valuable.gotmpl":                  Float64ValueValuableTemplate,
+	"float64_value_value.gotmpl":                     Float64ValueValueTemplate,
+	"int32_from.gotmpl":                              Int32FromTemplate,
+	"int32_to.gotmpl":                                Int32ToTemplate,
+	"int32_type_equal.gotmpl":                        Int32TypeEqualTemplate,
+	"int32_type_string.gotmpl":                       Int32TypeStringTemplate,
+	"int32_type_typable.gotmpl":                      Int32TypeTypableTemplate,
+	"int32_type_type.gotmpl":                         Int32TypeTypeTemplate,
+	"int32_type_value_from_int32.gotmpl":             Int32TypeValueFromInt32Template,
+	"int32_type_value_from_terraform.gotmpl":         Int32TypeValueFromTerraformTemplate,
+	"int32_type_value_type.gotmpl":                   Int32TypeValueTypeTemplate,
+	"int32_value_equal.gotmpl":                       Int32ValueEqualTemplate,
+	"int32_value_type.gotmpl":                        Int32ValueTypeTemplate,
+	"int32_value_valuable.gotmpl":                    Int32ValueValuableTemplate,
+	"int32_value_value.gotmpl":                       Int32ValueValueTemplate,
+	"int64_from.gotmpl":                              Int64FromTemplate,
+	"int64_to.gotmpl":                                Int64ToTemplate,
+	"int64_type_equal.gotmpl":                        Int64TypeEqualTemplate,
+	"int64_type_string.gotmpl":                       Int64TypeStringTemplate,
+	"int64_type_typable.gotmpl":                      Int64TypeTypableTemplate,
+	"int64_type_type.gotmpl":                         Int64TypeTypeTemplate,
+	"int64_type_value_from_int64.gotmpl":             Int64TypeValueFromInt64Template,
+	"int64_type_value_from_terraform.gotmpl":         Int64TypeValueFromTerraformTemplate,
+	"int64_type_value_type.gotmpl":                   Int64TypeValueTypeTemplate,
+	"int64_value_equal.gotmpl":                       Int64ValueEqualTemplate,
+	"int64_value_type.gotmpl":                        Int64ValueTypeTemplate,
+	"int64_value_valuable.gotmpl":                    Int64ValueValuableTemplate,
+	"int64_value_value.gotmpl":                       Int64ValueValueTemplate,
+	"list_from.gotmpl":                               ListFromTemplate,
+	"list_to.gotmpl":                                 ListToTemplate,
+	"list_type_equal.gotmpl":                         ListTypeEqualTemplate,
+	"list_type_string.gotmpl":                        ListTypeStringTemplate,
+	"list_type_typable.gotmpl":                       ListTypeTypableTemplate,
+	"list_type_type.gotmpl":                          ListTypeTypeTemplate,
+	"list_type_value_from_list.gotmpl":               ListTypeValueFromListTemplate,
+	"list_type_value_from_terraform.gotmpl":          ListTypeValueFromTerraformTemplate,
+	"list_type_value_type.gotmpl":                    ListTypeValueTypeTemplate,
+	"list_value_equal.gotmpl":                        ListValueEqualTemplate,
+	"list_value_type.gotmpl":                         ListValueTypeTemplate,
+	"list_value_valuable.gotmpl":                     ListValueValuableTemplate,
+	"list_value_value.gotmpl":                        ListValueValueTemplate,
+	"map_from.gotmpl":                                MapFromTemplate,
+	"map_to.gotmpl":                                  MapToTemplate,
+	"map_type_equal.gotmpl":                          MapTypeEqualTemplate,
+	"map_type_string.gotmpl":                         MapTypeStringTemplate,
+	"map_type_typable.gotmpl":                        MapTypeTypableTemplate,
+	"map_type_type.gotmpl":                           MapTypeTypeTemplate,
+	"map_type_value_from_map.gotmpl":                 MapTypeValueFromMapTemplate,
+	"map_type_value_from_terraform.gotmpl":           MapTypeValueFromTerraformTemplate,
+	"map_type_value_type.gotmpl":                     MapTypeValueTypeTemplate,
+	"map_value_equal.gotmpl":                         MapValueEqualTemplate,
+	"map_value_type.gotmpl":                          MapValueTypeTemplate,
+	"map_value_valuable.gotmpl":                      MapValueValuableTemplate,
+	"map_value_value.gotmpl":                         MapValueValueTemplate,
+	"nested_object_from.gotmpl":                      NestedObjectFromTemplate,
+	"nested_object_to.gotmpl":                        NestedObjectToTemplate,
+	"nested_object_type_equal.gotmpl":                NestedObjectTypeEqualTemplate,
+	"nested_object_type_string.gotmpl":               NestedObjectTypeStringTemplate,
+	"nested_object_type_typable.gotmpl":              NestedObjectTypeTypableTemplate,
+	"nested_object_type_type.gotmpl":                 NestedObjectTypeTypeTemplate,
+	"nested_object_type_value.gotmpl":                NestedObjectTypeValueTemplate,
+	"nested_object_type_value_from_object.gotmpl":    NestedObjectTypeValueFromObjectTemplate,
+	"nested_object_type_value_from_terraform.gotmpl": NestedObjectTypeValueFromTerraformTemplate,
+	"nested_object_type_value_must.gotmpl":           NestedObjectTypeValueMustTemplate,
+	"nested_object_type_value_null.gotmpl":           NestedObjectTypeValueNullTemplate,
+	"nested_object_type_value_type.gotmpl":           NestedObjectTypeValueTypeTemplate,
+	"nested_object_type_value_unknown.gotmpl":        NestedObjectTypeValueUnknownTemplate,
+	"nested_object_value_attribute_types.gotmpl":     NestedObjectValueAttributeTypesTemplate,
+	"nested_object_value_equal.gotmpl":               NestedObjectValueEqualTemplate,
+	"nested_object_value_is_null.gotmpl":             NestedObjectValueIsNullTemplate,
+	"nested_object_value_is_unknown.gotmpl":          NestedObjectValueIsUnknownTemplate,
+	"nested_object_value_string.gotmpl":              NestedObjectValueStringTemplate,
+	"nested_object_value_to_object_value.gotmpl":     NestedObjectValueToObjectValueTemplate,
+	"nested_object_value_to_terraform_value.gotmpl":  NestedObjectValueToTerraformValueTemplate,
+	"nested_object_value_type.gotmpl":                NestedObjectValueTypeTemplate,
+	"nested_object_value_valuable.gotmpl":            NestedObjectValueValuableTemplate,
+	"nested_object_value_value.gotmpl":               NestedObjectValueValueTemplate,
+	"number_from.gotmpl":                             NumberFromTemplate,
+	"number_to.gotmpl":                               NumberToTemplate,
+	"number_type_equal.gotmpl":                       NumberTypeEqualTemplate,
+	"number_type_string.gotmpl":                      NumberTypeStringTemplate,
+	"number_type_typable.gotmpl":                     NumberTypeTypableTemplate,
+	"number_type_type.gotmpl":                        NumberTypeTypeTemplate,
+	"number_type_value_from_number.gotmpl":           NumberTypeValueFromNumberTemplate,
+	"number_type_value_from_terraform.gotmpl":        NumberTypeValueFromTerraformTemplate,
+	"number_type_value_type.gotmpl":                  NumberTypeValueTypeTemplate,
+	"number_value_equal.gotmpl":                      NumberValueEqualTemplate,
+	"number_value_type.gotmpl":                       NumberValueTypeTemplate,
+	"number_value_valuable.gotmpl":                   NumberValueValuableTemplate,
+	"number_value_value.gotmpl":                      NumberValueValueTemplate,
+	"object_from.gotmpl":                             ObjectFromTemplate,
+	"object_to.gotmpl":                               ObjectToTemplate,
+	"object_type_equal.gotmpl":                       ObjectTypeEqualTemplate,
+	"object_type_string.gotmpl":                      ObjectTypeStringTemplate,
+	"object_type_typable.gotmpl":                     ObjectTypeTypableTemplate,
+	"object_type_type.gotmpl":                        ObjectTypeTypeTemplate,
+	"object_type_value_from_object.gotmpl":           ObjectTypeValueFromObjectTemplate,
+	"object_type_value_from_terraform.gotmpl":        ObjectTypeValueFromTerraformTemplate,
+	"object_type_value_type.gotmpl":                  ObjectTypeValueTypeTemplate,
+	"object_value_attribute_types.gotmpl":            ObjectValueAttributeTypesTemplate,
+	"object_value_equal.gotmpl":                      ObjectValueEqualTemplate,
+	"object_value_type.gotmpl":                       ObjectValueTypeTemplate,
+	"object_value_valuable.gotmpl":                   ObjectValueValuableTemplate,
+	"object_value_value.gotmpl":                      ObjectValueValueTemplate,
+	"schema.gotmpl":                                  SchemaGoTemplate,
+	"set_from.gotmpl":                                SetFromTemplate,
+	"set_to.gotmpl":                                  SetToTemplate,
+	"set_type_equal.gotmpl":                          SetTypeEqualTemplate,
+	"set_type_string.gotmpl":                         SetTypeStringTemplate,
+	"set_type_typable.gotmpl":                        SetTypeTypableTemplate,
+	"set_type_type.gotmpl":                           SetTypeTypeTemplate,
+	"set_type_value_from_set.gotmpl":                 SetTypeValueFromSetTemplate,
+	"set_type_value_from_terraform.gotmpl":           SetTypeValueFromTerraformTemplate,
+	"set_type_value_type.gotmpl":                     SetTypeValueTypeTemplate,
+	"set_value_equal.gotmpl":                         SetValueEqualTemplate,
+	"set_value_type.gotmpl":                          SetValueTypeTemplate,
+	"set_value_valuable.gotmpl":                      SetValueValuableTemplate,
+	"set_value_value.gotmpl":                         SetValueValueTemplate,
+	"string_from.gotmpl":                             StringFromTemplate,
+	"string_to.gotmpl":                               StringToTemplate,
+	"string_type_equal.gotmpl":                       StringTypeEqualTemplate,
+	"string_type_string.gotmpl":                      StringTypeStringTemplate,
+	"string_type_typable.gotmpl":                     StringTypeTypableTemplate,
+	"string_type_type.gotmpl":                        StringTypeTypeTemplate,
+	"string_type_value_from_string.gotmpl":           StringTypeValueFromStringTemplate,
+	"string_type_value_from_terraform.gotmpl":        StringTypeValueFromTerraformTemplate,
+	"string_type_value_type.gotmpl":                  StringTypeValueTypeTemplate,
+	"string_value_equal.gotmpl":                      StringValueEqualTemplate,
+	"string_value_type.gotmpl":                       StringValueTypeTemplate,
+	"string_value_valuable.gotmpl":                   StringValueValuableTemplate,
+	"string_value_value.gotmpl":                      StringValueValueTemplate,
+}
+
+// Templates holds templates which override the embedded templates, keyed by
+// the file name of the embedded template.
+type Templates map[string]string
+
+// LoadTemplates reads the file of the same name as each embedded template in
+// dir, returning the Templates which override the embedded templates. Every
+// file is parsed as it is when generating code, without any functions beyond
+// the predefined functions, and an error naming the file is returned if a
+// file is not a valid template, or does not have the name of an embedded
+// template.
+func LoadTemplates(dir string) (Templates, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("error reading templates directory: %w", err)
+	}
+
+	overrides := make(Templates)
+
+	var errs []error
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".gotmpl") {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+
+		if _, ok := embeddedTemplates[entry.Name()]; !ok {
+			errs = append(errs, fmt.Errorf("%s does not match the name of an embedded template", path))
+
+			continue
+		}
+
+		b, err := os.ReadFile(path)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error reading template %s: %w", path, err))
+
+			continue
+		}
+
+		_, err = template.New(entry.Name()).Parse(string(b))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error parsing template %s: %w", path, err))
+
+			continue
+		}
+
+		overrides[entry.Name()] = string(b)
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return overrides, nil
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+//go:embed templates/*.gotmpl
+var embeddedTemplatesFS embed.FS
+
+func TestTemplates(t *testing.T) {
+	t.Parallel()
+
+	entries, err := embeddedTemplatesFS.ReadDir("templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the documentation template is overridden by generate docs instead
+	if len(entries)-1 != len(embeddedTemplates) {
+		t.Errorf("expected %d templates, got %d", len(entries)-1, len(embeddedTemplates))
+	}
+
+	for _, entry := range entries {
+		if entry.Name() == "docs.md.gotmpl" {
+			continue
+		}
+
+		v, ok := embeddedTemplates[entry.Name()]
+		if !ok {
+			t.Errorf("embedded template %s cannot be overridden", entry.Name())
+
+			continue
+		}
+
+		b, err := embeddedTemplatesFS.ReadFile("templates/" + entry.Name())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if v != string(b) {
+			t.Errorf("embedded template %s does not match its variable", entry.Name())
+		}
+	}
+}
+
+func TestLoadTemplates(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		files    map[string]string
+		expected string
+		// expectedError is formatted with the path of the errorFile
+		errorFile     string
+		expectedError string
+	}{
+		"override": {
+			files: map[string]string{
+				"bool_to.gotmpl": "override",
+				"README.md":      "ignored",
+			},
+			expected: "override",
+		},
+		"invalid-template": {
+			files: map[string]string{
+				"bool_to.gotmpl": "{{ .Name ",
+			},
+			expected:      BoolToTemplate,
+			errorFile:     "bool_to.gotmpl",
+			expectedError: "error parsing template %s: template: bool_to.gotmpl:1: unclosed action",
+		},
+		"unknown-function": {
+			files: map[string]string{
+				"bool_to.gotmpl": "{{ indent 2 .Name }}",
+			},
+			expected:      BoolToTemplate,
+			errorFile:     "bool_to.gotmpl",
+			expectedError: "error parsing template %s: template: bool_to.gotmpl:1: function \"indent\" not defined",
+		},
+		"docs-template": {
+			files: map[string]string{
+				"docs.md.gotmpl": "override",
+			},
+			expected:      BoolToTemplate,
+			errorFile:     "docs.md.gotmpl",
+			expectedError: "%s does not match the name of an embedded template",
+		},
+		"unknown-template": {
+			files: map[string]string{
+				"bool_to.gotmpl": "override",
+				"unknown.gotmpl": "",
+			},
+			expected:      BoolToTemplate,
+			errorFile:     "unknown.gotmpl",
+			expectedError: "%s does not match the name of an embedded template",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+
+			for k, v := range testCase.files {
+				err := os.WriteFile(filepath.Join(dir, k), []byte(v), 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			templates, err := LoadTemplates(dir)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else {
+				expectedError := fmt.Sprintf(testCase.expectedError, filepath.Join(dir, testCase.errorFile))
+
+				if err == nil || err.Error() != expectedError {
+					t.Fatalf("expected error %q, got %v", expectedError, err)
+				}
+			}
+
+			got := NewGenerator(GeneratorOptions{Templates: templates}).template("bool_to.gotmpl")
+
+			if got != testCase.expected {
+				t.Errorf("expected template %q, got %q", testCase.expected, got)
+			}
+
+			// the embedded template is unchanged
+			if BoolToTemplate == "override" {
+				t.Error("expected embedded template not to be overridden")
+			}
+		})
+	}
+}
@@ -14,10 +14,10 @@ type ToFromBool struct {
 	templates    map[string]string
 }
 
-func NewToFromBool(name string, assocExtType *AssocExtType) ToFromBool {
+func NewToFromBool(name string, assocExtType *AssocExtType, gen *Generator) ToFromBool {
 	t := map[string]string{
-		"from": BoolFromTemplate,
-		"to":   BoolToTemplate,
+		"from": gen.template("bool_from.gotmpl"),
+		"to":   gen.template("bool_to.gotmpl"),
 	}
 
 	return ToFromBool{
@@ -53,7 +53,7 @@ types.BoolPointerValue(*apiObject),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromBool := NewToFromBool(testCase.name, testCase.assocExtType)
+			toFromBool := NewToFromBool(testCase.name, testCase.assocExtType, nil)
 
 			got, err := toFromBool.renderFrom()
 
@@ -115,7 +115,7 @@ return &a, diags
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromBool := NewToFromBool(testCase.name, testCase.assocExtType)
+			toFromBool := NewToFromBool(testCase.name, testCase.assocExtType, nil)
 
 			got, err := toFromBool.renderTo()
 
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+)
+
+type ToFromDynamic struct {
+	Name         FrameworkIdentifier
+	AssocExtType *AssocExtType
+	templates    map[string]string
+}
+
+func NewToFromDynamic(name string, assocExtType *AssocExtType, gen *Generator) ToFromDynamic {
+	t := map[string]string{
+		"from": gen.template("dynamic_from.gotmpl"),
+		"to":   gen.template("dynamic_to.gotmpl"),
+	}
+
+	return ToFromDynamic{
+		Name:         FrameworkIdentifier(name),
+		AssocExtType: assocExtType,
+		templates:    t,
+	}
+}
+
+func (o ToFromDynamic) Render() ([]byte, error) {
+	var buf bytes.Buffer
+
+	switch o.AssocExtType.Type() {
+	case "any", "json.RawMessage":
+	default:
+		return nil, fmt.Errorf("associated external type of a dynamic attribute must be any or json.RawMessage, got %s", o.AssocExtType.Type())
+	}
+
+	renderFuncs := []func() ([]byte, error){
+		o.renderTo,
+		o.renderFrom,
+	}
+
+	for _, f := range renderFuncs {
+		b, err := f()
+
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write([]byte("\n"))
+
+		buf.Write(b)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (o ToFromDynamic) renderTo() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(o.templates["to"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name         string
+		AssocExtType *AssocExtType
+		RawMessage   bool
+	}{
+		Name:         o.Name.ToPascalCase(),
+		AssocExtType: o.AssocExtType,
+		RawMessage:   o.AssocExtType.Type() == "json.RawMessage",
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (o ToFromDynamic) renderFrom() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(o.templates["from"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name         string
+		AssocExtType *AssocExtType
+		RawMessage   bool
+	}{
+		Name:         o.Name.ToPascalCase(),
+		AssocExtType: o.AssocExtType,
+		RawMessage:   o.AssocExtType.Type() == "json.RawMessage",
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,376 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+	"github.com/greatman/terraform-plugin-codegen-spec/schema"
+)
+
+func TestToFromDynamic_Render(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		assocExtType  *AssocExtType
+		expectedError error
+	}{
+		"any": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				&schema.AssociatedExternalType{
+					Type: "any",
+				},
+			},
+		},
+		"json-raw-message": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				&schema.AssociatedExternalType{
+					Import: &code.Import{
+						Path: "encoding/json",
+					},
+					Type: "json.RawMessage",
+				},
+			},
+		},
+		"unsupported": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				&schema.AssociatedExternalType{
+					Import: &code.Import{
+						Path: "example.com/apisdk",
+					},
+					Type: "*apisdk.Type",
+				},
+			},
+			expectedError: fmt.Errorf("associated external type of a dynamic attribute must be any or json.RawMessage, got *apisdk.Type"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			toFromDynamic := NewToFromDynamic(testCase.name, testCase.assocExtType, nil)
+
+			_, err := toFromDynamic.Render()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+		})
+	}
+}
+
+func TestToFromDynamic_renderFrom(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		assocExtType  *AssocExtType
+		expected      []byte
+		expectedError error
+	}{
+		"json-raw-message": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				&schema.AssociatedExternalType{
+					Import: &code.Import{
+						Path: "encoding/json",
+					},
+					Type: "json.RawMessage",
+				},
+			},
+			expected: []byte(`
+func (v ExampleValue) FromJsonRawMessage(ctx context.Context, apiObject json.RawMessage) (ExampleValue, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if apiObject == nil {
+return ExampleValue{
+types.DynamicNull(),
+}, diags
+}
+
+var a any
+
+decoder := json.NewDecoder(bytes.NewReader(apiObject))
+
+decoder.UseNumber()
+
+if err := decoder.Decode(&a); err != nil {
+diags.Append(diag.NewErrorDiagnostic(
+"ExampleValue Value Cannot Be Decoded",
+fmt.Sprintf(` + "`" + `"ExampleValue" cannot be decoded from JSON: %s` + "`" + `, err),
+))
+
+return ExampleValue{
+types.DynamicUnknown(),
+}, diags
+}
+
+var fromAny func(a any) (attr.Value, diag.Diagnostics)
+
+fromAny = func(a any) (attr.Value, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+switch a := a.(type) {
+case nil:
+return types.DynamicNull(), diags
+case bool:
+return types.BoolValue(a), diags
+case float64:
+return types.NumberValue(big.NewFloat(a)), diags
+case int:
+return types.NumberValue(new(big.Float).SetInt64(int64(a))), diags
+case int64:
+return types.NumberValue(new(big.Float).SetInt64(a)), diags
+case json.Number:
+// The precision matches that of numbers in Terraform, so that large
+// integers keep their value.
+f, _, err := big.ParseFloat(a.String(), 10, 512, big.ToNearestEven)
+
+if err != nil {
+diags.Append(diag.NewErrorDiagnostic(
+"ExampleValue Value Is Invalid",
+fmt.Sprintf(` + "`" + `"ExampleValue" contains an invalid number %q.` + "`" + `, a),
+))
+
+return nil, diags
+}
+
+return types.NumberValue(f), diags
+case string:
+return types.StringValue(a), diags
+case []any:
+elementTypes := make([]attr.Type, 0, len(a))
+elements := make([]attr.Value, 0, len(a))
+
+for _, e := range a {
+element, d := fromAny(e)
+
+diags.Append(d...)
+
+if diags.HasError() {
+return nil, diags
+}
+
+elementTypes = append(elementTypes, element.Type(ctx))
+elements = append(elements, element)
+}
+
+tupleValue, d := basetypes.NewTupleValue(elementTypes, elements)
+
+diags.Append(d...)
+
+return tupleValue, diags
+case map[string]any:
+attributeTypes := make(map[string]attr.Type, len(a))
+attributes := make(map[string]attr.Value, len(a))
+
+for k, e := range a {
+attribute, d := fromAny(e)
+
+diags.Append(d...)
+
+if diags.HasError() {
+return nil, diags
+}
+
+attributeTypes[k] = attribute.Type(ctx)
+attributes[k] = attribute
+}
+
+objectValue, d := basetypes.NewObjectValue(attributeTypes, attributes)
+
+diags.Append(d...)
+
+return objectValue, diags
+}
+
+diags.Append(diag.NewErrorDiagnostic(
+"ExampleValue Value Is Unsupported",
+fmt.Sprintf(` + "`" + `"ExampleValue" cannot be created from a value of unsupported type %T.` + "`" + `, a),
+))
+
+return nil, diags
+}
+
+value, d := fromAny(a)
+
+diags.Append(d...)
+
+if diags.HasError() {
+return ExampleValue{
+types.DynamicUnknown(),
+}, diags
+}
+
+return ExampleValue{
+types.DynamicValue(value),
+}, diags
+}
+`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			toFromDynamic := NewToFromDynamic(testCase.name, testCase.assocExtType, nil)
+
+			got, err := toFromDynamic.renderFrom()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestToFromDynamic_renderTo(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		assocExtType  *AssocExtType
+		expected      []byte
+		expectedError error
+	}{
+		"any": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				&schema.AssociatedExternalType{
+					Type: "any",
+				},
+			},
+			expected: []byte(`func (v ExampleValue) ToAny(ctx context.Context) (any, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if v.IsNull() || v.IsUnderlyingValueNull() {
+return nil, diags
+}
+
+if v.IsUnknown() || v.IsUnderlyingValueUnknown() {
+diags.Append(diag.NewErrorDiagnostic(
+"ExampleValue Value Is Unknown",
+` + "`" + `"ExampleValue" is unknown.` + "`" + `,
+))
+
+return nil, diags
+}
+
+var toAny func(value attr.Value) (any, diag.Diagnostics)
+
+toAny = func(value attr.Value) (any, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if value.IsNull() {
+return nil, diags
+}
+
+if value.IsUnknown() {
+diags.Append(diag.NewErrorDiagnostic(
+"ExampleValue Value Is Unknown",
+` + "`" + `"ExampleValue" contains an unknown value.` + "`" + `,
+))
+
+return nil, diags
+}
+
+switch value := value.(type) {
+case basetypes.DynamicValue:
+return toAny(value.UnderlyingValue())
+case basetypes.BoolValue:
+return value.ValueBool(), diags
+case basetypes.NumberValue:
+return json.Number(value.ValueBigFloat().Text('g', -1)), diags
+case basetypes.StringValue:
+return value.ValueString(), diags
+case basetypes.MapValue:
+m := make(map[string]any, len(value.Elements()))
+
+for k, e := range value.Elements() {
+a, d := toAny(e)
+
+diags.Append(d...)
+
+m[k] = a
+}
+
+return m, diags
+case basetypes.ObjectValue:
+m := make(map[string]any, len(value.Attributes()))
+
+for k, e := range value.Attributes() {
+a, d := toAny(e)
+
+diags.Append(d...)
+
+m[k] = a
+}
+
+return m, diags
+case interface{ Elements() []attr.Value }:
+s := make([]any, 0, len(value.Elements()))
+
+for _, e := range value.Elements() {
+a, d := toAny(e)
+
+diags.Append(d...)
+
+s = append(s, a)
+}
+
+return s, diags
+}
+
+diags.Append(diag.NewErrorDiagnostic(
+"ExampleValue Value Is Unsupported",
+fmt.Sprintf(` + "`" + `"ExampleValue" contains a value of unsupported type %T.` + "`" + `, value),
+))
+
+return nil, diags
+}
+
+a, d := toAny(v.UnderlyingValue())
+
+diags.Append(d...)
+
+if diags.HasError() {
+return nil, diags
+}
+
+return a, diags
+}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			toFromDynamic := NewToFromDynamic(testCase.name, testCase.assocExtType, nil)
+
+			got, err := toFromDynamic.renderTo()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
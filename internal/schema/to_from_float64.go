@@ -14,10 +14,10 @@ type ToFromFloat64 struct {
 	templates    map[string]string
 }
 
-func NewToFromFloat64(name string, assocExtType *AssocExtType) ToFromFloat64 {
+func NewToFromFloat64(name string, assocExtType *AssocExtType, gen *Generator) ToFromFloat64 {
 	t := map[string]string{
-		"from": Float64FromTemplate,
-		"to":   Float64ToTemplate,
+		"from": gen.template("float64_from.gotmpl"),
+		"to":   gen.template("float64_to.gotmpl"),
 	}
 
 	return ToFromFloat64{
@@ -53,7 +53,7 @@ types.Float64PointerValue(*apiObject),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromFloat64 := NewToFromFloat64(testCase.name, testCase.assocExtType)
+			toFromFloat64 := NewToFromFloat64(testCase.name, testCase.assocExtType, nil)
 
 			got, err := toFromFloat64.renderFrom()
 
@@ -115,7 +115,7 @@ return &a, diags
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromFloat64 := NewToFromFloat64(testCase.name, testCase.assocExtType)
+			toFromFloat64 := NewToFromFloat64(testCase.name, testCase.assocExtType, nil)
 
 			got, err := toFromFloat64.renderTo()
 
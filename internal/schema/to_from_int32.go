@@ -14,10 +14,10 @@ type ToFromInt32 struct {
 	templates    map[string]string
 }
 
-func NewToFromInt32(name string, assocExtType *AssocExtType) ToFromInt32 {
+func NewToFromInt32(name string, assocExtType *AssocExtType, gen *Generator) ToFromInt32 {
 	t := map[string]string{
-		"from": Int32FromTemplate,
-		"to":   Int32ToTemplate,
+		"from": gen.template("int32_from.gotmpl"),
+		"to":   gen.template("int32_to.gotmpl"),
 	}
 
 	return ToFromInt32{
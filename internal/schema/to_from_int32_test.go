@@ -53,7 +53,7 @@ types.Int32PointerValue(*apiObject),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromInt32 := NewToFromInt32(testCase.name, testCase.assocExtType)
+			toFromInt32 := NewToFromInt32(testCase.name, testCase.assocExtType, nil)
 
 			got, err := toFromInt32.renderFrom()
 
@@ -115,7 +115,7 @@ return &a, diags
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromInt32 := NewToFromInt32(testCase.name, testCase.assocExtType)
+			toFromInt32 := NewToFromInt32(testCase.name, testCase.assocExtType, nil)
 
 			got, err := toFromInt32.renderTo()
 
@@ -14,10 +14,10 @@ type ToFromInt64 struct {
 	templates    map[string]string
 }
 
-func NewToFromInt64(name string, assocExtType *AssocExtType) ToFromInt64 {
+func NewToFromInt64(name string, assocExtType *AssocExtType, gen *Generator) ToFromInt64 {
 	t := map[string]string{
-		"from": Int64FromTemplate,
-		"to":   Int64ToTemplate,
+		"from": gen.template("int64_from.gotmpl"),
+		"to":   gen.template("int64_to.gotmpl"),
 	}
 
 	return ToFromInt64{
@@ -53,7 +53,7 @@ types.Int64PointerValue(*apiObject),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromInt64 := NewToFromInt64(testCase.name, testCase.assocExtType)
+			toFromInt64 := NewToFromInt64(testCase.name, testCase.assocExtType, nil)
 
 			got, err := toFromInt64.renderFrom()
 
@@ -115,7 +115,7 @@ return &a, diags
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromInt64 := NewToFromInt64(testCase.name, testCase.assocExtType)
+			toFromInt64 := NewToFromInt64(testCase.name, testCase.assocExtType, nil)
 
 			got, err := toFromInt64.renderTo()
 
@@ -13,14 +13,15 @@ type ToFromList struct {
 	AssocExtType     *AssocExtType
 	ElementTypeType  string
 	ElementTypeValue string
+	ElementTo        string
 	ElementFrom      string
 	templates        map[string]string
 }
 
-func NewToFromList(name string, assocExtType *AssocExtType, elemTypeType, elemTypeValue, elemFrom string) ToFromList {
+func NewToFromList(name string, assocExtType *AssocExtType, elemTypeType, elemTypeValue, elemTo, elemFrom string, gen *Generator) ToFromList {
 	t := map[string]string{
-		"from": ListFromTemplate,
-		"to":   ListToTemplate,
+		"from": gen.template("list_from.gotmpl"),
+		"to":   gen.template("list_to.gotmpl"),
 	}
 
 	return ToFromList{
@@ -28,6 +29,7 @@ func NewToFromList(name string, assocExtType *AssocExtType, elemTypeType, elemTy
 		AssocExtType:     assocExtType,
 		ElementTypeType:  elemTypeType,
 		ElementTypeValue: elemTypeValue,
+		ElementTo:        elemTo,
 		ElementFrom:      elemFrom,
 		templates:        t,
 	}
@@ -68,9 +70,11 @@ func (o ToFromList) renderTo() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name         string
 		AssocExtType *AssocExtType
+		ElementTo    string
 	}{
 		Name:         o.Name.ToPascalCase(),
 		AssocExtType: o.AssocExtType,
+		ElementTo:    o.ElementTo,
 	})
 
 	if err != nil {
@@ -94,12 +98,14 @@ func (o ToFromList) renderFrom() ([]byte, error) {
 		AssocExtType     *AssocExtType
 		ElementTypeType  string
 		ElementTypeValue string
+		ElementTo        string
 		ElementFrom      string
 	}{
 		Name:             o.Name.ToPascalCase(),
 		AssocExtType:     o.AssocExtType,
 		ElementTypeType:  o.ElementTypeType,
 		ElementTypeValue: o.ElementTypeValue,
+		ElementTo:        o.ElementTo,
 		ElementFrom:      o.ElementFrom,
 	})
 
@@ -19,6 +19,7 @@ func TestToFromList_renderFrom(t *testing.T) {
 		assocExtType  *AssocExtType
 		elemTypeType  string
 		elemTypeValue string
+		elemTo        string
 		elemFrom      string
 		expected      []byte
 		expectedError error
@@ -75,7 +76,7 @@ l,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromList := NewToFromList(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom)
+			toFromList := NewToFromList(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemTo, testCase.elemFrom, nil)
 
 			got, err := toFromList.renderFrom()
 
@@ -98,6 +99,7 @@ func TestToFromList_renderTo(t *testing.T) {
 		assocExtType  *AssocExtType
 		elemTypeType  string
 		elemTypeValue string
+		elemTo        string
 		elemFrom      string
 		expected      []byte
 		expectedError error
@@ -141,6 +143,51 @@ if diags.HasError() {
 return nil, diags
 }
 
+return &apisdkType, diags
+}`),
+		},
+		"element-to": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				&schema.AssociatedExternalType{
+					Import: &code.Import{
+						Path: "example.com/apisdk",
+					},
+					Type: "*apisdk.Type",
+				},
+			},
+			elemTypeType:  "types.ListType{\nElemType: types.BoolType,\n}",
+			elemTypeValue: "types.List",
+			elemTo:        "func(v attr.Value) (result []*bool) {\nreturn\n}",
+			elemFrom:      "func(v []*bool) types.List {\nreturn types.ListNull(types.BoolType)\n}",
+			expected: []byte(`func (v ExampleValue) ToApisdkType(ctx context.Context) (*apisdk.Type, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if v.IsNull() {
+return nil, diags
+}
+
+if v.IsUnknown() {
+diags.Append(diag.NewErrorDiagnostic(
+"ExampleValue Value Is Unknown",
+` + "`" + `"ExampleValue" is unknown.` + "`" + `,
+))
+
+return nil, diags
+}
+
+apisdkType := make(apisdk.Type, 0, len(v.Elements()))
+
+for _, e := range v.Elements() {
+apisdkType = append(apisdkType, func(v attr.Value) (result []*bool) {
+return
+}(e))
+}
+
+if diags.HasError() {
+return nil, diags
+}
+
 return &apisdkType, diags
 }`),
 		},
@@ -151,7 +198,7 @@ return &apisdkType, diags
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromList := NewToFromList(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom)
+			toFromList := NewToFromList(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemTo, testCase.elemFrom, nil)
 
 			got, err := toFromList.renderTo()
 
@@ -165,3 +212,180 @@ return &apisdkType, diags
 		})
 	}
 }
+
+// TestToFromList_Render_NullElements renders the conversions of a list with
+// list elements in both directions, so that the round trip of a null element
+// can be followed: a null element converts to a nil slice, rather than an
+// empty slice, and a nil slice converts back to a null element. An empty
+// list converts to an empty, rather than null, list.
+func TestToFromList_Render_NullElements(t *testing.T) {
+	t.Parallel()
+
+	elementType := schema.ElementType{
+		List: &schema.ListType{
+			ElementType: schema.ElementType{
+				String: &schema.StringType{},
+			},
+		},
+	}
+
+	elemTypeType, err := ElementTypeString(elementType)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	elemTo, err := GetElementToFunc(elementType, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	elemFrom, err := GetElementFromFunc(elementType, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assocExtType := &AssocExtType{
+		&schema.AssociatedExternalType{
+			Import: &code.Import{
+				Path: "example.com/apisdk",
+			},
+			Type: "*apisdk.Type",
+		},
+	}
+
+	expected := []byte(`
+func (v ExampleValue) ToApisdkType(ctx context.Context) (*apisdk.Type, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if v.IsNull() {
+return nil, diags
+}
+
+if v.IsUnknown() {
+diags.Append(diag.NewErrorDiagnostic(
+"ExampleValue Value Is Unknown",
+` + "`" + `"ExampleValue" is unknown.` + "`" + `,
+))
+
+return nil, diags
+}
+
+apisdkType := make(apisdk.Type, 0, len(v.Elements()))
+
+for _, e := range v.Elements() {
+apisdkType = append(apisdkType, func(v attr.Value) (result []*string) {
+valuable, ok := v.(basetypes.ListValuable)
+
+if !ok {
+diags.AddError("Unexpected Value Type", fmt.Sprintf(` + "`" + `expected basetypes.ListValuable, was: %T` + "`" + `, v))
+
+return
+}
+
+c, d := valuable.ToListValue(ctx)
+
+diags.Append(d...)
+
+if c.IsNull() || c.IsUnknown() {
+return
+}
+
+result = make([]*string, 0, len(c.Elements()))
+
+for _, e := range c.Elements() {
+result = append(result, func(v attr.Value) (result *string) {
+valuable, ok := v.(basetypes.StringValuable)
+
+if !ok {
+diags.AddError("Unexpected Value Type", fmt.Sprintf(` + "`" + `expected basetypes.StringValuable, was: %T` + "`" + `, v))
+
+return
+}
+
+p, d := valuable.ToStringValue(ctx)
+
+diags.Append(d...)
+
+return p.ValueStringPointer()
+}(e))
+}
+
+return
+}(e))
+}
+
+if diags.HasError() {
+return nil, diags
+}
+
+return &apisdkType, diags
+}
+
+func (v ExampleValue) FromApisdkType(ctx context.Context, apiObject *apisdk.Type) (ExampleValue, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if apiObject == nil {
+return ExampleValue{
+types.ListNull(types.ListType{
+ElemType: types.StringType,
+}),
+}, diags
+}
+
+elems := make([]types.List, 0, len(*apiObject))
+
+for _, e := range *apiObject {
+elems = append(elems, func(v []*string) types.List {
+if v == nil {
+return types.ListNull(types.StringType)
+}
+
+elems := make([]attr.Value, 0, len(v))
+
+for _, e := range v {
+elems = append(elems, types.StringPointerValue(e))
+}
+
+c, d := types.ListValue(types.StringType, elems)
+
+diags.Append(d...)
+
+return c
+}(e))
+}
+
+l, d := basetypes.NewListValueFrom(ctx, types.ListType{
+ElemType: types.StringType,
+}, elems)
+
+diags.Append(d...)
+
+if diags.HasError() {
+return ExampleValue{
+types.ListUnknown(types.ListType{
+ElemType: types.StringType,
+}),
+}, diags
+}
+
+return ExampleValue{
+l,
+}, diags
+}
+`)
+
+	toFromList := NewToFromList("Example", assocExtType, elemTypeType, "types.List", elemTo, elemFrom, nil)
+
+	got, err := toFromList.Render()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -13,14 +13,15 @@ type ToFromMap struct {
 	AssocExtType     *AssocExtType
 	ElementTypeType  string
 	ElementTypeValue string
+	ElementTo        string
 	ElementFrom      string
 	templates        map[string]string
 }
 
-func NewToFromMap(name string, assocExtType *AssocExtType, elemTypeType, elemTypeValue, elemFrom string) ToFromMap {
+func NewToFromMap(name string, assocExtType *AssocExtType, elemTypeType, elemTypeValue, elemTo, elemFrom string, gen *Generator) ToFromMap {
 	t := map[string]string{
-		"from": MapFromTemplate,
-		"to":   MapToTemplate,
+		"from": gen.template("map_from.gotmpl"),
+		"to":   gen.template("map_to.gotmpl"),
 	}
 
 	return ToFromMap{
@@ -28,6 +29,7 @@ func NewToFromMap(name string, assocExtType *AssocExtType, elemTypeType, elemTyp
 		AssocExtType:     assocExtType,
 		ElementTypeType:  elemTypeType,
 		ElementTypeValue: elemTypeValue,
+		ElementTo:        elemTo,
 		ElementFrom:      elemFrom,
 		templates:        t,
 	}
@@ -68,9 +70,11 @@ func (o ToFromMap) renderTo() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name         string
 		AssocExtType *AssocExtType
+		ElementTo    string
 	}{
 		Name:         o.Name.ToPascalCase(),
 		AssocExtType: o.AssocExtType,
+		ElementTo:    o.ElementTo,
 	})
 
 	if err != nil {
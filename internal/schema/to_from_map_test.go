@@ -19,6 +19,7 @@ func TestToFromMap_renderFrom(t *testing.T) {
 		assocExtType  *AssocExtType
 		elemTypeType  string
 		elemTypeValue string
+		elemTo        string
 		elemFrom      string
 		expected      []byte
 		expectedError error
@@ -75,7 +76,7 @@ l,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromMap := NewToFromMap(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom)
+			toFromMap := NewToFromMap(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemTo, testCase.elemFrom, nil)
 
 			got, err := toFromMap.renderFrom()
 
@@ -98,6 +99,7 @@ func TestToFromMap_renderTo(t *testing.T) {
 		assocExtType  *AssocExtType
 		elemTypeType  string
 		elemTypeValue string
+		elemTo        string
 		elemFrom      string
 		expected      []byte
 		expectedError error
@@ -141,6 +143,51 @@ if diags.HasError() {
 return nil, diags
 }
 
+return &apisdkType, diags
+}`),
+		},
+		"element-to": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				&schema.AssociatedExternalType{
+					Import: &code.Import{
+						Path: "example.com/apisdk",
+					},
+					Type: "*apisdk.Type",
+				},
+			},
+			elemTypeType:  "types.ListType{\nElemType: types.BoolType,\n}",
+			elemTypeValue: "types.List",
+			elemTo:        "func(v attr.Value) (result []*bool) {\nreturn\n}",
+			elemFrom:      "func(v []*bool) types.List {\nreturn types.ListNull(types.BoolType)\n}",
+			expected: []byte(`func (v ExampleValue) ToApisdkType(ctx context.Context) (*apisdk.Type, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if v.IsNull() {
+return nil, diags
+}
+
+if v.IsUnknown() {
+diags.Append(diag.NewErrorDiagnostic(
+"ExampleValue Value Is Unknown",
+` + "`" + `"ExampleValue" is unknown.` + "`" + `,
+))
+
+return nil, diags
+}
+
+apisdkType := make(apisdk.Type, len(v.Elements()))
+
+for k, e := range v.Elements() {
+apisdkType[k] = func(v attr.Value) (result []*bool) {
+return
+}(e)
+}
+
+if diags.HasError() {
+return nil, diags
+}
+
 return &apisdkType, diags
 }`),
 		},
@@ -151,7 +198,7 @@ return &apisdkType, diags
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromMap := NewToFromMap(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom)
+			toFromMap := NewToFromMap(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemTo, testCase.elemFrom, nil)
 
 			got, err := toFromMap.renderTo()
 
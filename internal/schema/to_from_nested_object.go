@@ -16,10 +16,10 @@ type ToFromNestedObject struct {
 	templates    map[string]string
 }
 
-func NewToFromNestedObject(name string, assocExtType *AssocExtType, toFuncs, fromFuncs map[string]ToFromConversion) ToFromNestedObject {
+func NewToFromNestedObject(name string, assocExtType *AssocExtType, toFuncs, fromFuncs map[string]ToFromConversion, gen *Generator) ToFromNestedObject {
 	t := map[string]string{
-		"from": NestedObjectFromTemplate,
-		"to":   NestedObjectToTemplate,
+		"from": gen.template("nested_object_from.gotmpl"),
+		"to":   gen.template("nested_object_to.gotmpl"),
 	}
 
 	tf := make(map[FrameworkIdentifier]ToFromConversion, len(toFuncs))
@@ -189,6 +189,47 @@ BoolAttribute: boolAttributeVal,
 state: attr.ValueStateKnown,
 }, diags
 }
+`),
+		},
+		"collection-type-from-func": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
+					Type: "*apisdk.Type",
+				},
+			},
+			fromFuncs: map[string]ToFromConversion{
+				"list_attribute": {
+					CollectionType: CollectionFields{
+						ElementType:   "types.ListType{\nElemType: types.BoolType,\n}",
+						TypeValueFrom: "types.ListValueFrom",
+						FromFunc:      "func(v [][]*bool) types.List {\nreturn types.ListNull(types.ListType{\nElemType: types.BoolType,\n})\n}",
+					},
+				},
+			},
+			expected: []byte(`
+func (v ExampleValue) FromApisdkType(ctx context.Context, apiObject *apisdk.Type) (ExampleValue, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if apiObject == nil {
+return NewExampleValueNull(), diags
+}
+
+listAttributeVal := func(v [][]*bool) types.List {
+return types.ListNull(types.ListType{
+ElemType: types.BoolType,
+})
+}(apiObject.ListAttribute)
+
+if diags.HasError() {
+return NewExampleValueUnknown(), diags
+}
+
+return ExampleValue{
+ListAttribute: listAttributeVal,
+state: attr.ValueStateKnown,
+}, diags
+}
 `),
 		},
 		"collection-type-attribute-name-same-as-generated-method-name": {
@@ -227,6 +268,83 @@ ExampleType: typeVal,
 state: attr.ValueStateKnown,
 }, diags
 }
+`),
+		},
+		"config-only": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
+					Type: "*apisdk.Type",
+				},
+			},
+			fromFuncs: map[string]ToFromConversion{
+				"bool_attribute": {
+					Default:    "BoolPointerValue",
+					ConfigOnly: true,
+				},
+				"list_attribute": {
+					CollectionType: CollectionFields{
+						ElementType:   "types.BoolType",
+						GoType:        "[]*bool",
+						TypeValueFrom: "types.ListValueFrom",
+					},
+					ConfigOnly: true,
+				},
+				"nested_attribute": {
+					AssocExtType: &AssocExtType{
+						AssociatedExternalType: &schema.AssociatedExternalType{
+							Type: "*api.NestedAttribute",
+						},
+					},
+					ConfigOnly: true,
+				},
+				"object_attribute": {
+					ObjectType: map[FrameworkIdentifier]ObjectField{
+						FrameworkIdentifier("bool"): {
+							Type:     "types.BoolType",
+							FromFunc: "BoolPointerValue",
+						},
+					},
+					ConfigOnly: true,
+				},
+			},
+			expected: []byte(`
+func (v ExampleValue) FromApisdkType(ctx context.Context, apiObject *apisdk.Type) (ExampleValue, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if apiObject == nil {
+return NewExampleValueNull(), diags
+}
+
+listAttributeVal, d := types.ListValueFrom(ctx, types.BoolType, []*bool(nil))
+
+diags.Append(d...)
+
+if diags.HasError() {
+return NewExampleValueUnknown(), diags
+}
+
+nestedAttributeVal, d := NestedAttributeValue{}.FromApiNestedAttribute(ctx, nil)
+
+diags.Append(d...)
+
+if diags.HasError() {
+return NewExampleValueUnknown(), diags
+}
+
+objectAttributeVal := types.ObjectNull(
+map[string]attr.Type{
+"bool": types.BoolType,
+})
+
+return ExampleValue{
+BoolAttribute: types.BoolPointerValue(nil),
+ListAttribute: listAttributeVal,
+NestedAttribute: nestedAttributeVal,
+ObjectAttribute: objectAttributeVal,
+state: attr.ValueStateKnown,
+}, diags
+}
 `),
 		},
 		"object-type": {
@@ -374,7 +492,7 @@ state: attr.ValueStateKnown,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromObject := NewToFromNestedObject(testCase.name, testCase.assocExtType, nil, testCase.fromFuncs)
+			toFromObject := NewToFromNestedObject(testCase.name, testCase.assocExtType, nil, testCase.fromFuncs, nil)
 
 			got, err := toFromObject.renderFrom()
 
@@ -613,6 +731,53 @@ return nil, diags
 return &apisdk.Type{
 BoolAttribute: boolAttributeField,
 }, diags
+}`),
+		},
+		"collection-type-to-func": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				&schema.AssociatedExternalType{
+					Import: &code.Import{
+						Path: "example.com/apisdk",
+					},
+					Type: "*apisdk.Type",
+				},
+			},
+			toFuncs: map[string]ToFromConversion{
+				"list_attribute": {
+					CollectionType: CollectionFields{
+						GoType: "[][]*bool",
+						ToFunc: "func(v attr.Value) (result [][]*bool) {\nreturn\n}",
+					},
+				},
+			},
+			expected: []byte(`func (v ExampleValue) ToApisdkType(ctx context.Context) (*apisdk.Type, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if v.IsNull() {
+return nil, diags
+}
+
+if v.IsUnknown() {
+diags.Append(diag.NewErrorDiagnostic(
+"ExampleValue Value Is Unknown",
+` + "`" + `"ExampleValue" is unknown.` + "`" + `,
+))
+
+return nil, diags
+}
+
+listAttributeField := func(v attr.Value) (result [][]*bool) {
+return
+}(v.ListAttribute)
+
+if diags.HasError() {
+return nil, diags
+}
+
+return &apisdk.Type{
+ListAttribute: listAttributeField,
+}, diags
 }`),
 		},
 		"collection-type-attribute-name-same-as-generated-method-name": {
@@ -932,7 +1097,7 @@ String: typeFieldString.ValueStringPointer(),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromObject := NewToFromNestedObject(testCase.name, testCase.assocExtType, testCase.toFuncs, nil)
+			toFromObject := NewToFromNestedObject(testCase.name, testCase.assocExtType, testCase.toFuncs, nil, nil)
 
 			got, err := toFromObject.renderTo()
 
@@ -14,10 +14,10 @@ type ToFromNumber struct {
 	templates    map[string]string
 }
 
-func NewToFromNumber(name string, assocExtType *AssocExtType) ToFromNumber {
+func NewToFromNumber(name string, assocExtType *AssocExtType, gen *Generator) ToFromNumber {
 	t := map[string]string{
-		"from": NumberFromTemplate,
-		"to":   NumberToTemplate,
+		"from": gen.template("number_from.gotmpl"),
+		"to":   gen.template("number_to.gotmpl"),
 	}
 
 	return ToFromNumber{
@@ -53,7 +53,7 @@ types.NumberValue(*apiObject),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromNumber := NewToFromNumber(testCase.name, testCase.assocExtType)
+			toFromNumber := NewToFromNumber(testCase.name, testCase.assocExtType, nil)
 
 			got, err := toFromNumber.renderFrom()
 
@@ -115,7 +115,7 @@ return &a, diags
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromNumber := NewToFromNumber(testCase.name, testCase.assocExtType)
+			toFromNumber := NewToFromNumber(testCase.name, testCase.assocExtType, nil)
 
 			got, err := toFromNumber.renderTo()
 
@@ -16,10 +16,10 @@ type ToFromObject struct {
 	templates          map[string]string
 }
 
-func NewToFromObject(name string, assocExtType *AssocExtType, attrTypesToFuncs map[string]AttrTypesToFuncs, attrTypesFromFuncs map[string]string) ToFromObject {
+func NewToFromObject(name string, assocExtType *AssocExtType, attrTypesToFuncs map[string]AttrTypesToFuncs, attrTypesFromFuncs map[string]string, gen *Generator) ToFromObject {
 	t := map[string]string{
-		"from": ObjectFromTemplate,
-		"to":   ObjectToTemplate,
+		"from": gen.template("object_from.gotmpl"),
+		"to":   gen.template("object_to.gotmpl"),
 	}
 
 	attf := make(map[FrameworkIdentifier]AttrTypesToFuncs, len(attrTypesToFuncs))
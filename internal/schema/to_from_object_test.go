@@ -77,7 +77,7 @@ o,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromObject := NewToFromObject(testCase.name, testCase.assocExtType, nil, testCase.attrTypesFromFuncs)
+			toFromObject := NewToFromObject(testCase.name, testCase.assocExtType, nil, testCase.attrTypesFromFuncs, nil)
 
 			got, err := toFromObject.renderFrom()
 
@@ -221,7 +221,7 @@ return &apisdkType, diags
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromObject := NewToFromObject(testCase.name, testCase.assocExtType, testCase.attrTypesToFuncs, nil)
+			toFromObject := NewToFromObject(testCase.name, testCase.assocExtType, testCase.attrTypesToFuncs, nil, nil)
 
 			got, err := toFromObject.renderTo()
 
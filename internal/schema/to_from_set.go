@@ -13,14 +13,15 @@ type ToFromSet struct {
 	AssocExtType     *AssocExtType
 	ElementTypeType  string
 	ElementTypeValue string
+	ElementTo        string
 	ElementFrom      string
 	templates        map[string]string
 }
 
-func NewToFromSet(name string, assocExtType *AssocExtType, elemTypeType, elemTypeValue, elemFrom string) ToFromSet {
+func NewToFromSet(name string, assocExtType *AssocExtType, elemTypeType, elemTypeValue, elemTo, elemFrom string, gen *Generator) ToFromSet {
 	t := map[string]string{
-		"from": SetFromTemplate,
-		"to":   SetToTemplate,
+		"from": gen.template("set_from.gotmpl"),
+		"to":   gen.template("set_to.gotmpl"),
 	}
 
 	return ToFromSet{
@@ -28,6 +29,7 @@ func NewToFromSet(name string, assocExtType *AssocExtType, elemTypeType, elemTyp
 		AssocExtType:     assocExtType,
 		ElementTypeType:  elemTypeType,
 		ElementTypeValue: elemTypeValue,
+		ElementTo:        elemTo,
 		ElementFrom:      elemFrom,
 		templates:        t,
 	}
@@ -68,9 +70,11 @@ func (o ToFromSet) renderTo() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name         string
 		AssocExtType *AssocExtType
+		ElementTo    string
 	}{
 		Name:         o.Name.ToPascalCase(),
 		AssocExtType: o.AssocExtType,
+		ElementTo:    o.ElementTo,
 	})
 
 	if err != nil {
@@ -94,12 +98,14 @@ func (o ToFromSet) renderFrom() ([]byte, error) {
 		AssocExtType     *AssocExtType
 		ElementTypeType  string
 		ElementTypeValue string
+		ElementTo        string
 		ElementFrom      string
 	}{
 		Name:             o.Name.ToPascalCase(),
 		AssocExtType:     o.AssocExtType,
 		ElementTypeType:  o.ElementTypeType,
 		ElementTypeValue: o.ElementTypeValue,
+		ElementTo:        o.ElementTo,
 		ElementFrom:      o.ElementFrom,
 	})
 
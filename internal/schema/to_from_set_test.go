@@ -19,6 +19,7 @@ func TestToFromSet_renderFrom(t *testing.T) {
 		assocExtType  *AssocExtType
 		elemTypeType  string
 		elemTypeValue string
+		elemTo        string
 		elemFrom      string
 		expected      []byte
 		expectedError error
@@ -62,6 +63,60 @@ types.SetUnknown(types.BoolType),
 }, diags
 }
 
+return ExampleValue{
+l,
+}, diags
+}
+`),
+		},
+		"element-from": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				&schema.AssociatedExternalType{
+					Import: &code.Import{
+						Path: "example.com/apisdk",
+					},
+					Type: "*apisdk.Type",
+				},
+			},
+			elemTypeType:  "types.SetType{\nElemType: types.BoolType,\n}",
+			elemTypeValue: "types.Set",
+			elemTo:        "func(v attr.Value) (result []*bool) {\nreturn\n}",
+			elemFrom:      "func(v []*bool) types.Set {\nreturn types.SetNull(types.BoolType)\n}",
+			expected: []byte(`
+func (v ExampleValue) FromApisdkType(ctx context.Context, apiObject *apisdk.Type) (ExampleValue, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if apiObject == nil {
+return ExampleValue{
+types.SetNull(types.SetType{
+ElemType: types.BoolType,
+}),
+}, diags
+}
+
+elems := make([]types.Set, 0, len(*apiObject))
+
+for _, e := range *apiObject {
+elems = append(elems, func(v []*bool) types.Set {
+return types.SetNull(types.BoolType)
+}(e))
+}
+
+l, d := basetypes.NewSetValueFrom(ctx, types.SetType{
+ElemType: types.BoolType,
+}, elems)
+
+diags.Append(d...)
+
+if diags.HasError() {
+return ExampleValue{
+types.SetUnknown(types.SetType{
+ElemType: types.BoolType,
+}),
+}, diags
+}
+
 return ExampleValue{
 l,
 }, diags
@@ -75,7 +130,7 @@ l,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromSet := NewToFromSet(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom)
+			toFromSet := NewToFromSet(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemTo, testCase.elemFrom, nil)
 
 			got, err := toFromSet.renderFrom()
 
@@ -98,6 +153,7 @@ func TestToFromSet_renderTo(t *testing.T) {
 		assocExtType  *AssocExtType
 		elemTypeType  string
 		elemTypeValue string
+		elemTo        string
 		elemFrom      string
 		expected      []byte
 		expectedError error
@@ -141,6 +197,51 @@ if diags.HasError() {
 return nil, diags
 }
 
+return &apisdkType, diags
+}`),
+		},
+		"element-to": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				&schema.AssociatedExternalType{
+					Import: &code.Import{
+						Path: "example.com/apisdk",
+					},
+					Type: "*apisdk.Type",
+				},
+			},
+			elemTypeType:  "types.ListType{\nElemType: types.BoolType,\n}",
+			elemTypeValue: "types.List",
+			elemTo:        "func(v attr.Value) (result []*bool) {\nreturn\n}",
+			elemFrom:      "func(v []*bool) types.List {\nreturn types.ListNull(types.BoolType)\n}",
+			expected: []byte(`func (v ExampleValue) ToApisdkType(ctx context.Context) (*apisdk.Type, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if v.IsNull() {
+return nil, diags
+}
+
+if v.IsUnknown() {
+diags.Append(diag.NewErrorDiagnostic(
+"ExampleValue Value Is Unknown",
+` + "`" + `"ExampleValue" is unknown.` + "`" + `,
+))
+
+return nil, diags
+}
+
+apisdkType := make(apisdk.Type, 0, len(v.Elements()))
+
+for _, e := range v.Elements() {
+apisdkType = append(apisdkType, func(v attr.Value) (result []*bool) {
+return
+}(e))
+}
+
+if diags.HasError() {
+return nil, diags
+}
+
 return &apisdkType, diags
 }`),
 		},
@@ -151,7 +252,7 @@ return &apisdkType, diags
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromSet := NewToFromSet(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom)
+			toFromSet := NewToFromSet(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemTo, testCase.elemFrom, nil)
 
 			got, err := toFromSet.renderTo()
 
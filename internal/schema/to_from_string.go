@@ -14,10 +14,10 @@ type ToFromString struct {
 	templates    map[string]string
 }
 
-func NewToFromString(name string, assocExtType *AssocExtType) ToFromString {
+func NewToFromString(name string, assocExtType *AssocExtType, gen *Generator) ToFromString {
 	t := map[string]string{
-		"from": StringFromTemplate,
-		"to":   StringToTemplate,
+		"from": gen.template("string_from.gotmpl"),
+		"to":   gen.template("string_to.gotmpl"),
 	}
 
 	return ToFromString{
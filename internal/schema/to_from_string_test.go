@@ -53,7 +53,7 @@ types.StringPointerValue(*apiObject),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromString := NewToFromString(testCase.name, testCase.assocExtType)
+			toFromString := NewToFromString(testCase.name, testCase.assocExtType, nil)
 
 			got, err := toFromString.renderFrom()
 
@@ -115,7 +115,7 @@ return &a, diags
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromString := NewToFromString(testCase.name, testCase.assocExtType)
+			toFromString := NewToFromString(testCase.name, testCase.assocExtType, nil)
 
 			got, err := toFromString.renderTo()
 
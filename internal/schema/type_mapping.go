@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/code"
+)
+
+// TypeMapping defines the Go type which is used for a primitive Terraform
+// type within associated external types, in place of the default pointer
+// type, and the expressions which convert between the Go type and the
+// framework value. The To and From expressions are templates, in which {{.}}
+// is replaced with the framework value and the Go value respectively.
+type TypeMapping struct {
+	GoType string       `json:"go_type"`
+	Import *code.Import `json:"import,omitempty"`
+	To     string       `json:"to"`
+	From   string       `json:"from"`
+
+	to   *template.Template
+	from *template.Template
+}
+
+// ToExpression returns the expression which converts the supplied framework
+// value to the Go type.
+func (t *TypeMapping) ToExpression(value string) string {
+	return executeTypeMapping(t.to, value)
+}
+
+// FromExpression returns the expression which converts the supplied Go value
+// to a framework value.
+func (t *TypeMapping) FromExpression(value string) string {
+	return executeTypeMapping(t.from, value)
+}
+
+// executeTypeMapping ignores errors, as the templates are executed when the
+// type mappings are loaded.
+func executeTypeMapping(t *template.Template, value string) string {
+	var buf bytes.Buffer
+
+	_ = t.Execute(&buf, value)
+
+	return buf.String()
+}
+
+// typeMappingTypes are the Terraform types for which a type mapping can be
+// defined.
+var typeMappingTypes = []string{
+	"bool",
+	"float32",
+	"float64",
+	"int32",
+	"int64",
+	"number",
+	"string",
+}
+
+// TypeMappings holds type mappings keyed by Terraform type.
+type TypeMappings map[string]*TypeMapping
+
+// LoadTypeMappings loads type mappings from the JSON file at the supplied
+// path, which contains an object keyed by Terraform type, for instance:
+//
+//	{
+//	  "int64": {
+//	    "go_type": "int",
+//	    "to": "int({{.}}.ValueInt64())",
+//	    "from": "types.Int64Value(int64({{.}}))"
+//	  }
+//	}
+func LoadTypeMappings(filePath string) (TypeMappings, error) {
+	b, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading type mapping file: %w", err)
+	}
+
+	var mappings TypeMappings
+
+	if err := json.Unmarshal(b, &mappings); err != nil {
+		return nil, fmt.Errorf("error parsing type mapping file %s: %w", filePath, err)
+	}
+
+	var errs []error
+
+	keys := make([]string, 0, len(mappings))
+
+	for k := range mappings {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if err := mappings[k].parse(k); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return mappings, nil
+}
+
+func (t *TypeMapping) parse(terraformType string) error {
+	if !isTypeMappingType(terraformType) {
+		return fmt.Errorf("type mapping %q must be one of %s", terraformType, strings.Join(typeMappingTypes, ", "))
+	}
+
+	if t == nil || t.GoType == "" || t.To == "" || t.From == "" {
+		return fmt.Errorf("type mapping %q must define go_type, to and from", terraformType)
+	}
+
+	if t.Import != nil && t.Import.Path == "" {
+		return fmt.Errorf("type mapping %q import must define path", terraformType)
+	}
+
+	var err error
+
+	t.to, err = parseTypeMappingExpression(terraformType, "to", t.To)
+	if err != nil {
+		return err
+	}
+
+	t.from, err = parseTypeMappingExpression(terraformType, "from", t.From)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func parseTypeMappingExpression(terraformType, name, expression string) (*template.Template, error) {
+	t, err := template.New(name).Parse(expression)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing type mapping %q %s expression: %w", terraformType, name, err)
+	}
+
+	if err := t.Execute(&bytes.Buffer{}, "v"); err != nil {
+		return nil, fmt.Errorf("error executing type mapping %q %s expression: %w", terraformType, name, err)
+	}
+
+	return t, nil
+}
+
+func isTypeMappingType(terraformType string) bool {
+	for _, v := range typeMappingTypes {
+		if v == terraformType {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/greatman/terraform-plugin-codegen-spec/schema"
+)
+
+func TestLoadTypeMappings(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typeMapping   string
+		expectedError string
+		// expectedErrorPath formats the expectedError with the path of the
+		// type mapping file
+		expectedErrorPath bool
+	}{
+		"valid": {
+			typeMapping: `{"string": {"go_type": "string", "to": "{{.}}.ValueString()", "from": "types.StringValue({{.}})"}}`,
+		},
+		"invalid-json": {
+			typeMapping:       `{`,
+			expectedError:     "error parsing type mapping file %s: unexpected end of JSON input",
+			expectedErrorPath: true,
+		},
+		"unknown-type": {
+			typeMapping:   `{"bytes": {"go_type": "[]byte", "to": "{{.}}", "from": "{{.}}"}}`,
+			expectedError: `type mapping "bytes" must be one of bool, float32, float64, int32, int64, number, string`,
+		},
+		"missing-fields": {
+			typeMapping:   `{"int64": {"go_type": "int"}, "string": {"to": "{{.}}", "from": "{{.}}"}}`,
+			expectedError: "type mapping \"int64\" must define go_type, to and from\ntype mapping \"string\" must define go_type, to and from",
+		},
+		"missing-import-path": {
+			typeMapping:   `{"string": {"go_type": "string", "import": {}, "to": "{{.}}", "from": "{{.}}"}}`,
+			expectedError: `type mapping "string" import must define path`,
+		},
+		"invalid-expression": {
+			typeMapping:   `{"string": {"go_type": "string", "to": "{{ .Name ", "from": "{{.}}"}}`,
+			expectedError: `error parsing type mapping "string" to expression: template: to:1: unclosed action`,
+		},
+		"invalid-expression-execution": {
+			typeMapping:   `{"string": {"go_type": "string", "to": "{{.}}", "from": "{{.Value}}"}}`,
+			expectedError: `error executing type mapping "string" from expression: template: from:1:2: executing "from" at <.Value>: can't evaluate field Value in type string`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			typeMappingPath := filepath.Join(t.TempDir(), "type_mapping.json")
+
+			err := os.WriteFile(typeMappingPath, []byte(testCase.typeMapping), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			typeMappings, err := LoadTypeMappings(typeMappingPath)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			expectedError := testCase.expectedError
+
+			if testCase.expectedErrorPath {
+				expectedError = fmt.Sprintf(expectedError, typeMappingPath)
+			}
+
+			if err == nil || err.Error() != expectedError {
+				t.Fatalf("expected error %q, got %v", expectedError, err)
+			}
+
+			if typeMappings != nil {
+				t.Error("expected type mappings not to be returned")
+			}
+		})
+	}
+}
+
+// loadTestTypeMappings loads type mappings which map strings to time.Time,
+// and int64 values to int.
+func loadTestTypeMappings(t *testing.T) TypeMappings {
+	t.Helper()
+
+	typeMappingPath := filepath.Join(t.TempDir(), "type_mapping.json")
+
+	err := os.WriteFile(typeMappingPath, []byte(`{
+  "string": {
+    "go_type": "time.Time",
+    "import": {"path": "time"},
+    "to": "parseTime({{.}}.ValueString())",
+    "from": "types.StringValue({{.}}.Format(time.RFC3339))"
+  },
+  "int64": {
+    "go_type": "int",
+    "import": {"path": "math"},
+    "to": "int({{.}}.ValueInt64())",
+    "from": "types.Int64Value(int64({{.}}))"
+  }
+}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	typeMappings, err := LoadTypeMappings(typeMappingPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return typeMappings
+}
+
+func TestTypeMapping_Conversions(t *testing.T) {
+	t.Parallel()
+
+	gen := NewGenerator(GeneratorOptions{
+		TypeMappings: loadTestTypeMappings(t),
+	})
+
+	goType, err := ElementTypeGoType(schema.ElementType{
+		List: &schema.ListType{
+			ElementType: schema.ElementType{
+				String: &schema.StringType{},
+			},
+		},
+	}, gen)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(goType, "[]time.Time"); diff != "" {
+		t.Errorf("unexpected Go type difference: %s", diff)
+	}
+
+	to, err := ObjectFieldTo(schema.ObjectAttributeType{
+		Name:   "created",
+		String: &schema.StringType{},
+	}, gen)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedTo := ObjectField{
+		GoType: "time.Time",
+		Type:   "types.String",
+		ToFuncLiteral: `func(v attr.Value) (result time.Time) {
+valuable, ok := v.(basetypes.StringValuable)
+
+if !ok {
+diags.AddError("Unexpected Value Type", fmt.Sprintf(` + "`expected basetypes.StringValuable, was: %T`" + `, v))
+
+return
+}
+
+p, d := valuable.ToStringValue(ctx)
+
+diags.Append(d...)
+
+return parseTime(p.ValueString())
+}`,
+	}
+
+	if diff := cmp.Diff(to, expectedTo); diff != "" {
+		t.Errorf("unexpected to difference: %s", diff)
+	}
+
+	from, err := ObjectFieldFrom(schema.ObjectAttributeType{
+		Name:   "created",
+		String: &schema.StringType{},
+	}, gen)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedFrom := ObjectField{
+		Type: "types.StringType",
+		FromFuncLiteral: `func(v time.Time) types.String {
+return types.StringValue(v.Format(time.RFC3339))
+}`,
+	}
+
+	if diff := cmp.Diff(from, expectedFrom); diff != "" {
+		t.Errorf("unexpected from difference: %s", diff)
+	}
+
+	// the int64 type mapping is not used, so its import is not recorded
+	imports := gen.Imports().All()
+
+	if len(imports) != 1 || imports[0].Path != "time" {
+		t.Errorf("expected time import, got: %v", imports)
+	}
+}
+
+// toFromAttribute generates to/from functions which convert a list of
+// strings, or returns an unimplemented error, once the Go type of the list
+// has been determined.
+type toFromAttribute struct {
+	GeneratorAttribute
+
+	unimplemented bool
+}
+
+func (a toFromAttribute) ToFromFunctions(name string, gen *Generator) ([]byte, error) {
+	goType, err := ElementTypeGoType(schema.ElementType{
+		List: &schema.ListType{
+			ElementType: schema.ElementType{
+				String: &schema.StringType{},
+			},
+		},
+	}, gen)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.unimplemented {
+		return nil, NewUnimplementedError(errors.New("not yet implemented"))
+	}
+
+	return []byte("var " + name + " " + goType + "\n"), nil
+}
+
+func TestGeneratorSchemas_TypeMappingImports(t *testing.T) {
+	t.Parallel()
+
+	g := NewGeneratorSchemas(map[string]GeneratorSchema{
+		"example": {
+			Attributes: GeneratorAttributes{
+				"created": toFromAttribute{},
+			},
+		},
+		"example_unimplemented": {
+			Attributes: GeneratorAttributes{
+				"created": toFromAttribute{
+					unimplemented: true,
+				},
+			},
+		},
+	}, GeneratorOptions{
+		TypeMappings: loadTestTypeMappings(t),
+	})
+
+	_, err := g.ToFromFunctions(context.Background(), slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string][]string{
+		"example": {"time"},
+		// the to/from functions are not generated, so the type mapping
+		// is not used
+		"example_unimplemented": nil,
+	}
+
+	for name, expected := range testCases {
+		var paths []string
+
+		for _, i := range g.generators[name].Imports().All() {
+			paths = append(paths, i.Path)
+		}
+
+		if diff := cmp.Diff(paths, expected); diff != "" {
+			t.Errorf("unexpected %s imports difference: %s", name, diff)
+		}
+	}
+}
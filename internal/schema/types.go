@@ -9,6 +9,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
 )
 
+// AssociatedExternalTyped is implemented by nested attributes and blocks,
+// which have generated To and From functions when an associated external
+// type is defined.
+type AssociatedExternalTyped interface {
+	AssocExtType() *AssocExtType
+}
+
 type Attributes interface {
 	GetAttributes() GeneratorAttributes
 }
@@ -23,7 +30,11 @@ type Blocks interface {
 }
 
 type CustomTypeAndValue interface {
-	CustomTypeAndValue(name string) ([]byte, error)
+	CustomTypeAndValue(name string, gen *Generator) ([]byte, error)
+}
+
+type Documented interface {
+	Documentation() Documentation
 }
 
 type Elements interface {
@@ -59,7 +70,7 @@ type GeneratorBlock interface {
 }
 
 type ToFrom interface {
-	ToFromFunctions(name string) ([]byte, error)
+	ToFromFunctions(name string, gen *Generator) ([]byte, error)
 }
 
 type ToFromConversion struct {
@@ -67,12 +78,23 @@ type ToFromConversion struct {
 	AssocExtType   *AssocExtType
 	CollectionType CollectionFields
 	ObjectType     map[FrameworkIdentifier]ObjectField
+	// ConfigOnly is set for write-only attributes, the values of which are
+	// only available in configuration, and are therefore always null when
+	// converting from an associated external type.
+	ConfigOnly bool
+	// TypeMapping is set for primitive attributes, if a type mapping has
+	// been loaded for the type, and is used in place of Default.
+	TypeMapping *TypeMapping
 }
 
 type CollectionFields struct {
 	ElementType   string
 	GoType        string
 	TypeValueFrom string
+	// ToFunc and FromFunc are function literals, which are set for
+	// collections with collection or object element types.
+	ToFunc   string
+	FromFunc string
 }
 
 type ObjectField struct {
@@ -80,14 +102,18 @@ type ObjectField struct {
 	GoType   string
 	Type     string
 	ToFunc   string
+	// ToFuncLiteral and FromFuncLiteral are set in place of ToFunc and
+	// FromFunc for collection and object attribute types.
+	ToFuncLiteral   string
+	FromFuncLiteral string
 }
 
 type To interface {
-	To() (ToFromConversion, error)
+	To(gen *Generator) (ToFromConversion, error)
 }
 
 type From interface {
-	From() (ToFromConversion, error)
+	From(gen *Generator) (ToFromConversion, error)
 }
 
 type Type int64
@@ -111,4 +137,6 @@ const (
 	GeneratorSingleNestedBlock
 	GeneratorStringAttribute
 	GeneratorInt32Attribute
+	GeneratorDynamicAttribute
+	GeneratorFloat32Attribute
 )
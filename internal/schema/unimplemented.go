@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/logging"
+)
+
+type unimplementedTrackerKey struct{}
+
+// UnimplementedTracker determines how an UnimplementedError which is returned
+// when generating to/from functions is handled. If strict, code generation
+// fails, otherwise the error is logged and the path which is skipped is
+// recorded, so that all skipped paths can be summarised.
+type UnimplementedTracker struct {
+	strict  bool
+	skipped []SkippedPath
+}
+
+// SkippedPath is a path for which the generation of to/from functions was
+// skipped, as it returned an UnimplementedError.
+type SkippedPath struct {
+	// Path is the dot-separated path of the attribute or block, including
+	// the schema being processed.
+	Path string
+
+	// UnimplementedPath is the value of UnimplementedError.Path(), which is
+	// relative to the attribute or block.
+	UnimplementedPath string
+
+	// Err is the UnimplementedError.
+	Err error
+}
+
+// NewUnimplementedTracker returns an UnimplementedTracker which fails code
+// generation on an UnimplementedError if strict is true.
+func NewUnimplementedTracker(strict bool) *UnimplementedTracker {
+	return &UnimplementedTracker{
+		strict: strict,
+	}
+}
+
+// Skipped returns the paths for which the generation of to/from functions was
+// skipped, in the order that they were encountered.
+func (t *UnimplementedTracker) Skipped() []SkippedPath {
+	if t == nil {
+		return nil
+	}
+
+	return t.skipped
+}
+
+// SetUnimplementedTrackerInContext is used to supply the UnimplementedTracker
+// to use when generating to/from functions.
+func SetUnimplementedTrackerInContext(ctx context.Context, t *UnimplementedTracker) context.Context {
+	return context.WithValue(ctx, unimplementedTrackerKey{}, t)
+}
+
+// getUnimplementedTrackerFromContext returns the UnimplementedTracker, or nil
+// if none has been set, in which case an UnimplementedError is only logged.
+func getUnimplementedTrackerFromContext(ctx context.Context) *UnimplementedTracker {
+	if v, ok := ctx.Value(unimplementedTrackerKey{}).(*UnimplementedTracker); ok {
+		return v
+	}
+
+	return nil
+}
+
+func (t *UnimplementedTracker) isStrict() bool {
+	return t != nil && t.strict
+}
+
+func (t *UnimplementedTracker) skip(ctx context.Context, name string, err *UnimplementedError) {
+	if t == nil {
+		return
+	}
+
+	t.skipped = append(t.skipped, SkippedPath{
+		Path:              unimplementedPath(ctx, name, err),
+		UnimplementedPath: err.Path(),
+		Err:               err,
+	})
+}
+
+// unimplementedPath returns the dot-separated path of an UnimplementedError
+// returned for the named attribute or block, including the path from the
+// context.
+func unimplementedPath(ctx context.Context, name string, err *UnimplementedError) string {
+	var p []string
+
+	for _, v := range []string{logging.GetPathFromContext(ctx), name, err.Path()} {
+		if v != "" {
+			p = append(p, v)
+		}
+	}
+
+	return strings.Join(p, ".")
+}
@@ -6,12 +6,153 @@ package validate
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/greatman/terraform-plugin-codegen-spec/spec"
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diagnostic"
 )
 
+// JSON returns an error if the input is not valid JSON. The error includes
+// the byte offset of invalid syntax, if known.
 func JSON(input []byte) error {
-	if !json.Valid(input) {
-		return errors.New("invalid JSON")
+	if json.Valid(input) {
+		return nil
+	}
+
+	var v any
+
+	err := json.Unmarshal(input, &v)
+
+	var syntaxErr *json.SyntaxError
+
+	if errors.As(err, &syntaxErr) {
+		// the offset follows the invalid character
+		offset := max(syntaxErr.Offset-1, 0)
+
+		return diagnostic.NewOffsetError(fmt.Errorf("invalid JSON: %w", syntaxErr), offset)
+	}
+
+	return errors.New("invalid JSON")
+}
+
+// TokensFunc replaces the reference tokens of the JSON pointer of a value
+// within the validated document with those of the value within the document
+// from which it was derived.
+type TokensFunc func(tokens []string) []string
+
+// Specification validates the document, from which the additions to the
+// specification have been removed, against the Provider Code Specification
+// JSON schema. Every validation error is returned, rather than only the
+// last as with spec.Parse, each as a diagnostic.Error with the JSON pointer
+// of the invalid value, as replaced by the optional TokensFunc.
+func Specification(document []byte, tokensFunc TokensFunc) error {
+	var versionedDocument struct {
+		Version string `json:"version"`
+	}
+
+	if err := json.Unmarshal(document, &versionedDocument); err != nil {
+		return err
+	}
+
+	if versionedDocument.Version == "" {
+		return diagnostic.NewError(errors.New("version is required"), "version")
+	}
+
+	var schemaVersion []byte
+
+	switch versionedDocument.Version {
+	case spec.Version0_1:
+		schemaVersion = spec.JSONSchemaVersion0_1
+	default:
+		return diagnostic.NewError(fmt.Errorf("version %q is unsupported", versionedDocument.Version), "version")
+	}
+
+	return jsonSchema(schemaVersion, document, tokensFunc)
+}
+
+// EphemeralResources validates the ephemeral resources of the document
+// against the Provider Code Specification JSON schema for data sources, as the
+// schemas of both support the same attributes and blocks. The JSON pointers
+// of errors are replaced by the optional TokensFunc, as with Specification.
+func EphemeralResources(document []byte, tokensFunc TokensFunc) error {
+	var d struct {
+		EphemeralResources json.RawMessage `json:"ephemeral_resources"`
+		Version            string          `json:"version"`
+	}
+
+	if err := json.Unmarshal(document, &d); err != nil {
+		return err
+	}
+
+	if len(d.EphemeralResources) == 0 || d.Version != spec.Version0_1 {
+		return nil
+	}
+
+	dataSourcesDocument, err := json.Marshal(map[string]any{
+		"datasources": d.EphemeralResources,
+		"provider": map[string]string{
+			"name": "ephemeral_resources",
+		},
+		"version": d.Version,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return jsonSchema(spec.JSONSchemaVersion0_1, dataSourcesDocument, func(tokens []string) []string {
+		if len(tokens) > 0 && tokens[0] == "datasources" {
+			tokens = append([]string{"ephemeral_resources"}, tokens[1:]...)
+		}
+
+		if tokensFunc != nil {
+			tokens = tokensFunc(tokens)
+		}
+
+		return tokens
+	})
+}
+
+// jsonSchema validates the document against the JSON schema, replacing the
+// reference tokens of each JSON pointer using the optional TokensFunc. The
+// field within the description of each error, which is the dot-separated
+// reference tokens, is replaced in the same way.
+func jsonSchema(schema, document []byte, tokensFunc TokensFunc) error {
+	result, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(schema), gojsonschema.NewBytesLoader(document))
+
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, resultError := range result.Errors() {
+		// the context is joined with a separator which cannot occur in keys
+		tokens := strings.Split(resultError.Context().String("\x00"), "\x00")[1:]
+
+		description := resultError.Description()
+
+		if tokensFunc != nil {
+			replaced := tokensFunc(tokens)
+
+			if field := strings.Join(tokens, "."); field != "" {
+				description = strings.ReplaceAll(description, field, strings.Join(replaced, "."))
+			}
+
+			tokens = replaced
+		}
+
+		pointer := make([]any, 0, len(tokens))
+
+		for _, t := range tokens {
+			pointer = append(pointer, t)
+		}
+
+		errs = append(errs, diagnostic.NewError(errors.New(description), pointer...))
 	}
 
-	return nil
+	return errors.Join(errs...)
 }